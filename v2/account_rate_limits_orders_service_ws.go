@@ -0,0 +1,175 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AccountRateLimitsOrdersWsService queries the current order count usage of all intervals
+type AccountRateLimitsOrdersWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
+	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
+}
+
+// NewAccountRateLimitsOrdersWsService init AccountRateLimitsOrdersWsService
+func NewAccountRateLimitsOrdersWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*AccountRateLimitsOrdersWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountRateLimitsOrdersWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
+	}, nil
+}
+
+// NewAccountRateLimitsOrdersWsServiceWithSession init AccountRateLimitsOrdersWsService sending its requests on the connection of session
+func NewAccountRateLimitsOrdersWsServiceWithSession(session *SessionWsService) *AccountRateLimitsOrdersWsService {
+	return &AccountRateLimitsOrdersWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
+// AccountRateLimitsOrdersWsRequest parameters for 'account.rateLimits.orders' websocket API
+type AccountRateLimitsOrdersWsRequest struct {
+	recvWindow *uint16
+}
+
+// NewAccountRateLimitsOrdersWsRequest init AccountRateLimitsOrdersWsRequest
+func NewAccountRateLimitsOrdersWsRequest() *AccountRateLimitsOrdersWsRequest {
+	return &AccountRateLimitsOrdersWsRequest{}
+}
+
+func (s *AccountRateLimitsOrdersWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AccountRateLimitsOrdersWsRequest) buildParams() params {
+	m := params{}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'account.rateLimits.orders' request
+func (s *AccountRateLimitsOrdersWsService) Do(requestID string, request *AccountRateLimitsOrdersWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.AccountRateLimitsOrdersSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'account.rateLimits.orders' request and receives response
+func (s *AccountRateLimitsOrdersWsService) SyncDo(requestID string, request *AccountRateLimitsOrdersWsRequest) (*AccountRateLimitsOrdersWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.AccountRateLimitsOrdersSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	accountRateLimitsOrdersWsResponse := &AccountRateLimitsOrdersWsResponse{}
+	if err := json.Unmarshal(response, accountRateLimitsOrdersWsResponse); err != nil {
+		return nil, err
+	}
+	if accountRateLimitsOrdersWsResponse.Error != nil {
+		accountRateLimitsOrdersWsResponse.Error.StatusCode = accountRateLimitsOrdersWsResponse.Status
+		s.TimeSync.CheckError(accountRateLimitsOrdersWsResponse.Error)
+		return nil, accountRateLimitsOrdersWsResponse.Error
+	}
+
+	return accountRateLimitsOrdersWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AccountRateLimitsOrdersWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AccountRateLimitsOrdersWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AccountRateLimitsOrdersWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AccountRateLimitsOrdersWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// RecvWindow set recvWindow
+func (s *AccountRateLimitsOrdersWsRequest) RecvWindow(recvWindow uint16) *AccountRateLimitsOrdersWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// AccountRateLimitsOrdersWsResponse define 'account.rateLimits.orders' websocket API response
+type AccountRateLimitsOrdersWsResponse struct {
+	Id     string           `json:"id"`
+	Status int              `json:"status"`
+	Result []*RateLimitFull `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,108 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+	s.timeOffset = 0
+
+	s.requestID = "a9d64ef4-a993-414d-ab58-b2d1e4954117"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.accountRateLimitsOrders = &AccountRateLimitsOrdersWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.accountRateLimitsOrdersRequest = NewAccountRateLimitsOrdersWsRequest()
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type accountRateLimitsOrdersServiceWsTestSuite struct {
+	suite.Suite
+	apiKey     string
+	secretKey  string
+	signedKey  string
+	timeOffset int64
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	accountRateLimitsOrders        *AccountRateLimitsOrdersWsService
+	accountRateLimitsOrdersRequest *AccountRateLimitsOrdersWsRequest
+}
+
+func TestAccountRateLimitsOrdersServiceWs(t *testing.T) {
+	suite.Run(t, new(accountRateLimitsOrdersServiceWsTestSuite))
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestAccountRateLimitsOrders() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.accountRateLimitsOrders.Do(s.requestID, s.accountRateLimitsOrdersRequest)
+	s.NoError(err)
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestAccountRateLimitsOrders_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.accountRateLimitsOrders.Do("", s.accountRateLimitsOrdersRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestAccountRateLimitsOrders_EmptyApiKey() {
+	s.accountRateLimitsOrders.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.accountRateLimitsOrders.Do(s.requestID, s.accountRateLimitsOrdersRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestAccountRateLimitsOrdersSync() {
+	rawResponseData := []byte(`{"id":"a9d64ef4-a993-414d-ab58-b2d1e4954117","status":200,"result":[{"rateLimitType":"ORDERS","interval":"SECOND","intervalNum":10,"limit":50,"count":0},{"rateLimitType":"ORDERS","interval":"DAY","intervalNum":1,"limit":160000,"count":0}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.accountRateLimitsOrders.SyncDo(s.requestID, s.accountRateLimitsOrdersRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 2)
+	s.Equal(160000, response.Result[1].Limit)
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestAccountRateLimitsOrdersSync_Error() {
+	rawResponseData := []byte(`{"id":"a9d64ef4-a993-414d-ab58-b2d1e4954117","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.accountRateLimitsOrders.SyncDo(s.requestID, s.accountRateLimitsOrdersRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestAccountRateLimitsOrdersSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.accountRateLimitsOrders.SyncDo(s.requestID, s.accountRateLimitsOrdersRequest)
+	s.Nil(response)
+	s.Error(err)
+}
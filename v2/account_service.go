@@ -60,6 +60,8 @@ type Account struct {
 }
 
 // Balance define user balance of your account
+//
+//decimalgen:accessors
 type Balance struct {
 	Asset  string `json:"asset"`
 	Free   string `json:"free"`
@@ -151,6 +153,8 @@ type SnapshotVos struct {
 }
 
 // SnapshotData define content of a snapshot
+//
+//decimalgen:accessors
 type SnapshotData struct {
 	MarginLevel         string `json:"marginLevel"`
 	TotalAssetOfBtc     string `json:"totalAssetOfBtc"`
@@ -164,6 +168,8 @@ type SnapshotData struct {
 }
 
 // SnapshotBalances define snapshot balances
+//
+//decimalgen:accessors
 type SnapshotBalances struct {
 	Asset  string `json:"asset"`
 	Free   string `json:"free"`
@@ -171,6 +177,8 @@ type SnapshotBalances struct {
 }
 
 // SnapshotUserAssets define snapshot user assets
+//
+//decimalgen:accessors
 type SnapshotUserAssets struct {
 	Asset    string `json:"asset"`
 	Borrowed string `json:"borrowed"`
@@ -181,6 +189,8 @@ type SnapshotUserAssets struct {
 }
 
 // SnapshotAssets define snapshot assets
+//
+//decimalgen:accessors
 type SnapshotAssets struct {
 	Asset         string `json:"asset"`
 	MarginBalance string `json:"marginBalance"`
@@ -188,6 +198,8 @@ type SnapshotAssets struct {
 }
 
 // SnapshotPositions define snapshot positions
+//
+//decimalgen:accessors
 type SnapshotPositions struct {
 	EntryPrice       string `json:"entryPrice"`
 	MarkPrice        string `json:"markPrice"`
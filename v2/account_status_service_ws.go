@@ -0,0 +1,185 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AccountStatusWsService queries account information
+type AccountStatusWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
+	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
+}
+
+// NewAccountStatusWsService init AccountStatusWsService
+func NewAccountStatusWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*AccountStatusWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountStatusWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
+	}, nil
+}
+
+// NewAccountStatusWsServiceWithSession init AccountStatusWsService sending its requests on the connection of session
+func NewAccountStatusWsServiceWithSession(session *SessionWsService) *AccountStatusWsService {
+	return &AccountStatusWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
+// AccountStatusWsRequest parameters for 'account.status' websocket API
+type AccountStatusWsRequest struct {
+	omitZeroBalances *bool
+	recvWindow       *uint16
+}
+
+// NewAccountStatusWsRequest init AccountStatusWsRequest
+func NewAccountStatusWsRequest() *AccountStatusWsRequest {
+	return &AccountStatusWsRequest{}
+}
+
+func (s *AccountStatusWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AccountStatusWsRequest) buildParams() params {
+	m := params{}
+	if s.omitZeroBalances != nil {
+		m["omitZeroBalances"] = *s.omitZeroBalances
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'account.status' request
+func (s *AccountStatusWsService) Do(requestID string, request *AccountStatusWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.AccountStatusSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'account.status' request and receives response
+func (s *AccountStatusWsService) SyncDo(requestID string, request *AccountStatusWsRequest) (*AccountStatusWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.AccountStatusSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	accountStatusWsResponse := &AccountStatusWsResponse{}
+	if err := json.Unmarshal(response, accountStatusWsResponse); err != nil {
+		return nil, err
+	}
+	if accountStatusWsResponse.Error != nil {
+		accountStatusWsResponse.Error.StatusCode = accountStatusWsResponse.Status
+		s.TimeSync.CheckError(accountStatusWsResponse.Error)
+		return nil, accountStatusWsResponse.Error
+	}
+
+	return accountStatusWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AccountStatusWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AccountStatusWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AccountStatusWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AccountStatusWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// OmitZeroBalances set omitZeroBalances
+func (s *AccountStatusWsRequest) OmitZeroBalances(omitZeroBalances bool) *AccountStatusWsRequest {
+	s.omitZeroBalances = &omitZeroBalances
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *AccountStatusWsRequest) RecvWindow(recvWindow uint16) *AccountStatusWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// AccountStatusWsResponse define 'account.status' websocket API response
+type AccountStatusWsResponse struct {
+	Id     string  `json:"id"`
+	Status int     `json:"status"`
+	Result Account `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
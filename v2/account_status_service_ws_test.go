@@ -0,0 +1,109 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *accountStatusServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+	s.timeOffset = 0
+
+	s.requestID = "385023c0-faff-4f8e-844b-617e8f2ba887"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.accountStatus = &AccountStatusWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.accountStatusRequest = NewAccountStatusWsRequest().
+		OmitZeroBalances(true)
+}
+
+func (s *accountStatusServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type accountStatusServiceWsTestSuite struct {
+	suite.Suite
+	apiKey     string
+	secretKey  string
+	signedKey  string
+	timeOffset int64
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	accountStatus        *AccountStatusWsService
+	accountStatusRequest *AccountStatusWsRequest
+}
+
+func TestAccountStatusServiceWs(t *testing.T) {
+	suite.Run(t, new(accountStatusServiceWsTestSuite))
+}
+
+func (s *accountStatusServiceWsTestSuite) TestAccountStatus() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.accountStatus.Do(s.requestID, s.accountStatusRequest)
+	s.NoError(err)
+}
+
+func (s *accountStatusServiceWsTestSuite) TestAccountStatus_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.accountStatus.Do("", s.accountStatusRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *accountStatusServiceWsTestSuite) TestAccountStatus_EmptyApiKey() {
+	s.accountStatus.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.accountStatus.Do(s.requestID, s.accountStatusRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *accountStatusServiceWsTestSuite) TestAccountStatusSync() {
+	rawResponseData := []byte(`{"id":"385023c0-faff-4f8e-844b-617e8f2ba887","status":200,"result":{"makerCommission":15,"takerCommission":15,"buyerCommission":0,"sellerCommission":0,"canTrade":true,"canWithdraw":true,"canDeposit":true,"updateTime":1660801833000,"accountType":"SPOT","balances":[{"asset":"BNB","free":"0.00000000","locked":"0.00000000"},{"asset":"BTC","free":"1.3447112","locked":"0.08600000"}],"permissions":["SPOT"]}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.accountStatus.SyncDo(s.requestID, s.accountStatusRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result.Balances, 2)
+	s.Equal("0.08600000", response.Result.Balances[1].Locked)
+}
+
+func (s *accountStatusServiceWsTestSuite) TestAccountStatusSync_Error() {
+	rawResponseData := []byte(`{"id":"385023c0-faff-4f8e-844b-617e8f2ba887","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.accountStatus.SyncDo(s.requestID, s.accountStatusRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *accountStatusServiceWsTestSuite) TestAccountStatusSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.accountStatus.SyncDo(s.requestID, s.accountStatusRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -0,0 +1,174 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AggTradesWsService queries aggregate trades
+type AggTradesWsService struct {
+	c websocket.Client
+}
+
+// NewAggTradesWsService init AggTradesWsService
+func NewAggTradesWsService() (*AggTradesWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AggTradesWsService{
+		c: client,
+	}, nil
+}
+
+// NewAggTradesWsServiceWithSession init AggTradesWsService sending its requests on the connection of session
+func NewAggTradesWsServiceWithSession(session *SessionWsService) *AggTradesWsService {
+	return &AggTradesWsService{
+		c: session.c,
+	}
+}
+
+// AggTradesWsRequest parameters for 'trades.aggregate' websocket API
+type AggTradesWsRequest struct {
+	symbol    string
+	fromID    *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// NewAggTradesWsRequest init AggTradesWsRequest
+func NewAggTradesWsRequest() *AggTradesWsRequest {
+	return &AggTradesWsRequest{}
+}
+
+func (s *AggTradesWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AggTradesWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.fromID != nil {
+		m["fromId"] = *s.fromID
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'trades.aggregate' request
+func (s *AggTradesWsService) Do(requestID string, request *AggTradesWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TradesAggregateSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'trades.aggregate' request and receives response
+func (s *AggTradesWsService) SyncDo(requestID string, request *AggTradesWsRequest) (*AggTradesWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TradesAggregateSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	aggTradesWsResponse := &AggTradesWsResponse{}
+	if err := json.Unmarshal(response, aggTradesWsResponse); err != nil {
+		return nil, err
+	}
+	if aggTradesWsResponse.Error != nil {
+		aggTradesWsResponse.Error.StatusCode = aggTradesWsResponse.Status
+		return nil, aggTradesWsResponse.Error
+	}
+
+	return aggTradesWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AggTradesWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AggTradesWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AggTradesWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AggTradesWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *AggTradesWsRequest) Symbol(symbol string) *AggTradesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// FromID set fromId
+func (s *AggTradesWsRequest) FromID(fromID int64) *AggTradesWsRequest {
+	s.fromID = &fromID
+	return s
+}
+
+// StartTime set startTime
+func (s *AggTradesWsRequest) StartTime(startTime int64) *AggTradesWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *AggTradesWsRequest) EndTime(endTime int64) *AggTradesWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *AggTradesWsRequest) Limit(limit int) *AggTradesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// AggTradesWsResponse define 'trades.aggregate' websocket API response
+type AggTradesWsResponse struct {
+	Id     string      `json:"id"`
+	Status int         `json:"status"`
+	Result []*AggTrade `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,99 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *aggTradesServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.aggTrades = &AggTradesWsService{
+		c: s.client,
+	}
+
+	s.aggTradesRequest = NewAggTradesWsRequest().
+		Symbol("BNBBTC").
+		FromID(50000000).
+		Limit(1)
+}
+
+func (s *aggTradesServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type aggTradesServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	aggTrades        *AggTradesWsService
+	aggTradesRequest *AggTradesWsRequest
+}
+
+func TestAggTradesServiceWs(t *testing.T) {
+	suite.Run(t, new(aggTradesServiceWsTestSuite))
+}
+
+func (s *aggTradesServiceWsTestSuite) TestAggTrades() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.aggTrades.Do(s.requestID, s.aggTradesRequest)
+	s.NoError(err)
+}
+
+func (s *aggTradesServiceWsTestSuite) TestAggTrades_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.aggTrades.Do("", s.aggTradesRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *aggTradesServiceWsTestSuite) TestAggTradesSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":[{"a":50000000,"p":"0.00274100","q":"57.19000000","f":59120167,"l":59120170,"T":1565877971222,"m":true,"M":true}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.aggTrades.SyncDo(s.requestID, s.aggTradesRequest)
+	s.Require().NoError(err)
+	s.Equal([]*AggTrade{{
+		AggTradeID:       50000000,
+		Price:            "0.00274100",
+		Quantity:         "57.19000000",
+		FirstTradeID:     59120167,
+		LastTradeID:      59120170,
+		Timestamp:        1565877971222,
+		IsBuyerMaker:     true,
+		IsBestPriceMatch: true,
+	}}, response.Result)
+}
+
+func (s *aggTradesServiceWsTestSuite) TestAggTradesSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.aggTrades.SyncDo(s.requestID, s.aggTradesRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *aggTradesServiceWsTestSuite) TestAggTradesSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.aggTrades.SyncDo(s.requestID, s.aggTradesRequest)
+	s.Nil(response)
+	s.Error(err)
+}
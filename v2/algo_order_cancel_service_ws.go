@@ -15,11 +15,15 @@ type AlgoOrderCancelWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewAlgoOrderCancelWsService init AlgoOrderCancelWsService
-func NewAlgoOrderCancelWsService(apiKey, secretKey string) (*AlgoOrderCancelWsService, error) {
+func NewAlgoOrderCancelWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*AlgoOrderCancelWsService, error) {
 	conn, err := websocket.NewConnection(futures.WsApiInitReadWriteConn, futures.WebsocketKeepalive, futures.WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -35,9 +39,24 @@ func NewAlgoOrderCancelWsService(apiKey, secretKey string) (*AlgoOrderCancelWsSe
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewAlgoOrderCancelWsServiceWithSession init AlgoOrderCancelWsService sending its requests on the connection of session
+func NewAlgoOrderCancelWsServiceWithSession(session *SessionWsService) *AlgoOrderCancelWsService {
+	return &AlgoOrderCancelWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // AlgoOrderCancelWsRequest parameters for 'algoOrder.cancel' websocket API
 type AlgoOrderCancelWsRequest struct {
 	algoId       *int64
@@ -115,9 +134,9 @@ func (s *AlgoOrderCancelWsService) Do(requestID string, request *AlgoOrderCancel
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		method,
 		request.buildParams(),
 	)
@@ -142,9 +161,9 @@ func (s *AlgoOrderCancelWsService) SyncDo(requestID string, request *AlgoOrderCa
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		method,
 		request.buildParams(),
 	)
@@ -161,6 +180,11 @@ func (s *AlgoOrderCancelWsService) SyncDo(requestID string, request *AlgoOrderCa
 	if err := json.Unmarshal(response, cancelAlgoOrderWsResponse); err != nil {
 		return nil, err
 	}
+	if cancelAlgoOrderWsResponse.Error != nil {
+		cancelAlgoOrderWsResponse.Error.StatusCode = cancelAlgoOrderWsResponse.Status
+		s.TimeSync.CheckError(cancelAlgoOrderWsResponse.Error)
+		return nil, cancelAlgoOrderWsResponse.Error
+	}
 
 	return cancelAlgoOrderWsResponse, nil
 }
@@ -15,11 +15,15 @@ type AlgoOrderPlaceWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewAlgoOrderPlaceWsService init AlgoOrderPlaceWsService
-func NewAlgoOrderPlaceWsService(apiKey, secretKey string) (*AlgoOrderPlaceWsService, error) {
+func NewAlgoOrderPlaceWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*AlgoOrderPlaceWsService, error) {
 	conn, err := websocket.NewConnection(futures.WsApiInitReadWriteConn, futures.WebsocketKeepalive, futures.WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -35,9 +39,24 @@ func NewAlgoOrderPlaceWsService(apiKey, secretKey string) (*AlgoOrderPlaceWsServ
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewAlgoOrderPlaceWsServiceWithSession init AlgoOrderPlaceWsService sending its requests on the connection of session
+func NewAlgoOrderPlaceWsServiceWithSession(session *SessionWsService) *AlgoOrderPlaceWsService {
+	return &AlgoOrderPlaceWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // AlgoOrderPlaceWsRequest parameters for 'algoOrder.place' websocket API
 type AlgoOrderPlaceWsRequest struct {
 	algoType         futures.OrderAlgoType
@@ -229,9 +248,9 @@ func (s *AlgoOrderPlaceWsService) SyncDo(requestID string, request *AlgoOrderPla
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		method,
 		request.buildParams(),
 	)
@@ -248,6 +267,11 @@ func (s *AlgoOrderPlaceWsService) SyncDo(requestID string, request *AlgoOrderPla
 	if err := json.Unmarshal(response, createAlgoOrderWsResponse); err != nil {
 		return nil, err
 	}
+	if createAlgoOrderWsResponse.Error != nil {
+		createAlgoOrderWsResponse.Error.StatusCode = createAlgoOrderWsResponse.Status
+		s.TimeSync.CheckError(createAlgoOrderWsResponse.Error)
+		return nil, createAlgoOrderWsResponse.Error
+	}
 
 	return createAlgoOrderWsResponse, nil
 }
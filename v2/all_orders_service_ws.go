@@ -0,0 +1,224 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AllOrdersWsService queries all orders of a symbol; active, canceled, or filled
+type AllOrdersWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
+	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
+}
+
+// NewAllOrdersWsService init AllOrdersWsService
+func NewAllOrdersWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*AllOrdersWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AllOrdersWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
+	}, nil
+}
+
+// NewAllOrdersWsServiceWithSession init AllOrdersWsService sending its requests on the connection of session
+func NewAllOrdersWsServiceWithSession(session *SessionWsService) *AllOrdersWsService {
+	return &AllOrdersWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
+// AllOrdersWsRequest parameters for 'allOrders' websocket API
+type AllOrdersWsRequest struct {
+	symbol     string
+	orderID    *int64
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+	recvWindow *uint16
+}
+
+// NewAllOrdersWsRequest init AllOrdersWsRequest
+func NewAllOrdersWsRequest() *AllOrdersWsRequest {
+	return &AllOrdersWsRequest{}
+}
+
+func (s *AllOrdersWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AllOrdersWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'allOrders' request
+func (s *AllOrdersWsService) Do(requestID string, request *AllOrdersWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.AllOrdersSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'allOrders' request and receives response
+func (s *AllOrdersWsService) SyncDo(requestID string, request *AllOrdersWsRequest) (*AllOrdersWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.AllOrdersSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	allOrdersWsResponse := &AllOrdersWsResponse{}
+	if err := json.Unmarshal(response, allOrdersWsResponse); err != nil {
+		return nil, err
+	}
+	if allOrdersWsResponse.Error != nil {
+		allOrdersWsResponse.Error.StatusCode = allOrdersWsResponse.Status
+		s.TimeSync.CheckError(allOrdersWsResponse.Error)
+		return nil, allOrdersWsResponse.Error
+	}
+
+	return allOrdersWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AllOrdersWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AllOrdersWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AllOrdersWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AllOrdersWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *AllOrdersWsRequest) Symbol(symbol string) *AllOrdersWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *AllOrdersWsRequest) OrderID(orderID int64) *AllOrdersWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set startTime
+func (s *AllOrdersWsRequest) StartTime(startTime int64) *AllOrdersWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *AllOrdersWsRequest) EndTime(endTime int64) *AllOrdersWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *AllOrdersWsRequest) Limit(limit int) *AllOrdersWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *AllOrdersWsRequest) RecvWindow(recvWindow uint16) *AllOrdersWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// AllOrdersWsResponse define 'allOrders' websocket API response
+type AllOrdersWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result []*Order `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
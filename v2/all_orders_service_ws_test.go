@@ -0,0 +1,111 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *allOrdersServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+	s.timeOffset = 0
+
+	s.requestID = "6f2bdd89-919a-4931-b802-f8336170f18d"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.allOrders = &AllOrdersWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.allOrdersRequest = NewAllOrdersWsRequest().
+		Symbol("BTCUSDT").
+		StartTime(1660780800000).
+		Limit(5)
+}
+
+func (s *allOrdersServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type allOrdersServiceWsTestSuite struct {
+	suite.Suite
+	apiKey     string
+	secretKey  string
+	signedKey  string
+	timeOffset int64
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	allOrders        *AllOrdersWsService
+	allOrdersRequest *AllOrdersWsRequest
+}
+
+func TestAllOrdersServiceWs(t *testing.T) {
+	suite.Run(t, new(allOrdersServiceWsTestSuite))
+}
+
+func (s *allOrdersServiceWsTestSuite) TestAllOrders() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.allOrders.Do(s.requestID, s.allOrdersRequest)
+	s.NoError(err)
+}
+
+func (s *allOrdersServiceWsTestSuite) TestAllOrders_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.allOrders.Do("", s.allOrdersRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *allOrdersServiceWsTestSuite) TestAllOrders_EmptyApiKey() {
+	s.allOrders.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.allOrders.Do(s.requestID, s.allOrdersRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *allOrdersServiceWsTestSuite) TestAllOrdersSync() {
+	rawResponseData := []byte(`{"id":"6f2bdd89-919a-4931-b802-f8336170f18d","status":200,"result":[{"symbol":"BTCUSDT","orderId":12569099453,"orderListId":-1,"clientOrderId":"4d96324ff9d44481926157","price":"23416.10000000","origQty":"0.00847000","executedQty":"0.00847000","cummulativeQuoteQty":"198.33521500","status":"FILLED","timeInForce":"GTC","type":"LIMIT","side":"SELL","stopPrice":"0.00000000","icebergQty":"0.00000000","time":1660801715639,"updateTime":1660801717945,"isWorking":true,"origQuoteOrderQty":"0.00000000"}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.allOrders.SyncDo(s.requestID, s.allOrdersRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal(int64(12569099453), response.Result[0].OrderID)
+}
+
+func (s *allOrdersServiceWsTestSuite) TestAllOrdersSync_Error() {
+	rawResponseData := []byte(`{"id":"6f2bdd89-919a-4931-b802-f8336170f18d","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.allOrders.SyncDo(s.requestID, s.allOrdersRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *allOrdersServiceWsTestSuite) TestAllOrdersSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.allOrders.SyncDo(s.requestID, s.allOrdersRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -6,8 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"time"
-
-	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateAnnouncementParam creates a new WsAnnouncementParam for use with WsAnnouncementServe.
@@ -15,13 +13,9 @@ import (
 // Currently supports only WithRecvWindow option, which defaults to 6000 milliseconds
 // if not specified.
 func (c *Client) CreateAnnouncementParam(opts ...RequestOption) (WsAnnouncementParam, error) {
-	if c.APIKey == "" || c.SecretKey == "" {
+	if c.APIKey == "" || (c.SecretKey == "" && c.Signer == nil) {
 		return WsAnnouncementParam{}, errors.New("missing API key or secret key")
 	}
-	kt := c.KeyType
-	if kt == "" {
-		kt = common.KeyTypeHmac
-	}
 	req := new(request)
 	for _, opt := range opts {
 		opt(req)
@@ -30,7 +24,7 @@ func (c *Client) CreateAnnouncementParam(opts ...RequestOption) (WsAnnouncementP
 		req.recvWindow = 6000
 	}
 
-	sf, err := common.SignFunc(kt)
+	signer, err := c.signer()
 	if err != nil {
 		return WsAnnouncementParam{}, err
 	}
@@ -49,10 +43,10 @@ func (c *Client) CreateAnnouncementParam(opts ...RequestOption) (WsAnnouncementP
 		Timestamp:  timestamp,
 		ApiKey:     c.APIKey,
 	}
-	signature, err := sf(c.SecretKey, fmt.Sprintf("random=%s&topic=%s&recvWindow=%d&timestamp=%d", param.Random, param.Topic, param.RecvWindow, param.Timestamp))
+	signature, err := signer.Sign(fmt.Sprintf("random=%s&topic=%s&recvWindow=%d&timestamp=%d", param.Random, param.Topic, param.RecvWindow, param.Timestamp))
 	if err != nil {
 		return WsAnnouncementParam{}, err
 	}
-	param.Signature = *signature
+	param.Signature = signature
 	return param, nil
 }
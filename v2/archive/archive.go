@@ -0,0 +1,420 @@
+// Package archive reads the daily and monthly files of the Binance public data archive (https://data.binance.vision)
+// from disk into the types of this library, so backtests share the data model of live code.
+package archive
+
+import (
+	"archive/zip"
+	"bufio"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// ErrChecksumMismatch is returned when a file doesn't match its .CHECKSUM file
+var ErrChecksumMismatch = errors.New("archive: checksum mismatch")
+
+// Metric is a row of the futures metrics files
+type Metric struct {
+	Time                         int64
+	Symbol                       string
+	SumOpenInterest              string
+	SumOpenInterestValue         string
+	CountTopTraderLongShortRatio string
+	SumTopTraderLongShortRatio   string
+	CountLongShortRatio          string
+	SumTakerLongShortVolRatio    string
+}
+
+// Reader decodes the rows of an archive file one by one
+type Reader[T any] struct {
+	closer io.Closer
+	csv    *csv.Reader
+	decode func(record []string) (T, error)
+	line   int
+}
+
+// Read returns the next row, or io.EOF after the last one
+func (r *Reader[T]) Read() (T, error) {
+	var zero T
+	for {
+		record, err := r.csv.Read()
+		if err != nil {
+			return zero, err
+		}
+		r.line++
+		// files have a header row since some date, data rows start with a number
+		if r.line == 1 && isHeader(record) {
+			continue
+		}
+		v, err := r.decode(record)
+		if err != nil {
+			return zero, fmt.Errorf("archive: line %d: %w", r.line, err)
+		}
+		return v, nil
+	}
+}
+
+// ReadAll returns the remaining rows
+func (r *Reader[T]) ReadAll() ([]T, error) {
+	var res []T
+	for {
+		v, err := r.Read()
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return res, err
+		}
+		res = append(res, v)
+	}
+}
+
+// Close closes the file
+func (r *Reader[T]) Close() error {
+	return r.closer.Close()
+}
+
+// OpenKlines opens a spot klines file
+func OpenKlines(path string) (*Reader[*binance.Kline], error) {
+	return open(path, func(record []string) (*binance.Kline, error) {
+		k, err := decodeKline(record)
+		if err != nil {
+			return nil, err
+		}
+		return &binance.Kline{
+			OpenTime:                 k.OpenTime,
+			Open:                     k.Open,
+			High:                     k.High,
+			Low:                      k.Low,
+			Close:                    k.Close,
+			Volume:                   k.Volume,
+			CloseTime:                k.CloseTime,
+			QuoteAssetVolume:         k.QuoteAssetVolume,
+			TradeNum:                 k.TradeNum,
+			TakerBuyBaseAssetVolume:  k.TakerBuyBaseAssetVolume,
+			TakerBuyQuoteAssetVolume: k.TakerBuyQuoteAssetVolume,
+		}, nil
+	})
+}
+
+// OpenFuturesKlines opens a USDⓈ-M futures klines file, mark price, index price and premium index klines files
+// included
+func OpenFuturesKlines(path string) (*Reader[*futures.Kline], error) {
+	return open(path, decodeKline)
+}
+
+// OpenAggTrades opens a spot aggTrades file
+func OpenAggTrades(path string) (*Reader[*binance.AggTrade], error) {
+	return open(path, func(record []string) (*binance.AggTrade, error) {
+		t, err := decodeAggTrade(record)
+		if err != nil {
+			return nil, err
+		}
+		res := &binance.AggTrade{
+			AggTradeID:   t.AggTradeID,
+			Price:        t.Price,
+			Quantity:     t.Quantity,
+			FirstTradeID: t.FirstTradeID,
+			LastTradeID:  t.LastTradeID,
+			Timestamp:    t.Timestamp,
+			IsBuyerMaker: t.IsBuyerMaker,
+		}
+		if len(record) > 7 {
+			if res.IsBestPriceMatch, err = strconv.ParseBool(record[7]); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	})
+}
+
+// OpenFuturesAggTrades opens a USDⓈ-M futures aggTrades file
+func OpenFuturesAggTrades(path string) (*Reader[*futures.AggTrade], error) {
+	return open(path, decodeAggTrade)
+}
+
+// OpenTrades opens a spot trades file
+func OpenTrades(path string) (*Reader[*binance.Trade], error) {
+	return open(path, func(record []string) (*binance.Trade, error) {
+		t, err := decodeTrade(record)
+		if err != nil {
+			return nil, err
+		}
+		res := &binance.Trade{
+			ID:            t.ID,
+			Price:         t.Price,
+			Quantity:      t.Quantity,
+			QuoteQuantity: t.QuoteQuantity,
+			Time:          t.Time,
+			IsBuyerMaker:  t.IsBuyerMaker,
+		}
+		if len(record) > 6 {
+			if res.IsBestMatch, err = strconv.ParseBool(record[6]); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	})
+}
+
+// OpenFuturesTrades opens a USDⓈ-M futures trades file
+func OpenFuturesTrades(path string) (*Reader[*futures.Trade], error) {
+	return open(path, decodeTrade)
+}
+
+// OpenBookTickers opens a futures bookTicker file, the symbol is taken from the file name
+func OpenBookTickers(path string) (*Reader[*futures.BookTicker], error) {
+	symbol := strings.SplitN(filepath.Base(path), "-", 2)[0]
+	return open(path, func(record []string) (*futures.BookTicker, error) {
+		if err := checkFields(record, 6); err != nil {
+			return nil, err
+		}
+		updateID, err := strconv.ParseInt(record[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		t, err := parseTime(record[5])
+		if err != nil {
+			return nil, err
+		}
+		return &futures.BookTicker{
+			Symbol:       symbol,
+			BidPrice:     record[1],
+			BidQuantity:  record[2],
+			AskPrice:     record[3],
+			AskQuantity:  record[4],
+			Time:         t,
+			LastUpdateId: updateID,
+		}, nil
+	})
+}
+
+// OpenMetrics opens a futures metrics file
+func OpenMetrics(path string) (*Reader[*Metric], error) {
+	return open(path, func(record []string) (*Metric, error) {
+		if err := checkFields(record, 8); err != nil {
+			return nil, err
+		}
+		t, err := time.Parse("2006-01-02 15:04:05", record[0])
+		if err != nil {
+			return nil, err
+		}
+		return &Metric{
+			Time:                         t.UnixNano() / int64(time.Millisecond),
+			Symbol:                       record[1],
+			SumOpenInterest:              record[2],
+			SumOpenInterestValue:         record[3],
+			CountTopTraderLongShortRatio: record[4],
+			SumTopTraderLongShortRatio:   record[5],
+			CountLongShortRatio:          record[6],
+			SumTakerLongShortVolRatio:    record[7],
+		}, nil
+	})
+}
+
+// VerifyChecksum checks the file at path against the SHA-256 in path.CHECKSUM
+func VerifyChecksum(path string) error {
+	data, err := os.ReadFile(path + ".CHECKSUM")
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return fmt.Errorf("archive: empty checksum file %s.CHECKSUM", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if !strings.EqualFold(fields[0], hex.EncodeToString(h.Sum(nil))) {
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, filepath.Base(path))
+	}
+	return nil
+}
+
+// open opens a CSV file or the CSV file in a zip file, verifying its checksum if there is a .CHECKSUM file next to it
+func open[T any](path string, decode func(record []string) (T, error)) (*Reader[T], error) {
+	if _, err := os.Stat(path + ".CHECKSUM"); err == nil {
+		if err := VerifyChecksum(path); err != nil {
+			return nil, err
+		}
+	}
+	var r io.Reader
+	var closer io.Closer
+	if strings.EqualFold(filepath.Ext(path), ".zip") {
+		zr, err := zip.OpenReader(path)
+		if err != nil {
+			return nil, err
+		}
+		var file *zip.File
+		for _, f := range zr.File {
+			if strings.EqualFold(filepath.Ext(f.Name), ".csv") {
+				file = f
+				break
+			}
+		}
+		if file == nil {
+			zr.Close()
+			return nil, fmt.Errorf("archive: no csv file in %s", filepath.Base(path))
+		}
+		rc, err := file.Open()
+		if err != nil {
+			zr.Close()
+			return nil, err
+		}
+		r, closer = rc, multiCloser{rc, zr}
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		r, closer = f, f
+	}
+	c := csv.NewReader(bufio.NewReader(r))
+	c.FieldsPerRecord = -1
+	c.ReuseRecord = true
+	return &Reader[T]{closer: closer, csv: c, decode: decode}, nil
+}
+
+type multiCloser []io.Closer
+
+func (m multiCloser) Close() error {
+	var err error
+	for _, c := range m {
+		if cerr := c.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+func isHeader(record []string) bool {
+	return len(record) > 0 && (record[0] == "" || record[0][0] < '0' || record[0][0] > '9')
+}
+
+func checkFields(record []string, n int) error {
+	if len(record) < n {
+		return fmt.Errorf("%d fields, expected at least %d", len(record), n)
+	}
+	return nil
+}
+
+// parseTime parses a timestamp in milliseconds, spot files have timestamps in microseconds since 2025
+func parseTime(s string) (int64, error) {
+	t, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if t >= 1e14 {
+		t /= 1000
+	}
+	return t, nil
+}
+
+// decodeKline decodes open_time, open, high, low, close, volume, close_time, quote_volume, count, taker_buy_volume,
+// taker_buy_quote_volume
+func decodeKline(record []string) (*futures.Kline, error) {
+	if err := checkFields(record, 11); err != nil {
+		return nil, err
+	}
+	openTime, err := parseTime(record[0])
+	if err != nil {
+		return nil, err
+	}
+	closeTime, err := parseTime(record[6])
+	if err != nil {
+		return nil, err
+	}
+	tradeNum, err := strconv.ParseInt(record[8], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &futures.Kline{
+		OpenTime:                 openTime,
+		Open:                     record[1],
+		High:                     record[2],
+		Low:                      record[3],
+		Close:                    record[4],
+		Volume:                   record[5],
+		CloseTime:                closeTime,
+		QuoteAssetVolume:         record[7],
+		TradeNum:                 tradeNum,
+		TakerBuyBaseAssetVolume:  record[9],
+		TakerBuyQuoteAssetVolume: record[10],
+	}, nil
+}
+
+// decodeAggTrade decodes agg_trade_id, price, quantity, first_trade_id, last_trade_id, transact_time, is_buyer_maker
+func decodeAggTrade(record []string) (*futures.AggTrade, error) {
+	if err := checkFields(record, 7); err != nil {
+		return nil, err
+	}
+	var ids [3]int64
+	for i, field := range []string{record[0], record[3], record[4]} {
+		id, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	t, err := parseTime(record[5])
+	if err != nil {
+		return nil, err
+	}
+	isBuyerMaker, err := strconv.ParseBool(record[6])
+	if err != nil {
+		return nil, err
+	}
+	return &futures.AggTrade{
+		AggTradeID:   ids[0],
+		Price:        record[1],
+		Quantity:     record[2],
+		FirstTradeID: ids[1],
+		LastTradeID:  ids[2],
+		Timestamp:    t,
+		IsBuyerMaker: isBuyerMaker,
+	}, nil
+}
+
+// decodeTrade decodes id, price, qty, quote_qty, time, is_buyer_maker
+func decodeTrade(record []string) (*futures.Trade, error) {
+	if err := checkFields(record, 6); err != nil {
+		return nil, err
+	}
+	id, err := strconv.ParseInt(record[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	t, err := parseTime(record[4])
+	if err != nil {
+		return nil, err
+	}
+	isBuyerMaker, err := strconv.ParseBool(record[5])
+	if err != nil {
+		return nil, err
+	}
+	return &futures.Trade{
+		ID:            id,
+		Price:         record[1],
+		Quantity:      record[2],
+		QuoteQuantity: record[3],
+		Time:          t,
+		IsBuyerMaker:  isBuyerMaker,
+	}, nil
+}
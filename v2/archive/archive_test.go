@@ -0,0 +1,174 @@
+package archive
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/require"
+)
+
+// writeZip writes a zip file holding a csv file with data, and its checksum file if checksum is set
+func writeZip(t *testing.T, name, data string, checksum bool) string {
+	path := filepath.Join(t.TempDir(), name+".zip")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	zw := zip.NewWriter(f)
+	w, err := zw.Create(name + ".csv")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	require.NoError(t, f.Close())
+	if checksum {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		sum := sha256.Sum256(content)
+		require.NoError(t, os.WriteFile(path+".CHECKSUM", []byte(hex.EncodeToString(sum[:])+"  "+name+".zip\n"), 0o644))
+	}
+	return path
+}
+
+func TestKlines(t *testing.T) {
+	r := require.New(t)
+	// spot files have timestamps in microseconds since 2025
+	path := writeZip(t, "BTCUSDT-1m-2025-01-01", "1735689600000000,93576.00,93610.93,93537.50,93610.93,8.21827,1735689659999999,769109.31,1643,3.95251,369895.05,0\n"+
+		"1735689660000000,93610.93,93652.00,93606.00,93652.00,9.48,1735689719999999,887553.70,1534,6.19,579651.42,0\n", true)
+	rd, err := OpenKlines(path)
+	r.NoError(err)
+	defer rd.Close()
+	klines, err := rd.ReadAll()
+	r.NoError(err)
+	r.Len(klines, 2)
+	r.Equal(&binance.Kline{
+		OpenTime:                 1735689600000,
+		Open:                     "93576.00",
+		High:                     "93610.93",
+		Low:                      "93537.50",
+		Close:                    "93610.93",
+		Volume:                   "8.21827",
+		CloseTime:                1735689659999,
+		QuoteAssetVolume:         "769109.31",
+		TradeNum:                 1643,
+		TakerBuyBaseAssetVolume:  "3.95251",
+		TakerBuyQuoteAssetVolume: "369895.05",
+	}, klines[0])
+	r.Equal(int64(1735689660000), klines[1].OpenTime)
+}
+
+func TestFuturesKlines(t *testing.T) {
+	r := require.New(t)
+	path := writeZip(t, "BTCUSDT-1h-2024-01-01", "open_time,open,high,low,close,volume,close_time,quote_volume,count,taker_buy_volume,taker_buy_quote_volume,ignore\n"+
+		"1704067200000,42314.00,42603.00,42289.60,42503.50,6425.123,1704070799999,272608473.73,71683,3563.213,151154811.53,0\n", false)
+	rd, err := OpenFuturesKlines(path)
+	r.NoError(err)
+	defer rd.Close()
+	klines, err := rd.ReadAll()
+	r.NoError(err)
+	r.Equal([]*futures.Kline{{
+		OpenTime:                 1704067200000,
+		Open:                     "42314.00",
+		High:                     "42603.00",
+		Low:                      "42289.60",
+		Close:                    "42503.50",
+		Volume:                   "6425.123",
+		CloseTime:                1704070799999,
+		QuoteAssetVolume:         "272608473.73",
+		TradeNum:                 71683,
+		TakerBuyBaseAssetVolume:  "3563.213",
+		TakerBuyQuoteAssetVolume: "151154811.53",
+	}}, klines)
+}
+
+func TestTrades(t *testing.T) {
+	r := require.New(t)
+	path := writeZip(t, "BTCUSDT-aggTrades-2024-01-01", "3359286389,42283.58,0.00059,4336627547,4336627547,1704067200000,False,True\n", false)
+	aggTrades, err := OpenAggTrades(path)
+	r.NoError(err)
+	defer aggTrades.Close()
+	aggTrade, err := aggTrades.Read()
+	r.NoError(err)
+	r.Equal(&binance.AggTrade{
+		AggTradeID:       3359286389,
+		Price:            "42283.58",
+		Quantity:         "0.00059",
+		FirstTradeID:     4336627547,
+		LastTradeID:      4336627547,
+		Timestamp:        1704067200000,
+		IsBestPriceMatch: true,
+	}, aggTrade)
+
+	path = writeZip(t, "BTCUSDT-trades-2024-01-01", "id,price,qty,quote_qty,time,is_buyer_maker\n"+
+		"4483618024,42314.00,0.002,84.628,1704067200047,true\n", false)
+	trades, err := OpenFuturesTrades(path)
+	r.NoError(err)
+	defer trades.Close()
+	all, err := trades.ReadAll()
+	r.NoError(err)
+	r.Equal([]*futures.Trade{{
+		ID:            4483618024,
+		Price:         "42314.00",
+		Quantity:      "0.002",
+		QuoteQuantity: "84.628",
+		Time:          1704067200047,
+		IsBuyerMaker:  true,
+	}}, all)
+}
+
+func TestBookTickersAndMetrics(t *testing.T) {
+	r := require.New(t)
+	path := writeZip(t, "BTCUSDT-bookTicker-2024-01-01", "update_id,best_bid_price,best_bid_qty,best_ask_price,best_ask_qty,transaction_time,event_time\n"+
+		"3899183064213,42313.90,12.062,42314.00,3.467,1704067200005,1704067200011\n", false)
+	tickers, err := OpenBookTickers(path)
+	r.NoError(err)
+	defer tickers.Close()
+	ticker, err := tickers.Read()
+	r.NoError(err)
+	r.Equal(&futures.BookTicker{
+		Symbol:       "BTCUSDT",
+		BidPrice:     "42313.90",
+		BidQuantity:  "12.062",
+		AskPrice:     "42314.00",
+		AskQuantity:  "3.467",
+		Time:         1704067200005,
+		LastUpdateId: 3899183064213,
+	}, ticker)
+
+	path = writeZip(t, "BTCUSDT-metrics-2024-01-01", "create_time,symbol,sum_open_interest,sum_open_interest_value,count_toptrader_long_short_ratio,sum_toptrader_long_short_ratio,count_long_short_ratio,sum_taker_long_short_vol_ratio\n"+
+		"2024-01-01 00:05:00,BTCUSDT,78374.9,3314420549.5,1.33,1.42,1.86,0.79\n", false)
+	metrics, err := OpenMetrics(path)
+	r.NoError(err)
+	defer metrics.Close()
+	metric, err := metrics.Read()
+	r.NoError(err)
+	r.Equal(int64(1704067500000), metric.Time)
+	r.Equal("78374.9", metric.SumOpenInterest)
+	r.Equal("0.79", metric.SumTakerLongShortVolRatio)
+}
+
+func TestChecksumMismatch(t *testing.T) {
+	r := require.New(t)
+	path := writeZip(t, "BTCUSDT-1m-2024-01-01", "1704067200000,1,1,1,1,1,1704067259999,1,1,1,1,0\n", true)
+	r.NoError(VerifyChecksum(path))
+	r.NoError(os.WriteFile(path+".CHECKSUM", []byte("00  BTCUSDT-1m-2024-01-01.zip\n"), 0o644))
+	_, err := OpenKlines(path)
+	r.True(errors.Is(err, ErrChecksumMismatch))
+}
+
+func TestDecodeError(t *testing.T) {
+	r := require.New(t)
+	path := filepath.Join(t.TempDir(), "BTCUSDT-1m-2024-01-01.csv")
+	r.NoError(os.WriteFile(path, []byte("1704067200000,1,1,1,1,1,1704067259999,1,1,1,1,0\n1704067260000,1,1\n"), 0o644))
+	rd, err := OpenKlines(path)
+	r.NoError(err)
+	defer rd.Close()
+	klines, err := rd.ReadAll()
+	r.Len(klines, 1)
+	r.EqualError(err, "archive: line 2: 3 fields, expected at least 11")
+}
@@ -67,6 +67,8 @@ func (s *GetAllCoinsInfoService) Do(ctx context.Context) (res []*CoinInfo, err e
 }
 
 // AssetDetail represents the detail of an asset
+//
+//decimalgen:accessors
 type AssetDetail struct {
 	MinWithdrawAmount string `json:"minWithdrawAmount"`
 	DepositStatus     bool   `json:"depositStatus"`
@@ -75,6 +77,7 @@ type AssetDetail struct {
 	DepositTip        string `json:"depositTip"`
 }
 
+//decimalgen:accessors
 type CoinInfo struct {
 	Coin              string    `json:"coin"`
 	DepositAllEnable  bool      `json:"depositAllEnable"`
@@ -92,6 +95,7 @@ type CoinInfo struct {
 	Withdrawing       string    `json:"withdrawing"`
 }
 
+//decimalgen:accessors
 type Network struct {
 	AddressRegex            string `json:"addressRegex"`
 	Coin                    string `json:"coin"`
@@ -136,6 +140,7 @@ func (s *GetUserAssetService) NeedBtcValuation(val bool) *GetUserAssetService {
 	return s
 }
 
+//decimalgen:accessors
 type UserAssetRecord struct {
 	Asset        string `json:"asset"`
 	Free         string `json:"free"`
@@ -187,6 +192,8 @@ func (s *GetFundingAssetService) NeedBtcValuation(needBtcValuation string) *GetF
 }
 
 // FundingAsset define response of GetFundingAssetService
+//
+//decimalgen:accessors
 type FundingAsset struct {
 	Asset        string `json:"asset"`
 	Free         string `json:"free"`
@@ -81,6 +81,8 @@ type DividendResponseWrapper struct {
 }
 
 // DividendResponse represents a response from AssetDividendService.
+//
+//decimalgen:accessors
 type DividendResponse struct {
 	ID     int64  `json:"id"`
 	Amount string `json:"amount"`
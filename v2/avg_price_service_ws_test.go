@@ -0,0 +1,88 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *avgPriceServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.avgPrice = &AvgPriceWsService{
+		c: s.client,
+	}
+
+	s.avgPriceRequest = NewAvgPriceWsRequest().
+		Symbol("BNBBTC")
+}
+
+func (s *avgPriceServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type avgPriceServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	avgPrice        *AvgPriceWsService
+	avgPriceRequest *AvgPriceWsRequest
+}
+
+func TestAvgPriceServiceWs(t *testing.T) {
+	suite.Run(t, new(avgPriceServiceWsTestSuite))
+}
+
+func (s *avgPriceServiceWsTestSuite) TestAvgPrice() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.avgPrice.Do(s.requestID, s.avgPriceRequest)
+	s.NoError(err)
+}
+
+func (s *avgPriceServiceWsTestSuite) TestAvgPrice_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.avgPrice.Do("", s.avgPriceRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *avgPriceServiceWsTestSuite) TestAvgPriceSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":{"mins":5,"price":"9.35751834","closeTime":1694061154503}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.avgPrice.SyncDo(s.requestID, s.avgPriceRequest)
+	s.Require().NoError(err)
+	s.Equal(AvgPrice{Mins: 5, Price: "9.35751834"}, response.Result)
+}
+
+func (s *avgPriceServiceWsTestSuite) TestAvgPriceSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.avgPrice.SyncDo(s.requestID, s.avgPriceRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *avgPriceServiceWsTestSuite) TestAvgPriceSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.avgPrice.SyncDo(s.requestID, s.avgPriceRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -0,0 +1,1417 @@
+package binancetest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	sideBuy  = "BUY"
+	sideSell = "SELL"
+
+	orderTypeLimit      = "LIMIT"
+	orderTypeMarket     = "MARKET"
+	orderTypeLimitMaker = "LIMIT_MAKER"
+
+	statusNew      = "NEW"
+	statusFilled   = "FILLED"
+	statusCanceled = "CANCELED"
+	statusExpired  = "EXPIRED"
+
+	executionTypeNew      = "NEW"
+	executionTypeTrade    = "TRADE"
+	executionTypeCanceled = "CANCELED"
+	executionTypeExpired  = "EXPIRED"
+	executionTypeAmended  = "AMENDMENT"
+)
+
+// Symbol define a symbol traded on an exchange of the server, empty filters take default values
+type Symbol struct {
+	Symbol      string
+	BaseAsset   string
+	QuoteAsset  string
+	TickSize    string // 0.01 by default
+	StepSize    string // 0.00001 for spot and 0.001 for futures by default
+	MinNotional string // 5 by default
+}
+
+// rules returns the filters orders of the symbol are checked against
+func (s *Symbol) rules() *common.SymbolRules {
+	return &common.SymbolRules{
+		Symbol:      s.Symbol,
+		Status:      "TRADING",
+		MinPrice:    decimal.RequireFromString(s.TickSize),
+		MaxPrice:    decimal.NewFromInt(1000000),
+		TickSize:    decimal.RequireFromString(s.TickSize),
+		MinQty:      decimal.RequireFromString(s.StepSize),
+		MaxQty:      decimal.NewFromInt(9000),
+		StepSize:    decimal.RequireFromString(s.StepSize),
+		MinNotional: decimal.RequireFromString(s.MinNotional),
+		MaxNotional: decimal.NewFromInt(9000000),
+	}
+}
+
+type balance struct {
+	free   decimal.Decimal
+	locked decimal.Decimal
+}
+
+type position struct {
+	amount     decimal.Decimal
+	entryPrice decimal.Decimal
+}
+
+type fill struct {
+	tradeID     int64
+	price       decimal.Decimal
+	quantity    decimal.Decimal
+	maker       bool
+	realizedPnL decimal.Decimal
+}
+
+type order struct {
+	symbol           *Symbol
+	id               int64
+	clientOrderID    string
+	side             string
+	orderType        string
+	timeInForce      string
+	price            decimal.Decimal
+	quantity         decimal.Decimal
+	quoteOrderQty    decimal.Decimal
+	executedQty      decimal.Decimal
+	cumQuote         decimal.Decimal
+	status           string
+	reduceOnly       bool
+	time             int64
+	updateTime       int64
+	newOrderRespType string
+	lockedAsset      string // spot funds locked while the order is open
+	locked           decimal.Decimal
+	fills            []fill
+}
+
+func (o *order) isOpen() bool {
+	return o.status == statusNew
+}
+
+// Exchange is the spot or the futures market of the server, its methods set up and inspect the state of tests.
+// The account holds balances (the wallet balance for futures) and, for futures, a one-way position per symbol.
+type Exchange struct {
+	server  *Server
+	futures bool
+
+	mu           sync.Mutex
+	symbols      []*Symbol
+	prices       map[string]decimal.Decimal
+	balances     map[string]*balance
+	positions    map[string]*position
+	orders       []*order
+	lastOrderID  int64
+	lastTradeID  int64
+	lastUpdateID int64
+}
+
+func newExchange(server *Server, futures bool) *Exchange {
+	return &Exchange{
+		server:    server,
+		futures:   futures,
+		prices:    make(map[string]decimal.Decimal),
+		balances:  make(map[string]*balance),
+		positions: make(map[string]*position),
+	}
+}
+
+// AddSymbol add symbol traded at price, a symbol which already exists is replaced
+func (e *Exchange) AddSymbol(symbol Symbol, price string) {
+	if symbol.TickSize == "" {
+		symbol.TickSize = "0.01"
+	}
+	if symbol.StepSize == "" {
+		symbol.StepSize = "0.00001"
+		if e.futures {
+			symbol.StepSize = "0.001"
+		}
+	}
+	if symbol.MinNotional == "" {
+		symbol.MinNotional = "5"
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i, s := range e.symbols {
+		if s.Symbol == symbol.Symbol {
+			e.symbols = append(e.symbols[:i], e.symbols[i+1:]...)
+			break
+		}
+	}
+	e.symbols = append(e.symbols, &symbol)
+	e.prices[symbol.Symbol] = decimal.RequireFromString(price)
+}
+
+// SetPrice set the last price of symbol, resting orders crossing it are filled at their limit price
+func (e *Exchange) SetPrice(symbol, price string) {
+	e.mu.Lock()
+	p := decimal.RequireFromString(price)
+	e.prices[symbol] = p
+	var events []any
+	for _, o := range e.orders {
+		if o.symbol.Symbol == symbol && o.isOpen() && e.crossing(o, p) {
+			events = append(events, e.execute(o, o.price, true)...)
+		}
+	}
+	e.mu.Unlock()
+	e.server.publish(e, events)
+}
+
+// Price returns the last price of symbol
+func (e *Exchange) Price(symbol string) string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.prices[symbol].String()
+}
+
+// SetBalance set the free balance of asset, or the wallet balance for futures
+func (e *Exchange) SetBalance(asset, amount string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.balance(asset).free = decimal.RequireFromString(amount)
+}
+
+// Balance returns the free and the locked balance of asset, or the wallet balance for futures
+func (e *Exchange) Balance(asset string) (free, locked string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	b := e.balance(asset)
+	return b.free.String(), b.locked.String()
+}
+
+// Position returns the position amount (negative for short positions) and the entry price of a futures symbol
+func (e *Exchange) Position(symbol string) (amount, entryPrice string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	p := e.position(symbol)
+	return p.amount.String(), p.entryPrice.String()
+}
+
+func (e *Exchange) balance(asset string) *balance {
+	b, ok := e.balances[asset]
+	if !ok {
+		b = &balance{}
+		e.balances[asset] = b
+	}
+	return b
+}
+
+func (e *Exchange) position(symbol string) *position {
+	p, ok := e.positions[symbol]
+	if !ok {
+		p = &position{}
+		e.positions[symbol] = p
+	}
+	return p
+}
+
+func (e *Exchange) findSymbol(symbol string) *Symbol {
+	for _, s := range e.symbols {
+		if s.Symbol == symbol {
+			return s
+		}
+	}
+	return nil
+}
+
+// symbol returns the symbol of the mandatory symbol parameter
+func (e *Exchange) symbol(p params) (*Symbol, *common.APIError) {
+	name := p.get("symbol")
+	if name == "" {
+		return nil, errMandatory("symbol")
+	}
+	s := e.findSymbol(name)
+	if s == nil {
+		return nil, newError(http.StatusBadRequest, -1121, "Invalid symbol.")
+	}
+	return s, nil
+}
+
+// format formats amounts like the exchange, spot amounts have 8 decimals
+func (e *Exchange) format(d decimal.Decimal) string {
+	if e.futures {
+		return d.String()
+	}
+	return d.StringFixed(8)
+}
+
+func (e *Exchange) crossing(o *order, price decimal.Decimal) bool {
+	if o.orderType == orderTypeMarket {
+		return true
+	}
+	if o.side == sideBuy {
+		return o.price.GreaterThanOrEqual(price)
+	}
+	return o.price.LessThanOrEqual(price)
+}
+
+// newOrder parse and check the order parameters
+func (e *Exchange) newOrder(p params) (*order, *common.APIError) {
+	symbol, apiErr := e.symbol(p)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	o := &order{
+		symbol:           symbol,
+		side:             p.get("side"),
+		orderType:        p.get("type"),
+		timeInForce:      p.get("timeInForce"),
+		clientOrderID:    p.get("newClientOrderId"),
+		reduceOnly:       p.get("reduceOnly") == "true",
+		newOrderRespType: p.get("newOrderRespType"),
+	}
+	switch o.side {
+	case sideBuy, sideSell:
+	case "":
+		return nil, errMandatory("side")
+	default:
+		return nil, newError(http.StatusBadRequest, -1117, "Invalid side.")
+	}
+	switch {
+	case o.orderType == "":
+		return nil, errMandatory("type")
+	case o.orderType == orderTypeLimit, o.orderType == orderTypeMarket:
+	case o.orderType == orderTypeLimitMaker && !e.futures:
+	default:
+		return nil, newError(http.StatusBadRequest, -1116, "Invalid orderType.")
+	}
+	if e.futures && p.get("positionSide") != "" && p.get("positionSide") != "BOTH" {
+		return nil, newError(http.StatusBadRequest, -4061, "Order's position side does not match user's setting.")
+	}
+	var ok bool
+	if o.quantity, ok, apiErr = p.decimal("quantity"); apiErr != nil {
+		return nil, apiErr
+	}
+	quantitySet := ok
+	if o.quoteOrderQty, ok, apiErr = p.decimal("quoteOrderQty"); apiErr != nil {
+		return nil, apiErr
+	}
+	quoteOrderQtySet := ok && !e.futures && o.orderType == orderTypeMarket
+	if !quantitySet && !quoteOrderQtySet {
+		return nil, errMandatory("quantity")
+	}
+	if o.orderType != orderTypeMarket {
+		if o.price, ok, apiErr = p.decimal("price"); apiErr != nil {
+			return nil, apiErr
+		}
+		if !ok {
+			return nil, errMandatory("price")
+		}
+	}
+	switch {
+	case o.orderType == orderTypeLimit && o.timeInForce == "":
+		return nil, errMandatory("timeInForce")
+	case o.orderType != orderTypeLimit:
+		o.timeInForce = "GTC"
+	case o.timeInForce == "GTC", o.timeInForce == "IOC", o.timeInForce == "FOK":
+	case o.timeInForce == "GTX" && e.futures:
+	default:
+		return nil, newError(http.StatusBadRequest, -1115, "Invalid timeInForce.")
+	}
+	if o.newOrderRespType == "" {
+		o.newOrderRespType = "FULL"
+		if e.futures {
+			o.newOrderRespType = "ACK"
+		}
+	}
+	values := common.OrderValues{
+		Market:        o.orderType == orderTypeMarket,
+		Quantity:      p.get("quantity"),
+		QuoteQuantity: p.get("quoteOrderQty"),
+		ReduceOnly:    e.futures && (p.get("reduceOnly") == "true" || p.get("closePosition") == "true"),
+	}
+	if o.orderType != orderTypeMarket {
+		values.Price = p.get("price")
+	}
+	if violations := symbol.rules().Violations(values); len(violations) > 0 {
+		return nil, e.filterError(symbol, violations[0])
+	}
+	if o.clientOrderID != "" {
+		for _, other := range e.orders {
+			if other.clientOrderID == o.clientOrderID && other.isOpen() {
+				if e.futures {
+					return nil, newError(http.StatusBadRequest, -4116, "ClientOrderId is duplicated.")
+				}
+				return nil, newError(http.StatusBadRequest, -2010, "Duplicate order sent.")
+			}
+		}
+	}
+	return o, nil
+}
+
+func (e *Exchange) filterError(symbol *Symbol, v common.FilterViolation) *common.APIError {
+	if !e.futures {
+		return newError(http.StatusBadRequest, -1013, "Filter failure: "+v.Filter)
+	}
+	switch v.Filter {
+	case common.FilterTypePrice:
+		return newError(http.StatusBadRequest, -4014, "Price not increased by tick size.")
+	case common.FilterTypeNotional:
+		return newError(http.StatusBadRequest, -4164, "Order's notional must be no smaller than "+symbol.MinNotional+" (unless you choose reduce only).")
+	}
+	return newError(http.StatusBadRequest, -4023, "Quantity not increased by step size.")
+}
+
+// placeOrder places the order, nothing is placed if test is set
+func (e *Exchange) placeOrder(p params, test bool) (any, *common.APIError) {
+	e.mu.Lock()
+	o, apiErr := e.newOrder(p)
+	if apiErr != nil {
+		e.mu.Unlock()
+		return nil, apiErr
+	}
+	last := e.prices[o.symbol.Symbol]
+	crossing := e.crossing(o, last)
+	if o.orderType == orderTypeLimitMaker && crossing {
+		e.mu.Unlock()
+		return nil, newError(http.StatusBadRequest, -2010, "Order would immediately match and take.")
+	}
+	if e.futures {
+		apiErr = e.checkReduceOnly(o)
+	} else {
+		apiErr = e.checkFunds(o, last)
+	}
+	if apiErr != nil {
+		e.mu.Unlock()
+		return nil, apiErr
+	}
+	if test {
+		e.mu.Unlock()
+		return map[string]any{}, nil
+	}
+
+	now := e.server.now()
+	e.lastOrderID++
+	o.id = e.lastOrderID
+	if o.clientOrderID == "" {
+		o.clientOrderID = "binancetest" + strconv.FormatInt(o.id, 10)
+	}
+	o.status = statusNew
+	o.time = now
+	o.updateTime = now
+	e.orders = append(e.orders, o)
+	events := []any{e.orderEvent(o, executionTypeNew, nil)}
+	ack := e.orderJSON(o)
+	switch {
+	case crossing && o.timeInForce == "GTX":
+		events = append(events, e.expire(o)...)
+	case crossing:
+		events = append(events, e.execute(o, last, false)...)
+	case o.timeInForce == "IOC" || o.timeInForce == "FOK":
+		events = append(events, e.expire(o)...)
+	default:
+		events = append(events, e.lock(o)...)
+		e.lastUpdateID++
+	}
+	var res any
+	if e.futures {
+		res = ack
+		if o.newOrderRespType == "RESULT" {
+			res = e.orderJSON(o)
+		}
+	} else {
+		res = e.spotOrderResponse(o)
+	}
+	e.mu.Unlock()
+	e.server.publish(e, events)
+	return res, nil
+}
+
+// checkFunds check the spot balance needed by o, the quantity of orders placed by quote quantity is set
+func (e *Exchange) checkFunds(o *order, last decimal.Decimal) *common.APIError {
+	if o.quantity.IsZero() {
+		rules := o.symbol.rules()
+		o.quantity = decimal.RequireFromString(rules.RoundQuantity(o.quoteOrderQty.Div(last).String(), true))
+		if o.quantity.LessThan(rules.MinQty) {
+			return e.filterError(o.symbol, common.FilterViolation{Filter: common.FilterTypeLotSize})
+		}
+	}
+	var asset string
+	var needed decimal.Decimal
+	switch {
+	case o.side == sideSell:
+		asset, needed = o.symbol.BaseAsset, o.quantity
+	case o.orderType == orderTypeMarket:
+		asset, needed = o.symbol.QuoteAsset, o.quantity.Mul(last)
+	default:
+		asset, needed = o.symbol.QuoteAsset, o.quantity.Mul(o.price)
+	}
+	if e.balance(asset).free.LessThan(needed) {
+		return newError(http.StatusBadRequest, -2010, "Account has insufficient balance for requested action.")
+	}
+	return nil
+}
+
+// checkReduceOnly check that a reduce only futures order only reduces the position
+func (e *Exchange) checkReduceOnly(o *order) *common.APIError {
+	if !o.reduceOnly {
+		return nil
+	}
+	amount := e.position(o.symbol.Symbol).amount
+	if (o.side == sideBuy && amount.Neg().LessThan(o.quantity)) || (o.side == sideSell && amount.LessThan(o.quantity)) {
+		return newError(http.StatusBadRequest, -2022, "ReduceOnly Order is rejected.")
+	}
+	return nil
+}
+
+// lock locks the spot funds of a resting order
+func (e *Exchange) lock(o *order) []any {
+	if e.futures {
+		return nil
+	}
+	o.lockedAsset, o.locked = o.symbol.QuoteAsset, o.quantity.Mul(o.price)
+	if o.side == sideSell {
+		o.lockedAsset, o.locked = o.symbol.BaseAsset, o.quantity
+	}
+	b := e.balance(o.lockedAsset)
+	b.free = b.free.Sub(o.locked)
+	b.locked = b.locked.Add(o.locked)
+	return []any{e.accountEvent(o.lockedAsset)}
+}
+
+func (e *Exchange) unlock(o *order) {
+	if o.lockedAsset == "" {
+		return
+	}
+	b := e.balance(o.lockedAsset)
+	b.free = b.free.Add(o.locked)
+	b.locked = b.locked.Sub(o.locked)
+	o.lockedAsset, o.locked = "", decimal.Zero
+}
+
+// execute fills the rest of o at price
+func (e *Exchange) execute(o *order, price decimal.Decimal, maker bool) []any {
+	quantity := o.quantity.Sub(o.executedQty)
+	quote := quantity.Mul(price)
+	e.lastTradeID++
+	f := fill{tradeID: e.lastTradeID, price: price, quantity: quantity, maker: maker}
+	if maker {
+		e.lastUpdateID++
+	}
+	o.executedQty = o.executedQty.Add(quantity)
+	o.cumQuote = o.cumQuote.Add(quote)
+	o.status = statusFilled
+	o.updateTime = e.server.now()
+
+	if e.futures {
+		f.realizedPnL = e.updatePosition(o.symbol.Symbol, o.side, quantity, price)
+		o.fills = append(o.fills, f)
+		return []any{e.orderEvent(o, executionTypeTrade, &f), e.futuresAccountEvent(o.symbol)}
+	}
+	o.fills = append(o.fills, f)
+	e.unlock(o)
+	base, quoteAsset := e.balance(o.symbol.BaseAsset), e.balance(o.symbol.QuoteAsset)
+	if o.side == sideBuy {
+		base.free = base.free.Add(quantity)
+		quoteAsset.free = quoteAsset.free.Sub(quote)
+	} else {
+		base.free = base.free.Sub(quantity)
+		quoteAsset.free = quoteAsset.free.Add(quote)
+	}
+	return []any{e.orderEvent(o, executionTypeTrade, &f), e.accountEvent(o.symbol.BaseAsset, o.symbol.QuoteAsset)}
+}
+
+// updatePosition applies a futures fill to the position and the wallet, it returns the realized profit
+func (e *Exchange) updatePosition(symbol, side string, quantity, price decimal.Decimal) decimal.Decimal {
+	p := e.position(symbol)
+	if side == sideSell {
+		quantity = quantity.Neg()
+	}
+	realized := decimal.Zero
+	amount := p.amount.Add(quantity)
+	switch {
+	case p.amount.IsZero() || p.amount.Sign() == quantity.Sign():
+		p.entryPrice = p.entryPrice.Mul(p.amount.Abs()).Add(price.Mul(quantity.Abs())).Div(amount.Abs())
+	default:
+		closed := decimal.Min(p.amount.Abs(), quantity.Abs())
+		realized = price.Sub(p.entryPrice).Mul(closed)
+		if p.amount.IsNegative() {
+			realized = realized.Neg()
+		}
+		b := e.balance(e.marginAsset(symbol))
+		b.free = b.free.Add(realized)
+		if amount.IsZero() {
+			p.entryPrice = decimal.Zero
+		} else if amount.Sign() != p.amount.Sign() {
+			p.entryPrice = price
+		}
+	}
+	p.amount = amount
+	return realized
+}
+
+func (e *Exchange) marginAsset(symbol string) string {
+	if s := e.findSymbol(symbol); s != nil {
+		return s.QuoteAsset
+	}
+	return "USDT"
+}
+
+func (e *Exchange) expire(o *order) []any {
+	o.status = statusExpired
+	o.updateTime = e.server.now()
+	return []any{e.orderEvent(o, executionTypeExpired, nil)}
+}
+
+func (e *Exchange) cancel(o *order) []any {
+	o.status = statusCanceled
+	o.updateTime = e.server.now()
+	e.lastUpdateID++
+	events := []any{e.orderEvent(o, executionTypeCanceled, nil)}
+	if asset := o.lockedAsset; asset != "" {
+		e.unlock(o)
+		events = append(events, e.accountEvent(asset))
+	}
+	return events
+}
+
+// findOrder returns the order of the orderId or origClientOrderId parameter
+func (e *Exchange) findOrder(p params) (*order, *common.APIError) {
+	symbol, apiErr := e.symbol(p)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	id, clientOrderID := p.get("orderId"), p.get("origClientOrderId")
+	if id == "" && clientOrderID == "" {
+		return nil, newError(http.StatusBadRequest, -1102, "Param 'origClientOrderId' or 'orderId' must be sent, but both were empty/null!")
+	}
+	for i := len(e.orders) - 1; i >= 0; i-- {
+		o := e.orders[i]
+		if o.symbol != symbol {
+			continue
+		}
+		if (id != "" && strconv.FormatInt(o.id, 10) == id) || (id == "" && o.clientOrderID == clientOrderID) {
+			return o, nil
+		}
+	}
+	return nil, nil
+}
+
+func (e *Exchange) getOrder(p params) (any, *common.APIError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o, apiErr := e.findOrder(p)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if o == nil {
+		return nil, newError(http.StatusBadRequest, -2013, "Order does not exist.")
+	}
+	return e.orderJSON(o), nil
+}
+
+func (e *Exchange) cancelOrder(p params) (any, *common.APIError) {
+	e.mu.Lock()
+	o, apiErr := e.findOrder(p)
+	if apiErr == nil && (o == nil || !o.isOpen()) {
+		apiErr = newError(http.StatusBadRequest, -2011, "Unknown order sent.")
+	}
+	if apiErr != nil {
+		e.mu.Unlock()
+		return nil, apiErr
+	}
+	events := e.cancel(o)
+	res := e.cancelJSON(o)
+	e.mu.Unlock()
+	e.server.publish(e, events)
+	return res, nil
+}
+
+// modifyOrder amends price and quantity of an open futures order, the order is canceled if the new quantity isn't
+// above the executed quantity, and filled or expired (GTX) if the new price crosses the last price
+func (e *Exchange) modifyOrder(p params) (any, *common.APIError) {
+	e.mu.Lock()
+	o, apiErr := e.findOrder(p)
+	if apiErr == nil && (o == nil || !o.isOpen()) {
+		apiErr = newError(http.StatusBadRequest, -2013, "Order does not exist.")
+	}
+	if apiErr == nil && p.get("side") != o.side {
+		apiErr = newError(http.StatusBadRequest, -1117, "Invalid side.")
+	}
+	var quantity, price decimal.Decimal
+	var ok bool
+	if apiErr == nil {
+		if quantity, ok, apiErr = p.decimal("quantity"); apiErr == nil && !ok {
+			apiErr = errMandatory("quantity")
+		}
+	}
+	if apiErr == nil {
+		if price, ok, apiErr = p.decimal("price"); apiErr == nil && !ok {
+			apiErr = errMandatory("price")
+		}
+	}
+	if apiErr == nil {
+		values := common.OrderValues{Quantity: p.get("quantity"), Price: p.get("price")}
+		if violations := o.symbol.rules().Violations(values); len(violations) > 0 {
+			apiErr = e.filterError(o.symbol, violations[0])
+		}
+	}
+	if apiErr != nil {
+		e.mu.Unlock()
+		return nil, apiErr
+	}
+	o.quantity, o.price = quantity, price
+	o.updateTime = e.server.now()
+	last := e.prices[o.symbol.Symbol]
+	var events []any
+	switch {
+	case !o.quantity.GreaterThan(o.executedQty):
+		events = e.cancel(o)
+	case e.crossing(o, last) && o.timeInForce == "GTX":
+		events = e.expire(o)
+	case e.crossing(o, last):
+		events = e.execute(o, last, false)
+	default:
+		e.lastUpdateID++
+		events = []any{e.orderEvent(o, executionTypeAmended, nil)}
+	}
+	res := e.orderJSON(o)
+	e.mu.Unlock()
+	e.server.publish(e, events)
+	return res, nil
+}
+
+// cancelReplace cancels an order and places a new one, the new order isn't placed if the cancel fails in
+// STOP_ON_FAILURE mode
+func (e *Exchange) cancelReplace(p params) (any, *common.APIError) {
+	mode := p.get("cancelReplaceMode")
+	if mode != "STOP_ON_FAILURE" && mode != "ALLOW_FAILURE" {
+		return nil, errMandatory("cancelReplaceMode")
+	}
+	cancelParams := params{"symbol": p.get("symbol"), "orderId": p.get("cancelOrderId"), "origClientOrderId": p.get("cancelOrigClientOrderId")}
+	cancelRes, cancelErr := e.cancelOrder(cancelParams)
+	if cancelErr != nil && mode == "STOP_ON_FAILURE" {
+		return nil, newError(http.StatusBadRequest, -2022, "Order cancel-replace failed.")
+	}
+	newOrderRes, newOrderErr := e.placeOrder(p, false)
+	res := map[string]any{"cancelResult": "SUCCESS", "newOrderResult": "SUCCESS"}
+	if cancelErr != nil {
+		res["cancelResult"] = "FAILURE"
+	} else {
+		res["cancelResponse"] = cancelRes
+	}
+	if newOrderErr != nil {
+		res["newOrderResult"] = "FAILURE"
+	} else {
+		res["newOrderResponse"] = newOrderRes
+	}
+	switch {
+	case cancelErr != nil && newOrderErr != nil:
+		return nil, newError(http.StatusBadRequest, -2022, "Order cancel-replace failed.")
+	case cancelErr != nil || newOrderErr != nil:
+		return nil, newError(http.StatusBadRequest, -2021, "Order cancel-replace partially failed.")
+	}
+	return res, nil
+}
+
+// myTrades returns the trades of the symbol parameter
+func (e *Exchange) myTrades(p params) (any, *common.APIError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	symbol, apiErr := e.symbol(p)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	limit := 500
+	if l, err := strconv.Atoi(p.get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	fromID, _ := strconv.ParseInt(p.get("fromId"), 10, 64)
+	res := []any{}
+	for _, o := range e.orders {
+		if o.symbol != symbol || (p.get("orderId") != "" && strconv.FormatInt(o.id, 10) != p.get("orderId")) {
+			continue
+		}
+		for _, f := range o.fills {
+			if f.tradeID < fromID {
+				continue
+			}
+			res = append(res, map[string]any{
+				"symbol":          symbol.Symbol,
+				"id":              f.tradeID,
+				"orderId":         o.id,
+				"orderListId":     -1,
+				"price":           e.format(f.price),
+				"qty":             e.format(f.quantity),
+				"quoteQty":        e.format(f.price.Mul(f.quantity)),
+				"commission":      e.format(decimal.Zero),
+				"commissionAsset": e.commissionAsset(o),
+				"time":            o.updateTime,
+				"isBuyer":         o.side == sideBuy,
+				"isMaker":         f.maker,
+				"isBestMatch":     true,
+			})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].(map[string]any)["id"].(int64) < res[j].(map[string]any)["id"].(int64)
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+// orderRateLimits returns the order count usage of the spot order rate limits
+func (e *Exchange) orderRateLimits() any {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	now := e.server.now()
+	count := func(window int64) int {
+		n := 0
+		for _, o := range e.orders {
+			if now-o.time < window {
+				n++
+			}
+		}
+		return n
+	}
+	return []any{
+		map[string]any{"rateLimitType": "ORDERS", "interval": "SECOND", "intervalNum": 10, "limit": 100, "count": count(10 * 1000)},
+		map[string]any{"rateLimitType": "ORDERS", "interval": "DAY", "intervalNum": 1, "limit": 200000, "count": count(24 * 60 * 60 * 1000)},
+	}
+}
+
+func (e *Exchange) cancelOpenOrders(p params) (any, *common.APIError) {
+	e.mu.Lock()
+	symbol, apiErr := e.symbol(p)
+	if apiErr != nil {
+		e.mu.Unlock()
+		return nil, apiErr
+	}
+	var events []any
+	res := []any{}
+	for _, o := range e.orders {
+		if o.symbol == symbol && o.isOpen() {
+			events = append(events, e.cancel(o)...)
+			res = append(res, e.cancelJSON(o))
+		}
+	}
+	e.mu.Unlock()
+	e.server.publish(e, events)
+	if e.futures {
+		return map[string]any{"code": 200, "msg": "The operation of cancel all open order is done."}, nil
+	}
+	if len(res) == 0 {
+		return nil, newError(http.StatusBadRequest, -2011, "Unknown order sent.")
+	}
+	return res, nil
+}
+
+// listOrders returns the orders of the symbol parameter, which is mandatory unless openOnly is set
+func (e *Exchange) listOrders(p params, openOnly bool) (any, *common.APIError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var symbol *Symbol
+	if p.get("symbol") != "" || !openOnly {
+		var apiErr *common.APIError
+		if symbol, apiErr = e.symbol(p); apiErr != nil {
+			return nil, apiErr
+		}
+	}
+	res := []any{}
+	for _, o := range e.orders {
+		if (symbol == nil || o.symbol == symbol) && (!openOnly || o.isOpen()) {
+			res = append(res, e.orderJSON(o))
+		}
+	}
+	return res, nil
+}
+
+func (e *Exchange) depth(p params) (any, *common.APIError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	symbol, apiErr := e.symbol(p)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	limit := 100
+	if l, err := strconv.Atoi(p.get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	levels := map[string]map[string]decimal.Decimal{sideBuy: {}, sideSell: {}}
+	for _, o := range e.orders {
+		if o.symbol == symbol && o.isOpen() {
+			price := e.format(o.price)
+			levels[o.side][price] = levels[o.side][price].Add(o.quantity.Sub(o.executedQty))
+		}
+	}
+	side := func(side string, desc bool) [][]string {
+		res := [][]string{}
+		for price, quantity := range levels[side] {
+			res = append(res, []string{price, e.format(quantity)})
+		}
+		sort.Slice(res, func(i, j int) bool {
+			less := decimal.RequireFromString(res[i][0]).LessThan(decimal.RequireFromString(res[j][0]))
+			return less != desc
+		})
+		if len(res) > limit {
+			res = res[:limit]
+		}
+		return res
+	}
+	res := map[string]any{
+		"lastUpdateId": e.lastUpdateID,
+		"bids":         side(sideBuy, true),
+		"asks":         side(sideSell, false),
+	}
+	if e.futures {
+		now := e.server.now()
+		res["E"] = now
+		res["T"] = now
+	}
+	return res, nil
+}
+
+func (e *Exchange) tickerPrice(p params) (any, *common.APIError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ticker := func(s *Symbol) map[string]any {
+		res := map[string]any{"symbol": s.Symbol, "price": e.format(e.prices[s.Symbol])}
+		if e.futures {
+			res["time"] = e.server.now()
+		}
+		return res
+	}
+	if p.get("symbol") != "" {
+		symbol, apiErr := e.symbol(p)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		return ticker(symbol), nil
+	}
+	res := []any{}
+	for _, s := range e.symbols {
+		res = append(res, ticker(s))
+	}
+	return res, nil
+}
+
+// bookTicker returns the best price and quantity on the order book of a symbol or all symbols
+func (e *Exchange) bookTicker(p params) (any, *common.APIError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ticker := func(s *Symbol) map[string]any {
+		bid, bidQty, ask, askQty := decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero
+		for _, o := range e.orders {
+			if o.symbol != s || !o.isOpen() {
+				continue
+			}
+			rest := o.quantity.Sub(o.executedQty)
+			switch {
+			case o.side == sideBuy && o.price.Equal(bid):
+				bidQty = bidQty.Add(rest)
+			case o.side == sideBuy && o.price.GreaterThan(bid):
+				bid, bidQty = o.price, rest
+			case o.side == sideSell && o.price.Equal(ask):
+				askQty = askQty.Add(rest)
+			case o.side == sideSell && (ask.IsZero() || o.price.LessThan(ask)):
+				ask, askQty = o.price, rest
+			}
+		}
+		res := map[string]any{
+			"symbol":   s.Symbol,
+			"bidPrice": e.format(bid),
+			"bidQty":   e.format(bidQty),
+			"askPrice": e.format(ask),
+			"askQty":   e.format(askQty),
+		}
+		if e.futures {
+			res["time"] = e.server.now()
+			res["lastUpdateId"] = e.lastUpdateID
+		}
+		return res
+	}
+	if p.get("symbol") != "" {
+		symbol, apiErr := e.symbol(p)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		return ticker(symbol), nil
+	}
+	res := []any{}
+	for _, s := range e.symbols {
+		res = append(res, ticker(s))
+	}
+	return res, nil
+}
+
+func (e *Exchange) exchangeInfo(p params) (any, *common.APIError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	rateLimits := []any{
+		map[string]any{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 6000},
+		map[string]any{"rateLimitType": "ORDERS", "interval": "SECOND", "intervalNum": 10, "limit": 100},
+		map[string]any{"rateLimitType": "ORDERS", "interval": "DAY", "intervalNum": 1, "limit": 200000},
+		map[string]any{"rateLimitType": "RAW_REQUESTS", "interval": "MINUTE", "intervalNum": 5, "limit": 61000},
+	}
+	if e.futures {
+		rateLimits = []any{
+			map[string]any{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 2400},
+			map[string]any{"rateLimitType": "ORDERS", "interval": "MINUTE", "intervalNum": 1, "limit": 1200},
+			map[string]any{"rateLimitType": "ORDERS", "interval": "SECOND", "intervalNum": 10, "limit": 300},
+		}
+	}
+	if _, apiErr := e.symbol(p); p.get("symbol") != "" && apiErr != nil {
+		return nil, apiErr
+	}
+	symbols := []any{}
+	for _, s := range e.symbols {
+		if symbol := p.get("symbol"); symbol != "" && s.Symbol != symbol {
+			continue
+		}
+		filters := []any{
+			map[string]any{"filterType": "PRICE_FILTER", "minPrice": s.TickSize, "maxPrice": "1000000", "tickSize": s.TickSize},
+			map[string]any{"filterType": "LOT_SIZE", "minQty": s.StepSize, "maxQty": "9000", "stepSize": s.StepSize},
+			map[string]any{"filterType": "MARKET_LOT_SIZE", "minQty": "0", "maxQty": "9000", "stepSize": "0"},
+		}
+		symbol := map[string]any{
+			"symbol":             s.Symbol,
+			"status":             "TRADING",
+			"baseAsset":          s.BaseAsset,
+			"quoteAsset":         s.QuoteAsset,
+			"baseAssetPrecision": 8,
+			"quotePrecision":     8,
+		}
+		if e.futures {
+			filters = append(filters, map[string]any{"filterType": "MIN_NOTIONAL", "notional": s.MinNotional})
+			symbol["pair"] = s.Symbol
+			symbol["contractType"] = "PERPETUAL"
+			symbol["marginAsset"] = s.QuoteAsset
+			symbol["pricePrecision"] = int(-decimal.RequireFromString(s.TickSize).Exponent())
+			symbol["quantityPrecision"] = int(-decimal.RequireFromString(s.StepSize).Exponent())
+			symbol["orderType"] = []string{orderTypeLimit, orderTypeMarket}
+			symbol["timeInForce"] = []string{"GTC", "IOC", "FOK", "GTX"}
+		} else {
+			filters = append(filters, map[string]any{"filterType": "NOTIONAL", "minNotional": s.MinNotional, "applyMinToMarket": true,
+				"maxNotional": "9000000", "applyMaxToMarket": false, "avgPriceMins": 5})
+			symbol["quoteAssetPrecision"] = 8
+			symbol["orderTypes"] = []string{orderTypeLimit, orderTypeLimitMaker, orderTypeMarket}
+			symbol["quoteOrderQtyMarketAllowed"] = true
+			symbol["isSpotTradingAllowed"] = true
+			symbol["permissions"] = []string{"SPOT"}
+		}
+		symbol["filters"] = filters
+		symbols = append(symbols, symbol)
+	}
+	return map[string]any{
+		"timezone":        "UTC",
+		"serverTime":      e.server.now(),
+		"rateLimits":      rateLimits,
+		"exchangeFilters": []any{},
+		"symbols":         symbols,
+	}, nil
+}
+
+func (e *Exchange) assets() []string {
+	assets := make([]string, 0, len(e.balances))
+	for asset := range e.balances {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	return assets
+}
+
+func (e *Exchange) account(omitZeroBalances bool) any {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.futures {
+		return e.futuresAccount()
+	}
+	balances := []any{}
+	for _, asset := range e.assets() {
+		b := e.balances[asset]
+		if omitZeroBalances && b.free.IsZero() && b.locked.IsZero() {
+			continue
+		}
+		balances = append(balances, map[string]any{"asset": asset, "free": e.format(b.free), "locked": e.format(b.locked)})
+	}
+	return map[string]any{
+		"makerCommission": 0,
+		"takerCommission": 0,
+		"canTrade":        true,
+		"canWithdraw":     true,
+		"canDeposit":      true,
+		"updateTime":      e.server.now(),
+		"accountType":     "SPOT",
+		"balances":        balances,
+		"permissions":     []string{"SPOT"},
+	}
+}
+
+// unrealizedProfit returns the unrealized profit of the futures positions margined in asset
+func (e *Exchange) unrealizedProfit(asset string) decimal.Decimal {
+	total := decimal.Zero
+	for symbol, p := range e.positions {
+		if e.marginAsset(symbol) == asset {
+			total = total.Add(e.prices[symbol].Sub(p.entryPrice).Mul(p.amount))
+		}
+	}
+	return total
+}
+
+func (e *Exchange) futuresAccount() any {
+	assets := []any{}
+	totalWallet, totalProfit := decimal.Zero, decimal.Zero
+	for _, asset := range e.assets() {
+		wallet, profit := e.balances[asset].free, e.unrealizedProfit(asset)
+		totalWallet, totalProfit = totalWallet.Add(wallet), totalProfit.Add(profit)
+		margin := wallet.Add(profit).String()
+		assets = append(assets, map[string]any{
+			"asset":                  asset,
+			"walletBalance":          wallet.String(),
+			"unrealizedProfit":       profit.String(),
+			"marginBalance":          margin,
+			"maintMargin":            "0",
+			"initialMargin":          "0",
+			"positionInitialMargin":  "0",
+			"openOrderInitialMargin": "0",
+			"crossWalletBalance":     wallet.String(),
+			"crossUnPnl":             profit.String(),
+			"availableBalance":       margin,
+			"maxWithdrawAmount":      margin,
+			"marginAvailable":        true,
+			"updateTime":             e.server.now(),
+		})
+	}
+	margin := totalWallet.Add(totalProfit).String()
+	return map[string]any{
+		"feeTier":                     0,
+		"canTrade":                    true,
+		"canDeposit":                  true,
+		"canWithdraw":                 true,
+		"updateTime":                  0,
+		"multiAssetsMargin":           false,
+		"totalInitialMargin":          "0",
+		"totalMaintMargin":            "0",
+		"totalWalletBalance":          totalWallet.String(),
+		"totalUnrealizedProfit":       totalProfit.String(),
+		"totalMarginBalance":          margin,
+		"totalPositionInitialMargin":  "0",
+		"totalOpenOrderInitialMargin": "0",
+		"totalCrossWalletBalance":     totalWallet.String(),
+		"totalCrossUnPnl":             totalProfit.String(),
+		"availableBalance":            margin,
+		"maxWithdrawAmount":           margin,
+		"assets":                      assets,
+		"positions":                   e.positionsJSON(""),
+	}
+}
+
+func (e *Exchange) futuresBalances() any {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	res := []any{}
+	for _, asset := range e.assets() {
+		wallet, profit := e.balances[asset].free, e.unrealizedProfit(asset)
+		res = append(res, map[string]any{
+			"accountAlias":       "binancetest",
+			"asset":              asset,
+			"balance":            wallet.String(),
+			"crossWalletBalance": wallet.String(),
+			"crossUnPnl":         profit.String(),
+			"availableBalance":   wallet.Add(profit).String(),
+			"maxWithdrawAmount":  wallet.Add(profit).String(),
+			"marginAvailable":    true,
+			"updateTime":         e.server.now(),
+		})
+	}
+	return res
+}
+
+func (e *Exchange) positionRisk(p params) (any, *common.APIError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if p.get("symbol") != "" {
+		if _, apiErr := e.symbol(p); apiErr != nil {
+			return nil, apiErr
+		}
+	}
+	return e.positionsJSON(p.get("symbol")), nil
+}
+
+func (e *Exchange) positionsJSON(symbol string) []any {
+	res := []any{}
+	for _, s := range e.symbols {
+		if symbol != "" && s.Symbol != symbol {
+			continue
+		}
+		p := e.position(s.Symbol)
+		mark := e.prices[s.Symbol]
+		res = append(res, map[string]any{
+			"symbol":           s.Symbol,
+			"positionSide":     "BOTH",
+			"positionAmt":      p.amount.String(),
+			"entryPrice":       p.entryPrice.String(),
+			"breakEvenPrice":   p.entryPrice.String(),
+			"markPrice":        mark.String(),
+			"unRealizedProfit": mark.Sub(p.entryPrice).Mul(p.amount).String(),
+			"unrealizedProfit": mark.Sub(p.entryPrice).Mul(p.amount).String(),
+			"notional":         mark.Mul(p.amount).String(),
+			"marginType":       "cross",
+			"marginAsset":      s.QuoteAsset,
+			"isolated":         false,
+			"isAutoAddMargin":  "false",
+			"isolatedMargin":   "0",
+			"isolatedWallet":   "0",
+			"leverage":         "20",
+			"liquidationPrice": "0",
+			"maxNotionalValue": "1000000",
+			"updateTime":       e.server.now(),
+		})
+	}
+	return res
+}
+
+// orderJSON returns o like queries of orders
+func (e *Exchange) orderJSON(o *order) map[string]any {
+	res := map[string]any{
+		"symbol":        o.symbol.Symbol,
+		"orderId":       o.id,
+		"clientOrderId": o.clientOrderID,
+		"price":         e.format(o.price),
+		"origQty":       e.format(o.quantity),
+		"executedQty":   e.format(o.executedQty),
+		"status":        o.status,
+		"timeInForce":   o.timeInForce,
+		"type":          o.orderType,
+		"side":          o.side,
+		"stopPrice":     e.format(decimal.Zero),
+		"time":          o.time,
+		"updateTime":    o.updateTime,
+	}
+	if e.futures {
+		avgPrice := decimal.Zero
+		if o.executedQty.IsPositive() {
+			avgPrice = o.cumQuote.Div(o.executedQty)
+		}
+		res["avgPrice"] = avgPrice.String()
+		res["cumQty"] = o.executedQty.String()
+		res["cumQuote"] = o.cumQuote.String()
+		res["reduceOnly"] = o.reduceOnly
+		res["closePosition"] = false
+		res["positionSide"] = "BOTH"
+		res["workingType"] = "CONTRACT_PRICE"
+		res["priceProtect"] = false
+		res["origType"] = o.orderType
+		res["priceMatch"] = "NONE"
+		res["selfTradePreventionMode"] = "NONE"
+		res["goodTillDate"] = 0
+		return res
+	}
+	res["orderListId"] = -1
+	res["cummulativeQuoteQty"] = e.format(o.cumQuote)
+	res["icebergQty"] = e.format(decimal.Zero)
+	res["isWorking"] = true
+	res["workingTime"] = o.time
+	res["origQuoteOrderQty"] = e.format(o.quoteOrderQty)
+	res["selfTradePreventionMode"] = "EXPIRE_MAKER"
+	return res
+}
+
+// spotOrderResponse returns the response to a placed spot order according to its newOrderRespType
+func (e *Exchange) spotOrderResponse(o *order) map[string]any {
+	res := map[string]any{
+		"symbol":        o.symbol.Symbol,
+		"orderId":       o.id,
+		"orderListId":   -1,
+		"clientOrderId": o.clientOrderID,
+		"transactTime":  o.time,
+	}
+	if o.newOrderRespType == "ACK" {
+		return res
+	}
+	for k, v := range e.orderJSON(o) {
+		switch k {
+		case "time", "updateTime", "stopPrice", "icebergQty", "isWorking":
+		default:
+			res[k] = v
+		}
+	}
+	if o.newOrderRespType == "FULL" {
+		fills := []any{}
+		for _, f := range o.fills {
+			fills = append(fills, map[string]any{
+				"price":           e.format(f.price),
+				"qty":             e.format(f.quantity),
+				"commission":      e.format(decimal.Zero),
+				"commissionAsset": e.commissionAsset(o),
+				"tradeId":         f.tradeID,
+			})
+		}
+		res["fills"] = fills
+	}
+	return res
+}
+
+func (e *Exchange) cancelJSON(o *order) map[string]any {
+	res := e.orderJSON(o)
+	if !e.futures {
+		delete(res, "time")
+		delete(res, "updateTime")
+		delete(res, "isWorking")
+		res["origClientOrderId"] = o.clientOrderID
+		res["transactTime"] = o.updateTime
+	}
+	return res
+}
+
+func (e *Exchange) commissionAsset(o *order) string {
+	if e.futures || o.side == sideSell {
+		return o.symbol.QuoteAsset
+	}
+	return o.symbol.BaseAsset
+}
+
+// orderEvent returns the user data event of an order update
+func (e *Exchange) orderEvent(o *order, executionType string, f *fill) any {
+	now := e.server.now()
+	lastQuantity, lastPrice, tradeID, maker := decimal.Zero, decimal.Zero, int64(-1), false
+	if f != nil {
+		lastQuantity, lastPrice, tradeID, maker = f.quantity, f.price, f.tradeID, f.maker
+	}
+	if e.futures {
+		avgPrice, realizedPnL := decimal.Zero, decimal.Zero
+		if o.executedQty.IsPositive() {
+			avgPrice = o.cumQuote.Div(o.executedQty)
+		}
+		if f != nil {
+			realizedPnL = f.realizedPnL
+		}
+		return map[string]any{
+			"e": "ORDER_TRADE_UPDATE",
+			"E": now,
+			"T": now,
+			"o": map[string]any{
+				"s":  o.symbol.Symbol,
+				"c":  o.clientOrderID,
+				"S":  o.side,
+				"o":  o.orderType,
+				"f":  o.timeInForce,
+				"q":  o.quantity.String(),
+				"p":  o.price.String(),
+				"ap": avgPrice.String(),
+				"sp": "0",
+				"x":  executionType,
+				"X":  o.status,
+				"i":  o.id,
+				"l":  lastQuantity.String(),
+				"z":  o.executedQty.String(),
+				"L":  lastPrice.String(),
+				"N":  o.symbol.QuoteAsset,
+				"n":  "0",
+				"T":  now,
+				"t":  tradeID,
+				"b":  "0",
+				"a":  "0",
+				"m":  maker,
+				"R":  o.reduceOnly,
+				"wt": "CONTRACT_PRICE",
+				"ot": o.orderType,
+				"ps": "BOTH",
+				"cp": false,
+				"rp": realizedPnL.String(),
+				"pP": false,
+				"V":  "NONE",
+				"pm": "NONE",
+			},
+		}
+	}
+	var commissionAsset any
+	if f != nil {
+		commissionAsset = e.commissionAsset(o)
+	}
+	origClientOrderID := ""
+	if executionType == executionTypeCanceled {
+		origClientOrderID = o.clientOrderID
+	}
+	return map[string]any{
+		"e": "executionReport",
+		"E": now,
+		"s": o.symbol.Symbol,
+		"c": o.clientOrderID,
+		"S": o.side,
+		"o": o.orderType,
+		"f": o.timeInForce,
+		"q": e.format(o.quantity),
+		"p": e.format(o.price),
+		"P": e.format(decimal.Zero),
+		"F": e.format(decimal.Zero),
+		"g": -1,
+		"C": origClientOrderID,
+		"x": executionType,
+		"X": o.status,
+		"r": "NONE",
+		"i": o.id,
+		"l": e.format(lastQuantity),
+		"z": e.format(o.executedQty),
+		"L": e.format(lastPrice),
+		"n": e.format(decimal.Zero),
+		"N": commissionAsset,
+		"T": now,
+		"t": tradeID,
+		"I": 0,
+		"w": o.isOpen(),
+		"m": maker,
+		"M": false,
+		"O": o.time,
+		"Z": e.format(o.cumQuote),
+		"Y": e.format(lastQuantity.Mul(lastPrice)),
+		"Q": e.format(o.quoteOrderQty),
+		"W": o.time,
+		"V": "EXPIRE_MAKER",
+	}
+}
+
+// accountEvent returns the spot user data event of balance changes of assets
+func (e *Exchange) accountEvent(assets ...string) any {
+	now := e.server.now()
+	balances := []any{}
+	for _, asset := range assets {
+		b := e.balance(asset)
+		balances = append(balances, map[string]any{"a": asset, "f": e.format(b.free), "l": e.format(b.locked)})
+	}
+	return map[string]any{
+		"e": "outboundAccountPosition",
+		"E": now,
+		"u": now,
+		"B": balances,
+	}
+}
+
+// futuresAccountEvent returns the futures user data event of the wallet and the position of symbol
+func (e *Exchange) futuresAccountEvent(symbol *Symbol) any {
+	now := e.server.now()
+	wallet := e.balance(symbol.QuoteAsset).free.String()
+	p := e.position(symbol.Symbol)
+	return map[string]any{
+		"e": "ACCOUNT_UPDATE",
+		"E": now,
+		"T": now,
+		"a": map[string]any{
+			"m": "ORDER",
+			"B": []any{map[string]any{"a": symbol.QuoteAsset, "wb": wallet, "cw": wallet, "bc": "0"}},
+			"P": []any{map[string]any{
+				"s":  symbol.Symbol,
+				"pa": p.amount.String(),
+				"ep": p.entryPrice.String(),
+				"cr": "0",
+				"up": e.prices[symbol.Symbol].Sub(p.entryPrice).Mul(p.amount).String(),
+				"mt": "cross",
+				"iw": "0",
+				"ps": "BOTH",
+			}},
+		},
+	}
+}
@@ -0,0 +1,205 @@
+package binancetest
+
+import (
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// security types of endpoints
+const (
+	securityNone = iota
+	securityAPIKey
+	securitySigned
+)
+
+type route struct {
+	security int
+	handle   func(e *Exchange, p params) (any, *common.APIError)
+}
+
+// routes are the REST endpoints served, keyed by method and path
+var routes = map[string]route{
+	"GET /api/v3/ping":                 {securityNone, handlePing},
+	"GET /api/v3/time":                 {securityNone, handleTime},
+	"GET /api/v3/exchangeInfo":         {securityNone, handleExchangeInfo},
+	"GET /api/v3/depth":                {securityNone, handleDepth},
+	"GET /api/v3/ticker/price":         {securityNone, handleTickerPrice},
+	"GET /api/v3/ticker/bookTicker":    {securityNone, handleBookTicker},
+	"POST /api/v3/order":               {securitySigned, handlePlaceOrder},
+	"POST /api/v3/order/test":          {securitySigned, handleTestOrder},
+	"GET /api/v3/order":                {securitySigned, handleGetOrder},
+	"DELETE /api/v3/order":             {securitySigned, handleCancelOrder},
+	"GET /api/v3/openOrders":           {securitySigned, handleOpenOrders},
+	"DELETE /api/v3/openOrders":        {securitySigned, handleCancelOpenOrders},
+	"GET /api/v3/allOrders":            {securitySigned, handleAllOrders},
+	"GET /api/v3/account":              {securitySigned, handleAccount},
+	"GET /api/v3/myTrades":             {securitySigned, handleMyTrades},
+	"GET /api/v3/rateLimit/order":      {securitySigned, handleOrderRateLimits},
+	"POST /api/v3/order/cancelReplace": {securitySigned, handleCancelReplace},
+	"POST /api/v3/userDataStream":      {securityAPIKey, handleStartUserStream},
+	"PUT /api/v3/userDataStream":       {securityAPIKey, handleKeepaliveUserStream},
+	"DELETE /api/v3/userDataStream":    {securityAPIKey, handleCloseUserStream},
+
+	"GET /fapi/v1/ping":              {securityNone, handlePing},
+	"GET /fapi/v1/time":              {securityNone, handleTime},
+	"GET /fapi/v1/exchangeInfo":      {securityNone, handleExchangeInfo},
+	"GET /fapi/v1/depth":             {securityNone, handleDepth},
+	"GET /fapi/v1/ticker/price":      {securityNone, handleTickerPrice},
+	"GET /fapi/v2/ticker/price":      {securityNone, handleTickerPrice},
+	"GET /fapi/v1/ticker/bookTicker": {securityNone, handleBookTicker},
+	"POST /fapi/v1/order":            {securitySigned, handlePlaceOrder},
+	"PUT /fapi/v1/order":             {securitySigned, handleModifyOrder},
+	"POST /fapi/v1/order/test":       {securitySigned, handleTestOrder},
+	"GET /fapi/v1/order":             {securitySigned, handleGetOrder},
+	"DELETE /fapi/v1/order":          {securitySigned, handleCancelOrder},
+	"GET /fapi/v1/openOrders":        {securitySigned, handleOpenOrders},
+	"DELETE /fapi/v1/allOpenOrders":  {securitySigned, handleCancelOpenOrders},
+	"GET /fapi/v1/allOrders":         {securitySigned, handleAllOrders},
+	"GET /fapi/v2/account":           {securitySigned, handleAccount},
+	"GET /fapi/v3/account":           {securitySigned, handleAccount},
+	"GET /fapi/v2/balance":           {securitySigned, handleBalance},
+	"GET /fapi/v3/balance":           {securitySigned, handleBalance},
+	"GET /fapi/v2/positionRisk":      {securitySigned, handlePositionRisk},
+	"GET /fapi/v3/positionRisk":      {securitySigned, handlePositionRisk},
+	"POST /fapi/v1/listenKey":        {securityAPIKey, handleStartUserStream},
+	"PUT /fapi/v1/listenKey":         {securityAPIKey, handleKeepaliveUserStream},
+	"DELETE /fapi/v1/listenKey":      {securityAPIKey, handleCloseUserStream},
+}
+
+// wsApiMethods map the websocket API methods of each market to the REST endpoints serving them
+var wsApiMethods = map[bool]map[string]string{
+	false: {
+		"ping":                      "GET /api/v3/ping",
+		"time":                      "GET /api/v3/time",
+		"exchangeInfo":              "GET /api/v3/exchangeInfo",
+		"depth":                     "GET /api/v3/depth",
+		"ticker.price":              "GET /api/v3/ticker/price",
+		"ticker.book":               "GET /api/v3/ticker/bookTicker",
+		"order.place":               "POST /api/v3/order",
+		"order.test":                "POST /api/v3/order/test",
+		"order.status":              "GET /api/v3/order",
+		"order.cancel":              "DELETE /api/v3/order",
+		"openOrders.status":         "GET /api/v3/openOrders",
+		"openOrders.cancelAll":      "DELETE /api/v3/openOrders",
+		"allOrders":                 "GET /api/v3/allOrders",
+		"order.cancelReplace":       "POST /api/v3/order/cancelReplace",
+		"myTrades":                  "GET /api/v3/myTrades",
+		"account.status":            "GET /api/v3/account",
+		"account.rateLimits.orders": "GET /api/v3/rateLimit/order",
+	},
+	true: {
+		"ping":                "GET /fapi/v1/ping",
+		"time":                "GET /fapi/v1/time",
+		"depth":               "GET /fapi/v1/depth",
+		"ticker.price":        "GET /fapi/v2/ticker/price",
+		"ticker.book":         "GET /fapi/v1/ticker/bookTicker",
+		"order.place":         "POST /fapi/v1/order",
+		"order.modify":        "PUT /fapi/v1/order",
+		"order.status":        "GET /fapi/v1/order",
+		"order.cancel":        "DELETE /fapi/v1/order",
+		"account.status":      "GET /fapi/v3/account",
+		"v2/account.status":   "GET /fapi/v2/account",
+		"account.balance":     "GET /fapi/v3/balance",
+		"v2/account.balance":  "GET /fapi/v2/balance",
+		"account.position":    "GET /fapi/v3/positionRisk",
+		"v2/account.position": "GET /fapi/v2/positionRisk",
+	},
+}
+
+func handlePing(e *Exchange, p params) (any, *common.APIError) {
+	return map[string]any{}, nil
+}
+
+func handleTime(e *Exchange, p params) (any, *common.APIError) {
+	return map[string]any{"serverTime": e.server.now()}, nil
+}
+
+func handleExchangeInfo(e *Exchange, p params) (any, *common.APIError) {
+	return e.exchangeInfo(p)
+}
+
+func handleDepth(e *Exchange, p params) (any, *common.APIError) {
+	return e.depth(p)
+}
+
+func handleTickerPrice(e *Exchange, p params) (any, *common.APIError) {
+	return e.tickerPrice(p)
+}
+
+func handleBookTicker(e *Exchange, p params) (any, *common.APIError) {
+	return e.bookTicker(p)
+}
+
+func handlePlaceOrder(e *Exchange, p params) (any, *common.APIError) {
+	return e.placeOrder(p, false)
+}
+
+func handleTestOrder(e *Exchange, p params) (any, *common.APIError) {
+	return e.placeOrder(p, true)
+}
+
+func handleGetOrder(e *Exchange, p params) (any, *common.APIError) {
+	return e.getOrder(p)
+}
+
+func handleCancelOrder(e *Exchange, p params) (any, *common.APIError) {
+	return e.cancelOrder(p)
+}
+
+func handleOpenOrders(e *Exchange, p params) (any, *common.APIError) {
+	return e.listOrders(p, true)
+}
+
+func handleCancelOpenOrders(e *Exchange, p params) (any, *common.APIError) {
+	return e.cancelOpenOrders(p)
+}
+
+func handleAllOrders(e *Exchange, p params) (any, *common.APIError) {
+	return e.listOrders(p, false)
+}
+
+func handleAccount(e *Exchange, p params) (any, *common.APIError) {
+	return e.account(p.get("omitZeroBalances") == "true"), nil
+}
+
+func handleMyTrades(e *Exchange, p params) (any, *common.APIError) {
+	return e.myTrades(p)
+}
+
+func handleOrderRateLimits(e *Exchange, p params) (any, *common.APIError) {
+	return e.orderRateLimits(), nil
+}
+
+func handleModifyOrder(e *Exchange, p params) (any, *common.APIError) {
+	return e.modifyOrder(p)
+}
+
+func handleCancelReplace(e *Exchange, p params) (any, *common.APIError) {
+	return e.cancelReplace(p)
+}
+
+func handleBalance(e *Exchange, p params) (any, *common.APIError) {
+	return e.futuresBalances(), nil
+}
+
+func handlePositionRisk(e *Exchange, p params) (any, *common.APIError) {
+	return e.positionRisk(p)
+}
+
+func handleStartUserStream(e *Exchange, p params) (any, *common.APIError) {
+	return map[string]any{"listenKey": e.server.newListenKey(e)}, nil
+}
+
+func handleKeepaliveUserStream(e *Exchange, p params) (any, *common.APIError) {
+	if apiErr := e.server.checkListenKey(e, p.get("listenKey")); apiErr != nil {
+		return nil, apiErr
+	}
+	return map[string]any{}, nil
+}
+
+func handleCloseUserStream(e *Exchange, p params) (any, *common.APIError) {
+	if apiErr := e.server.checkListenKey(e, p.get("listenKey")); apiErr != nil {
+		return nil, apiErr
+	}
+	e.server.closeListenKey(p.get("listenKey"))
+	return map[string]any{}, nil
+}
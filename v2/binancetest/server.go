@@ -0,0 +1,394 @@
+// Package binancetest provides a fake exchange serving the core spot and USDⓈ-M futures REST endpoints, user data
+// streams and the websocket API in process, so that clients can be tested offline:
+//
+//	srv := binancetest.NewServer()
+//	defer srv.Close()
+//	client := binance.NewClient(binancetest.APIKey, binancetest.SecretKey).SetApiEndpoint(srv.URL)
+//	futuresClient := futures.NewClient(binancetest.APIKey, binancetest.SecretKey).SetApiEndpoint(srv.URL)
+//
+// The websocket services dial the package endpoints of the clients, which are pointed at the server with:
+//
+//	binance.BaseWsMainURL = srv.WsURL()
+//	binance.BaseWsApiMainURL = srv.WsApiURL()
+//	futures.BaseWsPrivateMainUrl = srv.FuturesWsPrivateURL()
+//	futures.BaseWsApiMainURL = srv.FuturesWsApiURL()
+//
+// Orders are filled against the last price of their symbol, set with SetPrice: market orders and limit orders crossing
+// the price are filled at once at the last price, other limit orders rest until the price crosses them and are then
+// filled at their limit price. Orders are always filled completely, no fees are charged and futures margin isn't
+// checked. Signatures, API keys and timestamps are checked like the exchange does.
+package binancetest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
+)
+
+// Credentials of the default HMAC API key of the server
+const (
+	APIKey    = "binancetest-api-key"
+	SecretKey = "binancetest-secret-key"
+)
+
+type apiKey struct {
+	secretKey string
+	keyType   string
+}
+
+// Server is a fake exchange listening on a local port
+type Server struct {
+	// URL is the base URL of the REST endpoints of both markets, e.g. http://127.0.0.1:1234
+	URL     string
+	Spot    *Exchange
+	Futures *Exchange
+
+	httpServer *httptest.Server
+	upgrader   websocket.Upgrader
+
+	mu                 sync.Mutex
+	keys               map[string]apiKey
+	clockOffset        time.Duration
+	listenKeys         map[string]*Exchange
+	subscribers        map[*subscriber]struct{}
+	failures           map[string][]*common.APIError
+	lastSubscriptionID int64
+}
+
+// NewServer starts a server with the default API key, BTCUSDT and ETHUSDT on both markets, a spot account holding
+// 10000 USDT, 1 BTC and 10 ETH and a futures wallet of 10000 USDT
+func NewServer() *Server {
+	s := &Server{
+		keys:        map[string]apiKey{APIKey: {secretKey: SecretKey, keyType: common.KeyTypeHmac}},
+		listenKeys:  make(map[string]*Exchange),
+		subscribers: make(map[*subscriber]struct{}),
+		failures:    make(map[string][]*common.APIError),
+	}
+	s.Spot = newExchange(s, false)
+	s.Futures = newExchange(s, true)
+
+	s.Spot.AddSymbol(Symbol{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT"}, "60000")
+	s.Spot.AddSymbol(Symbol{Symbol: "ETHUSDT", BaseAsset: "ETH", QuoteAsset: "USDT", StepSize: "0.0001"}, "3000")
+	s.Spot.SetBalance("USDT", "10000")
+	s.Spot.SetBalance("BTC", "1")
+	s.Spot.SetBalance("ETH", "10")
+	s.Futures.AddSymbol(Symbol{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", TickSize: "0.1", MinNotional: "100"}, "60000")
+	s.Futures.AddSymbol(Symbol{Symbol: "ETHUSDT", BaseAsset: "ETH", QuoteAsset: "USDT", MinNotional: "20"}, "3000")
+	s.Futures.SetBalance("USDT", "10000")
+
+	s.httpServer = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.httpServer.URL
+	return s
+}
+
+// Close closes the websocket connections and shuts down the server
+func (s *Server) Close() {
+	s.mu.Lock()
+	for sub := range s.subscribers {
+		sub.conn.close()
+	}
+	s.mu.Unlock()
+	s.httpServer.CloseClientConnections()
+	s.httpServer.Close()
+}
+
+func (s *Server) wsBaseURL() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+// WsURL returns the spot websocket endpoint, user data streams are served at WsURL()/<listenKey>
+func (s *Server) WsURL() string {
+	return s.wsBaseURL() + "/ws"
+}
+
+// WsApiURL returns the spot websocket API endpoint
+func (s *Server) WsApiURL() string {
+	return s.wsBaseURL() + "/ws-api/v3"
+}
+
+// FuturesWsPrivateURL returns the futures private websocket endpoint, user data streams are served at
+// FuturesWsPrivateURL()?listenKey=<listenKey>
+func (s *Server) FuturesWsPrivateURL() string {
+	return s.wsBaseURL() + "/private/ws"
+}
+
+// FuturesWsApiURL returns the futures websocket API endpoint
+func (s *Server) FuturesWsApiURL() string {
+	return s.wsBaseURL() + "/ws-fapi/v1"
+}
+
+// AddKey add an API key, keyType is one of common.KeyTypeHmac, common.KeyTypeRsa and common.KeyTypeEd25519
+func (s *Server) AddKey(key, secretKey, keyType string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[key] = apiKey{secretKey: secretKey, keyType: keyType}
+}
+
+// SetClockOffset shifts the server clock by offset, e.g. to test recvWindow errors
+func (s *Server) SetClockOffset(offset time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clockOffset = offset
+}
+
+// FailNext makes the next request to the REST endpoint fail with the given error instead of being served,
+// e.g. FailNext("POST", "/api/v3/order", http.StatusServiceUnavailable, -1001, "Internal error; unable to process your request. Please try again.")
+func (s *Server) FailNext(method, endpoint string, statusCode int, code int64, msg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := method + " " + endpoint
+	s.failures[key] = append(s.failures[key], newError(statusCode, code, msg))
+}
+
+func (s *Server) nextFailure(key string) *common.APIError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	failures := s.failures[key]
+	if len(failures) == 0 {
+		return nil
+	}
+	s.failures[key] = failures[1:]
+	return failures[0]
+}
+
+// now returns the server time in milliseconds
+func (s *Server) now() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return time.Now().Add(s.clockOffset).UnixMilli()
+}
+
+func (s *Server) exchange(path string) *Exchange {
+	if strings.HasPrefix(path, "/fapi/") || strings.HasPrefix(path, "/ws-fapi/") || strings.HasPrefix(path, "/private/") {
+		return s.Futures
+	}
+	return s.Spot
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/ws-api/v3" || r.URL.Path == "/ws-fapi/v1":
+		s.serveWsApi(w, r)
+		return
+	case strings.HasPrefix(r.URL.Path, "/ws/") || r.URL.Path == "/private/ws":
+		s.serveUserData(w, r)
+		return
+	}
+	key := r.Method + " " + r.URL.Path
+	rt, ok := routes[key]
+	if !ok {
+		writeError(w, newError(http.StatusNotFound, -1000, "binancetest: unsupported endpoint "+key))
+		return
+	}
+	if apiErr := s.nextFailure(key); apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, newError(http.StatusBadRequest, -1000, err.Error()))
+		return
+	}
+	p := params{}
+	for _, raw := range []string{r.URL.RawQuery, string(body)} {
+		values, err := url.ParseQuery(raw)
+		if err != nil {
+			writeError(w, newError(http.StatusBadRequest, -1100, "Illegal characters found in a parameter."))
+			return
+		}
+		for k := range values {
+			p[k] = values.Get(k)
+		}
+	}
+	if rt.security != securityNone {
+		payload, signature := splitSignature(r.URL.RawQuery)
+		if apiErr := s.authenticate(rt.security, r.Header.Get("X-MBX-APIKEY"), payload+string(body), signature, p); apiErr != nil {
+			writeError(w, apiErr)
+			return
+		}
+	}
+	res, apiErr := rt.handle(s.exchange(r.URL.Path), p)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+// splitSignature returns the query without the signature parameter, which the client appends last, and the signature
+func splitSignature(rawQuery string) (payload, signature string) {
+	i := strings.LastIndex("&"+rawQuery, "&signature=")
+	if i < 0 {
+		return rawQuery, ""
+	}
+	signature, _ = url.QueryUnescape(rawQuery[i+len("signature="):])
+	if i > 0 {
+		i--
+	}
+	return rawQuery[:i], signature
+}
+
+// authenticate checks the API key, and the signature and the timestamp of signed requests
+func (s *Server) authenticate(security int, key, payload, signature string, p params) *common.APIError {
+	if key == "" {
+		return newError(http.StatusUnauthorized, -2014, "API-key format invalid.")
+	}
+	s.mu.Lock()
+	k, ok := s.keys[key]
+	s.mu.Unlock()
+	if !ok {
+		return newError(http.StatusUnauthorized, -2015, "Invalid API-key, IP, or permissions for action.")
+	}
+	if security != securitySigned {
+		return nil
+	}
+	if signature == "" {
+		return errMandatory("signature")
+	}
+	if _, err := strconv.ParseInt(p.get("timestamp"), 10, 64); err != nil {
+		return errMandatory("timestamp")
+	}
+	sf, err := common.SignFunc(k.keyType)
+	if err != nil {
+		return newError(http.StatusBadRequest, -1022, "Signature for this request is not valid.")
+	}
+	expected, err := sf(k.secretKey, payload)
+	if err != nil || *expected != signature {
+		return newError(http.StatusBadRequest, -1022, "Signature for this request is not valid.")
+	}
+	return s.checkTimestamp(p)
+}
+
+// checkTimestamp checks the timestamp of a signed request against its recvWindow
+func (s *Server) checkTimestamp(p params) *common.APIError {
+	timestamp, err := strconv.ParseInt(p.get("timestamp"), 10, 64)
+	if err != nil {
+		return errMandatory("timestamp")
+	}
+	recvWindow := int64(5000)
+	if v, err := strconv.ParseInt(p.get("recvWindow"), 10, 64); err == nil {
+		recvWindow = v
+	}
+	if now := s.now(); timestamp > now+1000 || now-timestamp > recvWindow {
+		return newError(http.StatusBadRequest, -1021, "Timestamp for this request is outside of the recvWindow.")
+	}
+	return nil
+}
+
+// newListenKey creates a listen key of the user data stream of e
+func (s *Server) newListenKey(e *Exchange) string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	key := hex.EncodeToString(b)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listenKeys[key] = e
+	return key
+}
+
+func (s *Server) checkListenKey(e *Exchange, key string) *common.APIError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if key == "" {
+		return errMandatory("listenKey")
+	}
+	if s.listenKeys[key] != e {
+		return newError(http.StatusBadRequest, -1125, "This listenKey does not exist.")
+	}
+	return nil
+}
+
+// listenKeyOf returns a listen key of the user data stream of e, or "" if there is none
+func (s *Server) listenKeyOf(e *Exchange) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, owner := range s.listenKeys {
+		if owner == e {
+			return key
+		}
+	}
+	return ""
+}
+
+// closeListenKey removes the listen key and closes its streams
+func (s *Server) closeListenKey(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.listenKeys, key)
+	for sub := range s.subscribers {
+		if sub.listenKey == key {
+			delete(s.subscribers, sub)
+			sub.conn.close()
+		}
+	}
+}
+
+// publish sends events of e to its user data streams
+func (s *Server) publish(e *Exchange, events []any) {
+	if len(events) == 0 {
+		return
+	}
+	s.mu.Lock()
+	var subscribers []*subscriber
+	for sub := range s.subscribers {
+		if sub.exchange == e {
+			subscribers = append(subscribers, sub)
+		}
+	}
+	s.mu.Unlock()
+	for _, sub := range subscribers {
+		for _, event := range events {
+			if sub.listenKey == "" {
+				event = map[string]any{"subscriptionId": sub.subscriptionID, "event": event}
+			}
+			sub.conn.writeJSON(event)
+		}
+	}
+}
+
+func writeError(w http.ResponseWriter, apiErr *common.APIError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(apiErr.StatusCode)
+	json.NewEncoder(w).Encode(apiErr)
+}
+
+func newError(statusCode int, code int64, msg string) *common.APIError {
+	return &common.APIError{Code: code, Message: msg, StatusCode: statusCode}
+}
+
+func errMandatory(name string) *common.APIError {
+	return newError(http.StatusBadRequest, -1102, "Mandatory parameter '"+name+"' was not sent, was empty/null, or malformed.")
+}
+
+// params are the parameters of a REST or websocket API request
+type params map[string]string
+
+func (p params) get(key string) string {
+	return p[key]
+}
+
+// decimal returns the decimal value of key and whether it's set
+func (p params) decimal(key string) (decimal.Decimal, bool, *common.APIError) {
+	v := p.get(key)
+	if v == "" {
+		return decimal.Zero, false, nil
+	}
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return decimal.Zero, false, newError(http.StatusBadRequest, -1100, "Illegal characters found in parameter '"+key+"'; legal range is '^([0-9]{1,20})(\\.[0-9]{1,20})?$'.")
+	}
+	return d, true, nil
+}
@@ -0,0 +1,573 @@
+package binancetest_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/binancetest"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/suite"
+)
+
+type serverTestSuite struct {
+	suite.Suite
+	srv           *binancetest.Server
+	client        *binance.Client
+	futuresClient *futures.Client
+}
+
+func TestServer(t *testing.T) {
+	suite.Run(t, new(serverTestSuite))
+}
+
+func (s *serverTestSuite) SetupTest() {
+	s.srv = binancetest.NewServer()
+	s.client = binance.NewClient(binancetest.APIKey, binancetest.SecretKey).SetApiEndpoint(s.srv.URL)
+	s.futuresClient = futures.NewClient(binancetest.APIKey, binancetest.SecretKey).SetApiEndpoint(s.srv.URL)
+}
+
+func (s *serverTestSuite) TearDownTest() {
+	s.srv.Close()
+}
+
+func (s *serverTestSuite) TestSpotOrders() {
+	r := s.Require()
+	ctx := context.Background()
+
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("0.01").Price("59000").Do(ctx)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeNew, res.Status)
+	free, locked := s.srv.Spot.Balance("USDT")
+	r.Equal("9410", free)
+	r.Equal("590", locked)
+
+	depth, err := s.client.NewDepthService().Symbol("BTCUSDT").Do(ctx)
+	r.NoError(err)
+	r.Len(depth.Bids, 1)
+	r.Equal("59000.00000000", depth.Bids[0].Price)
+
+	s.srv.Spot.SetPrice("BTCUSDT", "58500")
+	order, err := s.client.NewGetOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(ctx)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeFilled, order.Status)
+	r.Equal("590.00000000", order.CummulativeQuoteQuantity)
+	free, locked = s.srv.Spot.Balance("BTC")
+	r.Equal("1.01", free)
+	r.Equal("0", locked)
+
+	res, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeSell).
+		Type(binance.OrderTypeMarket).Quantity("0.5").Do(ctx)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeFilled, res.Status)
+	r.Len(res.Fills, 1)
+	r.Equal("58500.00000000", res.Fills[0].Price)
+
+	res, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeSell).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("0.1").Price("70000").Do(ctx)
+	r.NoError(err)
+	openOrders, err := s.client.NewListOpenOrdersService().Symbol("BTCUSDT").Do(ctx)
+	r.NoError(err)
+	r.Len(openOrders, 1)
+	cancel, err := s.client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(ctx)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeCanceled, cancel.Status)
+	_, err = s.client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(ctx)
+	r.ErrorIs(err, common.ErrUnknownOrder)
+
+	account, err := s.client.NewGetAccountService().Do(ctx)
+	r.NoError(err)
+	r.Len(account.Balances, 3)
+	r.Equal(binance.Balance{Asset: "BTC", Free: "0.51000000", Locked: "0.00000000"}, account.Balances[0])
+}
+
+func (s *serverTestSuite) TestSpotOrderRejected() {
+	r := s.Require()
+	ctx := context.Background()
+
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("1").Do(ctx)
+	r.ErrorIs(err, common.ErrInsufficientBalance)
+
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("0.01").Price("59000.001").Do(ctx)
+	r.ErrorIs(err, common.ErrFilterFailure)
+
+	_, err = s.client.NewCreateOrderService().Symbol("XRPUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("1").Do(ctx)
+	r.ErrorIs(err, common.ErrInvalidSymbol)
+}
+
+func (s *serverTestSuite) TestAuthentication() {
+	r := s.Require()
+	ctx := context.Background()
+
+	client := binance.NewClient(binancetest.APIKey, "wrong").SetApiEndpoint(s.srv.URL)
+	_, err := client.NewGetAccountService().Do(ctx)
+	r.ErrorIs(err, common.ErrInvalidSignature)
+
+	client = binance.NewClient("unknown", binancetest.SecretKey).SetApiEndpoint(s.srv.URL)
+	_, err = client.NewGetAccountService().Do(ctx)
+	r.ErrorIs(err, common.ErrInvalidAPIKey)
+
+	s.srv.SetClockOffset(time.Minute)
+	_, err = s.client.NewGetAccountService().Do(ctx)
+	r.ErrorIs(err, common.ErrTimestampOutsideRecvWindow)
+}
+
+func (s *serverTestSuite) TestFailNext() {
+	r := s.Require()
+	s.srv.FailNext(http.MethodGet, "/api/v3/time", http.StatusServiceUnavailable, -1001, "Internal error; unable to process your request. Please try again.")
+	_, err := s.client.NewServerTimeService().Do(context.Background())
+	r.ErrorIs(err, common.ErrServiceUnavailable)
+	_, err = s.client.NewServerTimeService().Do(context.Background())
+	r.NoError(err)
+}
+
+func (s *serverTestSuite) TestSymbolRules() {
+	r := s.Require()
+	rules, err := s.client.NewSymbolRulesRegistry().Rules(context.Background(), "ETHUSDT")
+	r.NoError(err)
+	r.Equal("0.0001", rules.StepSize.String())
+	r.Equal("5", rules.MinNotional.String())
+}
+
+func (s *serverTestSuite) TestSpotUserDataStream() {
+	r := s.Require()
+	ctx := context.Background()
+	defer setURL(&binance.BaseWsMainURL, s.srv.WsURL())()
+
+	listenKey, err := s.client.NewStartUserStreamService().Do(ctx)
+	r.NoError(err)
+	events := make(chan *binance.WsUserDataEvent, 10)
+	doneC, stopC, err := binance.WsUserDataServe(listenKey, func(event *binance.WsUserDataEvent) {
+		events <- event
+	}, func(err error) {})
+	r.NoError(err)
+	defer func() {
+		close(stopC)
+		<-doneC
+	}()
+
+	_, err = s.client.NewCreateOrderService().Symbol("ETHUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).QuoteOrderQty("300").Do(ctx)
+	r.NoError(err)
+
+	event := receiveSpot(s.T(), events)
+	r.Equal(binance.UserDataEventTypeExecutionReport, event.Event)
+	r.Equal("NEW", event.OrderUpdate.ExecutionType)
+	event = receiveSpot(s.T(), events)
+	r.Equal("TRADE", event.OrderUpdate.ExecutionType)
+	r.Equal("0.10000000", event.OrderUpdate.LatestVolume)
+	event = receiveSpot(s.T(), events)
+	r.Equal(binance.UserDataEventTypeOutboundAccountPosition, event.Event)
+	r.Equal([]binance.WsAccountUpdate{
+		{Asset: "ETH", Free: "10.10000000", Locked: "0.00000000"},
+		{Asset: "USDT", Free: "9700.00000000", Locked: "0.00000000"},
+	}, event.AccountUpdate.WsAccountUpdates)
+}
+
+func (s *serverTestSuite) TestSpotWsApi() {
+	r := s.Require()
+	defer setURL(&binance.BaseWsApiMainURL, s.srv.WsApiURL())()
+
+	service, err := binance.NewOrderCreateWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	request := binance.NewOrderCreateWsRequest().Symbol("BTCUSDT").Side(binance.SideTypeSell).
+		Type(binance.OrderTypeMarket).Quantity("0.1")
+	res, err := service.SyncDo("request-1", request)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeFilled, res.Result.Status)
+	r.Equal("6000.00000000", res.Result.CummulativeQuoteQuantity)
+
+	service.SecretKey = "wrong"
+	_, err = service.SyncDo("request-2", request)
+	r.ErrorIs(err, common.ErrInvalidSignature)
+}
+
+func (s *serverTestSuite) TestSpotWsApiOrderLifecycle() {
+	r := s.Require()
+	defer setURL(&binance.BaseWsApiMainURL, s.srv.WsApiURL())()
+
+	create, err := binance.NewOrderCreateWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	placed, err := create.SyncDo("place", binance.NewOrderCreateWsRequest().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).Quantity("0.01").Price("59000"))
+	r.NoError(err)
+
+	replace, err := binance.NewOrderCancelReplaceWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	replaced, err := replace.SyncDo("replace", binance.NewOrderCancelReplaceWsRequest().Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).Type(binance.OrderTypeLimit).CancelReplaceMode(binance.CancelReplaceModeStopOnFailure).
+		TimeInForce(binance.TimeInForceTypeGTC).Quantity("0.01").Price("59500").CancelOrderID(placed.Result.OrderID))
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeCanceled, replaced.Result.CancelResponse.Status)
+	newOrderID := replaced.Result.NewOrderResponse.OrderID
+
+	openOrders, err := binance.NewOpenOrdersStatusWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	open, err := openOrders.SyncDo("open", binance.NewOpenOrdersStatusWsRequest().Symbol("BTCUSDT"))
+	r.NoError(err)
+	r.Len(open.Result, 1)
+	r.Equal(newOrderID, open.Result[0].OrderID)
+
+	s.srv.Spot.SetPrice("BTCUSDT", "59400")
+	status, err := binance.NewOrderStatusWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	order, err := status.SyncDo("status", binance.NewOrderStatusWsRequest().Symbol("BTCUSDT").OrderID(newOrderID))
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeFilled, order.Result.Status)
+
+	myTrades, err := binance.NewMyTradesWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	trades, err := myTrades.SyncDo("trades", binance.NewMyTradesWsRequest().Symbol("BTCUSDT"))
+	r.NoError(err)
+	r.Len(trades.Result, 1)
+	r.Equal("59500.00000000", trades.Result[0].Price)
+	r.True(trades.Result[0].IsMaker)
+
+	cancel, err := binance.NewOrderCancelWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	_, err = cancel.SyncDo("cancel", binance.NewOrderCancelWsRequest().Symbol("BTCUSDT").OrderID(newOrderID))
+	r.ErrorIs(err, common.ErrUnknownOrder)
+
+	_, err = create.SyncDo("place-2", binance.NewOrderCreateWsRequest().Symbol("ETHUSDT").Side(binance.SideTypeSell).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).Quantity("1").Price("3500"))
+	r.NoError(err)
+	cancelAll, err := binance.NewOpenOrdersCancelAllWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	canceled, err := cancelAll.SyncDo("cancel-all", binance.NewOpenOrdersCancelAllWsRequest().Symbol("ETHUSDT"))
+	r.NoError(err)
+	r.Len(canceled.Result.Orders, 1)
+
+	allOrders, err := binance.NewAllOrdersWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	all, err := allOrders.SyncDo("all", binance.NewAllOrdersWsRequest().Symbol("BTCUSDT"))
+	r.NoError(err)
+	r.Len(all.Result, 2)
+
+	account, err := binance.NewAccountStatusWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	status2, err := account.SyncDo("account", binance.NewAccountStatusWsRequest())
+	r.NoError(err)
+	r.Equal(binance.Balance{Asset: "BTC", Free: "1.01000000", Locked: "0.00000000"}, status2.Result.Balances[0])
+
+	rateLimits, err := binance.NewAccountRateLimitsOrdersWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	limits, err := rateLimits.SyncDo("rate-limits", binance.NewAccountRateLimitsOrdersWsRequest())
+	r.NoError(err)
+	r.Len(limits.Result, 2)
+	r.Equal(3, limits.Result[0].Count)
+}
+
+func (s *serverTestSuite) TestSpotWsApiSession() {
+	r := s.Require()
+	defer setURL(&binance.BaseWsApiMainURL, s.srv.WsApiURL())()
+
+	secretKey := s.addEd25519Key("ed25519-key")
+
+	session, err := binance.NewSessionWsService("ed25519-key", secretKey)
+	r.NoError(err)
+	status, err := session.Status("status")
+	r.NoError(err)
+	r.Empty(status.Result.APIKey)
+
+	logon, err := session.Logon("logon")
+	r.NoError(err)
+	r.Equal("ed25519-key", logon.Result.APIKey)
+	r.True(session.IsLoggedOn())
+
+	// the services of the session send unsigned requests, the secret key isn't needed anymore
+	create := binance.NewOrderCreateWsServiceWithSession(session)
+	create.SecretKey = ""
+	order, err := create.SyncDo("place", binance.NewOrderCreateWsRequest().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("0.01"))
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeFilled, order.Result.Status)
+
+	_, err = session.Logout("logout")
+	r.NoError(err)
+	r.False(session.IsLoggedOn())
+	_, err = create.SyncDo("place-2", binance.NewOrderCreateWsRequest().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("0.01"))
+	r.ErrorIs(err, websocket.ErrorSecretKeyIsNotSet)
+
+	// a HMAC key can't log on
+	hmacSession, err := binance.NewSessionWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	hmacSession.KeyType = common.KeyTypeHmac
+	_, err = hmacSession.Logon("logon")
+	r.ErrorIs(err, websocket.ErrorSessionKeyType)
+}
+
+func (s *serverTestSuite) TestSpotWsApiMarketData() {
+	r := s.Require()
+	defer setURL(&binance.BaseWsApiMainURL, s.srv.WsApiURL())()
+
+	// market data and trading share the connection of the session
+	session, err := binance.NewSessionWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	session.KeyType = common.KeyTypeHmac
+
+	_, err = binance.NewPingWsServiceWithSession(session).SyncDo("ping")
+	r.NoError(err)
+	serverTime, err := binance.NewServerTimeWsServiceWithSession(session).SyncDo("time")
+	r.NoError(err)
+	r.InDelta(time.Now().UnixMilli(), serverTime.Result.ServerTime, 5000)
+
+	exchangeInfo, err := binance.NewExchangeInfoWsServiceWithSession(session).SyncDo("exchange-info", binance.NewExchangeInfoWsRequest().Symbol("ETHUSDT"))
+	r.NoError(err)
+	r.Len(exchangeInfo.Result.Symbols, 1)
+	r.Equal("0.0001", exchangeInfo.Result.Symbols[0].LotSizeFilter().StepSize)
+
+	prices, err := binance.NewTickerPriceWsServiceWithSession(session).SyncDo("price", binance.NewTickerPriceWsRequest().Symbol("BTCUSDT"))
+	r.NoError(err)
+	r.Equal([]*binance.SymbolPrice{{Symbol: "BTCUSDT", Price: "60000.00000000"}}, prices.Result)
+
+	_, err = binance.NewOrderCreateWsServiceWithSession(session).SyncDo("place", binance.NewOrderCreateWsRequest().Symbol("BTCUSDT").
+		Side(binance.SideTypeSell).Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).Quantity("0.5").Price("61000"))
+	r.NoError(err)
+	depth, err := binance.NewDepthWsServiceWithSession(session).SyncDo("depth", binance.NewDepthWsRequest().Symbol("BTCUSDT"))
+	r.NoError(err)
+	r.Empty(depth.Result.Bids)
+	r.Equal([]binance.Ask{{Price: "61000.00000000", Quantity: "0.50000000"}}, depth.Result.Asks)
+
+	_, err = binance.NewTickerPriceWsServiceWithSession(session).SyncDo("unknown", binance.NewTickerPriceWsRequest().Symbol("XRPUSDT"))
+	r.ErrorIs(err, common.ErrInvalidSymbol)
+}
+
+func (s *serverTestSuite) TestSpotUserDataSubscription() {
+	r := s.Require()
+	defer setURL(&binance.BaseWsApiMainURL, s.srv.WsApiURL())()
+
+	events := make(chan *binance.WsUserDataEvent, 10)
+	doneC, stopC, err := binance.WsUserDataServeSignature(binancetest.APIKey, binancetest.SecretKey, common.KeyTypeHmac, 0,
+		func(event *binance.WsUserDataEvent) {
+			events <- event
+		}, func(err error) {})
+	r.NoError(err)
+	defer func() {
+		close(stopC)
+		<-doneC
+	}()
+
+	// orders are placed until the subscription, which is sent asynchronously, receives their events
+	r.Eventually(func() bool {
+		_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+			Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+			Quantity("0.001").Price("50000").Do(context.Background())
+		r.NoError(err)
+		select {
+		case event := <-events:
+			r.Equal(binance.UserDataEventTypeExecutionReport, event.Event)
+			r.Equal("BTCUSDT", event.OrderUpdate.Symbol)
+			return true
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func (s *serverTestSuite) TestFuturesPosition() {
+	r := s.Require()
+	ctx := context.Background()
+
+	_, err := s.futuresClient.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeMarket).Quantity("0.1").Do(ctx)
+	r.NoError(err)
+	amount, entryPrice := s.srv.Futures.Position("BTCUSDT")
+	r.Equal("0.1", amount)
+	r.Equal("60000", entryPrice)
+
+	_, err = s.futuresClient.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeMarket).Quantity("0.2").ReduceOnly(true).Do(ctx)
+	r.Error(err)
+
+	res, err := s.futuresClient.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceTypeGTC).
+		Quantity("0.1").Price("61000").ReduceOnly(true).Do(ctx)
+	r.NoError(err)
+	r.Equal(futures.OrderStatusTypeNew, res.Status)
+
+	s.srv.Futures.SetPrice("BTCUSDT", "61500")
+	order, err := s.futuresClient.NewGetOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(ctx)
+	r.NoError(err)
+	r.Equal(futures.OrderStatusTypeFilled, order.Status)
+	r.Equal("61000", order.AvgPrice)
+
+	balances, err := s.futuresClient.NewGetBalanceService().Do(ctx)
+	r.NoError(err)
+	r.Len(balances, 1)
+	r.Equal("10100", balances[0].Balance)
+	positions, err := s.futuresClient.NewGetPositionRiskService().Symbol("BTCUSDT").Do(ctx)
+	r.NoError(err)
+	r.Len(positions, 1)
+	r.Equal("0", positions[0].PositionAmt)
+}
+
+func (s *serverTestSuite) TestFuturesUserDataStream() {
+	r := s.Require()
+	ctx := context.Background()
+	defer setURL(&futures.BaseWsPrivateMainUrl, s.srv.FuturesWsPrivateURL())()
+
+	listenKey, err := s.futuresClient.NewStartUserStreamService().Do(ctx)
+	r.NoError(err)
+	events := make(chan *futures.WsUserDataEvent, 10)
+	doneC, stopC, err := futures.WsUserDataServe(listenKey, func(event *futures.WsUserDataEvent) {
+		events <- event
+	}, func(err error) {})
+	r.NoError(err)
+	defer func() {
+		close(stopC)
+		<-doneC
+	}()
+
+	_, err = s.futuresClient.NewCreateOrderService().Symbol("ETHUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeMarket).Quantity("1").Do(ctx)
+	r.NoError(err)
+
+	event := receiveFutures(s.T(), events)
+	r.Equal(futures.UserDataEventTypeOrderTradeUpdate, event.Event)
+	r.Equal(futures.OrderExecutionTypeNew, event.OrderTradeUpdate.ExecutionType)
+	event = receiveFutures(s.T(), events)
+	r.Equal(futures.OrderExecutionTypeTrade, event.OrderTradeUpdate.ExecutionType)
+	r.Equal("3000", event.OrderTradeUpdate.LastFilledPrice)
+	event = receiveFutures(s.T(), events)
+	r.Equal(futures.UserDataEventTypeAccountUpdate, event.Event)
+	r.Equal("-1", event.AccountUpdate.Positions[0].Amount)
+}
+
+func (s *serverTestSuite) TestFuturesWsApiSession() {
+	r := s.Require()
+	defer setURL(&futures.BaseWsApiMainURL, s.srv.FuturesWsApiURL())()
+	secretKey := s.addEd25519Key("ed25519-key")
+
+	session, err := futures.NewSessionWsService("ed25519-key", secretKey)
+	r.NoError(err)
+	_, err = session.Logon("logon")
+	r.NoError(err)
+
+	account := futures.NewWsAccountServiceWithSession(session)
+	account.SecretKey = ""
+	balances, err := account.SyncGetAccountBalance("balance")
+	r.NoError(err)
+	r.Equal("USDT", balances.Result[0].Asset)
+	r.Equal("10000", balances.Result[0].Balance)
+}
+
+func (s *serverTestSuite) TestFuturesWsApiModify() {
+	r := s.Require()
+	defer setURL(&futures.BaseWsApiMainURL, s.srv.FuturesWsApiURL())()
+
+	place, err := futures.NewOrderPlaceWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	placed, err := place.SyncDo("place", futures.NewOrderPlaceWsRequest().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceTypeGTC).Quantity("0.1").Price("59000"))
+	r.NoError(err)
+
+	modify, err := futures.NewOrderModifyWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	modified, err := modify.SyncDo("modify", futures.NewOrderModifyWsRequest().Symbol("BTCUSDT").
+		OrderID(placed.Result.OrderID).Side(futures.SideTypeBuy).Quantity("0.2").Price("59500"))
+	r.NoError(err)
+	r.Equal(futures.OrderStatusTypeNew, modified.Result.Status)
+	r.Equal("59500", modified.Result.Price)
+	r.Equal("0.2", modified.Result.OriginalQuantity)
+
+	book, err := futures.NewBookTickerWsService()
+	r.NoError(err)
+	ticker, err := book.SyncDo("book", futures.NewBookTickerWsRequest().Symbol("BTCUSDT"))
+	r.NoError(err)
+	r.Equal("59500", ticker.Result[0].BidPrice)
+	r.Equal("0.2", ticker.Result[0].BidQuantity)
+	depth, err := futures.NewDepthWsService()
+	r.NoError(err)
+	orderBook, err := depth.SyncDo("depth", futures.NewDepthWsRequest().Symbol("BTCUSDT"))
+	r.NoError(err)
+	r.Equal([]futures.Bid{{Price: "59500", Quantity: "0.2"}}, orderBook.Result.Bids)
+
+	modified, err = modify.SyncDo("take", futures.NewOrderModifyWsRequest().Symbol("BTCUSDT").
+		OrderID(placed.Result.OrderID).Side(futures.SideTypeBuy).Quantity("0.2").Price("60500"))
+	r.NoError(err)
+	r.Equal(futures.OrderStatusTypeFilled, modified.Result.Status)
+	positions, err := futures.NewAccountPositionWsService(binancetest.APIKey, binancetest.SecretKey)
+	r.NoError(err)
+	position, err := positions.SyncDo("position", futures.NewAccountPositionWsRequest().Symbol("BTCUSDT"))
+	r.NoError(err)
+	r.Equal("0.2", position.Result[0].PositionAmt)
+}
+
+func (s *serverTestSuite) TestFuturesWsApiUserDataStream() {
+	r := s.Require()
+	defer setURL(&futures.BaseWsApiMainURL, s.srv.FuturesWsApiURL())()
+
+	start, err := futures.NewStartUserStreamWsService(binancetest.APIKey)
+	r.NoError(err)
+	started, err := start.SyncDo("start")
+	r.NoError(err)
+	r.NotEmpty(started.Result.ListenKey)
+
+	ping, err := futures.NewKeepaliveUserStreamWsService(binancetest.APIKey)
+	r.NoError(err)
+	pinged, err := ping.SyncDo("ping")
+	r.NoError(err)
+	r.Equal(started.Result.ListenKey, pinged.Result.ListenKey)
+
+	stop, err := futures.NewCloseUserStreamWsService(binancetest.APIKey)
+	r.NoError(err)
+	_, err = stop.SyncDo("stop")
+	r.NoError(err)
+	_, err = ping.SyncDo("ping again")
+	r.Error(err)
+	r.Equal(int64(-1125), err.(*common.APIError).Code)
+}
+
+// addEd25519Key adds an Ed25519 API key to the server and returns its private key
+func (s *serverTestSuite) addEd25519Key(key string) string {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	s.Require().NoError(err)
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	s.Require().NoError(err)
+	secretKey := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	s.srv.AddKey(key, secretKey, common.KeyTypeEd25519)
+	return secretKey
+}
+
+func receiveSpot(t *testing.T, events chan *binance.WsUserDataEvent) *binance.WsUserDataEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event received")
+	}
+	return nil
+}
+
+func receiveFutures(t *testing.T, events chan *futures.WsUserDataEvent) *futures.WsUserDataEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event received")
+	}
+	return nil
+}
+
+// setURL sets an endpoint variable, the returned function restores it
+func setURL(v *string, url string) func() {
+	old := *v
+	*v = url
+	return func() { *v = old }
+}
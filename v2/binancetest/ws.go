@@ -0,0 +1,244 @@
+package binancetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/gorilla/websocket"
+)
+
+// wsConn serializes writes to a websocket connection
+type wsConn struct {
+	conn *websocket.Conn
+	mu   sync.Mutex
+}
+
+func (c *wsConn) writeJSON(v any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.WriteJSON(v)
+}
+
+func (c *wsConn) close() {
+	c.conn.Close()
+}
+
+// subscriber receives the user data events of an exchange, either on a listen key stream or, for subscriptions of
+// the websocket API, wrapped with the subscription id
+type subscriber struct {
+	conn           *wsConn
+	exchange       *Exchange
+	listenKey      string
+	subscriptionID int64
+}
+
+func (s *Server) addSubscriber(sub *subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers[sub] = struct{}{}
+}
+
+func (s *Server) removeSubscribers(conn *wsConn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subscribers {
+		if sub.conn == conn {
+			delete(s.subscribers, sub)
+		}
+	}
+}
+
+// serveUserData serves the user data stream of the listen key in the path or the listenKey parameter
+func (s *Server) serveUserData(w http.ResponseWriter, r *http.Request) {
+	listenKey := strings.TrimPrefix(r.URL.Path, "/ws/")
+	if r.URL.Path == "/private/ws" {
+		listenKey = r.URL.Query().Get("listenKey")
+	}
+	s.mu.Lock()
+	e := s.listenKeys[listenKey]
+	s.mu.Unlock()
+	if e == nil {
+		writeError(w, newError(http.StatusBadRequest, -1125, "This listenKey does not exist."))
+		return
+	}
+	c, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	conn := &wsConn{conn: c}
+	s.addSubscriber(&subscriber{conn: conn, exchange: e, listenKey: listenKey})
+	defer s.removeSubscribers(conn)
+	defer c.Close()
+	for {
+		// read until the client closes the connection, pings are answered by the default handler
+		if _, _, err := c.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// wsSession is the authentication state of a websocket API connection set by session.logon
+type wsSession struct {
+	apiKey          string
+	authorizedSince int64
+	connectedSince  int64
+}
+
+func (ws *wsSession) status(serverTime int64) map[string]any {
+	res := map[string]any{
+		"apiKey":           nil,
+		"authorizedSince":  nil,
+		"connectedSince":   ws.connectedSince,
+		"returnRateLimits": true,
+		"serverTime":       serverTime,
+		"userDataStream":   false,
+	}
+	if ws.apiKey != "" {
+		res["apiKey"] = ws.apiKey
+		res["authorizedSince"] = ws.authorizedSince
+	}
+	return res
+}
+
+type wsApiRequest struct {
+	ID     any            `json:"id"`
+	Method string         `json:"method"`
+	Params map[string]any `json:"params"`
+}
+
+// serveWsApi serves the websocket API of the exchange of the path
+func (s *Server) serveWsApi(w http.ResponseWriter, r *http.Request) {
+	e := s.exchange(r.URL.Path)
+	c, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	conn := &wsConn{conn: c}
+	session := &wsSession{connectedSince: s.now()}
+	defer s.removeSubscribers(conn)
+	defer c.Close()
+	for {
+		_, message, err := c.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req wsApiRequest
+		d := json.NewDecoder(bytes.NewReader(message))
+		d.UseNumber()
+		if err := d.Decode(&req); err != nil {
+			conn.writeJSON(wsApiError(nil, newError(http.StatusBadRequest, -1000, "binancetest: invalid request: "+err.Error())))
+			continue
+		}
+		res, apiErr := s.handleWsApi(conn, session, e, &req)
+		if apiErr != nil {
+			conn.writeJSON(wsApiError(req.ID, apiErr))
+			continue
+		}
+		conn.writeJSON(map[string]any{"id": req.ID, "status": http.StatusOK, "result": res, "rateLimits": []any{}})
+	}
+}
+
+func (s *Server) handleWsApi(conn *wsConn, session *wsSession, e *Exchange, req *wsApiRequest) (any, *common.APIError) {
+	p := params{}
+	values := url.Values{}
+	for k, v := range req.Params {
+		p[k] = fmt.Sprintf("%v", v)
+		if k != "signature" {
+			values.Add(k, p[k])
+		}
+	}
+	auth := func() *common.APIError {
+		// requests on an authenticated connection may leave out apiKey and signature
+		if session.apiKey != "" && p.get("apiKey") == "" && p.get("signature") == "" {
+			return s.checkTimestamp(p)
+		}
+		return s.authenticate(securitySigned, p.get("apiKey"), values.Encode(), p.get("signature"), p)
+	}
+	switch req.Method {
+	case "session.logon":
+		if apiErr := s.authenticate(securitySigned, p.get("apiKey"), values.Encode(), p.get("signature"), p); apiErr != nil {
+			return nil, apiErr
+		}
+		s.mu.Lock()
+		k := s.keys[p.get("apiKey")]
+		s.mu.Unlock()
+		if k.keyType != common.KeyTypeEd25519 {
+			return nil, newError(http.StatusBadRequest, -4056, k.keyType+" API key is not supported.")
+		}
+		session.apiKey = p.get("apiKey")
+		session.authorizedSince = s.now()
+		return session.status(s.now()), nil
+	case "session.status":
+		return session.status(s.now()), nil
+	case "session.logout":
+		session.apiKey = ""
+		return session.status(s.now()), nil
+	case "userDataStream.subscribe.signature":
+		if e.futures {
+			break
+		}
+		if apiErr := auth(); apiErr != nil {
+			return nil, apiErr
+		}
+		s.mu.Lock()
+		s.lastSubscriptionID++
+		sub := &subscriber{conn: conn, exchange: e, subscriptionID: s.lastSubscriptionID}
+		s.mu.Unlock()
+		s.addSubscriber(sub)
+		return map[string]any{"subscriptionId": sub.subscriptionID}, nil
+	case "userDataStream.start", "userDataStream.ping", "userDataStream.stop":
+		if !e.futures {
+			break
+		}
+		if apiErr := s.authenticate(securityAPIKey, p.get("apiKey"), "", "", p); apiErr != nil {
+			return nil, apiErr
+		}
+		// futures accounts have a single listen key, ping and stop apply to it
+		key := s.listenKeyOf(e)
+		if req.Method == "userDataStream.start" {
+			if key == "" {
+				key = s.newListenKey(e)
+			}
+			return map[string]any{"listenKey": key}, nil
+		}
+		if key == "" {
+			return nil, newError(http.StatusBadRequest, -1125, "This listenKey does not exist.")
+		}
+		if req.Method == "userDataStream.stop" {
+			s.closeListenKey(key)
+			return map[string]any{}, nil
+		}
+		return map[string]any{"listenKey": key}, nil
+	case "userDataStream.unsubscribe":
+		if e.futures {
+			break
+		}
+		s.removeSubscribers(conn)
+		return map[string]any{}, nil
+	}
+	key, ok := wsApiMethods[e.futures][req.Method]
+	if !ok {
+		return nil, newError(http.StatusBadRequest, -1000, "binancetest: unsupported method "+req.Method)
+	}
+	rt := routes[key]
+	if rt.security != securityNone {
+		if apiErr := auth(); apiErr != nil {
+			return nil, apiErr
+		}
+	}
+	return rt.handle(e, p)
+}
+
+func wsApiError(id any, apiErr *common.APIError) any {
+	return map[string]any{
+		"id":     id,
+		"status": apiErr.StatusCode,
+		"error":  map[string]any{"code": apiErr.Code, "msg": apiErr.Message},
+	}
+}
@@ -87,6 +87,8 @@ type C2CTradeHistory struct {
 }
 
 // C2CRecord a record of c2c
+//
+//decimalgen:accessors
 type C2CRecord struct {
 	OrderNumber         string `json:"orderNumber"`
 	AdvNo               string `json:"advNo"`
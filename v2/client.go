@@ -22,6 +22,9 @@ import (
 	"github.com/adshao/go-binance/v2/options"
 )
 
+//go:generate go run ./internal/decimalgen
+//go:generate go run ./internal/streamgen
+
 // SideType define side type of order
 type SideType string
 
@@ -441,6 +444,19 @@ type Client struct {
 
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+
+	// RateLimiter holds requests back before they exceed the limits, it can be shared by several clients
+	RateLimiter *common.RateLimiter
+	// RetryPolicy retries failed requests, requests are not retried if it is nil
+	RetryPolicy *common.RetryPolicy
+	// TimeSync keeps the offset to the server clock, if set its offset is used instead of TimeOffset
+	TimeSync *common.TimeSync
+	// SymbolRules holds the exchange filters orders are checked against before they're sent, if set
+	SymbolRules *common.SymbolRulesRegistry
+	// Signer signs the requests instead of SecretKey and KeyType, if set
+	Signer common.Signer
+
+	signers common.SignerCache
 }
 
 func (c *Client) debug(format string, v ...any) {
@@ -449,6 +465,18 @@ func (c *Client) debug(format string, v ...any) {
 	}
 }
 
+// signer returns Signer if it is set, otherwise the signer of SecretKey and KeyType
+func (c *Client) signer() (common.Signer, error) {
+	if c.Signer != nil {
+		return c.Signer, nil
+	}
+	kt := c.KeyType
+	if kt == "" {
+		kt = common.KeyTypeHmac
+	}
+	return c.signers.Signer(kt, c.SecretKey)
+}
+
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	// set request options from user
 	for _, opt := range opts {
@@ -464,7 +492,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.TimeSync.OffsetOr(c.TimeOffset))
 	}
 	queryString := r.query.Encode()
 	// @ is a safe character and does not require escape, So replace it back.
@@ -484,22 +512,18 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
 		header.Set("X-MBX-APIKEY", c.APIKey)
 	}
-	kt := c.KeyType
-	if kt == "" {
-		kt = common.KeyTypeHmac
-	}
-	sf, err := common.SignFunc(kt)
-	if err != nil {
-		return err
-	}
 	if r.secType == secTypeSigned {
+		signer, err := c.signer()
+		if err != nil {
+			return err
+		}
 		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		sign, err := sf(c.SecretKey, raw)
+		sign, err := signer.Sign(raw)
 		if err != nil {
 			return err
 		}
 		v := url.Values{}
-		v.Set(signatureKey, *sign)
+		v.Set(signatureKey, sign)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -518,16 +542,28 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	if c.RetryPolicy == nil {
+		data, _, _, err = c.sendRequest(ctx, r, opts...)
+		return data, err
+	}
+	return c.callAPIWithRetry(ctx, r, opts...)
+}
+
+// sendRequest sends r once and returns the response body, status code and headers
+func (c *Client) sendRequest(ctx context.Context, r *request, opts ...RequestOption) (data []byte, statusCode int, header http.Header, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
+	if err = c.waitRateLimit(ctx, r); err != nil {
+		return []byte{}, 0, nil, err
+	}
 	c.debug("request: %#v\n", req)
 	f := c.do
 	if f == nil {
@@ -535,15 +571,18 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
+	if c.RateLimiter != nil {
+		c.RateLimiter.UpdateByHeader(common.RateLimitAPI(r.endpoint), res.Header)
+	}
 
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, res.StatusCode, res.Header, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -566,9 +605,17 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, apiErr
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Header = res.Header
+		apiErr.Method = r.method
+		apiErr.Endpoint = r.endpoint
+		c.TimeSync.CheckError(apiErr)
+		if res.StatusCode == http.StatusTeapot {
+			return nil, res.StatusCode, res.Header, common.NewIPBannedError(res.Header, apiErr)
+		}
+		return nil, res.StatusCode, res.Header, apiErr
 	}
-	return data, nil
+	return data, res.StatusCode, res.Header, nil
 }
 
 // SetApiEndpoint set api Endpoint
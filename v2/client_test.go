@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -167,3 +168,53 @@ func TestFormatTimestamp(t *testing.T) {
 	tm, _ := time.Parse("2006-01-02 15:04:05", "2018-06-01 01:01:01")
 	assert.Equal(t, int64(1527814861000), FormatTimestamp(tm))
 }
+
+type stubSigner struct {
+	payloads []string
+}
+
+func (s *stubSigner) KeyType() string {
+	return common.KeyTypeEd25519
+}
+
+func (s *stubSigner) Sign(data string) (string, error) {
+	s.payloads = append(s.payloads, data)
+	return "stub-signature", nil
+}
+
+func TestClientSigner(t *testing.T) {
+	r := require.New(t)
+	signer := new(stubSigner)
+	c := NewClient("apiKey", "")
+	c.Signer = signer
+	var query url.Values
+	c.do = func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		return newHTTPResponse([]byte(`{}`), http.StatusOK), nil
+	}
+
+	_, err := c.NewGetAccountService().Do(context.Background())
+	r.NoError(err)
+	r.Equal("stub-signature", query.Get(signatureKey))
+	r.Len(signer.payloads, 1)
+	r.Contains(signer.payloads[0], "timestamp="+query.Get(timestampKey))
+}
+
+func TestClientSignerCache(t *testing.T) {
+	r := require.New(t)
+	c := NewClient("apiKey", "secretKey")
+	signer, err := c.signer()
+	r.NoError(err)
+	cached, err := c.signer()
+	r.NoError(err)
+	r.Same(signer, cached)
+
+	other, err := NewClient("apiKey", "secretKey").signer()
+	r.NoError(err)
+	r.NotSame(signer, other)
+
+	c.SecretKey = "rotatedKey"
+	rotated, err := c.signer()
+	r.NoError(err)
+	r.NotSame(signer, rotated)
+}
@@ -0,0 +1,15 @@
+// Code generated by decimalgen; DO NOT EDIT.
+
+package common
+
+import "github.com/shopspring/decimal"
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *PriceLevel) PriceDecimal() (decimal.Decimal, error) {
+	return ParseDecimal("PriceLevel.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *PriceLevel) QuantityDecimal() (decimal.Decimal, error) {
+	return ParseDecimal("PriceLevel.Quantity", v.Quantity)
+}
@@ -1,14 +1,73 @@
 package common
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
+// Errors an APIError matches with errors.Is according to its code, message and HTTP status
+var (
+	ErrInsufficientBalance        = errors.New("insufficient balance")
+	ErrUnknownOrder               = errors.New("unknown order")
+	ErrTimestampOutsideRecvWindow = errors.New("timestamp outside of recvWindow")
+	ErrInvalidSignature           = errors.New("invalid signature")
+	ErrInvalidAPIKey              = errors.New("invalid API key, IP or permissions")
+	ErrInvalidSymbol              = errors.New("invalid symbol")
+	ErrTooManyRequests            = errors.New("too many requests")
+	ErrIPBanned                   = errors.New("IP banned")
+	ErrFilterFailure              = errors.New("filter failure")
+	ErrServiceUnavailable         = errors.New("service unavailable")
+)
+
+// errorCodes maps the error codes of spot, futures, options and portfolio margin APIs to errors
+var errorCodes = map[int64]error{
+	-1001: ErrServiceUnavailable, // DISCONNECTED
+	-1003: ErrTooManyRequests,    // TOO_MANY_REQUESTS
+	-1006: ErrServiceUnavailable, // UNEXPECTED_RESP
+	-1007: ErrServiceUnavailable, // TIMEOUT
+	-1008: ErrServiceUnavailable, // SERVER_BUSY
+	-1015: ErrTooManyRequests,    // TOO_MANY_ORDERS
+	-1021: ErrTimestampOutsideRecvWindow,
+	-1022: ErrInvalidSignature,
+	-1121: ErrInvalidSymbol,
+	-2011: ErrUnknownOrder, // CANCEL_REJECTED / UNKNOWN_ORDER
+	-2013: ErrUnknownOrder, // NO_SUCH_ORDER
+	-2014: ErrInvalidAPIKey,
+	-2015: ErrInvalidAPIKey,
+	-2018: ErrInsufficientBalance, // BALANCE_NOT_SUFFICIENT
+	-2019: ErrInsufficientBalance, // MARGIN_NOT_SUFFICIEN
+	-4003: ErrFilterFailure,       // QTY_LESS_THAN_ZERO
+	-4004: ErrFilterFailure,       // QTY_LESS_THAN_MIN_QTY
+	-4005: ErrFilterFailure,       // QTY_GREATER_THAN_MAX_QTY
+	-4013: ErrFilterFailure,       // PRICE_LESS_THAN_MIN_PRICE
+	-4014: ErrFilterFailure,       // PRICE_NOT_INCREASED_BY_TICK_SIZE
+	-4016: ErrFilterFailure,       // PRICE_HIGHER_THAN_MULTIPLIER_UP
+	-4023: ErrFilterFailure,       // QTY_NOT_INCREASED_BY_STEP_SIZE
+	-4024: ErrFilterFailure,       // PRICE_LOWER_THAN_MULTIPLIER_DOWN
+	-4164: ErrFilterFailure,       // MIN_NOTIONAL
+}
+
+// errorMessages maps messages shared by several codes (e.g. -1013, -2010) to errors
+var errorMessages = map[string]error{
+	"insufficient balance": ErrInsufficientBalance,
+	"filter failure":       ErrFilterFailure,
+	"unknown order sent":   ErrUnknownOrder,
+	"order does not exist": ErrUnknownOrder,
+	"banned until":         ErrIPBanned,
+}
+
 // APIError define API error when response status is 4xx or 5xx
 type APIError struct {
 	Code     int64  `json:"code"`
 	Message  string `json:"msg"`
 	Response []byte `json:"-"` // Assign the body value when the Code and Message fields are invalid.
+
+	StatusCode int         `json:"-"` // HTTP status, or status of the websocket API response
+	Header     http.Header `json:"-"`
+	Method     string      `json:"-"` // method of the failed request
+	Endpoint   string      `json:"-"` // endpoint of the failed request
 }
 
 // Error return error code and message
@@ -23,8 +82,35 @@ func (e APIError) IsValid() bool {
 	return e.Code != 0 || e.Message != ""
 }
 
+// Is check whether the error is one of the exported Err errors, e.g. errors.Is(err, common.ErrUnknownOrder)
+func (e APIError) Is(target error) bool {
+	if err, ok := errorCodes[e.Code]; ok && err == target {
+		return true
+	}
+	msg := strings.ToLower(e.Message)
+	for m, err := range errorMessages {
+		if err == target && strings.Contains(msg, m) {
+			return true
+		}
+	}
+	switch {
+	case e.StatusCode == http.StatusTeapot:
+		return target == ErrIPBanned
+	case e.StatusCode == http.StatusTooManyRequests:
+		return target == ErrTooManyRequests
+	case e.StatusCode >= http.StatusInternalServerError:
+		return target == ErrServiceUnavailable
+	}
+	return false
+}
+
+// Retryable check whether the request may succeed when sent again
+func (e APIError) Retryable() bool {
+	return IsRetryable(e.StatusCode, &e)
+}
+
 // IsAPIError check if e is an API error
 func IsAPIError(e error) bool {
-	_, ok := e.(*APIError)
-	return ok
+	var apiErr *APIError
+	return errors.As(e, &apiErr)
 }
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		name   string
+		err    error
+		target error
+	}{
+		{"spot insufficient balance", &APIError{Code: -2010, Message: "Account has insufficient balance for requested action."}, ErrInsufficientBalance},
+		{"futures margin", &APIError{Code: -2019, Message: "Margin is insufficient."}, ErrInsufficientBalance},
+		{"cancel unknown order", &APIError{Code: -2011, Message: "Unknown order sent."}, ErrUnknownOrder},
+		{"no such order", &APIError{Code: -2013, Message: "Order does not exist."}, ErrUnknownOrder},
+		{"timestamp", &APIError{Code: -1021, Message: "Timestamp for this request is outside of the recvWindow."}, ErrTimestampOutsideRecvWindow},
+		{"too many requests", &APIError{Code: -1003, StatusCode: http.StatusTooManyRequests}, ErrTooManyRequests},
+		{"status 429", &APIError{StatusCode: http.StatusTooManyRequests}, ErrTooManyRequests},
+		{"status 418", &APIError{Code: -1003, StatusCode: http.StatusTeapot}, ErrIPBanned},
+		{"ban error", &IPBannedError{Err: &APIError{Code: -1003}}, ErrIPBanned},
+		{"spot filter", &APIError{Code: -1013, Message: "Filter failure: LOT_SIZE"}, ErrFilterFailure},
+		{"futures min notional", &APIError{Code: -4164, Message: "Order's notional must be no smaller than 5.0"}, ErrFilterFailure},
+		{"wrapped", fmt.Errorf("create order: %w", &APIError{Code: -1022}), ErrInvalidSignature},
+		{"status 503", &APIError{StatusCode: http.StatusServiceUnavailable}, ErrServiceUnavailable},
+	}
+	for _, tt := range tests {
+		assert.True(errors.Is(tt.err, tt.target), tt.name)
+	}
+	assert.False(errors.Is(&APIError{Code: -1121}, ErrUnknownOrder))
+	assert.False(errors.Is(&APIError{Code: -2010, Message: "Duplicate order sent."}, ErrInsufficientBalance))
+}
+
+func TestAPIErrorRetryable(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(APIError{Code: -1001}.Retryable())
+	assert.True(APIError{StatusCode: http.StatusBadGateway}.Retryable())
+	assert.False(APIError{Code: -1003, StatusCode: http.StatusTeapot}.Retryable())
+	assert.False(APIError{Code: -2010, StatusCode: http.StatusBadRequest}.Retryable())
+}
+
+func TestIsAPIError(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsAPIError(&APIError{Code: -1000}))
+	assert.True(IsAPIError(fmt.Errorf("wrapped: %w", &APIError{Code: -1000})))
+	assert.True(IsAPIError(&IPBannedError{Err: &APIError{Code: -1003}}))
+	assert.False(IsAPIError(errors.New("error")))
+}
@@ -22,6 +22,18 @@ func AmountToLotSize(amount, minQty, stepSize string, precision int) string {
 	return baseAmountDec.Add(minQtyDec).Truncate(int32(precision)).String()
 }
 
+// ParseDecimal parses the decimal string of a response field like Order.Price, an empty string is zero
+func ParseDecimal(field, s string) (decimal.Decimal, error) {
+	if s == "" {
+		return decimal.Zero, nil
+	}
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("%s: invalid decimal %q", field, s)
+	}
+	return d, nil
+}
+
 // ToJSONList convert v to json list if v is a map
 func ToJSONList(v []byte) []byte {
 	if len(v) > 0 && v[0] == '{' {
@@ -107,3 +107,22 @@ func TestAmountToLotSize(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDecimal(t *testing.T) {
+	assert := assert.New(t)
+	d, err := ParseDecimal("Order.Price", "0.10000000")
+	assert.NoError(err)
+	assert.Equal("0.1", d.String())
+
+	d, err = ParseDecimal("Order.Price", "")
+	assert.NoError(err)
+	assert.True(d.IsZero())
+
+	_, err = ParseDecimal("Order.Price", "abc")
+	assert.EqualError(err, `Order.Price: invalid decimal "abc"`)
+
+	level := PriceLevel{Price: "29500.12345678", Quantity: "x"}
+	price, _, err := level.ParseDecimal()
+	assert.EqualError(err, `PriceLevel.Quantity: invalid decimal "x"`)
+	assert.Equal("29500.12345678", price.String())
+}
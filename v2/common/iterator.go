@@ -0,0 +1,197 @@
+package common
+
+import (
+	"context"
+	"time"
+)
+
+// Iterator walks the rows of a history endpoint over a time range, requesting the pages as they are needed. The
+// requests are sent by the services of the client, so they are held back by its RateLimiter and retried by its
+// RetryPolicy. Rows are read with
+//
+//	for it.Next(ctx) {
+//		row := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	next func(ctx context.Context) (rows []T, more bool, err error)
+	rows []T
+	row  T
+	done bool
+	err  error
+}
+
+// Next advances to the next row, it returns false when there are no more rows or a request failed
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for len(it.rows) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		rows, more, err := it.next(ctx)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.rows, it.done = rows, !more
+	}
+	it.row, it.rows = it.rows[0], it.rows[1:]
+	return true
+}
+
+// Value returns the current row
+func (it *Iterator[T]) Value() T {
+	return it.row
+}
+
+// Err returns the error of the failed request, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// PageFunc requests up to limit rows between startTime and endTime in milliseconds, both inclusive
+type PageFunc[T any] func(ctx context.Context, startTime, endTime int64, limit int) ([]T, error)
+
+// OffsetFunc requests the page of the rows between startTime and endTime, pages start at 1
+type OffsetFunc[T any] func(ctx context.Context, startTime, endTime int64, page, size int) ([]T, error)
+
+// SplitFunc requests the rows between startTime and endTime, full reports that there are more rows than returned
+type SplitFunc[T any] func(ctx context.Context, startTime, endTime int64) (rows []T, full bool, err error)
+
+// windows splits [startTime, endTime] into consecutive windows no longer than size, a size of 0 doesn't split
+type windows struct {
+	next int64
+	end  int64
+	size int64
+}
+
+func newWindows(startTime, endTime int64, size time.Duration) *windows {
+	return &windows{next: startTime, end: endTime, size: size.Milliseconds()}
+}
+
+// pop returns the next window, ok is false once the range is exhausted
+func (w *windows) pop() (startTime, endTime int64, ok bool) {
+	if w.next > w.end {
+		return 0, 0, false
+	}
+	startTime, endTime = w.next, w.end
+	if w.size > 0 && endTime-startTime >= w.size {
+		endTime = startTime + w.size - 1
+	}
+	w.next = endTime + 1
+	return startTime, endTime, true
+}
+
+// NewCursorIterator walks an endpoint returning its rows in ascending time order. Within every window the start time
+// of the next request is moved to the time of the last row of a full page, rows of that time which were already
+// returned are skipped by their key. If a full page consists of rows already returned only, the start time is moved
+// one millisecond further.
+func NewCursorIterator[T any, K comparable](startTime, endTime int64, window time.Duration, limit int,
+	fetch PageFunc[T], timeOf func(row T) int64, keyOf func(row T) K) *Iterator[T] {
+	w := newWindows(startTime, endTime, window)
+	cursor, windowEnd, ok := w.pop()
+	seen := make(map[K]struct{})
+	return &Iterator[T]{next: func(ctx context.Context) ([]T, bool, error) {
+		if !ok {
+			return nil, false, nil
+		}
+		page, err := fetch(ctx, cursor, windowEnd, limit)
+		if err != nil {
+			return nil, false, err
+		}
+		rows := make([]T, 0, len(page))
+		for _, row := range page {
+			if _, dup := seen[keyOf(row)]; !dup {
+				rows = append(rows, row)
+			}
+		}
+		if len(page) < limit {
+			cursor, windowEnd, ok = w.pop()
+			seen = make(map[K]struct{})
+			return rows, ok, nil
+		}
+		last := timeOf(page[len(page)-1])
+		switch {
+		case len(rows) == 0 || last < cursor:
+			cursor++
+			seen = make(map[K]struct{})
+		case last > cursor:
+			cursor = last
+			seen = make(map[K]struct{})
+		}
+		for _, row := range page {
+			if timeOf(row) == cursor {
+				seen[keyOf(row)] = struct{}{}
+			}
+		}
+		if cursor > windowEnd {
+			cursor, windowEnd, ok = w.pop()
+			seen = make(map[K]struct{})
+		}
+		return rows, ok, nil
+	}}
+}
+
+// NewOffsetIterator walks an endpoint paged by page number or offset. Within every window the pages are requested
+// until one isn't full, rows moved to the next page by new rows are skipped by their key.
+func NewOffsetIterator[T any, K comparable](startTime, endTime int64, window time.Duration, size int,
+	fetch OffsetFunc[T], keyOf func(row T) K) *Iterator[T] {
+	w := newWindows(startTime, endTime, window)
+	windowStart, windowEnd, ok := w.pop()
+	page := 1
+	seen := make(map[K]struct{})
+	return &Iterator[T]{next: func(ctx context.Context) ([]T, bool, error) {
+		if !ok {
+			return nil, false, nil
+		}
+		rows, err := fetch(ctx, windowStart, windowEnd, page, size)
+		if err != nil {
+			return nil, false, err
+		}
+		res := make([]T, 0, len(rows))
+		for _, row := range rows {
+			key := keyOf(row)
+			if _, dup := seen[key]; !dup {
+				seen[key] = struct{}{}
+				res = append(res, row)
+			}
+		}
+		page++
+		if len(rows) < size {
+			windowStart, windowEnd, ok = w.pop()
+			page = 1
+			seen = make(map[K]struct{})
+		}
+		return res, ok, nil
+	}}
+}
+
+// NewSplitIterator walks an endpoint which only reports whether there are more rows than returned. A window with
+// more rows is split in halves which are requested instead, down to a single millisecond.
+func NewSplitIterator[T any](startTime, endTime int64, window time.Duration, fetch SplitFunc[T]) *Iterator[T] {
+	w := newWindows(startTime, endTime, window)
+	type span struct{ start, end int64 }
+	var stack []span
+	return &Iterator[T]{next: func(ctx context.Context) ([]T, bool, error) {
+		if len(stack) == 0 {
+			start, end, ok := w.pop()
+			if !ok {
+				return nil, false, nil
+			}
+			stack = append(stack, span{start, end})
+		}
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		rows, full, err := fetch(ctx, s.start, s.end)
+		if err != nil {
+			return nil, false, err
+		}
+		if full && s.end > s.start {
+			mid := s.start + (s.end-s.start)/2
+			stack = append(stack, span{mid + 1, s.end}, span{s.start, mid})
+			return nil, true, nil
+		}
+		return rows, true, nil
+	}}
+}
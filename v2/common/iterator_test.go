@@ -0,0 +1,169 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testRow struct {
+	ID   int64
+	Time int64
+}
+
+// testRows returns rows in ascending time order, several rows share a time
+func testRows() []testRow {
+	times := []int64{1, 2, 2, 2, 3, 5, 5, 8, 9, 9, 9, 9, 12, 15, 15, 20}
+	rows := make([]testRow, len(times))
+	for i, t := range times {
+		rows[i] = testRow{ID: int64(i + 1), Time: t}
+	}
+	return rows
+}
+
+func between(rows []testRow, startTime, endTime int64) []testRow {
+	var res []testRow
+	for _, row := range rows {
+		if row.Time >= startTime && row.Time <= endTime {
+			res = append(res, row)
+		}
+	}
+	return res
+}
+
+func collect(t *testing.T, it *Iterator[testRow]) []testRow {
+	var rows []testRow
+	for it.Next(context.Background()) {
+		rows = append(rows, it.Value())
+	}
+	require.NoError(t, it.Err())
+	return rows
+}
+
+func TestWindows(t *testing.T) {
+	r := require.New(t)
+	w := newWindows(0, 25, 10*time.Millisecond)
+	var spans [][2]int64
+	for {
+		start, end, ok := w.pop()
+		if !ok {
+			break
+		}
+		spans = append(spans, [2]int64{start, end})
+	}
+	r.Equal([][2]int64{{0, 9}, {10, 19}, {20, 25}}, spans)
+
+	w = newWindows(5, 4, 0)
+	_, _, ok := w.pop()
+	r.False(ok)
+}
+
+func TestCursorIterator(t *testing.T) {
+	r := require.New(t)
+	data := testRows()
+	var requests [][2]int64
+	it := NewCursorIterator(0, 19, 10*time.Millisecond, 4,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]testRow, error) {
+			requests = append(requests, [2]int64{startTime, endTime})
+			rows := between(data, startTime, endTime)
+			if len(rows) > limit {
+				rows = rows[:limit]
+			}
+			return rows, nil
+		},
+		func(row testRow) int64 { return row.Time },
+		func(row testRow) int64 { return row.ID })
+
+	r.Equal(between(data, 0, 19), collect(t, it))
+	r.Equal([2]int64{0, 9}, requests[0])
+	r.Equal([2]int64{10, 19}, requests[len(requests)-1])
+}
+
+func TestCursorIterator_SameTime(t *testing.T) {
+	r := require.New(t)
+	// more rows of the same time than fit into a page, the cursor is moved past them
+	data := []testRow{{1, 1}, {2, 1}, {3, 1}, {4, 2}}
+	it := NewCursorIterator(0, 10, 0, 2,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]testRow, error) {
+			rows := between(data, startTime, endTime)
+			if len(rows) > limit {
+				rows = rows[:limit]
+			}
+			return rows, nil
+		},
+		func(row testRow) int64 { return row.Time },
+		func(row testRow) int64 { return row.ID })
+
+	r.Equal([]testRow{{1, 1}, {2, 1}, {4, 2}}, collect(t, it))
+}
+
+func TestOffsetIterator(t *testing.T) {
+	r := require.New(t)
+	data := testRows()
+	expected := between(data, 0, 19)
+	it := NewOffsetIterator(0, 19, 10*time.Millisecond, 3,
+		func(ctx context.Context, startTime, endTime int64, page, size int) ([]testRow, error) {
+			if page == 2 && len(data) == len(testRows()) {
+				// a new row pushes the rows to the next page
+				data = append(data, testRow{ID: 100, Time: 9})
+			}
+			rows := between(data, startTime, endTime)
+			sort.Slice(rows, func(i, j int) bool { return rows[i].ID > rows[j].ID })
+			from := (page - 1) * size
+			if from >= len(rows) {
+				return nil, nil
+			}
+			to := from + size
+			if to > len(rows) {
+				to = len(rows)
+			}
+			return rows[from:to], nil
+		},
+		func(row testRow) int64 { return row.ID })
+
+	r.ElementsMatch(expected, collect(t, it))
+}
+
+func TestSplitIterator(t *testing.T) {
+	r := require.New(t)
+	data := testRows()
+	requests := 0
+	it := NewSplitIterator(0, 19, 0,
+		func(ctx context.Context, startTime, endTime int64) ([]testRow, bool, error) {
+			requests++
+			rows := between(data, startTime, endTime)
+			if len(rows) > 4 {
+				return rows[:4], true, nil
+			}
+			return rows, false, nil
+		})
+
+	r.Equal(between(data, 0, 19), collect(t, it))
+	r.Greater(requests, 1)
+}
+
+func TestIteratorError(t *testing.T) {
+	r := require.New(t)
+	fetchErr := errors.New("fetch error")
+	calls := 0
+	it := NewCursorIterator(0, 100, 10*time.Millisecond, 1,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]testRow, error) {
+			calls++
+			if calls == 2 {
+				return nil, fetchErr
+			}
+			return []testRow{{ID: int64(calls), Time: startTime}}, nil
+		},
+		func(row testRow) int64 { return row.Time },
+		func(row testRow) int64 { return row.ID })
+
+	r.True(it.Next(context.Background()))
+	r.False(it.Next(context.Background()))
+	r.ErrorIs(it.Err(), fetchErr)
+	r.False(it.Next(context.Background()))
+	r.Equal(2, calls)
+}
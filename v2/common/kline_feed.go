@@ -0,0 +1,370 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// klineCloseDelay is how long after its close time a kline requested from the REST API is taken as final
+const klineCloseDelay = time.Second
+
+// errKlineStreamEnded is passed to the ErrHandler of a KlineFeed when the stream has ended
+var errKlineStreamEnded = errors.New("kline stream ended, subscribing again")
+
+// KlineHandler receives the klines of a KlineFeed, closed is false for updates of the kline in progress
+type KlineHandler[T any] func(kline T, closed bool)
+
+// KlineSource defines how a KlineFeed requests klines and subscribes to their stream
+type KlineSource[T any] struct {
+	// Fetch requests up to limit klines opening between startTime and endTime in milliseconds, both inclusive
+	Fetch func(ctx context.Context, startTime, endTime int64, limit int) ([]T, error)
+	// Serve subscribes to the kline stream, the feed polls Fetch instead if it is nil
+	Serve func(handler KlineHandler[T], errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+	// OpenTime and CloseTime return the open and close time of a kline in milliseconds
+	OpenTime  func(kline T) int64
+	CloseTime func(kline T) int64
+}
+
+// KlineFeed emits the klines of a symbol and interval from a start time on, closed and in order without gaps or
+// duplicates. The history is backfilled with concurrent requests, which are held back by the RateLimiter and retried
+// by the RetryPolicy of the client. Then the feed follows the kline stream, gaps left by reconnects are filled with
+// requests. Set the options before calling Run.
+type KlineFeed[T any] struct {
+	source    KlineSource[T]
+	interval  string
+	startTime int64
+
+	// EndTime ends the feed with the kline open at EndTime, the feed follows the stream if it is 0
+	EndTime int64
+	// Limit of klines per request, 1000 by default
+	Limit int
+	// Concurrency of the backfill requests, 4 by default
+	Concurrency int
+	// InProgress emits every update of the kline in progress as well
+	InProgress bool
+	// PollInterval between requests for new klines if the source has no stream, 5 seconds by default
+	PollInterval time.Duration
+	// ErrHandler receives the errors of the stream, which is subscribed again after it has ended
+	ErrHandler func(err error)
+}
+
+// NewKlineFeed creates a feed of the klines of source starting with the kline open at startTime
+func NewKlineFeed[T any](source KlineSource[T], interval string, startTime int64) *KlineFeed[T] {
+	return &KlineFeed[T]{source: source, interval: interval, startTime: startTime}
+}
+
+// IntervalDuration returns the duration of a kline interval like 1m, 4h or 1w, a month is taken as 31 days
+func IntervalDuration(interval string) (time.Duration, error) {
+	if len(interval) < 2 {
+		return 0, fmt.Errorf("invalid kline interval %q", interval)
+	}
+	n, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid kline interval %q", interval)
+	}
+	var unit time.Duration
+	switch interval[len(interval)-1] {
+	case 's':
+		unit = time.Second
+	case 'm':
+		unit = time.Minute
+	case 'h':
+		unit = time.Hour
+	case 'd':
+		unit = 24 * time.Hour
+	case 'w':
+		unit = 7 * 24 * time.Hour
+	case 'M':
+		unit = 31 * 24 * time.Hour
+	default:
+		return 0, fmt.Errorf("invalid kline interval %q", interval)
+	}
+	return time.Duration(n) * unit, nil
+}
+
+// Run emits the klines to handler until ctx is done, a request fails or EndTime is reached. It returns nil if the
+// feed has reached EndTime.
+func (f *KlineFeed[T]) Run(ctx context.Context, handler KlineHandler[T]) error {
+	interval, err := IntervalDuration(f.interval)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	r := &klineRun[T]{
+		feed:        f,
+		handler:     handler,
+		span:        interval.Milliseconds(),
+		next:        f.startTime,
+		limit:       f.Limit,
+		concurrency: f.Concurrency,
+	}
+	if r.limit <= 0 {
+		r.limit = 1000
+	}
+	if r.concurrency <= 0 {
+		r.concurrency = 4
+	}
+	if f.EndTime != 0 {
+		return r.backfill(ctx, f.EndTime)
+	}
+	if f.source.Serve == nil {
+		if err := r.backfill(ctx, nowMillis()); err != nil {
+			return err
+		}
+		return r.poll(ctx)
+	}
+	// subscribe before the backfill, so no kline is missed in between
+	r.events = newKlineQueue[T]()
+	if err := r.subscribe(); err != nil {
+		return err
+	}
+	defer r.unsubscribe()
+	if err := r.backfill(ctx, nowMillis()); err != nil {
+		return err
+	}
+	return r.follow(ctx)
+}
+
+func nowMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// klineRun is the state of a running feed
+type klineRun[T any] struct {
+	feed        *KlineFeed[T]
+	handler     KlineHandler[T]
+	span        int64
+	limit       int
+	concurrency int
+	// next is the open time of the next closed kline to emit
+	next int64
+
+	events *klineQueue[T]
+	doneC  chan struct{}
+	stopC  chan struct{}
+}
+
+// emit passes on the klines opening at next or later, the ones closing before closedBefore as closed
+func (r *klineRun[T]) emit(klines []T, closedBefore int64) {
+	src := r.feed.source
+	for _, k := range klines {
+		if src.OpenTime(k) < r.next {
+			continue
+		}
+		if src.CloseTime(k) >= closedBefore {
+			if r.feed.InProgress {
+				r.handler(k, false)
+			}
+			return
+		}
+		r.handler(k, true)
+		r.next = src.CloseTime(k) + 1
+	}
+}
+
+// fetch requests all klines opening between startTime and endTime page by page
+func (r *klineRun[T]) fetch(ctx context.Context, startTime, endTime int64) ([]T, error) {
+	var res []T
+	for startTime <= endTime {
+		klines, err := r.feed.source.Fetch(ctx, startTime, endTime, r.limit)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, klines...)
+		if len(klines) < r.limit {
+			break
+		}
+		// a full page may end right at endTime
+		startTime = r.feed.source.OpenTime(klines[len(klines)-1]) + 1
+		if startTime-1+r.span > endTime {
+			break
+		}
+	}
+	return res, nil
+}
+
+// backfill requests the klines from next up to endTime in chunks of a page, concurrently but emitted in order
+func (r *klineRun[T]) backfill(ctx context.Context, endTime int64) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	type chunk struct {
+		klines       []T
+		closedBefore int64
+		err          error
+		doneC        chan struct{}
+	}
+	chunks := make(chan *chunk, r.concurrency)
+	sem := make(chan struct{}, r.concurrency)
+	size := int64(r.limit) * r.span
+	go func() {
+		defer close(chunks)
+		for start := r.next; start <= endTime; start += size {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			end := start + size - 1
+			if end > endTime {
+				end = endTime
+			}
+			c := &chunk{doneC: make(chan struct{})}
+			go func(start, end int64) {
+				defer close(c.doneC)
+				c.klines, c.err = r.fetch(ctx, start, end)
+				c.closedBefore = nowMillis() - klineCloseDelay.Milliseconds()
+			}(start, end)
+			chunks <- c
+		}
+	}()
+	for c := range chunks {
+		select {
+		case <-c.doneC:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		<-sem
+		if c.err != nil {
+			return c.err
+		}
+		r.emit(c.klines, c.closedBefore)
+	}
+	return ctx.Err()
+}
+
+// poll requests the new klines every PollInterval
+func (r *klineRun[T]) poll(ctx context.Context) error {
+	interval := r.feed.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		now := nowMillis()
+		klines, err := r.fetch(ctx, r.next, now)
+		if err != nil {
+			return err
+		}
+		r.emit(klines, now-klineCloseDelay.Milliseconds())
+	}
+}
+
+// follow emits the klines of the stream, subscribing again whenever it ends
+func (r *klineRun[T]) follow(ctx context.Context) error {
+	backoff := time.Second
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-r.events.signal:
+			for _, e := range r.events.pop() {
+				if err := r.process(ctx, e.kline, e.closed); err != nil {
+					return err
+				}
+			}
+			backoff = time.Second
+		case <-r.doneC:
+			if r.feed.ErrHandler != nil {
+				r.feed.ErrHandler(errKlineStreamEnded)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			if backoff < time.Minute {
+				backoff *= 2
+			}
+			if err := r.subscribe(); err != nil {
+				if r.feed.ErrHandler != nil {
+					r.feed.ErrHandler(err)
+				}
+				// doneC stays closed, so the subscription is tried again
+			}
+		}
+	}
+}
+
+// process emits a kline of the stream, the klines missed before it are requested first
+func (r *klineRun[T]) process(ctx context.Context, kline T, closed bool) error {
+	open := r.feed.source.OpenTime(kline)
+	if open < r.next {
+		return nil
+	}
+	if open > r.next {
+		klines, err := r.fetch(ctx, r.next, open-1)
+		if err != nil {
+			return err
+		}
+		r.emit(klines, open)
+	}
+	if closed {
+		r.handler(kline, true)
+		r.next = r.feed.source.CloseTime(kline) + 1
+	} else if r.feed.InProgress {
+		r.handler(kline, false)
+	}
+	return nil
+}
+
+func (r *klineRun[T]) subscribe() error {
+	doneC, stopC, err := r.feed.source.Serve(r.events.push, r.feed.ErrHandler)
+	if err != nil {
+		return err
+	}
+	r.doneC, r.stopC = doneC, stopC
+	return nil
+}
+
+func (r *klineRun[T]) unsubscribe() {
+	select {
+	case <-r.doneC:
+	default:
+		close(r.stopC)
+	}
+}
+
+type klineEvent[T any] struct {
+	kline  T
+	closed bool
+}
+
+// klineQueue buffers the klines of the stream until the feed gets to them
+type klineQueue[T any] struct {
+	mu     sync.Mutex
+	events []klineEvent[T]
+	signal chan struct{}
+}
+
+func newKlineQueue[T any]() *klineQueue[T] {
+	return &klineQueue[T]{signal: make(chan struct{}, 1)}
+}
+
+func (q *klineQueue[T]) push(kline T, closed bool) {
+	q.mu.Lock()
+	q.events = append(q.events, klineEvent[T]{kline, closed})
+	q.mu.Unlock()
+	select {
+	case q.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (q *klineQueue[T]) pop() []klineEvent[T] {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	events := q.events
+	q.events = nil
+	return events
+}
@@ -0,0 +1,177 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testKline struct {
+	Open  int64
+	Close int64
+}
+
+// testKlineSource serves one minute klines, the streams are fed by the test
+type testKlineSource struct {
+	mu       sync.Mutex
+	requests int
+	failAt   int
+	streams  chan testStream
+}
+
+type testStream struct {
+	handler KlineHandler[testKline]
+	endC    chan struct{}
+}
+
+func (s *testKlineSource) source(stream bool) KlineSource[testKline] {
+	src := KlineSource[testKline]{
+		Fetch: func(ctx context.Context, startTime, endTime int64, limit int) ([]testKline, error) {
+			s.mu.Lock()
+			s.requests++
+			fail := s.requests == s.failAt
+			s.mu.Unlock()
+			if fail {
+				return nil, errors.New("fetch error")
+			}
+			var klines []testKline
+			for open := (startTime + 59999) / 60000 * 60000; open <= endTime && len(klines) < limit; open += 60000 {
+				klines = append(klines, testKline{open, open + 59999})
+			}
+			return klines, nil
+		},
+		OpenTime:  func(k testKline) int64 { return k.Open },
+		CloseTime: func(k testKline) int64 { return k.Close },
+	}
+	if stream {
+		src.Serve = func(handler KlineHandler[testKline], errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			doneC = make(chan struct{})
+			stopC = make(chan struct{})
+			endC := make(chan struct{})
+			go func() {
+				defer close(doneC)
+				select {
+				case <-stopC:
+				case <-endC:
+				}
+			}()
+			s.streams <- testStream{handler, endC}
+			return doneC, stopC, nil
+		}
+	}
+	return src
+}
+
+func TestIntervalDuration(t *testing.T) {
+	r := require.New(t)
+	d, err := IntervalDuration("15m")
+	r.NoError(err)
+	r.Equal(15*time.Minute, d)
+	d, err = IntervalDuration("1M")
+	r.NoError(err)
+	r.Equal(31*24*time.Hour, d)
+	for _, interval := range []string{"", "m", "0h", "3x"} {
+		_, err = IntervalDuration(interval)
+		r.Error(err)
+	}
+}
+
+func TestKlineFeedBackfill(t *testing.T) {
+	r := require.New(t)
+	s := &testKlineSource{}
+	feed := NewKlineFeed(s.source(false), "1m", 0)
+	feed.EndTime = 60000*50 - 1
+	feed.Limit = 7
+	feed.Concurrency = 3
+	var klines []testKline
+	err := feed.Run(context.Background(), func(k testKline, closed bool) {
+		r.True(closed)
+		klines = append(klines, k)
+	})
+	r.NoError(err)
+	r.Len(klines, 50)
+	for i, k := range klines {
+		r.Equal(int64(i)*60000, k.Open)
+	}
+	r.Equal(8, s.requests)
+
+	s = &testKlineSource{failAt: 3}
+	feed = NewKlineFeed(s.source(false), "1m", 0)
+	feed.EndTime = 60000*50 - 1
+	feed.Limit = 7
+	r.EqualError(feed.Run(context.Background(), func(k testKline, closed bool) {}), "fetch error")
+
+	feed = NewKlineFeed(s.source(false), "1x", 0)
+	r.Error(feed.Run(context.Background(), func(k testKline, closed bool) {}))
+}
+
+func TestKlineFeedStream(t *testing.T) {
+	r := require.New(t)
+	s := &testKlineSource{streams: make(chan testStream, 1)}
+	if nowMillis()%60000 > 55000 {
+		// keep the kline in progress open during the test
+		time.Sleep(5 * time.Second)
+	}
+	current := nowMillis() / 60000 * 60000
+	feed := NewKlineFeed(s.source(true), "1m", current-10*60000)
+	feed.InProgress = true
+	var handled []error
+	feed.ErrHandler = func(err error) {
+		handled = append(handled, err)
+	}
+
+	type emitted struct {
+		open   int64
+		closed bool
+	}
+	emittedC := make(chan emitted, 100)
+	ctx, cancel := context.WithCancel(context.Background())
+	errC := make(chan error, 1)
+	go func() {
+		errC <- feed.Run(ctx, func(k testKline, closed bool) {
+			emittedC <- emitted{k.Open, closed}
+		})
+	}()
+	next := func() emitted {
+		select {
+		case e := <-emittedC:
+			return e
+		case <-time.After(time.Second):
+			t.Fatal("no kline emitted")
+			return emitted{}
+		}
+	}
+
+	stream := <-s.streams
+	// a kline of the stream which is also requested is emitted once
+	stream.handler(testKline{current - 10*60000, current - 9*60000 - 1}, true)
+	for i := int64(10); i > 0; i-- {
+		r.Equal(emitted{current - i*60000, true}, next())
+	}
+	r.Equal(emitted{current, false}, next())
+
+	stream.handler(testKline{current, current + 59999}, false)
+	r.Equal(emitted{current, false}, next())
+	stream.handler(testKline{current, current + 59999}, true)
+	r.Equal(emitted{current, true}, next())
+
+	// the stream ends and the klines missed meanwhile are requested when it is back
+	close(stream.endC)
+	select {
+	case stream = <-s.streams:
+	case <-time.After(3 * time.Second):
+		t.Fatal("stream not subscribed again")
+	}
+	r.Equal([]error{errKlineStreamEnded}, handled)
+	stream.handler(testKline{current + 3*60000, current + 4*60000 - 1}, false)
+	r.Equal(emitted{current + 60000, true}, next())
+	r.Equal(emitted{current + 2*60000, true}, next())
+	r.Equal(emitted{current + 3*60000, false}, next())
+
+	cancel()
+	r.ErrorIs(<-errC, context.Canceled)
+}
@@ -0,0 +1,79 @@
+package common
+
+import (
+	"context"
+	"sort"
+)
+
+// OrderReplay rebuilds the order events of a user data stream from the orders and trades returned by the REST API:
+// the creation of an order, every trade and the end of an order which didn't fill. O is the order, T the trade and E
+// the event of the stream. Fields which the responses lack are left empty by the callbacks.
+type OrderReplay[O, T, E any] struct {
+	// Orders iterates the orders of symbol which may have been updated since the start time
+	Orders func(symbol string) *Iterator[O]
+	// Trades iterates the trades of symbol since the start time
+	Trades func(symbol string) *Iterator[T]
+	// OrderInfo returns the id, the creation time and the update time of an order
+	OrderInfo func(o O) (id, createTime, updateTime int64)
+	// TradeInfo returns the id of a trade and the id of its order
+	TradeInfo func(t T) (id, orderID int64)
+	// Created returns the event of the creation of an order
+	Created func(o O) E
+	// Ended returns the event of the cancellation, rejection or expiry of an order, false if it hasn't ended so
+	Ended func(o O) (E, bool)
+	// Traded returns the event of a trade of order o. found is false if the order wasn't returned, last is true for
+	// the last trade of the order.
+	Traded func(t T, o O, found, last bool) E
+	// Time returns the time of an event
+	Time func(e E) int64
+}
+
+// Events returns the events of symbols since startTime in milliseconds, ordered by time
+func (r *OrderReplay[O, T, E]) Events(ctx context.Context, symbols []string, startTime int64) ([]E, error) {
+	var events []E
+	for _, symbol := range symbols {
+		var updated []O
+		byID := make(map[int64]O)
+		it := r.Orders(symbol)
+		for it.Next(ctx) {
+			o := it.Value()
+			if id, _, updateTime := r.OrderInfo(o); updateTime >= startTime {
+				updated = append(updated, o)
+				byID[id] = o
+			}
+		}
+		if err := it.Err(); err != nil {
+			return nil, err
+		}
+		var trades []T
+		lastTrade := make(map[int64]int64)
+		tit := r.Trades(symbol)
+		for tit.Next(ctx) {
+			t := tit.Value()
+			trades = append(trades, t)
+			id, orderID := r.TradeInfo(t)
+			lastTrade[orderID] = id
+		}
+		if err := tit.Err(); err != nil {
+			return nil, err
+		}
+
+		for _, o := range updated {
+			if _, createTime, _ := r.OrderInfo(o); createTime >= startTime {
+				events = append(events, r.Created(o))
+			}
+			if e, ok := r.Ended(o); ok {
+				events = append(events, e)
+			}
+		}
+		for _, t := range trades {
+			id, orderID := r.TradeInfo(t)
+			o, found := byID[orderID]
+			events = append(events, r.Traded(t, o, found, lastTrade[orderID] == id))
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return r.Time(events[i]) < r.Time(events[j])
+	})
+	return events, nil
+}
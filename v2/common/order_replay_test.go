@@ -0,0 +1,67 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testOrder struct {
+	ID, Create, Update int64
+	Status             string
+}
+
+type testTrade struct {
+	ID, Order, Time int64
+}
+
+func sliceIterator[T any](rows []T) *Iterator[T] {
+	return NewSplitIterator(0, 0, time.Millisecond, func(ctx context.Context, startTime, endTime int64) ([]T, bool, error) {
+		return rows, false, nil
+	})
+}
+
+func TestOrderReplay(t *testing.T) {
+	r := require.New(t)
+	orders := []testOrder{
+		{ID: 1, Create: 90, Update: 110, Status: "FILLED"},
+		{ID: 2, Create: 105, Update: 120, Status: "CANCELED"},
+		{ID: 3, Create: 50, Update: 60, Status: "FILLED"},
+	}
+	trades := []testTrade{{ID: 11, Order: 1, Time: 108}, {ID: 12, Order: 1, Time: 110}, {ID: 13, Order: 4, Time: 115}}
+	replay := &OrderReplay[testOrder, testTrade, [2]string]{
+		Orders: func(symbol string) *Iterator[testOrder] { return sliceIterator(orders) },
+		Trades: func(symbol string) *Iterator[testTrade] { return sliceIterator(trades) },
+		OrderInfo: func(o testOrder) (id, createTime, updateTime int64) {
+			return o.ID, o.Create, o.Update
+		},
+		TradeInfo: func(t testTrade) (id, orderID int64) { return t.ID, t.Order },
+		Created: func(o testOrder) [2]string {
+			return [2]string{fmt.Sprint(o.Create), fmt.Sprintf("%d NEW", o.ID)}
+		},
+		Ended: func(o testOrder) ([2]string, bool) {
+			return [2]string{fmt.Sprint(o.Update), fmt.Sprintf("%d %s", o.ID, o.Status)}, o.Status == "CANCELED"
+		},
+		Traded: func(t testTrade, o testOrder, found, last bool) [2]string {
+			return [2]string{fmt.Sprint(t.Time), fmt.Sprintf("%d TRADE %d %t %t", t.Order, t.ID, found, last)}
+		},
+		Time: func(e [2]string) int64 {
+			var t int64
+			fmt.Sscan(e[0], &t)
+			return t
+		},
+	}
+
+	events, err := replay.Events(context.Background(), []string{"BTCUSDT"}, 100)
+	r.NoError(err)
+	r.Equal([][2]string{
+		{"105", "2 NEW"},
+		{"108", "1 TRADE 11 true false"},
+		{"110", "1 TRADE 12 true true"},
+		{"115", "4 TRADE 13 false true"},
+		{"120", "2 CANCELED"},
+	}, events)
+}
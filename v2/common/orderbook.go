@@ -0,0 +1,87 @@
+package common
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// orderBookLevel is a parsed PriceLevel
+type orderBookLevel struct {
+	price decimal.Decimal
+	level PriceLevel
+}
+
+// OrderBookSide keeps the price levels of one side of an order book sorted from the best price.
+// It is not safe for concurrent use.
+type OrderBookSide struct {
+	desc   bool
+	levels []orderBookLevel
+}
+
+// NewOrderBookSide init order book side, bids are sorted descending and asks ascending
+func NewOrderBookSide(desc bool) *OrderBookSide {
+	return &OrderBookSide{desc: desc}
+}
+
+// Update sets the quantity of the price level, a zero quantity removes the level
+func (s *OrderBookSide) Update(level PriceLevel) error {
+	price, err := decimal.NewFromString(level.Price)
+	if err != nil {
+		return err
+	}
+	quantity, err := decimal.NewFromString(level.Quantity)
+	if err != nil {
+		return err
+	}
+
+	i := sort.Search(len(s.levels), func(i int) bool {
+		if s.desc {
+			return s.levels[i].price.LessThanOrEqual(price)
+		}
+		return s.levels[i].price.GreaterThanOrEqual(price)
+	})
+	found := i < len(s.levels) && s.levels[i].price.Equal(price)
+	switch {
+	case quantity.IsZero() && found:
+		s.levels = append(s.levels[:i], s.levels[i+1:]...)
+	case quantity.IsZero():
+	case found:
+		s.levels[i].level = level
+	default:
+		s.levels = append(s.levels, orderBookLevel{})
+		copy(s.levels[i+1:], s.levels[i:])
+		s.levels[i] = orderBookLevel{price: price, level: level}
+	}
+	return nil
+}
+
+// Best returns the best price level
+func (s *OrderBookSide) Best() (PriceLevel, bool) {
+	if len(s.levels) == 0 {
+		return PriceLevel{}, false
+	}
+	return s.levels[0].level, true
+}
+
+// Depth returns up to n best price levels, all levels if n <= 0
+func (s *OrderBookSide) Depth(n int) []PriceLevel {
+	if n <= 0 || n > len(s.levels) {
+		n = len(s.levels)
+	}
+	levels := make([]PriceLevel, n)
+	for i := 0; i < n; i++ {
+		levels[i] = s.levels[i].level
+	}
+	return levels
+}
+
+// Len returns the number of price levels
+func (s *OrderBookSide) Len() int {
+	return len(s.levels)
+}
+
+// Reset removes all price levels
+func (s *OrderBookSide) Reset() {
+	s.levels = nil
+}
@@ -0,0 +1,56 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderBookSide(t *testing.T) {
+	assert := assert.New(t)
+
+	bids := NewOrderBookSide(true)
+	_, ok := bids.Best()
+	assert.False(ok)
+	for _, level := range []PriceLevel{
+		{Price: "10.5", Quantity: "1"},
+		{Price: "9.00", Quantity: "2"},
+		{Price: "11", Quantity: "3"},
+		{Price: "10.50", Quantity: "4"},
+		{Price: "12", Quantity: "0"},
+	} {
+		assert.NoError(bids.Update(level))
+	}
+	assert.Equal(3, bids.Len())
+	best, ok := bids.Best()
+	assert.True(ok)
+	assert.Equal(PriceLevel{Price: "11", Quantity: "3"}, best)
+	assert.Equal([]PriceLevel{
+		{Price: "11", Quantity: "3"},
+		{Price: "10.50", Quantity: "4"},
+	}, bids.Depth(2))
+
+	assert.NoError(bids.Update(PriceLevel{Price: "11.0", Quantity: "0.000"}))
+	assert.Equal([]PriceLevel{
+		{Price: "10.50", Quantity: "4"},
+		{Price: "9.00", Quantity: "2"},
+	}, bids.Depth(0))
+
+	asks := NewOrderBookSide(false)
+	for _, level := range []PriceLevel{
+		{Price: "10.5", Quantity: "1"},
+		{Price: "9", Quantity: "2"},
+		{Price: "11", Quantity: "3"},
+	} {
+		assert.NoError(asks.Update(level))
+	}
+	assert.Equal([]PriceLevel{
+		{Price: "9", Quantity: "2"},
+		{Price: "10.5", Quantity: "1"},
+		{Price: "11", Quantity: "3"},
+	}, asks.Depth(10))
+
+	assert.Error(asks.Update(PriceLevel{Price: "abc", Quantity: "1"}))
+	asks.Reset()
+	assert.Equal(0, asks.Len())
+}
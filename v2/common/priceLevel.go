@@ -1,9 +1,17 @@
 package common
 
-import "strconv"
+import (
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+//go:generate go run ../internal/decimalgen
 
 // PriceLevel is a common structure for bids and asks in the
 // order book.
+//
+//decimalgen:accessors
 type PriceLevel struct {
 	Price    string
 	Quantity string
@@ -23,3 +31,17 @@ func (p *PriceLevel) Parse() (float64, float64, error) {
 	}
 	return price, quantity, nil
 }
+
+// ParseDecimal parses this PriceLevel's Price and Quantity
+// into decimals without losing precision.
+func (p *PriceLevel) ParseDecimal() (decimal.Decimal, decimal.Decimal, error) {
+	price, err := p.PriceDecimal()
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	quantity, err := p.QuantityDecimal()
+	if err != nil {
+		return price, decimal.Zero, err
+	}
+	return price, quantity, nil
+}
@@ -0,0 +1,265 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rate limit types of exchange info
+const (
+	RateLimitTypeRequestWeight = "REQUEST_WEIGHT"
+	RateLimitTypeOrders        = "ORDERS"
+	RateLimitTypeRawRequests   = "RAW_REQUESTS"
+)
+
+var rateLimitIntervals = map[string]time.Duration{
+	"SECOND": time.Second,
+	"MINUTE": time.Minute,
+	"HOUR":   time.Hour,
+	"DAY":    24 * time.Hour,
+}
+
+// RateLimitRule define a limit of the exchange, e.g. 6000 REQUEST_WEIGHT per 1 MINUTE
+type RateLimitRule struct {
+	Type     string
+	Interval time.Duration
+	Limit    int64
+}
+
+// NewRateLimitRule init rule from the rateLimits of exchange info
+func NewRateLimitRule(rateLimitType string, interval string, intervalNum int64, limit int64) (RateLimitRule, error) {
+	d, ok := rateLimitIntervals[interval]
+	if !ok {
+		return RateLimitRule{}, fmt.Errorf("unknown rate limit interval: %s", interval)
+	}
+	return RateLimitRule{Type: rateLimitType, Interval: d * time.Duration(intervalNum), Limit: limit}, nil
+}
+
+// RateLimitError is returned when a request would exceed a rate limit
+type RateLimitError struct {
+	API        string
+	Type       string
+	Interval   time.Duration
+	Limit      int64
+	RetryAfter time.Duration
+}
+
+// Error return rate limit and the time until it resets
+func (e RateLimitError) Error() string {
+	return fmt.Sprintf("<RateLimitError> api=%s, type=%s, interval=%s, limit=%d, retryAfter=%s",
+		e.API, e.Type, e.Interval, e.Limit, e.RetryAfter)
+}
+
+// rateLimitWindow counts usage of a rule in the current fixed window
+type rateLimitWindow struct {
+	rule  RateLimitRule
+	start time.Time
+	used  int64
+}
+
+func (w *rateLimitWindow) roll(now time.Time) {
+	start := now.Truncate(w.rule.Interval)
+	if !start.Equal(w.start) {
+		w.start = start
+		w.used = 0
+	}
+}
+
+func (w *rateLimitWindow) cost(weight, orders int64) int64 {
+	switch w.rule.Type {
+	case RateLimitTypeRequestWeight:
+		return weight
+	case RateLimitTypeOrders:
+		return orders
+	case RateLimitTypeRawRequests:
+		return 1
+	}
+	return 0
+}
+
+// RateLimiter counts request weight and orders of every API (e.g. api, fapi, dapi) before requests are sent.
+// Limits of Binance are per IP (request weight) and per account (orders), so a single limiter can be
+// shared by all clients using the same IP and account.
+type RateLimiter struct {
+	mu       sync.Mutex
+	failFast bool
+	windows  map[string][]*rateLimitWindow
+	now      func() time.Time
+}
+
+// NewRateLimiter init rate limiter, requests block until the limits allow them by default
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		windows: map[string][]*rateLimitWindow{},
+		now:     time.Now,
+	}
+}
+
+// FailFast set whether requests exceeding a limit fail with RateLimitError instead of waiting
+func (l *RateLimiter) FailFast(failFast bool) *RateLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.failFast = failFast
+	return l
+}
+
+// SetRules replace the rules of api, usage of rules which are kept is preserved
+func (l *RateLimiter) SetRules(api string, rules []RateLimitRule) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	windows := make([]*rateLimitWindow, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Interval <= 0 {
+			continue
+		}
+		w := &rateLimitWindow{rule: rule}
+		for _, old := range l.windows[api] {
+			if old.rule.Type == rule.Type && old.rule.Interval == rule.Interval {
+				w.start, w.used = old.start, old.used
+			}
+		}
+		windows = append(windows, w)
+	}
+	l.windows[api] = windows
+}
+
+// HasRules check whether rules of api have been set
+func (l *RateLimiter) HasRules(api string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, ok := l.windows[api]
+	return ok
+}
+
+// Used returns usage of the rule of api in the current window
+func (l *RateLimiter) Used(api string, rateLimitType string, interval time.Duration) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	for _, w := range l.windows[api] {
+		if w.rule.Type == rateLimitType && w.rule.Interval == interval {
+			w.roll(now)
+			return w.used
+		}
+	}
+	return 0
+}
+
+// Wait reserves weight and orders of a request to api, it blocks until all limits allow the request
+// or ctx is done. In fail fast mode a RateLimitError is returned instead of blocking.
+func (l *RateLimiter) Wait(ctx context.Context, api string, weight, orders int64) error {
+	for {
+		l.mu.Lock()
+		now := l.now()
+		var exceeded *rateLimitWindow
+		var retryAfter time.Duration
+		for _, w := range l.windows[api] {
+			w.roll(now)
+			cost := w.cost(weight, orders)
+			if cost == 0 || w.used+cost <= w.rule.Limit {
+				continue
+			}
+			if d := w.start.Add(w.rule.Interval).Sub(now); exceeded == nil || d > retryAfter {
+				exceeded, retryAfter = w, d
+			}
+		}
+		if exceeded == nil {
+			for _, w := range l.windows[api] {
+				w.used += w.cost(weight, orders)
+			}
+			l.mu.Unlock()
+			return nil
+		}
+		failFast := l.failFast
+		l.mu.Unlock()
+
+		if failFast {
+			return &RateLimitError{
+				API:        api,
+				Type:       exceeded.rule.Type,
+				Interval:   exceeded.rule.Interval,
+				Limit:      exceeded.rule.Limit,
+				RetryAfter: retryAfter,
+			}
+		}
+		timer := time.NewTimer(retryAfter)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// UpdateByHeader correct usage of api from the X-Mbx-Used-Weight-* and X-Mbx-Order-Count-* headers
+func (l *RateLimiter) UpdateByHeader(api string, header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	for key, values := range header {
+		var rateLimitType string
+		key = http.CanonicalHeaderKey(key)
+		switch {
+		case strings.HasPrefix(key, "X-Mbx-Used-Weight-"):
+			rateLimitType = RateLimitTypeRequestWeight
+		case strings.HasPrefix(key, "X-Mbx-Order-Count-"):
+			rateLimitType = RateLimitTypeOrders
+		default:
+			continue
+		}
+		interval, ok := parseHeaderInterval(key[strings.LastIndex(key, "-")+1:])
+		if !ok || len(values) == 0 {
+			continue
+		}
+		used, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		for _, w := range l.windows[api] {
+			if w.rule.Type == rateLimitType && w.rule.Interval == interval {
+				w.roll(now)
+				w.used = used
+			}
+		}
+	}
+}
+
+// parseHeaderInterval parse interval suffix of rate limit headers, e.g. 10s, 1m, 1d
+func parseHeaderInterval(s string) (time.Duration, bool) {
+	if len(s) < 2 {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	var unit time.Duration
+	switch strings.ToLower(s[len(s)-1:]) {
+	case "s":
+		unit = time.Second
+	case "m":
+		unit = time.Minute
+	case "h":
+		unit = time.Hour
+	case "d":
+		unit = 24 * time.Hour
+	default:
+		return 0, false
+	}
+	return time.Duration(n) * unit, true
+}
+
+// RateLimitAPI returns the api an endpoint belongs to, e.g. fapi for /fapi/v1/order
+func RateLimitAPI(endpoint string) string {
+	endpoint = strings.TrimPrefix(endpoint, "/")
+	if i := strings.Index(endpoint, "/"); i >= 0 {
+		return endpoint[:i]
+	}
+	return endpoint
+}
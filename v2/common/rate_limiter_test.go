@@ -0,0 +1,92 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRateLimitRule(t *testing.T) {
+	assert := assert.New(t)
+	rule, err := NewRateLimitRule(RateLimitTypeOrders, "SECOND", 10, 100)
+	assert.NoError(err)
+	assert.Equal(RateLimitRule{Type: RateLimitTypeOrders, Interval: 10 * time.Second, Limit: 100}, rule)
+
+	_, err = NewRateLimitRule(RateLimitTypeOrders, "WEEK", 1, 100)
+	assert.Error(err)
+}
+
+func TestRateLimiterFailFast(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 15, 0, time.UTC)
+	l := NewRateLimiter().FailFast(true)
+	l.now = func() time.Time { return now }
+	l.SetRules("api", []RateLimitRule{
+		{Type: RateLimitTypeRequestWeight, Interval: time.Minute, Limit: 10},
+		{Type: RateLimitTypeOrders, Interval: 10 * time.Second, Limit: 1},
+	})
+	assert.True(l.HasRules("api"))
+	assert.False(l.HasRules("fapi"))
+
+	ctx := context.Background()
+	assert.NoError(l.Wait(ctx, "api", 5, 1))
+	assert.NoError(l.Wait(ctx, "api", 4, 0))
+	assert.Equal(int64(9), l.Used("api", RateLimitTypeRequestWeight, time.Minute))
+
+	err := l.Wait(ctx, "api", 1, 1)
+	var rateLimitErr *RateLimitError
+	assert.True(errors.As(err, &rateLimitErr))
+	assert.Equal(RateLimitTypeOrders, rateLimitErr.Type)
+	assert.Equal(5*time.Second, rateLimitErr.RetryAfter)
+
+	err = l.Wait(ctx, "api", 2, 0)
+	assert.True(errors.As(err, &rateLimitErr))
+	assert.Equal(RateLimitTypeRequestWeight, rateLimitErr.Type)
+	assert.Equal(45*time.Second, rateLimitErr.RetryAfter)
+
+	// other apis are not limited
+	assert.NoError(l.Wait(ctx, "fapi", 100, 100))
+
+	// usage is corrected by the response headers
+	l.UpdateByHeader("api", http.Header{
+		"X-Mbx-Used-Weight-1m":  []string{"2"},
+		"X-Mbx-Order-Count-10s": []string{"0"},
+	})
+	assert.Equal(int64(2), l.Used("api", RateLimitTypeRequestWeight, time.Minute))
+	assert.NoError(l.Wait(ctx, "api", 8, 1))
+
+	// usage resets in the next window
+	now = now.Add(time.Minute)
+	assert.Equal(int64(0), l.Used("api", RateLimitTypeRequestWeight, time.Minute))
+	assert.NoError(l.Wait(ctx, "api", 10, 1))
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	assert := assert.New(t)
+	l := NewRateLimiter()
+	l.SetRules("api", []RateLimitRule{
+		{Type: RateLimitTypeRawRequests, Interval: 100 * time.Millisecond, Limit: 1},
+	})
+	ctx := context.Background()
+	assert.NoError(l.Wait(ctx, "api", 1, 0))
+	assert.NoError(l.Wait(ctx, "api", 1, 0))
+
+	l.SetRules("fapi", []RateLimitRule{
+		{Type: RateLimitTypeRawRequests, Interval: 24 * time.Hour, Limit: 1},
+	})
+	assert.NoError(l.Wait(ctx, "fapi", 1, 0))
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(l.Wait(ctx, "fapi", 1, 0), context.Canceled)
+}
+
+func TestRateLimitAPI(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("api", RateLimitAPI("/api/v3/order"))
+	assert.Equal("fapi", RateLimitAPI("/fapi/v1/order"))
+	assert.Equal("sapi", RateLimitAPI("sapi"))
+}
@@ -0,0 +1,138 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// API error codes which are worth retrying
+const (
+	ErrorCodeDisconnected    = -1001
+	ErrorCodeTooManyRequests = -1003
+	ErrorCodeTimeout         = -1007
+	ErrorCodeNoSuchOrder     = -2013
+)
+
+// RetryPolicy define how failed requests are retried. GET requests are retried on 5xx responses, 429 responses,
+// timeouts and the -1001, -1003 and -1007 error codes. Orders are only retried when they carry a client order id
+// and the exchange reports that the order doesn't exist.
+type RetryPolicy struct {
+	MaxRetries int           // number of retries after the first attempt
+	MinBackoff time.Duration // delay before the first retry
+	MaxBackoff time.Duration // maximum delay between retries
+}
+
+// NewRetryPolicy init retry policy with 3 retries and a backoff from 200ms up to 5s
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 200 * time.Millisecond,
+		MaxBackoff: 5 * time.Second,
+	}
+}
+
+// Delay returns the delay before retry attempt (starting from 0), the Retry-After header is honored if present
+func (p *RetryPolicy) Delay(attempt int, header http.Header) time.Duration {
+	if d, ok := retryAfter(header); ok {
+		return d
+	}
+	d := p.MinBackoff
+	for i := 0; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+// Wait sleeps for the delay before retry attempt, it returns early with ctx error if ctx is done
+func (p *RetryPolicy) Wait(ctx context.Context, attempt int, header http.Header) error {
+	timer := time.NewTimer(p.Delay(attempt, header))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// IsRetryable check whether a request failed with statusCode and err may succeed when sent again
+func IsRetryable(statusCode int, err error) bool {
+	if statusCode == http.StatusTeapot || errors.Is(err, ErrIPBanned) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	if statusCode >= http.StatusInternalServerError || statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.Code {
+		case ErrorCodeDisconnected, ErrorCodeTooManyRequests, ErrorCodeTimeout:
+			return true
+		}
+	}
+	return false
+}
+
+// IPBannedError is returned when the IP has been banned for exceeding the rate limits (HTTP 418)
+type IPBannedError struct {
+	Until time.Time // time the ban is lifted, zero if unknown
+	Err   *APIError
+}
+
+// Error return unban time and the API error
+func (e IPBannedError) Error() string {
+	return fmt.Sprintf("<IPBannedError> until=%s, err=%s", e.Until.Format(time.RFC3339), e.Err)
+}
+
+// Unwrap returns the API error
+func (e IPBannedError) Unwrap() error {
+	return e.Err
+}
+
+// Is check whether target is ErrIPBanned
+func (e IPBannedError) Is(target error) bool {
+	return target == ErrIPBanned
+}
+
+var bannedUntilRegexp = regexp.MustCompile(`banned until (\d+)`)
+
+// NewIPBannedError init ban error from the Retry-After header or the "banned until" timestamp of the message
+func NewIPBannedError(header http.Header, apiErr *APIError) *IPBannedError {
+	e := &IPBannedError{Err: apiErr}
+	if m := bannedUntilRegexp.FindStringSubmatch(apiErr.Message); m != nil {
+		if ms, err := strconv.ParseInt(m[1], 10, 64); err == nil {
+			e.Until = time.UnixMilli(ms)
+			return e
+		}
+	}
+	if d, ok := retryAfter(header); ok {
+		e.Until = time.Now().Add(d)
+	}
+	return e
+}
+
+// retryAfter parse the Retry-After header in seconds
+func retryAfter(header http.Header) (time.Duration, bool) {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	seconds, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
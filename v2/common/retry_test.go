@@ -0,0 +1,51 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestRetryPolicyDelay(t *testing.T) {
+	assert := assert.New(t)
+	p := &RetryPolicy{MaxRetries: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	assert.Equal(100*time.Millisecond, p.Delay(0, nil))
+	assert.Equal(400*time.Millisecond, p.Delay(2, nil))
+	assert.Equal(time.Second, p.Delay(10, nil))
+	assert.Equal(3*time.Second, p.Delay(0, http.Header{"Retry-After": []string{"3"}}))
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsRetryable(http.StatusServiceUnavailable, &APIError{Response: []byte("unavailable")}))
+	assert.True(IsRetryable(http.StatusTooManyRequests, &APIError{Code: ErrorCodeTooManyRequests}))
+	assert.True(IsRetryable(http.StatusBadRequest, &APIError{Code: ErrorCodeDisconnected}))
+	assert.True(IsRetryable(0, &url.Error{Op: "Get", URL: "https://api.binance.com", Err: timeoutError{}}))
+	assert.False(IsRetryable(http.StatusBadRequest, &APIError{Code: -2010}))
+	assert.False(IsRetryable(http.StatusTeapot, &IPBannedError{Err: &APIError{Code: ErrorCodeTooManyRequests}}))
+	assert.False(IsRetryable(0, errors.New("invalid request")))
+}
+
+func TestNewIPBannedError(t *testing.T) {
+	assert := assert.New(t)
+	e := NewIPBannedError(nil, &APIError{Code: -1003, Message: "Way too many requests; IP(127.0.0.1) banned until 1659146399830. Please use the websocket for live updates to avoid bans."})
+	assert.Equal(time.UnixMilli(1659146399830), e.Until)
+
+	before := time.Now()
+	e = NewIPBannedError(http.Header{"Retry-After": []string{"120"}}, &APIError{Code: -1003})
+	assert.False(e.Until.Before(before.Add(120 * time.Second)))
+
+	var apiErr *APIError
+	assert.True(errors.As(e, &apiErr))
+	assert.Equal(int64(-1003), apiErr.Code)
+}
@@ -12,6 +12,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"sync"
 )
 
 const (
@@ -44,6 +45,80 @@ func Hmac(secretKey string, data string) (*string, error) {
 }
 
 func Rsa(secretKey string, data string) (*string, error) {
+	signer, err := NewRsaSigner(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(data)
+	if err != nil {
+		return nil, err
+	}
+	return &signature, nil
+}
+
+func Ed25519(secretKey string, data string) (*string, error) {
+	signer, err := NewEd25519Signer(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(data)
+	if err != nil {
+		return nil, err
+	}
+	return &signature, nil
+}
+
+// Signer signs the payload of signed requests. Clients sign with their Signer instead of SecretKey and KeyType if it
+// is set, so that the private key can be kept outside of the library, e.g. in a remote signing service.
+type Signer interface {
+	// KeyType returns the type of the key, one of KeyTypeHmac, KeyTypeRsa and KeyTypeEd25519
+	KeyType() string
+	// Sign returns the encoded signature of data
+	Sign(data string) (string, error)
+}
+
+// NewSigner creates the signer of a secret key, RSA and Ed25519 keys are PEM encoded PKCS #8 private keys
+func NewSigner(keyType string, secretKey string) (Signer, error) {
+	switch keyType {
+	case KeyTypeHmac:
+		return NewHmacSigner(secretKey), nil
+	case KeyTypeRsa:
+		return NewRsaSigner(secretKey)
+	case KeyTypeEd25519:
+		return NewEd25519Signer(secretKey)
+	default:
+		return nil, fmt.Errorf("unsupported keyType=%s", keyType)
+	}
+}
+
+type hmacSigner struct {
+	secretKey []byte
+}
+
+// NewHmacSigner creates the signer of an HMAC secret key
+func NewHmacSigner(secretKey string) Signer {
+	return &hmacSigner{secretKey: []byte(secretKey)}
+}
+
+func (s *hmacSigner) KeyType() string {
+	return KeyTypeHmac
+}
+
+func (s *hmacSigner) Sign(data string) (string, error) {
+	mac := hmac.New(sha256.New, s.secretKey)
+	_, err := mac.Write([]byte(data))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", mac.Sum(nil)), nil
+}
+
+type rsaSigner struct {
+	privateKey *rsa.PrivateKey
+}
+
+// NewRsaSigner creates the signer of a PEM encoded RSA private key, the key is parsed once
+func NewRsaSigner(secretKey string) (Signer, error) {
 	block, _ := pem.Decode([]byte(secretKey))
 	if block == nil {
 		return nil, errors.New("Rsa pem.Decode failed, invalid pem format secretKey")
@@ -56,16 +131,28 @@ func Rsa(secretKey string, data string) (*string, error) {
 	if !ok {
 		return nil, fmt.Errorf("Rsa convert PrivateKey failed")
 	}
+	return &rsaSigner{privateKey: rsaPrivateKey}, nil
+}
+
+func (s *rsaSigner) KeyType() string {
+	return KeyTypeRsa
+}
+
+func (s *rsaSigner) Sign(data string) (string, error) {
 	hashed := sha256.Sum256([]byte(data))
-	signature, err := rsa.SignPKCS1v15(rand.Reader, rsaPrivateKey, crypto.SHA256, hashed[:])
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.privateKey, crypto.SHA256, hashed[:])
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	encodedSignature := base64.StdEncoding.EncodeToString(signature)
-	return &encodedSignature, nil
+	return base64.StdEncoding.EncodeToString(signature), nil
 }
 
-func Ed25519(secretKey string, data string) (*string, error) {
+type ed25519Signer struct {
+	privateKey ed25519.PrivateKey
+}
+
+// NewEd25519Signer creates the signer of a PEM encoded Ed25519 private key, the key is parsed once
+func NewEd25519Signer(secretKey string) (Signer, error) {
 	block, _ := pem.Decode([]byte(secretKey))
 	if block == nil {
 		return nil, fmt.Errorf("Ed25519 pem.Decode failed, invalid pem format secretKey")
@@ -78,8 +165,42 @@ func Ed25519(secretKey string, data string) (*string, error) {
 	if !ok {
 		return nil, fmt.Errorf("Ed25519 convert PrivateKey failed")
 	}
-	pk := ed25519.PrivateKey(ed25519PrivateKey)
-	signature := ed25519.Sign(pk, []byte(data))
-	encodedSignature := base64.StdEncoding.EncodeToString(signature)
-	return &encodedSignature, nil
+	return &ed25519Signer{privateKey: ed25519PrivateKey}, nil
+}
+
+func (s *ed25519Signer) KeyType() string {
+	return KeyTypeEd25519
+}
+
+func (s *ed25519Signer) Sign(data string) (string, error) {
+	signature := ed25519.Sign(s.privateKey, []byte(data))
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// SignerCache holds the signer of the secret key of a client or service, so that the key isn't parsed for every
+// request. The key is parsed again only after the key type or the secret key changed, the signer of the previous key
+// is dropped. The zero value is ready to use.
+type SignerCache struct {
+	mu     sync.Mutex
+	id     [sha256.Size]byte
+	signer Signer
+}
+
+// Signer returns the signer of secretKey like NewSigner. If c is nil, the signer is created on every call.
+func (c *SignerCache) Signer(keyType string, secretKey string) (Signer, error) {
+	if c == nil {
+		return NewSigner(keyType, secretKey)
+	}
+	id := sha256.Sum256([]byte(keyType + "\x00" + secretKey))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.signer != nil && c.id == id {
+		return c.signer, nil
+	}
+	signer, err := NewSigner(keyType, secretKey)
+	if err != nil {
+		return nil, err
+	}
+	c.id, c.signer = id, signer
+	return signer, nil
 }
@@ -0,0 +1,114 @@
+package common
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodePrivateKey(t *testing.T, privateKey any) string {
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func TestHmacSigner(t *testing.T) {
+	r := require.New(t)
+	signer, err := NewSigner(KeyTypeHmac, "secret")
+	r.NoError(err)
+	r.Equal(KeyTypeHmac, signer.KeyType())
+
+	signature, err := signer.Sign("symbol=BTCUSDT&timestamp=1")
+	r.NoError(err)
+	expected, err := Hmac("secret", "symbol=BTCUSDT&timestamp=1")
+	r.NoError(err)
+	r.Equal(*expected, signature)
+}
+
+func TestRsaSigner(t *testing.T) {
+	r := require.New(t)
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	r.NoError(err)
+	signer, err := NewSigner(KeyTypeRsa, encodePrivateKey(t, privateKey))
+	r.NoError(err)
+	r.Equal(KeyTypeRsa, signer.KeyType())
+
+	signature, err := signer.Sign("symbol=BTCUSDT&timestamp=1")
+	r.NoError(err)
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	r.NoError(err)
+	hashed := sha256.Sum256([]byte("symbol=BTCUSDT&timestamp=1"))
+	r.NoError(rsa.VerifyPKCS1v15(&privateKey.PublicKey, crypto.SHA256, hashed[:], decoded))
+}
+
+func TestEd25519Signer(t *testing.T) {
+	r := require.New(t)
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	r.NoError(err)
+	secretKey := encodePrivateKey(t, privateKey)
+	signer, err := NewSigner(KeyTypeEd25519, secretKey)
+	r.NoError(err)
+	r.Equal(KeyTypeEd25519, signer.KeyType())
+
+	signature, err := signer.Sign("symbol=BTCUSDT&timestamp=1")
+	r.NoError(err)
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	r.NoError(err)
+	r.True(ed25519.Verify(publicKey, []byte("symbol=BTCUSDT&timestamp=1"), decoded))
+
+	expected, err := Ed25519(secretKey, "symbol=BTCUSDT&timestamp=1")
+	r.NoError(err)
+	r.Equal(*expected, signature)
+}
+
+func TestNewSigner_InvalidKey(t *testing.T) {
+	r := require.New(t)
+	_, err := NewSigner("DSA", "secret")
+	r.EqualError(err, "unsupported keyType=DSA")
+	_, err = NewSigner(KeyTypeRsa, "secret")
+	r.Error(err)
+	_, err = NewSigner(KeyTypeEd25519, "secret")
+	r.Error(err)
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	r.NoError(err)
+	_, err = NewSigner(KeyTypeRsa, encodePrivateKey(t, privateKey))
+	r.EqualError(err, "Rsa convert PrivateKey failed")
+}
+
+func TestSignerCache(t *testing.T) {
+	r := require.New(t)
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	r.NoError(err)
+	secretKey := encodePrivateKey(t, privateKey)
+
+	var cache SignerCache
+	signer, err := cache.Signer(KeyTypeEd25519, secretKey)
+	r.NoError(err)
+	cached, err := cache.Signer(KeyTypeEd25519, secretKey)
+	r.NoError(err)
+	r.Same(signer, cached)
+
+	// a rotated key replaces the signer
+	other, err := cache.Signer(KeyTypeHmac, secretKey)
+	r.NoError(err)
+	r.Equal(KeyTypeHmac, other.KeyType())
+	r.Same(other, cache.signer)
+
+	_, err = cache.Signer(KeyTypeRsa, "secret")
+	r.Error(err)
+	r.Same(other, cache.signer)
+
+	var nilCache *SignerCache
+	signer, err = nilCache.Signer(KeyTypeHmac, "secret")
+	r.NoError(err)
+	r.Equal(KeyTypeHmac, signer.KeyType())
+}
@@ -0,0 +1,95 @@
+package common
+
+import (
+	"context"
+	"sync"
+)
+
+// ServeFunc starts a websocket stream reporting its errors to errHandler, e.g. a WsXxxServe call
+type ServeFunc func(errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+
+// Stream is the handle of a running websocket stream. It is stopped by Close or when its context is done, and can be
+// closed any number of times.
+type Stream struct {
+	doneC chan struct{}
+	stopC chan struct{}
+	once  sync.Once
+
+	mu      sync.Mutex
+	lastErr error
+	err     error
+	stopped bool
+}
+
+// ServeStream starts the stream of serve and returns its handle, the stream is stopped when ctx is done. Errors of the
+// stream are passed on to errHandler if it isn't nil, the one which ended the stream is returned by Err.
+func ServeStream(ctx context.Context, serve ServeFunc, errHandler func(err error)) (*Stream, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s := &Stream{}
+	doneC, stopC, err := serve(func(err error) {
+		s.mu.Lock()
+		s.lastErr = err
+		s.mu.Unlock()
+		if errHandler != nil {
+			errHandler(err)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.doneC, s.stopC = doneC, stopC
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.stop(ctx.Err())
+		case <-doneC:
+		}
+	}()
+	return s, nil
+}
+
+// Done returns a channel which is closed once the stream has ended
+func (s *Stream) Done() <-chan struct{} {
+	return s.doneC
+}
+
+// Err returns nil while the stream is running or after it was closed, the error of the context if the context ended
+// the stream, otherwise the error which ended it
+func (s *Stream) Err() error {
+	select {
+	case <-s.doneC:
+	default:
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return s.err
+	}
+	return s.lastErr
+}
+
+// Close stops the stream and waits until it has ended
+func (s *Stream) Close() error {
+	s.stop(nil)
+	<-s.doneC
+	return nil
+}
+
+// stop closes stopC once, err is returned by Err if it stops the stream
+func (s *Stream) stop(err error) {
+	s.once.Do(func() {
+		s.mu.Lock()
+		select {
+		case <-s.doneC:
+			// the stream has already ended by itself
+		default:
+			s.stopped = true
+			s.err = err
+		}
+		s.mu.Unlock()
+		close(s.stopC)
+	})
+}
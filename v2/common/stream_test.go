@@ -0,0 +1,92 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServe serves a stream which ends when stopC is closed or endC receives the error ending it
+func fakeServe(endC chan error) ServeFunc {
+	return func(errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			defer close(doneC)
+			select {
+			case <-stopC:
+			case err := <-endC:
+				errHandler(err)
+			}
+		}()
+		return doneC, stopC, nil
+	}
+}
+
+func assertDone(t *testing.T, s *Stream) {
+	select {
+	case <-s.Done():
+	case <-time.After(time.Second):
+		t.Fatal("stream hasn't ended")
+	}
+}
+
+func TestStreamClose(t *testing.T) {
+	r := require.New(t)
+	s, err := ServeStream(context.Background(), fakeServe(make(chan error)), nil)
+	r.NoError(err)
+	r.NoError(s.Err())
+
+	r.NoError(s.Close())
+	r.NoError(s.Close())
+	assertDone(t, s)
+	r.NoError(s.Err())
+}
+
+func TestStreamContextDone(t *testing.T) {
+	r := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	s, err := ServeStream(ctx, fakeServe(make(chan error)), nil)
+	r.NoError(err)
+
+	cancel()
+	assertDone(t, s)
+	r.ErrorIs(s.Err(), context.Canceled)
+	r.NoError(s.Close())
+	r.ErrorIs(s.Err(), context.Canceled)
+}
+
+func TestStreamError(t *testing.T) {
+	r := require.New(t)
+	endC := make(chan error)
+	var handled []error
+	s, err := ServeStream(context.Background(), fakeServe(endC), func(err error) {
+		handled = append(handled, err)
+	})
+	r.NoError(err)
+
+	readErr := errors.New("read error")
+	endC <- readErr
+	assertDone(t, s)
+	r.ErrorIs(s.Err(), readErr)
+	r.Equal([]error{readErr}, handled)
+	r.NoError(s.Close())
+	r.ErrorIs(s.Err(), readErr)
+}
+
+func TestServeStreamError(t *testing.T) {
+	r := require.New(t)
+	dialErr := errors.New("dial error")
+	_, err := ServeStream(context.Background(), func(errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return nil, nil, dialErr
+	}, nil)
+	r.ErrorIs(err, dialErr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ServeStream(ctx, fakeServe(make(chan error)), nil)
+	r.ErrorIs(err, context.Canceled)
+}
@@ -0,0 +1,320 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Filter types checked by SymbolRules
+const (
+	FilterTypePrice         = "PRICE_FILTER"
+	FilterTypeLotSize       = "LOT_SIZE"
+	FilterTypeMarketLotSize = "MARKET_LOT_SIZE"
+	FilterTypeNotional      = "NOTIONAL"
+)
+
+// SymbolRules define the exchange filters of a symbol orders are checked against, zero values disable a rule.
+// Filters depending on market prices (e.g. PERCENT_PRICE) aren't checked.
+type SymbolRules struct {
+	Symbol string
+	Status string
+
+	MinPrice decimal.Decimal // PRICE_FILTER
+	MaxPrice decimal.Decimal
+	TickSize decimal.Decimal
+
+	MinQty   decimal.Decimal // LOT_SIZE
+	MaxQty   decimal.Decimal
+	StepSize decimal.Decimal
+
+	MarketMinQty   decimal.Decimal // MARKET_LOT_SIZE
+	MarketMaxQty   decimal.Decimal
+	MarketStepSize decimal.Decimal
+
+	MinNotional      decimal.Decimal // NOTIONAL or MIN_NOTIONAL
+	MaxNotional      decimal.Decimal
+	ApplyMinToMarket bool
+	ApplyMaxToMarket bool
+}
+
+// OrderValues are the order parameters checked by SymbolRules, empty strings are parameters which aren't set
+type OrderValues struct {
+	Market          bool // market orders are checked against MARKET_LOT_SIZE
+	Price           string
+	StopPrice       string
+	ActivationPrice string // trailing stop orders of futures
+	Quantity        string
+	QuoteQuantity   string
+	IcebergQuantity string
+	ReduceOnly      bool // reduce-only and close-position orders of futures aren't checked against the minimum notional
+}
+
+// FilterViolation describe an order parameter rejected by a filter
+type FilterViolation struct {
+	Filter string // filter type, e.g. PRICE_FILTER
+	Field  string // order parameter, e.g. price
+	Value  string
+	Reason string
+}
+
+// String return the violation in a readable form
+func (v FilterViolation) String() string {
+	return fmt.Sprintf("%s %s %s (%s)", v.Field, v.Value, v.Reason, v.Filter)
+}
+
+// FilterError is returned when an order fails the filters of its symbol before it's sent
+type FilterError struct {
+	Symbol     string
+	Violations []FilterViolation
+}
+
+// Error return the symbol and the violations
+func (e FilterError) Error() string {
+	violations := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		violations = append(violations, v.String())
+	}
+	return fmt.Sprintf("<FilterError> symbol=%s, %s", e.Symbol, strings.Join(violations, "; "))
+}
+
+// Is check whether target is ErrFilterFailure
+func (e FilterError) Is(target error) bool {
+	return target == ErrFilterFailure
+}
+
+// RoundPrice round price down to a multiple of tick size
+func (r *SymbolRules) RoundPrice(price string) string {
+	return roundToStep(price, r.MinPrice, r.TickSize)
+}
+
+// RoundQuantity round quantity down to a multiple of the step size of LOT_SIZE, or of MARKET_LOT_SIZE as well for market orders
+func (r *SymbolRules) RoundQuantity(quantity string, market bool) string {
+	quantity = roundToStep(quantity, r.MinQty, r.StepSize)
+	if market {
+		quantity = roundToStep(quantity, r.MarketMinQty, r.MarketStepSize)
+	}
+	return quantity
+}
+
+// Round round prices and quantities of o down to tick and step size
+func (r *SymbolRules) Round(o *OrderValues) {
+	o.Price = r.RoundPrice(o.Price)
+	o.StopPrice = r.RoundPrice(o.StopPrice)
+	o.ActivationPrice = r.RoundPrice(o.ActivationPrice)
+	o.Quantity = r.RoundQuantity(o.Quantity, o.Market)
+	o.IcebergQuantity = r.RoundQuantity(o.IcebergQuantity, false)
+}
+
+// Violations returns the filters o doesn't pass
+func (r *SymbolRules) Violations(o OrderValues) []FilterViolation {
+	var violations []FilterViolation
+	check := func(filter, field, value string, min, max, step decimal.Decimal) (decimal.Decimal, bool) {
+		if value == "" {
+			return decimal.Zero, false
+		}
+		d, err := decimal.NewFromString(value)
+		if err != nil {
+			violations = append(violations, FilterViolation{Filter: filter, Field: field, Value: value, Reason: "is not a number"})
+			return decimal.Zero, false
+		}
+		switch {
+		case min.IsPositive() && d.LessThan(min):
+			violations = append(violations, FilterViolation{Filter: filter, Field: field, Value: value, Reason: "is below minimum " + min.String()})
+		case max.IsPositive() && d.GreaterThan(max):
+			violations = append(violations, FilterViolation{Filter: filter, Field: field, Value: value, Reason: "is above maximum " + max.String()})
+		case step.IsPositive() && !d.Sub(min).Mod(step).IsZero():
+			violations = append(violations, FilterViolation{Filter: filter, Field: field, Value: value, Reason: "is not a multiple of " + step.String()})
+		}
+		return d, true
+	}
+	price, hasPrice := check(FilterTypePrice, "price", o.Price, r.MinPrice, r.MaxPrice, r.TickSize)
+	check(FilterTypePrice, "stopPrice", o.StopPrice, r.MinPrice, r.MaxPrice, r.TickSize)
+	check(FilterTypePrice, "activationPrice", o.ActivationPrice, r.MinPrice, r.MaxPrice, r.TickSize)
+	quantity, hasQuantity := check(FilterTypeLotSize, "quantity", o.Quantity, r.MinQty, r.MaxQty, r.StepSize)
+	if o.Market {
+		check(FilterTypeMarketLotSize, "quantity", o.Quantity, r.MarketMinQty, r.MarketMaxQty, r.MarketStepSize)
+	}
+	check(FilterTypeLotSize, "icebergQty", o.IcebergQuantity, r.MinQty, r.MaxQty, r.StepSize)
+
+	// the notional of market orders is only known when they're placed by quote quantity
+	field, value := "price*quantity", ""
+	var notional decimal.Decimal
+	switch {
+	case o.Market && o.QuoteQuantity != "":
+		field, value = "quoteOrderQty", o.QuoteQuantity
+		d, err := decimal.NewFromString(o.QuoteQuantity)
+		if err != nil {
+			violations = append(violations, FilterViolation{Filter: FilterTypeNotional, Field: field, Value: value, Reason: "is not a number"})
+			return violations
+		}
+		notional = d
+	case !o.Market && hasPrice && hasQuantity:
+		notional = price.Mul(quantity)
+		value = notional.String()
+	default:
+		return violations
+	}
+	if r.MinNotional.IsPositive() && notional.LessThan(r.MinNotional) && (!o.Market || r.ApplyMinToMarket) && !o.ReduceOnly {
+		violations = append(violations, FilterViolation{Filter: FilterTypeNotional, Field: field, Value: value, Reason: "is below minimum " + r.MinNotional.String()})
+	}
+	if r.MaxNotional.IsPositive() && notional.GreaterThan(r.MaxNotional) && (!o.Market || r.ApplyMaxToMarket) {
+		violations = append(violations, FilterViolation{Filter: FilterTypeNotional, Field: field, Value: value, Reason: "is above maximum " + r.MaxNotional.String()})
+	}
+	return violations
+}
+
+// Validate returns a *FilterError listing the violations if o doesn't pass the filters
+func (r *SymbolRules) Validate(o OrderValues) error {
+	violations := r.Violations(o)
+	if len(violations) == 0 {
+		return nil
+	}
+	return &FilterError{Symbol: r.Symbol, Violations: violations}
+}
+
+// roundToStep round value down to min plus a multiple of step, value is returned as is if it isn't a number or step is zero
+func roundToStep(value string, min, step decimal.Decimal) string {
+	if value == "" || !step.IsPositive() {
+		return value
+	}
+	d, err := decimal.NewFromString(value)
+	if err != nil || d.LessThan(min) {
+		return value
+	}
+	return d.Sub(min).Div(step).Floor().Mul(step).Add(min).String()
+}
+
+// SymbolRulesLoader loads the rules of all symbols, e.g. from exchange info
+type SymbolRulesLoader func(ctx context.Context) ([]*SymbolRules, error)
+
+// SymbolRulesRegistry caches the rules of symbols and refreshes them in the background once started
+type SymbolRulesRegistry struct {
+	load       SymbolRulesLoader
+	rules      map[string]*SymbolRules
+	updateTime time.Time
+
+	// Interval between refreshes in the background, 1 hour by default
+	Interval time.Duration
+	// ErrHandler receives errors of background refreshes
+	ErrHandler func(err error)
+
+	mu     sync.RWMutex
+	loadMu sync.Mutex
+	stopC  chan struct{}
+	doneC  chan struct{}
+}
+
+// NewSymbolRulesRegistry init registry loading the rules with load
+func NewSymbolRulesRegistry(load SymbolRulesLoader) *SymbolRulesRegistry {
+	return &SymbolRulesRegistry{
+		load:     load,
+		Interval: time.Hour,
+	}
+}
+
+// Refresh loads the rules of all symbols
+func (r *SymbolRulesRegistry) Refresh(ctx context.Context) error {
+	r.loadMu.Lock()
+	defer r.loadMu.Unlock()
+	return r.refresh(ctx)
+}
+
+func (r *SymbolRulesRegistry) refresh(ctx context.Context) error {
+	list, err := r.load(ctx)
+	if err != nil {
+		return err
+	}
+	rules := make(map[string]*SymbolRules, len(list))
+	for _, rule := range list {
+		rules[rule.Symbol] = rule
+	}
+	r.mu.Lock()
+	r.rules = rules
+	r.updateTime = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+// Get returns the cached rules of symbol
+func (r *SymbolRulesRegistry) Get(symbol string) (*SymbolRules, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	rules, ok := r.rules[symbol]
+	return rules, ok
+}
+
+// Rules returns the rules of symbol, they are loaded first if the registry is empty. nil is returned for unknown symbols.
+func (r *SymbolRulesRegistry) Rules(ctx context.Context, symbol string) (*SymbolRules, error) {
+	if r.UpdateTime().IsZero() {
+		r.loadMu.Lock()
+		// another caller may have loaded the rules meanwhile
+		if r.UpdateTime().IsZero() {
+			if err := r.refresh(ctx); err != nil {
+				r.loadMu.Unlock()
+				return nil, err
+			}
+		}
+		r.loadMu.Unlock()
+	}
+	rules, _ := r.Get(symbol)
+	return rules, nil
+}
+
+// UpdateTime returns the time of the last refresh, zero if the rules haven't been loaded yet
+func (r *SymbolRulesRegistry) UpdateTime() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.updateTime
+}
+
+// Start loads the rules and refreshes them in the background until Stop is called or ctx is done
+func (r *SymbolRulesRegistry) Start(ctx context.Context) error {
+	if err := r.Refresh(ctx); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stopC != nil {
+		return errors.New("symbol rules registry already started")
+	}
+	r.stopC = make(chan struct{})
+	r.doneC = make(chan struct{})
+	go r.run(ctx, r.stopC, r.doneC)
+	return nil
+}
+
+// Stop stops refreshing in the background
+func (r *SymbolRulesRegistry) Stop() {
+	r.mu.Lock()
+	stopC, doneC := r.stopC, r.doneC
+	r.stopC, r.doneC = nil, nil
+	r.mu.Unlock()
+	if stopC != nil {
+		close(stopC)
+		<-doneC
+	}
+}
+
+func (r *SymbolRulesRegistry) run(ctx context.Context, stopC, doneC chan struct{}) {
+	defer close(doneC)
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopC:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := r.Refresh(ctx); err != nil && r.ErrHandler != nil {
+			r.ErrHandler(err)
+		}
+	}
+}
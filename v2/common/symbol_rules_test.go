@@ -0,0 +1,106 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSymbolRules() *SymbolRules {
+	return &SymbolRules{
+		Symbol:         "BNBBTC",
+		MinPrice:       decimal.RequireFromString("0.0001"),
+		MaxPrice:       decimal.RequireFromString("100"),
+		TickSize:       decimal.RequireFromString("0.0001"),
+		MinQty:         decimal.RequireFromString("0.01"),
+		MaxQty:         decimal.RequireFromString("1000"),
+		StepSize:       decimal.RequireFromString("0.01"),
+		MarketMaxQty:   decimal.RequireFromString("100"),
+		MarketStepSize: decimal.RequireFromString("0.1"),
+		MinNotional:    decimal.RequireFromString("0.001"),
+	}
+}
+
+func TestSymbolRulesValidate(t *testing.T) {
+	assert := assert.New(t)
+	rules := newTestSymbolRules()
+	assert.NoError(rules.Validate(OrderValues{Price: "0.0123", Quantity: "1.25"}))
+
+	err := rules.Validate(OrderValues{Price: "0.01234", Quantity: "0.001", StopPrice: "200"})
+	assert.ErrorIs(err, ErrFilterFailure)
+	var filterErr *FilterError
+	assert.True(errors.As(err, &filterErr))
+	assert.Equal("BNBBTC", filterErr.Symbol)
+	assert.Equal([]FilterViolation{
+		{Filter: FilterTypePrice, Field: "price", Value: "0.01234", Reason: "is not a multiple of 0.0001"},
+		{Filter: FilterTypePrice, Field: "stopPrice", Value: "200", Reason: "is above maximum 100"},
+		{Filter: FilterTypeLotSize, Field: "quantity", Value: "0.001", Reason: "is below minimum 0.01"},
+		{Filter: FilterTypeNotional, Field: "price*quantity", Value: "0.00001234", Reason: "is below minimum 0.001"},
+	}, filterErr.Violations)
+
+	// market orders are checked against MARKET_LOT_SIZE, notional only applies if set so
+	assert.Equal([]FilterViolation{
+		{Filter: FilterTypeMarketLotSize, Field: "quantity", Value: "1.25", Reason: "is not a multiple of 0.1"},
+	}, rules.Violations(OrderValues{Market: true, Quantity: "1.25"}))
+	assert.Empty(rules.Violations(OrderValues{Market: true, QuoteQuantity: "0.0001"}))
+	rules.ApplyMinToMarket = true
+	assert.Equal([]FilterViolation{
+		{Filter: FilterTypeNotional, Field: "quoteOrderQty", Value: "0.0001", Reason: "is below minimum 0.001"},
+	}, rules.Violations(OrderValues{Market: true, QuoteQuantity: "0.0001"}))
+
+	// reduce-only orders may be below the minimum notional
+	assert.Empty(rules.Violations(OrderValues{Price: "0.0123", Quantity: "0.01", ReduceOnly: true}))
+	assert.Len(rules.Violations(OrderValues{Price: "0.0123", Quantity: "0.01"}), 1)
+
+	assert.Equal([]FilterViolation{
+		{Filter: FilterTypePrice, Field: "price", Value: "abc", Reason: "is not a number"},
+	}, rules.Violations(OrderValues{Price: "abc"}))
+}
+
+func TestSymbolRulesRound(t *testing.T) {
+	assert := assert.New(t)
+	rules := newTestSymbolRules()
+	o := OrderValues{Price: "0.012345", StopPrice: "0.0001", Quantity: "1.259", IcebergQuantity: "0.5"}
+	rules.Round(&o)
+	assert.Equal(OrderValues{Price: "0.0123", StopPrice: "0.0001", Quantity: "1.25", IcebergQuantity: "0.5"}, o)
+	assert.Equal("1.2", rules.RoundQuantity("1.259", true))
+	// values below the minimum are left for validation
+	assert.Equal("0.001", rules.RoundQuantity("0.001", false))
+	assert.Equal("", rules.RoundPrice(""))
+}
+
+func TestSymbolRulesRegistry(t *testing.T) {
+	assert := assert.New(t)
+	loads := 0
+	registry := NewSymbolRulesRegistry(func(ctx context.Context) ([]*SymbolRules, error) {
+		loads++
+		return []*SymbolRules{newTestSymbolRules()}, nil
+	})
+	_, ok := registry.Get("BNBBTC")
+	assert.False(ok)
+	assert.True(registry.UpdateTime().IsZero())
+
+	rules, err := registry.Rules(context.Background(), "BNBBTC")
+	assert.NoError(err)
+	assert.Equal("BNBBTC", rules.Symbol)
+	rules, err = registry.Rules(context.Background(), "ETHBTC")
+	assert.NoError(err)
+	assert.Nil(rules)
+	assert.Equal(1, loads)
+	assert.False(registry.UpdateTime().IsZero())
+
+	assert.NoError(registry.Refresh(context.Background()))
+	assert.Equal(2, loads)
+}
+
+func TestSymbolRulesRegistryError(t *testing.T) {
+	registry := NewSymbolRulesRegistry(func(ctx context.Context) ([]*SymbolRules, error) {
+		return nil, errors.New("unavailable")
+	})
+	_, err := registry.Rules(context.Background(), "BNBBTC")
+	assert.Error(t, err)
+	assert.Error(t, registry.Start(context.Background()))
+}
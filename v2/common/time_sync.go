@@ -0,0 +1,177 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ServerTimeFunc returns the server time in milliseconds
+type ServerTimeFunc func(ctx context.Context) (int64, error)
+
+// TimeSync keeps the offset between the local clock and the server clock, like TimeOffset of the clients it is
+// the local time minus the server time in milliseconds. The server time is sampled several times and the sample
+// with the shortest round trip is used, the offset is smoothed unless it jumps (e.g. after sleep or an NTP jump).
+type TimeSync struct {
+	serverTime ServerTimeFunc
+	offset     int64
+	synced     int32
+
+	// Interval between syncs in the background, 1 minute by default
+	Interval time.Duration
+	// Samples taken on every sync, 3 by default
+	Samples int
+	// Smoothing is the weight of a new offset, 0.3 by default
+	Smoothing float64
+	// MaxDrift is the difference from the current offset which is applied without smoothing, 1s by default
+	MaxDrift time.Duration
+	// ErrHandler receives errors of background syncs
+	ErrHandler func(err error)
+
+	mu      sync.Mutex
+	resyncC chan struct{}
+	stopC   chan struct{}
+	doneC   chan struct{}
+}
+
+// NewTimeSync init time sync sampling serverTime
+func NewTimeSync(serverTime ServerTimeFunc) *TimeSync {
+	return &TimeSync{
+		serverTime: serverTime,
+		Interval:   time.Minute,
+		Samples:    3,
+		Smoothing:  0.3,
+		MaxDrift:   time.Second,
+		resyncC:    make(chan struct{}, 1),
+	}
+}
+
+// Offset returns the current offset in milliseconds
+func (t *TimeSync) Offset() int64 {
+	return atomic.LoadInt64(&t.offset)
+}
+
+// OffsetOr returns the current offset, or offset if t is nil or hasn't been synced yet
+func (t *TimeSync) OffsetOr(offset int64) int64 {
+	if t == nil || atomic.LoadInt32(&t.synced) == 0 {
+		return offset
+	}
+	return t.Offset()
+}
+
+// Sync samples the server time and updates the offset
+func (t *TimeSync) Sync(ctx context.Context) error {
+	samples := t.Samples
+	if samples < 1 {
+		samples = 1
+	}
+	var offset int64
+	bestRTT := time.Duration(math.MaxInt64)
+	var err error
+	for i := 0; i < samples; i++ {
+		start := time.Now()
+		serverTime, e := t.serverTime(ctx)
+		rtt := time.Since(start)
+		if e != nil {
+			err = e
+			continue
+		}
+		if rtt < bestRTT {
+			// the server time is assumed to be taken halfway through the round trip
+			bestRTT = rtt
+			offset = start.Add(rtt/2).UnixMilli() - serverTime
+		}
+	}
+	if bestRTT == time.Duration(math.MaxInt64) {
+		return err
+	}
+	t.update(offset)
+	return nil
+}
+
+func (t *TimeSync) update(offset int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	current := t.Offset()
+	drift := offset - current
+	if atomic.LoadInt32(&t.synced) == 1 && time.Duration(abs(drift))*time.Millisecond < t.MaxDrift {
+		offset = current + int64(math.Round(float64(drift)*t.Smoothing))
+	}
+	atomic.StoreInt64(&t.offset, offset)
+	atomic.StoreInt32(&t.synced, 1)
+}
+
+// Start syncs the offset and keeps it in sync in the background until Stop is called or ctx is done
+func (t *TimeSync) Start(ctx context.Context) error {
+	if err := t.Sync(ctx); err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stopC != nil {
+		return errors.New("time sync already started")
+	}
+	t.stopC = make(chan struct{})
+	t.doneC = make(chan struct{})
+	go t.run(ctx, t.stopC, t.doneC)
+	return nil
+}
+
+// Stop stops syncing in the background
+func (t *TimeSync) Stop() {
+	t.mu.Lock()
+	stopC, doneC := t.stopC, t.doneC
+	t.stopC, t.doneC = nil, nil
+	t.mu.Unlock()
+	if stopC != nil {
+		close(stopC)
+		<-doneC
+	}
+}
+
+// Resync requests a sync in the background without waiting for the interval
+func (t *TimeSync) Resync() {
+	if t == nil {
+		return
+	}
+	select {
+	case t.resyncC <- struct{}{}:
+	default:
+	}
+}
+
+// CheckError requests a resync if err reports a timestamp outside of recvWindow (-1021)
+func (t *TimeSync) CheckError(err error) {
+	if t != nil && errors.Is(err, ErrTimestampOutsideRecvWindow) {
+		t.Resync()
+	}
+}
+
+func (t *TimeSync) run(ctx context.Context, stopC, doneC chan struct{}) {
+	defer close(doneC)
+	ticker := time.NewTicker(t.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopC:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-t.resyncC:
+		}
+		if err := t.Sync(ctx); err != nil && t.ErrHandler != nil {
+			t.ErrHandler(err)
+		}
+	}
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,69 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeSync(t *testing.T) {
+	assert := assert.New(t)
+	skew := int64(5000)
+	ts := NewTimeSync(func(ctx context.Context) (int64, error) {
+		return time.Now().UnixMilli() - skew, nil
+	})
+	var nilSync *TimeSync
+	assert.Equal(int64(7), nilSync.OffsetOr(7))
+	assert.Equal(int64(7), ts.OffsetOr(7))
+
+	assert.NoError(ts.Sync(context.Background()))
+	assert.InDelta(5000, ts.OffsetOr(7), 20)
+
+	// small drifts are smoothed
+	skew = 5100
+	assert.NoError(ts.Sync(context.Background()))
+	assert.InDelta(5030, ts.Offset(), 20)
+
+	// jumps are applied at once
+	skew = -2000
+	assert.NoError(ts.Sync(context.Background()))
+	assert.InDelta(-2000, ts.Offset(), 20)
+}
+
+func TestTimeSyncError(t *testing.T) {
+	ts := NewTimeSync(func(ctx context.Context) (int64, error) {
+		return 0, errors.New("unavailable")
+	})
+	assert.Error(t, ts.Sync(context.Background()))
+	assert.Equal(t, int64(3), ts.OffsetOr(3))
+}
+
+func TestTimeSyncResync(t *testing.T) {
+	assert := assert.New(t)
+	syncC := make(chan struct{}, 10)
+	ts := NewTimeSync(func(ctx context.Context) (int64, error) {
+		syncC <- struct{}{}
+		return time.Now().UnixMilli(), nil
+	})
+	ts.Samples = 1
+	ts.Interval = time.Hour
+	assert.NoError(ts.Start(context.Background()))
+	defer ts.Stop()
+	<-syncC
+
+	ts.CheckError(&APIError{Code: -1022})
+	ts.CheckError(&APIError{Code: -1021, Message: "Timestamp for this request is outside of the recvWindow."})
+	select {
+	case <-syncC:
+	case <-time.After(5 * time.Second):
+		assert.Fail("time was not resynced")
+	}
+	select {
+	case <-syncC:
+		assert.Fail("time was resynced more than once")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
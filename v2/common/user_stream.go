@@ -0,0 +1,250 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// userStreamCloseTimeout limits the request closing the listen key after the stream has stopped
+	userStreamCloseTimeout = 10 * time.Second
+	// userStreamBackfillMargin is backfilled before the last message received ahead of an outage
+	userStreamBackfillMargin = 5 * time.Second
+)
+
+// UserStreamSource defines how a UserStream manages its listen key and serves its stream
+type UserStreamSource[E any] struct {
+	// Start creates a listen key, or returns the one still valid
+	Start func(ctx context.Context) (listenKey string, err error)
+	// Keepalive extends the validity of the listen key
+	Keepalive func(ctx context.Context, listenKey string) error
+	// Close invalidates the listen key
+	Close func(ctx context.Context, listenKey string) error
+	// Serve connects to the stream of the listen key
+	Serve func(listenKey string, handler func(event E), errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+	// Expired reports whether the event tells that the listen key has expired
+	Expired func(event E) bool
+}
+
+// UserStream owns the listen key of a user data stream. It keeps the key alive, creates a new key and reconnects
+// when the key has expired or the connection failed, and backfills the events missed meanwhile from the REST API.
+// The gaps are reported to ErrHandler as UserStreamGapError. Set the options before calling Run.
+type UserStream[E any] struct {
+	source UserStreamSource[E]
+
+	// KeepaliveInterval between the keepalive requests, 30 minutes by default
+	KeepaliveInterval time.Duration
+	// Backfill requests the events between startTime and endTime in milliseconds after a reconnect. The events of
+	// the stream received meanwhile are held back until the backfilled events are delivered, some events may be
+	// delivered twice. No events are backfilled if it is nil, a UserStreamGapError is reported either way.
+	Backfill func(ctx context.Context, startTime, endTime int64) ([]E, error)
+	// ErrHandler receives the errors of the stream and of the requests, they don't stop the stream. A
+	// *UserStreamGapError is passed after every reconnect.
+	ErrHandler func(err error)
+}
+
+// UserStreamGapError tells that a UserStream has been reconnected. Backfill only rebuilds order events, so the other
+// events between StartTime and EndTime in milliseconds, e.g. balance and position updates, are lost. The state of
+// the account should be requested again from the REST API.
+type UserStreamGapError struct {
+	StartTime int64
+	EndTime   int64
+}
+
+func (e *UserStreamGapError) Error() string {
+	return fmt.Sprintf("user stream: reconnected, events between %d and %d other than backfilled orders are lost",
+		e.StartTime, e.EndTime)
+}
+
+// NewUserStream creates a user data stream served by source
+func NewUserStream[E any](source UserStreamSource[E]) *UserStream[E] {
+	return &UserStream[E]{source: source}
+}
+
+// userStreamEvent is an event of the connection gen
+type userStreamEvent[E any] struct {
+	gen   int
+	event E
+}
+
+// Run delivers the events to handler until ctx is done, then closes the listen key. It returns an error if the first
+// listen key can't be created or its stream can't be connected.
+func (s *UserStream[E]) Run(ctx context.Context, handler func(event E)) error {
+	r := &userStreamRun[E]{stream: s, events: newUserStreamQueue[E]()}
+	if err := r.connect(ctx); err != nil {
+		return err
+	}
+	defer r.close()
+	interval := s.KeepaliveInterval
+	if interval <= 0 {
+		interval = 30 * time.Minute
+	}
+	keepalive := time.NewTicker(interval)
+	defer keepalive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-r.events.signal:
+			expired := false
+			for _, e := range r.events.pop() {
+				handler(e.event)
+				if e.gen == r.gen && s.source.Expired != nil && s.source.Expired(e.event) {
+					expired = true
+				}
+			}
+			if !expired {
+				continue
+			}
+		case <-keepalive.C:
+			err := s.source.Keepalive(ctx, r.listenKey)
+			if err == nil {
+				continue
+			}
+			r.handleErr(err)
+		case <-r.doneC:
+		}
+		// the listen key has expired, couldn't be kept alive or the connection failed
+		if err := r.reconnect(ctx, handler); err != nil {
+			return err
+		}
+		keepalive.Reset(interval)
+	}
+}
+
+// userStreamRun is the state of a running user data stream
+type userStreamRun[E any] struct {
+	stream    *UserStream[E]
+	events    *userStreamQueue[E]
+	listenKey string
+	gen       int
+	// seen is the time in milliseconds the current connection was made or last received a message
+	seen  *int64
+	doneC chan struct{}
+	stopC chan struct{}
+}
+
+func (r *userStreamRun[E]) handleErr(err error) {
+	if r.stream.ErrHandler != nil {
+		r.stream.ErrHandler(err)
+	}
+}
+
+// connect creates a listen key and connects to its stream
+func (r *userStreamRun[E]) connect(ctx context.Context) error {
+	src := r.stream.source
+	listenKey, err := src.Start(ctx)
+	if err != nil {
+		return err
+	}
+	r.gen++
+	gen := r.gen
+	seen := nowMillis()
+	doneC, stopC, err := src.Serve(listenKey, func(event E) {
+		atomic.StoreInt64(&seen, nowMillis())
+		r.events.push(userStreamEvent[E]{gen, event})
+	}, r.handleErr)
+	if err != nil {
+		return err
+	}
+	r.listenKey, r.seen, r.doneC, r.stopC = listenKey, &seen, doneC, stopC
+	return nil
+}
+
+// reconnect replaces the connection, retrying with a growing delay, then backfills the events missed since the
+// last message of the previous connection
+func (r *userStreamRun[E]) reconnect(ctx context.Context, handler func(event E)) error {
+	since := atomic.LoadInt64(r.seen) - userStreamBackfillMargin.Milliseconds()
+	listenKey := r.listenKey
+	r.stop()
+	backoff := time.Second
+	for {
+		err := r.connect(ctx)
+		if err == nil {
+			break
+		}
+		r.handleErr(err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff < time.Minute {
+			backoff *= 2
+		}
+	}
+	if r.listenKey != listenKey {
+		// the replaced key may still be valid, it's closed so it doesn't linger, it's fine if it's gone already
+		closeCtx, cancel := context.WithTimeout(context.Background(), userStreamCloseTimeout)
+		r.stream.source.Close(closeCtx, listenKey)
+		cancel()
+	}
+	now := nowMillis()
+	r.handleErr(&UserStreamGapError{StartTime: since, EndTime: now})
+	if r.stream.Backfill == nil {
+		return nil
+	}
+	events, err := r.stream.Backfill(ctx, since, now)
+	if err != nil {
+		r.handleErr(err)
+	}
+	for _, e := range events {
+		handler(e)
+	}
+	return nil
+}
+
+// stop stops the current connection unless it has ended by itself
+func (r *userStreamRun[E]) stop() {
+	if r.stopC == nil {
+		return
+	}
+	select {
+	case <-r.doneC:
+	default:
+		close(r.stopC)
+	}
+	r.doneC, r.stopC = nil, nil
+}
+
+// close stops the connection and closes the listen key
+func (r *userStreamRun[E]) close() {
+	r.stop()
+	ctx, cancel := context.WithTimeout(context.Background(), userStreamCloseTimeout)
+	defer cancel()
+	if err := r.stream.source.Close(ctx, r.listenKey); err != nil {
+		r.handleErr(err)
+	}
+}
+
+// userStreamQueue buffers the events of the connections until the stream gets to them
+type userStreamQueue[E any] struct {
+	mu     sync.Mutex
+	events []userStreamEvent[E]
+	signal chan struct{}
+}
+
+func newUserStreamQueue[E any]() *userStreamQueue[E] {
+	return &userStreamQueue[E]{signal: make(chan struct{}, 1)}
+}
+
+func (q *userStreamQueue[E]) push(event userStreamEvent[E]) {
+	q.mu.Lock()
+	q.events = append(q.events, event)
+	q.mu.Unlock()
+	select {
+	case q.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (q *userStreamQueue[E]) pop() []userStreamEvent[E] {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	events := q.events
+	q.events = nil
+	return events
+}
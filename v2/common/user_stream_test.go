@@ -0,0 +1,179 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testUserConn struct {
+	listenKey string
+	handler   func(event string)
+	endC      chan struct{}
+}
+
+// testUserSource hands its connections to the test, the events "expired" end the listen key
+type testUserSource struct {
+	mu         sync.Mutex
+	keys       int
+	sameKey    bool // Start returns the valid key again instead of a new one
+	keepalives []string
+	closed     []string
+	conns      chan *testUserConn
+}
+
+func (s *testUserSource) source() UserStreamSource[string] {
+	return UserStreamSource[string]{
+		Start: func(ctx context.Context) (string, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if !s.sameKey || s.keys == 0 {
+				s.keys++
+			}
+			return fmt.Sprintf("key%d", s.keys), nil
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.keepalives = append(s.keepalives, listenKey)
+			return nil
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.closed = append(s.closed, listenKey)
+			return nil
+		},
+		Serve: func(listenKey string, handler func(event string), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			doneC = make(chan struct{})
+			stopC = make(chan struct{})
+			endC := make(chan struct{})
+			go func() {
+				defer close(doneC)
+				select {
+				case <-stopC:
+				case <-endC:
+				}
+			}()
+			s.conns <- &testUserConn{listenKey, handler, endC}
+			return doneC, stopC, nil
+		},
+		Expired: func(event string) bool {
+			return event == "expired"
+		},
+	}
+}
+
+func TestUserStream(t *testing.T) {
+	r := require.New(t)
+	s := &testUserSource{conns: make(chan *testUserConn, 1)}
+	stream := NewUserStream(s.source())
+	stream.KeepaliveInterval = 50 * time.Millisecond
+	var gaps []*UserStreamGapError
+	stream.ErrHandler = func(err error) {
+		var gap *UserStreamGapError
+		if errors.As(err, &gap) {
+			gaps = append(gaps, gap)
+		}
+	}
+	var backfills [][2]int64
+	stream.Backfill = func(ctx context.Context, startTime, endTime int64) ([]string, error) {
+		backfills = append(backfills, [2]int64{startTime, endTime})
+		return []string{fmt.Sprintf("backfill%d", len(backfills))}, nil
+	}
+
+	eventC := make(chan string, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	errC := make(chan error, 1)
+	go func() {
+		errC <- stream.Run(ctx, func(event string) {
+			eventC <- event
+		})
+	}()
+	next := func() string {
+		select {
+		case e := <-eventC:
+			return e
+		case <-time.After(time.Second):
+			t.Fatal("no event delivered")
+			return ""
+		}
+	}
+	nextConn := func() *testUserConn {
+		select {
+		case c := <-s.conns:
+			return c
+		case <-time.After(3 * time.Second):
+			t.Fatal("not connected")
+			return nil
+		}
+	}
+
+	conn := nextConn()
+	r.Equal("key1", conn.listenKey)
+	conn.handler("a")
+	r.Equal("a", next())
+
+	// the listen key expires, a new one is connected and the gap backfilled
+	conn.handler("expired")
+	r.Equal("expired", next())
+	conn = nextConn()
+	r.Equal("key2", conn.listenKey)
+	r.Equal("backfill1", next())
+	before := time.Now().UnixMilli()
+	conn.handler("b")
+	after := time.Now().UnixMilli()
+	r.Equal("b", next())
+
+	// the connection fails some time after its last message, the gap is backfilled from that message on
+	time.Sleep(200 * time.Millisecond)
+	close(conn.endC)
+	conn = nextConn()
+	r.Equal("key3", conn.listenKey)
+	r.Equal("backfill2", next())
+	r.Len(backfills, 2)
+	r.GreaterOrEqual(backfills[1][0], before-userStreamBackfillMargin.Milliseconds())
+	r.LessOrEqual(backfills[1][0], after-userStreamBackfillMargin.Milliseconds())
+	r.Less(backfills[1][0], backfills[1][1])
+	// every reconnect is reported with the backfilled gap
+	r.Equal([]*UserStreamGapError{
+		{StartTime: backfills[0][0], EndTime: backfills[0][1]},
+		{StartTime: backfills[1][0], EndTime: backfills[1][1]},
+	}, gaps)
+
+	time.Sleep(120 * time.Millisecond)
+	cancel()
+	r.ErrorIs(<-errC, context.Canceled)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r.NotEmpty(s.keepalives)
+	r.Equal("key3", s.keepalives[len(s.keepalives)-1])
+	r.Equal([]string{"key1", "key2", "key3"}, s.closed)
+}
+
+func TestUserStreamSameKey(t *testing.T) {
+	r := require.New(t)
+	s := &testUserSource{conns: make(chan *testUserConn, 1), sameKey: true}
+	stream := NewUserStream(s.source())
+	ctx, cancel := context.WithCancel(context.Background())
+	errC := make(chan error, 1)
+	go func() {
+		errC <- stream.Run(ctx, func(event string) {})
+	}()
+
+	// the connection fails, the key returned again isn't closed
+	conn := <-s.conns
+	close(conn.endC)
+	conn = <-s.conns
+	r.Equal("key1", conn.listenKey)
+	cancel()
+	r.ErrorIs(<-errC, context.Canceled)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r.Equal([]string{"key1"}, s.closed)
+}
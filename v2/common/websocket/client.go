@@ -6,11 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/jpillora/backoff"
 )
@@ -44,6 +47,13 @@ type messageId struct {
 	Id string `json:"id"`
 }
 
+// sessionResponse define the fields of session responses checked by the client
+type sessionResponse struct {
+	Id     string           `json:"id"`
+	Status int              `json:"status"`
+	Error  *common.APIError `json:"error"`
+}
+
 // client define API websocket client
 type client struct {
 	Debug                       bool
@@ -56,6 +66,8 @@ type client struct {
 	readC                       chan []byte
 	readErrChan                 chan error
 	reconnectCount              int64
+	sessionMu                   sync.Mutex
+	session                     *RequestData
 }
 
 func (c *client) debug(format string, v ...any) {
@@ -95,6 +107,10 @@ type Client interface {
 	GetReconnectCount() int64
 	Wait(timeout time.Duration)
 	Close() error
+	Logon(reqData RequestData) ([]byte, error)
+	SessionStatus(requestID string) ([]byte, error)
+	Logout(requestID string) ([]byte, error)
+	IsLoggedOn() bool
 }
 
 // Write sends data into websocket connection
@@ -155,6 +171,124 @@ func (c *client) WriteSync(id string, data []byte, timeout time.Duration) ([]byt
 	}
 }
 
+// Logon authenticates the connection with session.logon and returns the response, requests created with
+// RequestData.WithSession are accepted unsigned afterwards. Once the logon succeeded, it is repeated whenever the
+// connection is restored, until Logout is called. If the repeated logon is refused with an error which isn't
+// retryable, the session is dropped and the error is sent to the read error channel.
+func (c *client) Logon(reqData RequestData) ([]byte, error) {
+	if reqData.KeyType() != common.KeyTypeEd25519 {
+		return nil, ErrorSessionKeyType
+	}
+
+	rawData, err := CreateRequest(reqData.WithSession(false), SessionLogonWsApiMethod, map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.WriteSync(reqData.requestID, rawData, WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if sessionStatus(response) == http.StatusOK {
+		c.sessionMu.Lock()
+		c.session = &reqData
+		c.sessionMu.Unlock()
+	}
+
+	return response, nil
+}
+
+// SessionStatus sends session.status and returns the response
+func (c *client) SessionStatus(requestID string) ([]byte, error) {
+	rawData, err := marshalRequest(requestID, SessionStatusWsApiMethod, map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.WriteSync(requestID, rawData, WriteSyncWsTimeout)
+}
+
+// Logout sends session.logout and returns the response, the connection isn't authenticated again after reconnects
+func (c *client) Logout(requestID string) ([]byte, error) {
+	rawData, err := marshalRequest(requestID, SessionLogoutWsApiMethod, map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.WriteSync(requestID, rawData, WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if sessionStatus(response) == http.StatusOK {
+		c.sessionMu.Lock()
+		c.session = nil
+		c.sessionMu.Unlock()
+	}
+
+	return response, nil
+}
+
+// IsLoggedOn reports whether the connection is authenticated with session.logon
+func (c *client) IsLoggedOn() bool {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+	return c.session != nil
+}
+
+// sessionStatus returns the status of a session response
+func sessionStatus(rawData []byte) int {
+	msg := sessionResponse{}
+	if err := json.Unmarshal(rawData, &msg); err != nil {
+		return 0
+	}
+	return msg.Status
+}
+
+// restoreSession repeats session.logon on a restored connection, the read loop is blocked until the connection is
+// established so the response is read directly from conn
+func (c *client) restoreSession(conn Connection) error {
+	c.sessionMu.Lock()
+	session := c.session
+	c.sessionMu.Unlock()
+	if session == nil {
+		return nil
+	}
+
+	reqData := *session
+	reqData.requestID = uuid.New().String()
+	rawData, err := CreateRequest(reqData, SessionLogonWsApiMethod, map[string]any{})
+	if err != nil {
+		return err
+	}
+
+	if err := conn.WriteMessage(websocket.TextMessage, rawData); err != nil {
+		return err
+	}
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		msg := sessionResponse{}
+		if err := json.Unmarshal(message, &msg); err != nil || msg.Id != reqData.requestID {
+			continue
+		}
+		if msg.Status != http.StatusOK {
+			if msg.Error != nil {
+				msg.Error.StatusCode = msg.Status
+				return msg.Error
+			}
+			return fmt.Errorf("session.logon: unexpected status %d", msg.Status)
+		}
+
+		return nil
+	}
+}
+
 func (c *client) GetReadChannel() <-chan []byte {
 	return c.readC
 }
@@ -269,6 +403,24 @@ func (c *client) startReconnect(b *backoff.Backoff) Connection {
 			continue
 		}
 
+		if err := c.restoreSession(conn); err != nil {
+			var apiErr *common.APIError
+			if errors.As(err, &apiErr) && !apiErr.Retryable() {
+				// the logon is refused (e.g. the key was revoked), the connection is used without the session
+				c.sessionMu.Lock()
+				c.session = nil
+				c.sessionMu.Unlock()
+				c.debug("reconnect: session can't be restored '%v'", err)
+				c.readErrChan <- fmt.Errorf("ws error: session can't be restored: %w", err)
+				return conn
+			}
+			conn.Close()
+			delay := b.Duration()
+			c.debug("reconnect: error while restoring session '%v'. try in %s", err, delay.Round(time.Millisecond))
+			time.Sleep(delay)
+			continue
+		}
+
 		return conn
 	}
 }
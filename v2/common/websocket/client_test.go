@@ -2,14 +2,22 @@ package websocket
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"log"
 	"net"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/suite"
@@ -264,3 +272,198 @@ func startWsTestServer(stopCh chan struct{}, readyCh chan struct{}) {
 	}
 	log.Println("Graceful shutdown complete.")
 }
+
+func (s *clientTestSuite) TestSessionLogon() {
+	secretKey := newEd25519SecretKey(s.T())
+
+	var mu sync.Mutex
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			req := testApiRequest{}
+			s.Require().NoError(json.Unmarshal(message, &req))
+			mu.Lock()
+			methods = append(methods, req.Method)
+			mu.Unlock()
+			if req.Method == "drop" {
+				return
+			}
+			if req.Method == string(SessionLogonWsApiMethod) {
+				s.Equal(s.apiKey, req.Params["apiKey"])
+				s.NotEmpty(req.Params["signature"])
+			}
+			conn.WriteJSON(map[string]any{"id": req.Id, "status": http.StatusOK, "result": req.Params})
+		}
+	}))
+	defer server.Close()
+
+	conn, err := NewConnection(func() (*websocket.Conn, error) {
+		c, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+		return c, err
+	}, false, 10*time.Second)
+	s.Require().NoError(err)
+
+	client, err := NewClient(conn)
+	s.Require().NoError(err)
+	defer client.Close()
+
+	_, err = client.Logon(NewRequestData("logon", s.apiKey, s.secretKey, 0, common.KeyTypeHmac))
+	s.ErrorIs(err, ErrorSessionKeyType)
+	s.False(client.IsLoggedOn())
+
+	_, err = client.Logon(NewRequestData("logon", s.apiKey, secretKey, 0, common.KeyTypeEd25519))
+	s.Require().NoError(err)
+	s.True(client.IsLoggedOn())
+
+	rawData, err := CreateRequest(NewRequestData("unsigned", "", "", 0, "").WithSession(client.IsLoggedOn()), "order.status", map[string]any{"symbol": "BTCUSDT"})
+	s.Require().NoError(err)
+	response, err := client.WriteSync("unsigned", rawData, 5*time.Second)
+	s.Require().NoError(err)
+	s.NotContains(string(response), "signature")
+	s.NotContains(string(response), "apiKey")
+	s.Contains(string(response), "timestamp")
+
+	// the logon is repeated before the restored connection is used
+	s.Require().NoError(client.Write("drop", []byte(`{"id":"drop","method":"drop"}`)))
+	s.Require().Eventually(func() bool {
+		return client.GetReconnectCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	select {
+	case <-client.GetReadErrorChannel():
+	case <-time.After(5 * time.Second):
+		s.FailNow("connection wasn't dropped")
+	}
+	_, err = client.SessionStatus("status")
+	s.Require().NoError(err)
+	mu.Lock()
+	s.Equal([]string{"session.logon", "order.status", "drop", "session.logon", "session.status"}, methods)
+	mu.Unlock()
+
+	_, err = client.Logout("logout")
+	s.Require().NoError(err)
+	s.False(client.IsLoggedOn())
+}
+
+func (s *clientTestSuite) TestSessionLogonRefusedAfterReconnect() {
+	secretKey := newEd25519SecretKey(s.T())
+
+	var mu sync.Mutex
+	logons := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			req := testApiRequest{}
+			s.Require().NoError(json.Unmarshal(message, &req))
+			if req.Method == "drop" {
+				return
+			}
+			if req.Method == string(SessionLogonWsApiMethod) {
+				mu.Lock()
+				logons++
+				refused := logons > 1
+				mu.Unlock()
+				if refused {
+					// the key has been revoked meanwhile
+					conn.WriteJSON(map[string]any{"id": req.Id, "status": http.StatusUnauthorized,
+						"error": map[string]any{"code": -2015, "msg": "Invalid API-key, IP, or permissions for action."}})
+					continue
+				}
+			}
+			conn.WriteJSON(map[string]any{"id": req.Id, "status": http.StatusOK, "result": req.Params})
+		}
+	}))
+	defer server.Close()
+
+	conn, err := NewConnection(func() (*websocket.Conn, error) {
+		c, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+		return c, err
+	}, false, 10*time.Second)
+	s.Require().NoError(err)
+
+	client, err := NewClient(conn)
+	s.Require().NoError(err)
+	defer client.Close()
+
+	_, err = client.Logon(NewRequestData("logon", s.apiKey, secretKey, 0, common.KeyTypeEd25519))
+	s.Require().NoError(err)
+
+	// the refused logon isn't repeated, it is reported and the session is dropped
+	s.Require().NoError(client.Write("drop", []byte(`{"id":"drop","method":"drop"}`)))
+	var errs []error
+	for len(errs) < 2 {
+		select {
+		case err := <-client.GetReadErrorChannel():
+			errs = append(errs, err)
+		case <-time.After(5 * time.Second):
+			s.FailNow("errors weren't reported")
+		}
+	}
+	var apiErr *common.APIError
+	s.Require().True(errors.As(errs[1], &apiErr))
+	s.Equal(int64(-2015), apiErr.Code)
+	s.False(client.IsLoggedOn())
+	s.Equal(int64(1), client.GetReconnectCount())
+
+	_, err = client.SessionStatus("status")
+	s.Require().NoError(err)
+	mu.Lock()
+	s.Equal(2, logons)
+	mu.Unlock()
+}
+
+type stubSigner struct{}
+
+func (stubSigner) KeyType() string {
+	return common.KeyTypeEd25519
+}
+
+func (stubSigner) Sign(data string) (string, error) {
+	return "signed:" + data, nil
+}
+
+func (s *clientTestSuite) TestCreateRequestWithSigner() {
+	rawData, err := CreateRequest(
+		NewRequestData("signed", s.apiKey, "", 0, "").WithSigner(stubSigner{}),
+		"order.status",
+		map[string]any{"symbol": "BTCUSDT"},
+	)
+	s.Require().NoError(err)
+	req := testApiRequest{}
+	s.Require().NoError(json.Unmarshal(rawData, &req))
+	s.Equal(s.apiKey, req.Params["apiKey"])
+	s.Regexp(`^signed:apiKey=dummyApiKey&symbol=BTCUSDT&timestamp=\d+$`, req.Params["signature"])
+
+	_, err = CreateRequest(NewRequestData("unsigned", s.apiKey, "", 0, common.KeyTypeHmac), "order.status", map[string]any{})
+	s.ErrorIs(err, ErrorSecretKeyIsNotSet)
+
+	s.Equal(common.KeyTypeEd25519, NewRequestData("key", s.apiKey, "", 0, common.KeyTypeHmac).WithSigner(stubSigner{}).KeyType())
+}
+
+func newEd25519SecretKey(t *testing.T) string {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
@@ -125,6 +125,65 @@ func (mr *MockClientMockRecorder) WriteSync(id, data, timeout any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteSync", reflect.TypeOf((*MockClient)(nil).WriteSync), id, data, timeout)
 }
 
+// Logon mocks base method.
+func (m *MockClient) Logon(reqData websocket.RequestData) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logon", reqData)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logon indicates an expected call of Logon.
+func (mr *MockClientMockRecorder) Logon(reqData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logon", reflect.TypeOf((*MockClient)(nil).Logon), reqData)
+}
+
+// SessionStatus mocks base method.
+func (m *MockClient) SessionStatus(requestID string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SessionStatus", requestID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SessionStatus indicates an expected call of SessionStatus.
+func (mr *MockClientMockRecorder) SessionStatus(requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionStatus", reflect.TypeOf((*MockClient)(nil).SessionStatus), requestID)
+}
+
+// Logout mocks base method.
+func (m *MockClient) Logout(requestID string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", requestID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockClientMockRecorder) Logout(requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockClient)(nil).Logout), requestID)
+}
+
+// IsLoggedOn mocks base method.
+func (m *MockClient) IsLoggedOn() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLoggedOn")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsLoggedOn indicates an expected call of IsLoggedOn.
+func (mr *MockClientMockRecorder) IsLoggedOn() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLoggedOn", reflect.TypeOf((*MockClient)(nil).IsLoggedOn))
+}
+
 // MockConnection is a mock of Connection interface.
 type MockConnection struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,563 @@
+package websocket
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/jpillora/backoff"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+const (
+	// subscribeStreamMethod define method for subscribing to streams on a combined stream connection
+	subscribeStreamMethod = "SUBSCRIBE"
+
+	// unsubscribeStreamMethod define method for unsubscribing from streams on a combined stream connection
+	unsubscribeStreamMethod = "UNSUBSCRIBE"
+
+	// listSubscriptionsStreamMethod define method for listing the streams of a combined stream connection
+	listSubscriptionsStreamMethod = "LIST_SUBSCRIPTIONS"
+)
+
+var (
+	// ErrorStreamManagerClosed defines that the stream manager has been closed
+	ErrorStreamManagerClosed = errors.New("ws error: stream manager is closed")
+
+	// ErrorStreamConnClosed defines that the connection was closed while waiting for a response
+	ErrorStreamConnClosed = errors.New("ws error: stream connection closed")
+)
+
+// StreamHandler handles the payload of a message received for a single stream
+type StreamHandler func(data []byte)
+
+// StreamManagerConfig define configuration of StreamManager
+type StreamManagerConfig struct {
+	// Endpoint returns the combined stream endpoint a stream has to be subscribed on
+	Endpoint func(stream string) string
+	// Dial opens a new connection to the endpoint
+	Dial func(endpoint string) (*websocket.Conn, error)
+	// MaxStreamsPerConn is the number of streams a single connection can listen to
+	MaxStreamsPerConn int
+	// MaxMessagesPerSecond is the number of messages which can be sent over a single connection per second
+	MaxMessagesPerSecond int
+	// IdleTimeout closes and redials a connection when nothing was received for that long, 0 disables it
+	IdleTimeout time.Duration
+	// ErrHandler receives connection errors and messages which can't be routed
+	ErrHandler func(err error)
+}
+
+// streamRequest define request sent over combined stream connection
+type streamRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params,omitempty"`
+	Id     int64    `json:"id"`
+}
+
+// streamMessage define any message received over combined stream connection
+type streamMessage struct {
+	Stream string           `json:"stream"`
+	Data   json.RawMessage  `json:"data"`
+	Id     *int64           `json:"id"`
+	Result json.RawMessage  `json:"result"`
+	Error  *common.APIError `json:"error"`
+}
+
+// streamResponse define response to streamRequest
+type streamResponse struct {
+	result json.RawMessage
+	err    error
+}
+
+// StreamManager keeps streams subscribed over as few combined stream connections as the limits allow,
+// subscribing and unsubscribing at runtime and routing every payload to the handler of its stream
+type StreamManager struct {
+	cfg      StreamManagerConfig
+	opMu     sync.Mutex
+	mu       sync.RWMutex
+	conns    []*streamConn
+	handlers map[string]StreamHandler
+	streams  map[string]*streamConn
+	nextId   int64
+	closed   bool
+}
+
+// NewStreamManager init stream manager, connections are opened on the first subscription
+func NewStreamManager(cfg StreamManagerConfig) *StreamManager {
+	return &StreamManager{
+		cfg:      cfg,
+		handlers: make(map[string]StreamHandler),
+		streams:  make(map[string]*streamConn),
+	}
+}
+
+// Subscribe subscribes to the streams and routes their payloads to the given handlers.
+// Handlers of already subscribed streams are replaced.
+func (m *StreamManager) Subscribe(handlers map[string]StreamHandler) error {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+
+	names := make([]string, 0, len(handlers))
+	for stream := range handlers {
+		names = append(names, stream)
+	}
+	sort.Strings(names)
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return ErrorStreamManagerClosed
+	}
+	var order []*streamConn
+	batches := make(map[*streamConn][]string)
+	for _, stream := range names {
+		if _, ok := m.streams[stream]; ok {
+			m.handlers[stream] = handlers[stream]
+			continue
+		}
+		c := m.connFor(m.cfg.Endpoint(stream))
+		if c == nil {
+			c = newStreamConn(m, m.cfg.Endpoint(stream))
+			m.conns = append(m.conns, c)
+		}
+		if _, ok := batches[c]; !ok {
+			order = append(order, c)
+		}
+		batches[c] = append(batches[c], stream)
+		c.streams[stream] = struct{}{}
+		m.streams[stream] = c
+		m.handlers[stream] = handlers[stream]
+	}
+	m.mu.Unlock()
+
+	var err error
+	for _, c := range order {
+		if e := c.connect(); e != nil {
+			err = e
+			m.forget(c, batches[c])
+			continue
+		}
+		if _, e := c.request(subscribeStreamMethod, batches[c]); e != nil {
+			err = e
+			if e == ErrorWsReadConnectionTimeout {
+				// the server may still apply the subscription, undo it so the connection doesn't carry streams
+				// without handlers
+				_ = c.write(streamRequest{Method: unsubscribeStreamMethod, Params: batches[c], Id: atomic.AddInt64(&m.nextId, 1)})
+			}
+			m.forget(c, batches[c])
+		}
+	}
+	return err
+}
+
+// Unsubscribe unsubscribes from the streams, connections without streams left are closed
+func (m *StreamManager) Unsubscribe(streams ...string) error {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+
+	m.mu.RLock()
+	if m.closed {
+		m.mu.RUnlock()
+		return ErrorStreamManagerClosed
+	}
+	var order []*streamConn
+	batches := make(map[*streamConn][]string)
+	for _, stream := range streams {
+		c, ok := m.streams[stream]
+		if !ok {
+			continue
+		}
+		if _, ok := batches[c]; !ok {
+			order = append(order, c)
+		}
+		batches[c] = append(batches[c], stream)
+	}
+	m.mu.RUnlock()
+
+	var err error
+	for _, c := range order {
+		if _, e := c.request(unsubscribeStreamMethod, batches[c]); e != nil {
+			err = e
+			continue
+		}
+		m.forget(c, batches[c])
+	}
+	return err
+}
+
+// ListSubscriptions asks every connection for its streams and returns all of them sorted
+func (m *StreamManager) ListSubscriptions() ([]string, error) {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+
+	m.mu.RLock()
+	if m.closed {
+		m.mu.RUnlock()
+		return nil, ErrorStreamManagerClosed
+	}
+	conns := append([]*streamConn(nil), m.conns...)
+	m.mu.RUnlock()
+
+	streams := []string{}
+	for _, c := range conns {
+		result, err := c.request(listSubscriptionsStreamMethod, nil)
+		if err != nil {
+			return nil, err
+		}
+		var list []string
+		if err = json.Unmarshal(result, &list); err != nil {
+			return nil, err
+		}
+		streams = append(streams, list...)
+	}
+	sort.Strings(streams)
+	return streams, nil
+}
+
+// Streams returns the subscribed streams sorted, without asking the server
+func (m *StreamManager) Streams() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	streams := make([]string, 0, len(m.streams))
+	for stream := range m.streams {
+		streams = append(streams, stream)
+	}
+	sort.Strings(streams)
+	return streams
+}
+
+// ConnCount returns the number of open connections
+func (m *StreamManager) ConnCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.conns)
+}
+
+// Close closes all connections, the manager can't be used afterwards
+func (m *StreamManager) Close() error {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return nil
+	}
+	m.closed = true
+	conns := m.conns
+	m.conns = nil
+	m.streams = make(map[string]*streamConn)
+	m.handlers = make(map[string]StreamHandler)
+	m.mu.Unlock()
+
+	for _, c := range conns {
+		c.close()
+	}
+	return nil
+}
+
+// connFor returns a connection to endpoint which can take one more stream
+func (m *StreamManager) connFor(endpoint string) *streamConn {
+	for _, c := range m.conns {
+		if c.endpoint == endpoint && (m.cfg.MaxStreamsPerConn <= 0 || len(c.streams) < m.cfg.MaxStreamsPerConn) {
+			return c
+		}
+	}
+	return nil
+}
+
+// forget removes streams of the connection, the connection is closed if it has no streams left
+func (m *StreamManager) forget(c *streamConn, streams []string) {
+	m.mu.Lock()
+	for _, stream := range streams {
+		delete(c.streams, stream)
+		delete(m.streams, stream)
+		delete(m.handlers, stream)
+	}
+	empty := len(c.streams) == 0
+	if empty {
+		for i := range m.conns {
+			if m.conns[i] == c {
+				m.conns = append(m.conns[:i], m.conns[i+1:]...)
+				break
+			}
+		}
+	}
+	m.mu.Unlock()
+
+	if empty {
+		c.close()
+	}
+}
+
+// route passes payload of the stream to its handler
+func (m *StreamManager) route(stream string, data []byte) {
+	m.mu.RLock()
+	handler, ok := m.handlers[stream]
+	m.mu.RUnlock()
+	if !ok {
+		return
+	}
+	handler(data)
+}
+
+func (m *StreamManager) error(err error) {
+	if m.cfg.ErrHandler != nil {
+		m.cfg.ErrHandler(err)
+	}
+}
+
+// streamConn is a single combined stream connection of StreamManager
+type streamConn struct {
+	m         *StreamManager
+	endpoint  string
+	streams   map[string]struct{} // guarded by m.mu
+	writeMu   sync.Mutex
+	conn      *websocket.Conn
+	lastWrite time.Time
+	pendingMu sync.Mutex
+	pending   map[int64]chan streamResponse
+	closed    int32
+	doneC     chan struct{}
+}
+
+func newStreamConn(m *StreamManager, endpoint string) *streamConn {
+	return &streamConn{
+		m:        m,
+		endpoint: endpoint,
+		streams:  make(map[string]struct{}),
+		pending:  make(map[int64]chan streamResponse),
+		doneC:    make(chan struct{}),
+	}
+}
+
+// connect dials the connection unless it is already open
+func (c *streamConn) connect() error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if c.conn != nil {
+		return nil
+	}
+	conn, err := c.dial()
+	if err != nil {
+		return err
+	}
+	c.conn = conn
+	go c.read(conn)
+	return nil
+}
+
+func (c *streamConn) dial() (*websocket.Conn, error) {
+	conn, err := c.m.cfg.Dial(c.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if timeout := c.m.cfg.IdleTimeout; timeout > 0 {
+		conn.SetReadDeadline(time.Now().Add(timeout))
+		conn.SetPingHandler(func(appData string) error {
+			conn.SetReadDeadline(time.Now().Add(timeout))
+			err := conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(KeepAlivePingDeadline))
+			if err == websocket.ErrCloseSent {
+				return nil
+			}
+			return err
+		})
+	}
+	return conn, nil
+}
+
+// read routes messages of conn until the connection fails, then it redials and resubscribes
+func (c *streamConn) read(conn *websocket.Conn) {
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			if c.isClosed() {
+				return
+			}
+			c.m.error(err)
+			c.failPending(err)
+			conn = c.reconnect()
+			if conn == nil {
+				return
+			}
+			continue
+		}
+		if timeout := c.m.cfg.IdleTimeout; timeout > 0 {
+			conn.SetReadDeadline(time.Now().Add(timeout))
+		}
+
+		msg := streamMessage{}
+		if err = json.Unmarshal(message, &msg); err != nil {
+			c.m.error(err)
+			continue
+		}
+		if msg.Stream != "" {
+			c.m.route(msg.Stream, msg.Data)
+			continue
+		}
+		c.respond(msg)
+	}
+}
+
+// respond passes response to the request waiting for it
+func (c *streamConn) respond(msg streamMessage) {
+	resp := streamResponse{result: msg.Result}
+	if msg.Error != nil {
+		resp.err = msg.Error
+	}
+	if msg.Id == nil {
+		if resp.err != nil {
+			c.m.error(resp.err)
+		}
+		return
+	}
+
+	c.pendingMu.Lock()
+	ch, ok := c.pending[*msg.Id]
+	delete(c.pending, *msg.Id)
+	c.pendingMu.Unlock()
+	if !ok {
+		if resp.err != nil {
+			c.m.error(resp.err)
+		}
+		return
+	}
+	ch <- resp
+}
+
+// failPending fails all requests waiting for response
+func (c *streamConn) failPending(err error) {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	for id, ch := range c.pending {
+		ch <- streamResponse{err: err}
+		delete(c.pending, id)
+	}
+}
+
+// reconnect redials with increasing delay and subscribes to the streams again
+func (c *streamConn) reconnect() *websocket.Conn {
+	b := &backoff.Backoff{
+		Min:    reconnectMinInterval,
+		Max:    reconnectMaxInterval,
+		Factor: 1.8,
+		Jitter: false,
+	}
+	for {
+		select {
+		case <-c.doneC:
+			return nil
+		case <-time.After(b.Duration()):
+		}
+		conn, err := c.dial()
+		if err != nil {
+			c.m.error(err)
+			continue
+		}
+
+		c.writeMu.Lock()
+		if c.isClosed() {
+			c.writeMu.Unlock()
+			conn.Close()
+			return nil
+		}
+		c.conn.Close()
+		c.conn = conn
+		c.writeMu.Unlock()
+
+		c.m.mu.RLock()
+		streams := make([]string, 0, len(c.streams))
+		for stream := range c.streams {
+			streams = append(streams, stream)
+		}
+		c.m.mu.RUnlock()
+		sort.Strings(streams)
+
+		if len(streams) > 0 {
+			// the response is read by the caller, errors are reported through ErrHandler
+			id := atomic.AddInt64(&c.m.nextId, 1)
+			if err = c.write(streamRequest{Method: subscribeStreamMethod, Params: streams, Id: id}); err != nil {
+				c.m.error(err)
+			}
+		}
+		return conn
+	}
+}
+
+// request sends request and waits for its response
+func (c *streamConn) request(method string, params []string) (json.RawMessage, error) {
+	id := atomic.AddInt64(&c.m.nextId, 1)
+	ch := make(chan streamResponse, 1)
+
+	c.pendingMu.Lock()
+	c.pending[id] = ch
+	c.pendingMu.Unlock()
+	defer func() {
+		c.pendingMu.Lock()
+		delete(c.pending, id)
+		c.pendingMu.Unlock()
+	}()
+
+	if err := c.write(streamRequest{Method: method, Params: params, Id: id}); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(WriteSyncWsTimeout)
+	defer timer.Stop()
+	select {
+	case resp := <-ch:
+		return resp.result, resp.err
+	case <-timer.C:
+		return nil, ErrorWsReadConnectionTimeout
+	case <-c.doneC:
+		return nil, ErrorStreamConnClosed
+	}
+}
+
+// write sends request without exceeding MaxMessagesPerSecond
+func (c *streamConn) write(req streamRequest) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if c.conn == nil {
+		return ErrorStreamConnClosed
+	}
+	if perSecond := c.m.cfg.MaxMessagesPerSecond; perSecond > 0 {
+		interval := time.Second / time.Duration(perSecond)
+		if wait := interval - time.Since(c.lastWrite); wait > 0 {
+			time.Sleep(wait)
+		}
+	}
+	c.lastWrite = time.Now()
+	return c.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (c *streamConn) isClosed() bool {
+	return atomic.LoadInt32(&c.closed) == 1
+}
+
+// close closes the connection and stops reconnecting
+func (c *streamConn) close() {
+	if !atomic.CompareAndSwapInt32(&c.closed, 0, 1) {
+		return
+	}
+	close(c.doneC)
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if c.conn != nil {
+		c.conn.Close()
+	}
+}
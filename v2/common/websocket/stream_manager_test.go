@@ -0,0 +1,261 @@
+package websocket
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type streamManagerTestSuite struct {
+	suite.Suite
+	server  *httptest.Server
+	mu      sync.Mutex
+	conns   []*fakeStreamConn
+	connect chan *fakeStreamConn
+}
+
+// fakeStreamConn is the server side of a combined stream connection
+type fakeStreamConn struct {
+	mu      sync.Mutex
+	conn    *websocket.Conn
+	streams map[string]struct{}
+}
+
+func (c *fakeStreamConn) push(stream string, data string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"stream":%q,"data":%s}`, stream, data)))
+}
+
+func (c *fakeStreamConn) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.streams)
+}
+
+func TestStreamManager(t *testing.T) {
+	suite.Run(t, new(streamManagerTestSuite))
+}
+
+func (s *streamManagerTestSuite) SetupTest() {
+	s.conns = nil
+	s.connect = make(chan *fakeStreamConn, 10)
+	upgrader := websocket.Upgrader{}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		fc := &fakeStreamConn{conn: conn, streams: make(map[string]struct{})}
+		s.mu.Lock()
+		s.conns = append(s.conns, fc)
+		s.mu.Unlock()
+		s.connect <- fc
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			req := streamRequest{}
+			if err = json.Unmarshal(message, &req); err != nil {
+				return
+			}
+			var result any
+			fc.mu.Lock()
+			if req.Method == subscribeStreamMethod && strings.HasPrefix(strings.Join(req.Params, ","), "slow") {
+				time.Sleep(300 * time.Millisecond)
+			}
+			switch req.Method {
+			case subscribeStreamMethod:
+				for _, stream := range req.Params {
+					fc.streams[stream] = struct{}{}
+				}
+			case unsubscribeStreamMethod:
+				for _, stream := range req.Params {
+					delete(fc.streams, stream)
+				}
+			case listSubscriptionsStreamMethod:
+				list := []string{}
+				for stream := range fc.streams {
+					list = append(list, stream)
+				}
+				result = list
+			}
+			var resp []byte
+			if strings.HasPrefix(strings.Join(req.Params, ","), "invalid") {
+				resp, _ = json.Marshal(map[string]any{"error": map[string]any{"code": 2, "msg": "Invalid request"}, "id": req.Id})
+			} else {
+				resp, _ = json.Marshal(map[string]any{"result": result, "id": req.Id})
+			}
+			err = conn.WriteMessage(websocket.TextMessage, resp)
+			fc.mu.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	}))
+}
+
+func (s *streamManagerTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *streamManagerTestSuite) newManager(maxStreams int, errHandler func(error)) *StreamManager {
+	endpoint := "ws" + strings.TrimPrefix(s.server.URL, "http")
+	return NewStreamManager(StreamManagerConfig{
+		Endpoint: func(stream string) string {
+			return endpoint
+		},
+		Dial: func(endpoint string) (*websocket.Conn, error) {
+			c, _, err := websocket.DefaultDialer.Dial(endpoint, nil)
+			return c, err
+		},
+		MaxStreamsPerConn:    maxStreams,
+		MaxMessagesPerSecond: 100,
+		ErrHandler:           errHandler,
+	})
+}
+
+func (s *streamManagerTestSuite) TestSubscribeAndRoute() {
+	m := s.newManager(10, nil)
+	defer m.Close()
+
+	depthC := make(chan string, 1)
+	klineC := make(chan string, 1)
+	err := m.Subscribe(map[string]StreamHandler{
+		"btcusdt@depth": func(data []byte) {
+			depthC <- string(data)
+		},
+		"btcusdt@kline_1m": func(data []byte) {
+			klineC <- string(data)
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(1, m.ConnCount())
+	s.Equal([]string{"btcusdt@depth", "btcusdt@kline_1m"}, m.Streams())
+
+	fc := <-s.connect
+	s.Require().NoError(fc.push("btcusdt@kline_1m", `{"e":"kline"}`))
+	s.Require().NoError(fc.push("btcusdt@depth", `{"e":"depthUpdate"}`))
+	s.Equal(`{"e":"kline"}`, <-klineC)
+	s.Equal(`{"e":"depthUpdate"}`, <-depthC)
+
+	list, err := m.ListSubscriptions()
+	s.Require().NoError(err)
+	s.Equal([]string{"btcusdt@depth", "btcusdt@kline_1m"}, list)
+
+	s.Require().NoError(m.Unsubscribe("btcusdt@depth"))
+	s.Equal(1, fc.count())
+	list, err = m.ListSubscriptions()
+	s.Require().NoError(err)
+	s.Equal([]string{"btcusdt@kline_1m"}, list)
+
+	s.Require().NoError(m.Unsubscribe("btcusdt@kline_1m"))
+	s.Equal(0, m.ConnCount())
+	s.Empty(m.Streams())
+}
+
+func (s *streamManagerTestSuite) TestShardByMaxStreams() {
+	m := s.newManager(2, nil)
+	defer m.Close()
+
+	handlers := map[string]StreamHandler{}
+	for _, symbol := range []string{"a", "b", "c", "d", "e"} {
+		handlers[symbol+"@trade"] = func(data []byte) {}
+	}
+	s.Require().NoError(m.Subscribe(handlers))
+	s.Equal(3, m.ConnCount())
+
+	list, err := m.ListSubscriptions()
+	s.Require().NoError(err)
+	s.Equal([]string{"a@trade", "b@trade", "c@trade", "d@trade", "e@trade"}, list)
+
+	s.Require().NoError(m.Unsubscribe("e@trade"))
+	s.Equal(2, m.ConnCount())
+	s.Require().NoError(m.Subscribe(map[string]StreamHandler{"f@trade": func(data []byte) {}}))
+	s.Equal(3, m.ConnCount())
+}
+
+func (s *streamManagerTestSuite) TestSubscribeError() {
+	m := s.newManager(10, nil)
+	defer m.Close()
+
+	err := m.Subscribe(map[string]StreamHandler{"invalid": func(data []byte) {}})
+	s.Require().Error(err)
+	apiErr, ok := err.(*common.APIError)
+	s.Require().True(ok)
+	s.Equal(int64(2), apiErr.Code)
+	s.Empty(m.Streams())
+	s.Equal(0, m.ConnCount())
+}
+
+func (s *streamManagerTestSuite) TestSubscribeTimeout() {
+	m := s.newManager(10, nil)
+	defer m.Close()
+	timeout := WriteSyncWsTimeout
+	WriteSyncWsTimeout = 100 * time.Millisecond
+	defer func() { WriteSyncWsTimeout = timeout }()
+
+	s.Require().NoError(m.Subscribe(map[string]StreamHandler{"a@trade": func(data []byte) {}}))
+	fc := <-s.connect
+	err := m.Subscribe(map[string]StreamHandler{"slow@trade": func(data []byte) {}})
+	s.Require().ErrorIs(err, ErrorWsReadConnectionTimeout)
+	s.Equal([]string{"a@trade"}, m.Streams())
+
+	// the late subscription is undone on the server
+	WriteSyncWsTimeout = timeout
+	list, err := m.ListSubscriptions()
+	s.Require().NoError(err)
+	s.Equal([]string{"a@trade"}, list)
+	s.Equal(1, fc.count())
+}
+
+func (s *streamManagerTestSuite) TestResubscribeAfterReconnect() {
+	errC := make(chan error, 10)
+	m := s.newManager(10, func(err error) {
+		errC <- err
+	})
+	defer m.Close()
+
+	dataC := make(chan string, 1)
+	s.Require().NoError(m.Subscribe(map[string]StreamHandler{
+		"btcusdt@trade": func(data []byte) {
+			dataC <- string(data)
+		},
+	}))
+	fc := <-s.connect
+	fc.conn.Close()
+	s.Require().Error(<-errC)
+
+	var restored *fakeStreamConn
+	select {
+	case restored = <-s.connect:
+	case <-time.After(5 * time.Second):
+		s.FailNow("connection was not restored")
+	}
+	s.Eventually(func() bool {
+		return restored.count() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	s.Require().NoError(restored.push("btcusdt@trade", `{"e":"trade"}`))
+	s.Equal(`{"e":"trade"}`, <-dataC)
+}
+
+func (s *streamManagerTestSuite) TestClosed() {
+	m := s.newManager(10, nil)
+	s.Require().NoError(m.Close())
+	s.Equal(ErrorStreamManagerClosed, m.Subscribe(map[string]StreamHandler{"a@trade": func(data []byte) {}}))
+	s.Equal(ErrorStreamManagerClosed, m.Unsubscribe("a@trade"))
+	_, err := m.ListSubscriptions()
+	s.Equal(ErrorStreamManagerClosed, err)
+}
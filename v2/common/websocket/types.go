@@ -35,6 +35,17 @@ const (
 	// signatureKey define key for websocket API parameters
 	signatureKey = "signature"
 
+	// SESSION
+
+	// SessionLogonWsApiMethod define method for authenticating a websocket API connection
+	SessionLogonWsApiMethod WsApiMethodType = "session.logon"
+
+	// SessionStatusWsApiMethod define method for querying the authentication status of a websocket API connection
+	SessionStatusWsApiMethod WsApiMethodType = "session.status"
+
+	// SessionLogoutWsApiMethod define method for forgetting the API key a websocket API connection is authenticated with
+	SessionLogoutWsApiMethod WsApiMethodType = "session.logout"
+
 	// SPOT
 
 	// UserDataStreamSubscribeSignatureSpotWsApiMethod define method for user data stream subscription via websocket API with signature
@@ -64,6 +75,78 @@ const (
 	// SorOrderTestSpotWsApiMethod define method for SOR order testing via websocket API
 	SorOrderTestSpotWsApiMethod WsApiMethodType = "sor.order.test"
 
+	// OrderCancelSpotWsApiMethod define method for canceling order via websocket API
+	OrderCancelSpotWsApiMethod WsApiMethodType = "order.cancel"
+
+	// OrderStatusSpotWsApiMethod define method for querying order via websocket API
+	OrderStatusSpotWsApiMethod WsApiMethodType = "order.status"
+
+	// OrderCancelReplaceSpotWsApiMethod define method for canceling an order and placing a new one via websocket API
+	OrderCancelReplaceSpotWsApiMethod WsApiMethodType = "order.cancelReplace"
+
+	// OpenOrdersStatusSpotWsApiMethod define method for querying open orders via websocket API
+	OpenOrdersStatusSpotWsApiMethod WsApiMethodType = "openOrders.status"
+
+	// OpenOrdersCancelAllSpotWsApiMethod define method for canceling all open orders of a symbol via websocket API
+	OpenOrdersCancelAllSpotWsApiMethod WsApiMethodType = "openOrders.cancelAll"
+
+	// AllOrdersSpotWsApiMethod define method for querying all orders via websocket API
+	AllOrdersSpotWsApiMethod WsApiMethodType = "allOrders"
+
+	// MyTradesSpotWsApiMethod define method for querying account trades via websocket API
+	MyTradesSpotWsApiMethod WsApiMethodType = "myTrades"
+
+	// AccountStatusSpotWsApiMethod define method for querying account information via websocket API
+	AccountStatusSpotWsApiMethod WsApiMethodType = "account.status"
+
+	// AccountRateLimitsOrdersSpotWsApiMethod define method for querying order count usage via websocket API
+	AccountRateLimitsOrdersSpotWsApiMethod WsApiMethodType = "account.rateLimits.orders"
+
+	// DepthSpotWsApiMethod define method for querying order book via websocket API
+	DepthSpotWsApiMethod WsApiMethodType = "depth"
+
+	// TradesRecentSpotWsApiMethod define method for querying recent trades via websocket API
+	TradesRecentSpotWsApiMethod WsApiMethodType = "trades.recent"
+
+	// TradesHistoricalSpotWsApiMethod define method for querying historical trades via websocket API
+	TradesHistoricalSpotWsApiMethod WsApiMethodType = "trades.historical"
+
+	// TradesAggregateSpotWsApiMethod define method for querying aggregate trades via websocket API
+	TradesAggregateSpotWsApiMethod WsApiMethodType = "trades.aggregate"
+
+	// KlinesSpotWsApiMethod define method for querying klines via websocket API
+	KlinesSpotWsApiMethod WsApiMethodType = "klines"
+
+	// UiKlinesSpotWsApiMethod define method for querying klines optimized for presentation via websocket API
+	UiKlinesSpotWsApiMethod WsApiMethodType = "uiKlines"
+
+	// AvgPriceSpotWsApiMethod define method for querying current average price via websocket API
+	AvgPriceSpotWsApiMethod WsApiMethodType = "avgPrice"
+
+	// Ticker24hrSpotWsApiMethod define method for querying 24 hour price change statistics via websocket API
+	Ticker24hrSpotWsApiMethod WsApiMethodType = "ticker.24hr"
+
+	// TickerTradingDaySpotWsApiMethod define method for querying trading day price change statistics via websocket API
+	TickerTradingDaySpotWsApiMethod WsApiMethodType = "ticker.tradingDay"
+
+	// TickerSpotWsApiMethod define method for querying rolling window price change statistics via websocket API
+	TickerSpotWsApiMethod WsApiMethodType = "ticker"
+
+	// TickerPriceSpotWsApiMethod define method for querying latest price via websocket API
+	TickerPriceSpotWsApiMethod WsApiMethodType = "ticker.price"
+
+	// TickerBookSpotWsApiMethod define method for querying best price and quantity on the order book via websocket API
+	TickerBookSpotWsApiMethod WsApiMethodType = "ticker.book"
+
+	// ExchangeInfoSpotWsApiMethod define method for querying exchange information via websocket API
+	ExchangeInfoSpotWsApiMethod WsApiMethodType = "exchangeInfo"
+
+	// PingSpotWsApiMethod define method for testing connectivity via websocket API
+	PingSpotWsApiMethod WsApiMethodType = "ping"
+
+	// TimeSpotWsApiMethod define method for querying server time via websocket API
+	TimeSpotWsApiMethod WsApiMethodType = "time"
+
 	// FUTURES
 
 	// OrderPlaceFuturesWsApiMethod define method for creation order via websocket API
@@ -74,6 +157,53 @@ const (
 
 	// OrderStatusFuturesWsApiMethod define method for query order via websocket API
 	OrderStatusFuturesWsApiMethod WsApiMethodType = "order.status"
+
+	// OrderModifyFuturesWsApiMethod define method for modifying order via websocket API
+	OrderModifyFuturesWsApiMethod WsApiMethodType = "order.modify"
+
+	// AccountPositionV2FuturesWsApiMethod define method for querying position information via websocket API
+	AccountPositionV2FuturesWsApiMethod WsApiMethodType = "v2/account.position"
+
+	// TickerPriceFuturesWsApiMethod define method for querying latest price via websocket API
+	TickerPriceFuturesWsApiMethod WsApiMethodType = "ticker.price"
+
+	// TickerBookFuturesWsApiMethod define method for querying best price and quantity on the order book via websocket API
+	TickerBookFuturesWsApiMethod WsApiMethodType = "ticker.book"
+
+	// DepthFuturesWsApiMethod define method for querying order book via websocket API
+	DepthFuturesWsApiMethod WsApiMethodType = "depth"
+
+	// UserDataStreamStartFuturesWsApiMethod define method for starting user data stream via websocket API
+	UserDataStreamStartFuturesWsApiMethod WsApiMethodType = "userDataStream.start"
+
+	// UserDataStreamPingFuturesWsApiMethod define method for keeping alive user data stream via websocket API
+	UserDataStreamPingFuturesWsApiMethod WsApiMethodType = "userDataStream.ping"
+
+	// UserDataStreamStopFuturesWsApiMethod define method for closing user data stream via websocket API
+	UserDataStreamStopFuturesWsApiMethod WsApiMethodType = "userDataStream.stop"
+
+	// DELIVERY
+
+	// OrderPlaceDeliveryWsApiMethod define method for creation order via websocket API
+	OrderPlaceDeliveryWsApiMethod WsApiMethodType = "order.place"
+
+	// OrderModifyDeliveryWsApiMethod define method for modifying order via websocket API
+	OrderModifyDeliveryWsApiMethod WsApiMethodType = "order.modify"
+
+	// OrderCancelDeliveryWsApiMethod define method for canceling order via websocket API
+	OrderCancelDeliveryWsApiMethod WsApiMethodType = "order.cancel"
+
+	// OrderStatusDeliveryWsApiMethod define method for querying order via websocket API
+	OrderStatusDeliveryWsApiMethod WsApiMethodType = "order.status"
+
+	// AccountPositionDeliveryWsApiMethod define method for querying position information via websocket API
+	AccountPositionDeliveryWsApiMethod WsApiMethodType = "account.position"
+
+	// AccountBalanceDeliveryWsApiMethod define method for querying account balance via websocket API
+	AccountBalanceDeliveryWsApiMethod WsApiMethodType = "account.balance"
+
+	// AccountStatusDeliveryWsApiMethod define method for querying account information via websocket API
+	AccountStatusDeliveryWsApiMethod WsApiMethodType = "account.status"
 )
 
 var (
@@ -85,6 +215,9 @@ var (
 
 	// ErrorSecretKeyIsNotSet defines that SecretKey is not set
 	ErrorSecretKeyIsNotSet = errors.New("ws service: secret key is not set")
+
+	// ErrorSessionKeyType defines that session.logon was called with a key that isn't an Ed25519 key
+	ErrorSessionKeyType = errors.New("ws service: session.logon requires an Ed25519 key")
 )
 
 func NewRequestData(
@@ -109,6 +242,44 @@ type RequestData struct {
 	secretKey  string
 	timeOffset int64
 	keyType    string
+	signer     common.Signer
+	signers    *common.SignerCache
+	timeSync   *common.TimeSync
+	session    bool
+}
+
+// WithSigner returns a copy of the request data signed by signer instead of the secret key, if it is set
+func (d RequestData) WithSigner(signer common.Signer) RequestData {
+	d.signer = signer
+	return d
+}
+
+// WithSignerCache returns a copy of the request data whose secret key is parsed by cache, without a cache the key
+// is parsed for every request
+func (d RequestData) WithSignerCache(cache *common.SignerCache) RequestData {
+	d.signers = cache
+	return d
+}
+
+// KeyType returns the key type of the signer if it is set, otherwise the key type of the secret key
+func (d RequestData) KeyType() string {
+	if d.signer != nil {
+		return d.signer.KeyType()
+	}
+	return d.keyType
+}
+
+// WithTimeSync returns a copy of the request data taking the time offset from ts, if it is set
+func (d RequestData) WithTimeSync(ts *common.TimeSync) RequestData {
+	d.timeSync = ts
+	return d
+}
+
+// WithSession returns a copy of the request data for a connection authenticated with session.logon if loggedOn is
+// true, such requests are sent without apiKey and signature
+func (d RequestData) WithSession(loggedOn bool) RequestData {
+	d.session = loggedOn
+	return d
 }
 
 // CreateRequest creates signed ws request
@@ -117,29 +288,67 @@ func CreateRequest(reqData RequestData, method WsApiMethodType, params map[strin
 		return nil, ErrorRequestIDNotSet
 	}
 
+	if reqData.session {
+		params[timestampKey] = timestamp(reqData.timeSync.OffsetOr(reqData.timeOffset))
+		return marshalRequest(reqData.requestID, method, params)
+	}
+
 	if reqData.apiKey == "" {
 		return nil, ErrorApiKeyIsNotSet
 	}
 
-	if reqData.secretKey == "" {
-		return nil, ErrorSecretKeyIsNotSet
+	signer := reqData.signer
+	if signer == nil {
+		if reqData.secretKey == "" {
+			return nil, ErrorSecretKeyIsNotSet
+		}
+		var err error
+		signer, err = reqData.signers.Signer(reqData.keyType, reqData.secretKey)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	params[apiKey] = reqData.apiKey
-	params[timestampKey] = timestamp(reqData.timeOffset)
+	params[timestampKey] = timestamp(reqData.timeSync.OffsetOr(reqData.timeOffset))
 
-	sf, err := common.SignFunc(reqData.keyType)
-	if err != nil {
-		return nil, err
-	}
-	signature, err := sf(reqData.secretKey, encodeParams(params))
+	signature, err := signer.Sign(encodeParams(params))
 	if err != nil {
 		return nil, err
 	}
 	params[signatureKey] = signature
 
+	return marshalRequest(reqData.requestID, method, params)
+}
+
+// CreateUnsignedRequest creates ws request of a method which doesn't need authentication, e.g. market data
+func CreateUnsignedRequest(requestID string, method WsApiMethodType, params map[string]any) ([]byte, error) {
+	if requestID == "" {
+		return nil, ErrorRequestIDNotSet
+	}
+
+	return marshalRequest(requestID, method, params)
+}
+
+// CreateApiKeyRequest creates ws request of a method which needs the API key only, e.g. user data stream management
+func CreateApiKeyRequest(requestID string, key string, method WsApiMethodType, params map[string]any) ([]byte, error) {
+	if requestID == "" {
+		return nil, ErrorRequestIDNotSet
+	}
+
+	if key == "" {
+		return nil, ErrorApiKeyIsNotSet
+	}
+
+	params[apiKey] = key
+
+	return marshalRequest(requestID, method, params)
+}
+
+// marshalRequest encodes ws request
+func marshalRequest(requestID string, method WsApiMethodType, params map[string]any) ([]byte, error) {
 	req := WsApiRequest{
-		Id:     reqData.requestID,
+		Id:     requestID,
 		Method: method,
 		Params: params,
 	}
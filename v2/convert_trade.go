@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type ConvertTradeHistoryService struct {
@@ -54,6 +57,19 @@ func (s *ConvertTradeHistoryService) Do(ctx context.Context, opts ...RequestOpti
 	return &res, nil
 }
 
+// Iterator walks the convert trades between startTime and endTime in milliseconds, in windows of 30 days. Windows with
+// more than 1000 trades are split until all their trades are returned.
+func (s *ConvertTradeHistoryService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[ConvertTradeHistoryItem] {
+	return common.NewSplitIterator(startTime, endTime, 30*24*time.Hour,
+		func(ctx context.Context, startTime, endTime int64) ([]ConvertTradeHistoryItem, bool, error) {
+			res, err := s.StartTime(startTime).EndTime(endTime).Limit(1000).Do(ctx, opts...)
+			if err != nil {
+				return nil, false, err
+			}
+			return res.List, res.MoreData, nil
+		})
+}
+
 // ConvertTradeHistory define the convert trade history
 type ConvertTradeHistory struct {
 	List      []ConvertTradeHistoryItem `json:"list"`
@@ -64,6 +80,8 @@ type ConvertTradeHistory struct {
 }
 
 // ConvertTradeHistoryItem define a convert trade history item
+//
+//decimalgen:accessors
 type ConvertTradeHistoryItem struct {
 	QuoteId      string `json:"quoteId"`
 	OrderId      int64  `json:"orderId"`
@@ -122,6 +140,8 @@ func (s *ConvertExchangeInfoService) Do(ctx context.Context, opts ...RequestOpti
 }
 
 // ConvertExchangeInfo define the convert exchange info
+//
+//decimalgen:accessors
 type ConvertExchangeInfo struct {
 	FromAsset          string `json:"fromAsset"`
 	ToAsset            string `json:"toAsset"`
@@ -246,6 +266,8 @@ func (s *ConvertGetQuoteService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // ConvertQuote define the convert quote
+//
+//decimalgen:accessors
 type ConvertQuote struct {
 	QuoteId      string `json:"quoteId"`
 	Ratio        string `json:"ratio"`
@@ -337,6 +359,8 @@ func (s *ConvertOrderStatusService) Do(ctx context.Context, opts ...RequestOptio
 }
 
 // ConvertOrderStatus define the convert order status
+//
+//decimalgen:accessors
 type ConvertOrderStatus struct {
 	OrderId      int64  `json:"orderId"`
 	OrderStatus  string `json:"orderStatus"`
@@ -0,0 +1,2349 @@
+// Code generated by decimalgen; DO NOT EDIT.
+
+package binance
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// BaseAmtDecimal returns BaseAmt as a decimal, zero if it is empty
+func (v *AddLiquidityPreviewResponse) BaseAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AddLiquidityPreviewResponse.BaseAmt", v.BaseAmt)
+}
+
+// FeeDecimal returns Fee as a decimal, zero if it is empty
+func (v *AddLiquidityPreviewResponse) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AddLiquidityPreviewResponse.Fee", v.Fee)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *AddLiquidityPreviewResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AddLiquidityPreviewResponse.Price", v.Price)
+}
+
+// QuoteAmtDecimal returns QuoteAmt as a decimal, zero if it is empty
+func (v *AddLiquidityPreviewResponse) QuoteAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AddLiquidityPreviewResponse.QuoteAmt", v.QuoteAmt)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *AggTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AggTrade.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *AggTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AggTrade.Quantity", v.Quantity)
+}
+
+// MinWithdrawAmountDecimal returns MinWithdrawAmount as a decimal, zero if it is empty
+func (v *AssetDetail) MinWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AssetDetail.MinWithdrawAmount", v.MinWithdrawAmount)
+}
+
+// WithdrawFeeDecimal returns WithdrawFee as a decimal, zero if it is empty
+func (v *AssetDetail) WithdrawFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AssetDetail.WithdrawFee", v.WithdrawFee)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *AvgPrice) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AvgPrice.Price", v.Price)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *Balance) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.Free", v.Free)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *Balance) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.Locked", v.Locked)
+}
+
+// AskPriceDecimal returns AskPrice as a decimal, zero if it is empty
+func (v *BookTicker) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.AskPrice", v.AskPrice)
+}
+
+// AskQuantityDecimal returns AskQuantity as a decimal, zero if it is empty
+func (v *BookTicker) AskQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.AskQuantity", v.AskQuantity)
+}
+
+// BidPriceDecimal returns BidPrice as a decimal, zero if it is empty
+func (v *BookTicker) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.BidPrice", v.BidPrice)
+}
+
+// BidQuantityDecimal returns BidQuantity as a decimal, zero if it is empty
+func (v *BookTicker) BidQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.BidQuantity", v.BidQuantity)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *C2CRecord) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("C2CRecord.Amount", v.Amount)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *C2CRecord) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("C2CRecord.Commission", v.Commission)
+}
+
+// TotalPriceDecimal returns TotalPrice as a decimal, zero if it is empty
+func (v *C2CRecord) TotalPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("C2CRecord.TotalPrice", v.TotalPrice)
+}
+
+// UnitPriceDecimal returns UnitPrice as a decimal, zero if it is empty
+func (v *C2CRecord) UnitPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("C2CRecord.UnitPrice", v.UnitPrice)
+}
+
+// CummulativeQuoteQuantityDecimal returns CummulativeQuoteQuantity as a decimal, zero if it is empty
+func (v *CancelAllMarginOrdersReport) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelAllMarginOrdersReport.CummulativeQuoteQuantity", v.CummulativeQuoteQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *CancelAllMarginOrdersReport) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelAllMarginOrdersReport.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// IcebergQtyDecimal returns IcebergQty as a decimal, zero if it is empty
+func (v *CancelAllMarginOrdersReport) IcebergQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelAllMarginOrdersReport.IcebergQty", v.IcebergQty)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *CancelAllMarginOrdersReport) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelAllMarginOrdersReport.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CancelAllMarginOrdersReport) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelAllMarginOrdersReport.Price", v.Price)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *CancelAllMarginOrdersReport) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelAllMarginOrdersReport.StopPrice", v.StopPrice)
+}
+
+// CummulativeQuoteQuantityDecimal returns CummulativeQuoteQuantity as a decimal, zero if it is empty
+func (v *CancelAllMarginOrdersResponse) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelAllMarginOrdersResponse.CummulativeQuoteQuantity", v.CummulativeQuoteQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *CancelAllMarginOrdersResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelAllMarginOrdersResponse.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *CancelAllMarginOrdersResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelAllMarginOrdersResponse.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CancelAllMarginOrdersResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelAllMarginOrdersResponse.Price", v.Price)
+}
+
+// CummulativeQuoteQuantityDecimal returns CummulativeQuoteQuantity as a decimal, zero if it is empty
+func (v *CancelMarginOrderResponse) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelMarginOrderResponse.CummulativeQuoteQuantity", v.CummulativeQuoteQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *CancelMarginOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelMarginOrderResponse.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *CancelMarginOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelMarginOrderResponse.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CancelMarginOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelMarginOrderResponse.Price", v.Price)
+}
+
+// CummulativeQuoteQuantityDecimal returns CummulativeQuoteQuantity as a decimal, zero if it is empty
+func (v *CancelOrderResponse) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.CummulativeQuoteQuantity", v.CummulativeQuoteQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *CancelOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *CancelOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.OrigQuantity", v.OrigQuantity)
+}
+
+// OrigQuoteOrderQuantityDecimal returns OrigQuoteOrderQuantity as a decimal, zero if it is empty
+func (v *CancelOrderResponse) OrigQuoteOrderQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.OrigQuoteOrderQuantity", v.OrigQuoteOrderQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CancelOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.Price", v.Price)
+}
+
+// ClaimedAmountDecimal returns ClaimedAmount as a decimal, zero if it is empty
+func (v *ClaimedRewardHistory) ClaimedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ClaimedRewardHistory.ClaimedAmount", v.ClaimedAmount)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *CoinInfo) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CoinInfo.Free", v.Free)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *CoinInfo) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CoinInfo.Locked", v.Locked)
+}
+
+// FromAssetMaxAmountDecimal returns FromAssetMaxAmount as a decimal, zero if it is empty
+func (v *ConvertExchangeInfo) FromAssetMaxAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertExchangeInfo.FromAssetMaxAmount", v.FromAssetMaxAmount)
+}
+
+// FromAssetMinAmountDecimal returns FromAssetMinAmount as a decimal, zero if it is empty
+func (v *ConvertExchangeInfo) FromAssetMinAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertExchangeInfo.FromAssetMinAmount", v.FromAssetMinAmount)
+}
+
+// ToAssetMaxAmountDecimal returns ToAssetMaxAmount as a decimal, zero if it is empty
+func (v *ConvertExchangeInfo) ToAssetMaxAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertExchangeInfo.ToAssetMaxAmount", v.ToAssetMaxAmount)
+}
+
+// ToAssetMinAmountDecimal returns ToAssetMinAmount as a decimal, zero if it is empty
+func (v *ConvertExchangeInfo) ToAssetMinAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertExchangeInfo.ToAssetMinAmount", v.ToAssetMinAmount)
+}
+
+// FromAmountDecimal returns FromAmount as a decimal, zero if it is empty
+func (v *ConvertOrderStatus) FromAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertOrderStatus.FromAmount", v.FromAmount)
+}
+
+// ToAmountDecimal returns ToAmount as a decimal, zero if it is empty
+func (v *ConvertOrderStatus) ToAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertOrderStatus.ToAmount", v.ToAmount)
+}
+
+// FromAmountDecimal returns FromAmount as a decimal, zero if it is empty
+func (v *ConvertQuote) FromAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertQuote.FromAmount", v.FromAmount)
+}
+
+// ToAmountDecimal returns ToAmount as a decimal, zero if it is empty
+func (v *ConvertQuote) ToAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertQuote.ToAmount", v.ToAmount)
+}
+
+// FromAmountDecimal returns FromAmount as a decimal, zero if it is empty
+func (v *ConvertTradeHistoryItem) FromAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertTradeHistoryItem.FromAmount", v.FromAmount)
+}
+
+// ToAmountDecimal returns ToAmount as a decimal, zero if it is empty
+func (v *ConvertTradeHistoryItem) ToAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertTradeHistoryItem.ToAmount", v.ToAmount)
+}
+
+// MarginBuyBorrowAmountDecimal returns MarginBuyBorrowAmount as a decimal, zero if it is empty
+func (v *CreateMarginOCOResponse) MarginBuyBorrowAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateMarginOCOResponse.MarginBuyBorrowAmount", v.MarginBuyBorrowAmount)
+}
+
+// CummulativeQuoteQuantityDecimal returns CummulativeQuoteQuantity as a decimal, zero if it is empty
+func (v *CreateOrderResponse) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.CummulativeQuoteQuantity", v.CummulativeQuoteQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *CreateOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// MarginBuyBorrowAmountDecimal returns MarginBuyBorrowAmount as a decimal, zero if it is empty
+func (v *CreateOrderResponse) MarginBuyBorrowAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.MarginBuyBorrowAmount", v.MarginBuyBorrowAmount)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *CreateOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.OrigQuantity", v.OrigQuantity)
+}
+
+// OrigQuoteOrderQuantityDecimal returns OrigQuoteOrderQuantity as a decimal, zero if it is empty
+func (v *CreateOrderResponse) OrigQuoteOrderQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.OrigQuoteOrderQuantity", v.OrigQuoteOrderQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CreateOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.Price", v.Price)
+}
+
+// TotalMarginBalanceDecimal returns TotalMarginBalance as a decimal, zero if it is empty
+func (v *DeliverySubAccount) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("DeliverySubAccount.TotalMarginBalance", v.TotalMarginBalance)
+}
+
+// TotalUnrealizedProfitDecimal returns TotalUnrealizedProfit as a decimal, zero if it is empty
+func (v *DeliverySubAccount) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("DeliverySubAccount.TotalUnrealizedProfit", v.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal returns TotalWalletBalance as a decimal, zero if it is empty
+func (v *DeliverySubAccount) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("DeliverySubAccount.TotalWalletBalance", v.TotalWalletBalance)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *Deposit) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Deposit.Amount", v.Amount)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *DividendResponse) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("DividendResponse.Amount", v.Amount)
+}
+
+// MaxAmountDecimal returns MaxAmount as a decimal, zero if it is empty
+func (v *DualInvestmentProduct) MaxAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("DualInvestmentProduct.MaxAmount", v.MaxAmount)
+}
+
+// MinAmountDecimal returns MinAmount as a decimal, zero if it is empty
+func (v *DualInvestmentProduct) MinAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("DualInvestmentProduct.MinAmount", v.MinAmount)
+}
+
+// StrikePriceDecimal returns StrikePrice as a decimal, zero if it is empty
+func (v *DualInvestmentProduct) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("DualInvestmentProduct.StrikePrice", v.StrikePrice)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *DustTransferResult) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("DustTransferResult.Amount", v.Amount)
+}
+
+// ServiceChargeAmountDecimal returns ServiceChargeAmount as a decimal, zero if it is empty
+func (v *DustTransferResult) ServiceChargeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("DustTransferResult.ServiceChargeAmount", v.ServiceChargeAmount)
+}
+
+// TransferedAmountDecimal returns TransferedAmount as a decimal, zero if it is empty
+func (v *DustTransferResult) TransferedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("DustTransferResult.TransferedAmount", v.TransferedAmount)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *FiatDepositWithdrawHistoryItem) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FiatDepositWithdrawHistoryItem.Amount", v.Amount)
+}
+
+// IndicatedAmountDecimal returns IndicatedAmount as a decimal, zero if it is empty
+func (v *FiatDepositWithdrawHistoryItem) IndicatedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FiatDepositWithdrawHistoryItem.IndicatedAmount", v.IndicatedAmount)
+}
+
+// TotalFeeDecimal returns TotalFee as a decimal, zero if it is empty
+func (v *FiatDepositWithdrawHistoryItem) TotalFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FiatDepositWithdrawHistoryItem.TotalFee", v.TotalFee)
+}
+
+// ObtainAmountDecimal returns ObtainAmount as a decimal, zero if it is empty
+func (v *FiatPaymentsHistoryItem) ObtainAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FiatPaymentsHistoryItem.ObtainAmount", v.ObtainAmount)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *FiatPaymentsHistoryItem) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FiatPaymentsHistoryItem.Price", v.Price)
+}
+
+// SourceAmountDecimal returns SourceAmount as a decimal, zero if it is empty
+func (v *FiatPaymentsHistoryItem) SourceAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FiatPaymentsHistoryItem.SourceAmount", v.SourceAmount)
+}
+
+// TotalFeeDecimal returns TotalFee as a decimal, zero if it is empty
+func (v *FiatPaymentsHistoryItem) TotalFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FiatPaymentsHistoryItem.TotalFee", v.TotalFee)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *Fill) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Fill.Commission", v.Commission)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *Fill) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Fill.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *Fill) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Fill.Quantity", v.Quantity)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *FundingAsset) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FundingAsset.Free", v.Free)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *FundingAsset) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FundingAsset.Locked", v.Locked)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *FundsDetail) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FundsDetail.Amount", v.Amount)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *FuturesAlgoOrder) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAlgoOrder.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedAmountDecimal returns ExecutedAmount as a decimal, zero if it is empty
+func (v *FuturesAlgoOrder) ExecutedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAlgoOrder.ExecutedAmount", v.ExecutedAmount)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *FuturesAlgoOrder) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAlgoOrder.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// TotalQuantityDecimal returns TotalQuantity as a decimal, zero if it is empty
+func (v *FuturesAlgoOrder) TotalQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAlgoOrder.TotalQuantity", v.TotalQuantity)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *FuturesAlgoSubOrder) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAlgoSubOrder.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedAmountDecimal returns ExecutedAmount as a decimal, zero if it is empty
+func (v *FuturesAlgoSubOrder) ExecutedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAlgoSubOrder.ExecutedAmount", v.ExecutedAmount)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *FuturesAlgoSubOrder) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAlgoSubOrder.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// FeeAmountDecimal returns FeeAmount as a decimal, zero if it is empty
+func (v *FuturesAlgoSubOrder) FeeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAlgoSubOrder.FeeAmount", v.FeeAmount)
+}
+
+// OriginQuantityDecimal returns OriginQuantity as a decimal, zero if it is empty
+func (v *FuturesAlgoSubOrder) OriginQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAlgoSubOrder.OriginQuantity", v.OriginQuantity)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *FuturesAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAsset.InitialMargin", v.InitialMargin)
+}
+
+// MaintenanceMarginDecimal returns MaintenanceMargin as a decimal, zero if it is empty
+func (v *FuturesAsset) MaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAsset.MaintenanceMargin", v.MaintenanceMargin)
+}
+
+// MarginBalanceDecimal returns MarginBalance as a decimal, zero if it is empty
+func (v *FuturesAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAsset.MarginBalance", v.MarginBalance)
+}
+
+// MaxWithdrawAmountDecimal returns MaxWithdrawAmount as a decimal, zero if it is empty
+func (v *FuturesAsset) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAsset.MaxWithdrawAmount", v.MaxWithdrawAmount)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *FuturesAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAsset.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *FuturesAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAsset.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *FuturesAsset) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAsset.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// WalletBalanceDecimal returns WalletBalance as a decimal, zero if it is empty
+func (v *FuturesAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesAsset.WalletBalance", v.WalletBalance)
+}
+
+// TotalInitialMarginDecimal returns TotalInitialMargin as a decimal, zero if it is empty
+func (v *FuturesSubAccount) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesSubAccount.TotalInitialMargin", v.TotalInitialMargin)
+}
+
+// TotalMaintenanceMarginDecimal returns TotalMaintenanceMargin as a decimal, zero if it is empty
+func (v *FuturesSubAccount) TotalMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesSubAccount.TotalMaintenanceMargin", v.TotalMaintenanceMargin)
+}
+
+// TotalMarginBalanceDecimal returns TotalMarginBalance as a decimal, zero if it is empty
+func (v *FuturesSubAccount) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesSubAccount.TotalMarginBalance", v.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal returns TotalOpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *FuturesSubAccount) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesSubAccount.TotalOpenOrderInitialMargin", v.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal returns TotalPositionInitialMargin as a decimal, zero if it is empty
+func (v *FuturesSubAccount) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesSubAccount.TotalPositionInitialMargin", v.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal returns TotalUnrealizedProfit as a decimal, zero if it is empty
+func (v *FuturesSubAccount) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesSubAccount.TotalUnrealizedProfit", v.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal returns TotalWalletBalance as a decimal, zero if it is empty
+func (v *FuturesSubAccount) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesSubAccount.TotalWalletBalance", v.TotalWalletBalance)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *FuturesTransfer) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesTransfer.Amount", v.Amount)
+}
+
+// MarginBalanceDecimal returns MarginBalance as a decimal, zero if it is empty
+func (v *FuturesUserAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesUserAsset.MarginBalance", v.MarginBalance)
+}
+
+// WalletBalanceDecimal returns WalletBalance as a decimal, zero if it is empty
+func (v *FuturesUserAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesUserAsset.WalletBalance", v.WalletBalance)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *FuturesUserPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesUserPosition.EntryPrice", v.EntryPrice)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *FuturesUserPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesUserPosition.MarkPrice", v.MarkPrice)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *FuturesUserPosition) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesUserPosition.PositionAmt", v.PositionAmt)
+}
+
+// UnRealizedProfitDecimal returns UnRealizedProfit as a decimal, zero if it is empty
+func (v *FuturesUserPosition) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FuturesUserPosition.UnRealizedProfit", v.UnRealizedProfit)
+}
+
+// TotalAmountInBTCDecimal returns TotalAmountInBTC as a decimal, zero if it is empty
+func (v *GetDualInvestmentAccountsResp) TotalAmountInBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetDualInvestmentAccountsResp.TotalAmountInBTC", v.TotalAmountInBTC)
+}
+
+// TotalAmountInUSDTDecimal returns TotalAmountInUSDT as a decimal, zero if it is empty
+func (v *GetDualInvestmentAccountsResp) TotalAmountInUSDTDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetDualInvestmentAccountsResp.TotalAmountInUSDT", v.TotalAmountInUSDT)
+}
+
+// ExecutedAmountDecimal returns ExecutedAmount as a decimal, zero if it is empty
+func (v *GetFuturesAlgoSubOrdersResponse) ExecutedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetFuturesAlgoSubOrdersResponse.ExecutedAmount", v.ExecutedAmount)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *GetFuturesAlgoSubOrdersResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetFuturesAlgoSubOrdersResponse.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// BaseQtyDecimal returns BaseQty as a decimal, zero if it is empty
+func (v *GetSwapQuoteResponse) BaseQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetSwapQuoteResponse.BaseQty", v.BaseQty)
+}
+
+// FeeDecimal returns Fee as a decimal, zero if it is empty
+func (v *GetSwapQuoteResponse) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetSwapQuoteResponse.Fee", v.Fee)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *GetSwapQuoteResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetSwapQuoteResponse.Price", v.Price)
+}
+
+// QuoteQtyDecimal returns QuoteQty as a decimal, zero if it is empty
+func (v *GetSwapQuoteResponse) QuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetSwapQuoteResponse.QuoteQty", v.QuoteQty)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *InterestHistoryElement) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("InterestHistoryElement.Interest", v.Interest)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *InternalUniversalTransfer) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("InternalUniversalTransfer.Amount", v.Amount)
+}
+
+// TotalLiabilityOfBTCDecimal returns TotalLiabilityOfBTC as a decimal, zero if it is empty
+func (v *IsolatedMarginAccount) TotalLiabilityOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("IsolatedMarginAccount.TotalLiabilityOfBTC", v.TotalLiabilityOfBTC)
+}
+
+// IndexPriceDecimal returns IndexPrice as a decimal, zero if it is empty
+func (v *IsolatedMarginAsset) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("IsolatedMarginAsset.IndexPrice", v.IndexPrice)
+}
+
+// LiquidatePriceDecimal returns LiquidatePrice as a decimal, zero if it is empty
+func (v *IsolatedMarginAsset) LiquidatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("IsolatedMarginAsset.LiquidatePrice", v.LiquidatePrice)
+}
+
+// LiquidateRateDecimal returns LiquidateRate as a decimal, zero if it is empty
+func (v *IsolatedMarginAsset) LiquidateRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("IsolatedMarginAsset.LiquidateRate", v.LiquidateRate)
+}
+
+// MarginRatioDecimal returns MarginRatio as a decimal, zero if it is empty
+func (v *IsolatedMarginAsset) MarginRatioDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("IsolatedMarginAsset.MarginRatio", v.MarginRatio)
+}
+
+// BorrowedDecimal returns Borrowed as a decimal, zero if it is empty
+func (v *IsolatedUserAsset) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("IsolatedUserAsset.Borrowed", v.Borrowed)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *IsolatedUserAsset) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("IsolatedUserAsset.Free", v.Free)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *IsolatedUserAsset) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("IsolatedUserAsset.Interest", v.Interest)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *IsolatedUserAsset) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("IsolatedUserAsset.Locked", v.Locked)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *Kline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *Kline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *Kline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *Kline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Open", v.Open)
+}
+
+// QuoteAssetVolumeDecimal returns QuoteAssetVolume as a decimal, zero if it is empty
+func (v *Kline) QuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.QuoteAssetVolume", v.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal returns TakerBuyBaseAssetVolume as a decimal, zero if it is empty
+func (v *Kline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.TakerBuyBaseAssetVolume", v.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal returns TakerBuyQuoteAssetVolume as a decimal, zero if it is empty
+func (v *Kline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.TakerBuyQuoteAssetVolume", v.TakerBuyQuoteAssetVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *Kline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Volume", v.Volume)
+}
+
+// StrikePriceDecimal returns StrikePrice as a decimal, zero if it is empty
+func (v *ListDualInvestmentPosition) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ListDualInvestmentPosition.StrikePrice", v.StrikePrice)
+}
+
+// SubscriptionAmountDecimal returns SubscriptionAmount as a decimal, zero if it is empty
+func (v *ListDualInvestmentPosition) SubscriptionAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ListDualInvestmentPosition.SubscriptionAmount", v.SubscriptionAmount)
+}
+
+// AmountFreeDecimal returns AmountFree as a decimal, zero if it is empty
+func (v *ListDustDetail) AmountFreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ListDustDetail.AmountFree", v.AmountFree)
+}
+
+// MaxQuantityDecimal returns MaxQuantity as a decimal, zero if it is empty
+func (v *LotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LotSizeFilter.MaxQuantity", v.MaxQuantity)
+}
+
+// MinQuantityDecimal returns MinQuantity as a decimal, zero if it is empty
+func (v *LotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LotSizeFilter.MinQuantity", v.MinQuantity)
+}
+
+// AvailableBalanceDecimal returns AvailableBalance as a decimal, zero if it is empty
+func (v *ManagedSubAccountAsset) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubAccountAsset.AvailableBalance", v.AvailableBalance)
+}
+
+// BtcValueDecimal returns BtcValue as a decimal, zero if it is empty
+func (v *ManagedSubAccountAsset) BtcValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubAccountAsset.BtcValue", v.BtcValue)
+}
+
+// TotalBalanceDecimal returns TotalBalance as a decimal, zero if it is empty
+func (v *ManagedSubAccountAsset) TotalBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubAccountAsset.TotalBalance", v.TotalBalance)
+}
+
+// BorrowedDecimal returns Borrowed as a decimal, zero if it is empty
+func (v *ManagedSubAccountMarginAsset) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubAccountMarginAsset.Borrowed", v.Borrowed)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *ManagedSubAccountMarginAsset) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubAccountMarginAsset.Free", v.Free)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *ManagedSubAccountMarginAsset) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubAccountMarginAsset.Interest", v.Interest)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *ManagedSubAccountMarginAsset) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubAccountMarginAsset.Locked", v.Locked)
+}
+
+// TotalLiabilityOfBtcDecimal returns TotalLiabilityOfBtc as a decimal, zero if it is empty
+func (v *ManagedSubAccountQueryMarginAssetServiceResponse) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubAccountQueryMarginAssetServiceResponse.TotalLiabilityOfBtc", v.TotalLiabilityOfBtc)
+}
+
+// MarginBalanceDecimal returns MarginBalance as a decimal, zero if it is empty
+func (v *ManagedSubFuturesAccountSnapVoDataAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubFuturesAccountSnapVoDataAsset.MarginBalance", v.MarginBalance)
+}
+
+// WalletBalanceDecimal returns WalletBalance as a decimal, zero if it is empty
+func (v *ManagedSubFuturesAccountSnapVoDataAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubFuturesAccountSnapVoDataAsset.WalletBalance", v.WalletBalance)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *ManagedSubFuturesAccountSnapVoDataPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubFuturesAccountSnapVoDataPosition.EntryPrice", v.EntryPrice)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *ManagedSubFuturesAccountSnapVoDataPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubFuturesAccountSnapVoDataPosition.MarkPrice", v.MarkPrice)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *ManagedSubFuturesAccountSnapVoDataPosition) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubFuturesAccountSnapVoDataPosition.PositionAmt", v.PositionAmt)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *ManagedSubTransferHistoryVo) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ManagedSubTransferHistoryVo.Amount", v.Amount)
+}
+
+// TotalCollateralValueInUSDTDecimal returns TotalCollateralValueInUSDT as a decimal, zero if it is empty
+func (v *MarginAccount) TotalCollateralValueInUSDTDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginAccount.TotalCollateralValueInUSDT", v.TotalCollateralValueInUSDT)
+}
+
+// TotalLiabilityOfBTCDecimal returns TotalLiabilityOfBTC as a decimal, zero if it is empty
+func (v *MarginAccount) TotalLiabilityOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginAccount.TotalLiabilityOfBTC", v.TotalLiabilityOfBTC)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *MarginBorrowRepay) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginBorrowRepay.Amount", v.Amount)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *MarginBorrowRepay) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginBorrowRepay.Interest", v.Interest)
+}
+
+// PrincipalDecimal returns Principal as a decimal, zero if it is empty
+func (v *MarginBorrowRepay) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginBorrowRepay.Principal", v.Principal)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *MarginInterestHistoryRow) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginInterestHistoryRow.Interest", v.Interest)
+}
+
+// InterestRateDecimal returns InterestRate as a decimal, zero if it is empty
+func (v *MarginInterestHistoryRow) InterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginInterestHistoryRow.InterestRate", v.InterestRate)
+}
+
+// PrincipalDecimal returns Principal as a decimal, zero if it is empty
+func (v *MarginInterestHistoryRow) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginInterestHistoryRow.Principal", v.Principal)
+}
+
+// DailyInterestRateDecimal returns DailyInterestRate as a decimal, zero if it is empty
+func (v *MarginInterestRateHistoryElement) DailyInterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginInterestRateHistoryElement.DailyInterestRate", v.DailyInterestRate)
+}
+
+// PrincipalDecimal returns Principal as a decimal, zero if it is empty
+func (v *MarginLoan) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginLoan.Principal", v.Principal)
+}
+
+// NextHourlyInterestRateDecimal returns NextHourlyInterestRate as a decimal, zero if it is empty
+func (v *MarginNextHourlyInterestRateElement) NextHourlyInterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginNextHourlyInterestRateElement.NextHourlyInterestRate", v.NextHourlyInterestRate)
+}
+
+// CummulativeQuoteQuantityDecimal returns CummulativeQuoteQuantity as a decimal, zero if it is empty
+func (v *MarginOCOOrderReport) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOCOOrderReport.CummulativeQuoteQuantity", v.CummulativeQuoteQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *MarginOCOOrderReport) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOCOOrderReport.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *MarginOCOOrderReport) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOCOOrderReport.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *MarginOCOOrderReport) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOCOOrderReport.Price", v.Price)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *MarginOCOOrderReport) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOCOOrderReport.StopPrice", v.StopPrice)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *MarginPriceIndex) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginPriceIndex.Price", v.Price)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *MarginRepay) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginRepay.Amount", v.Amount)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *MarginRepay) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginRepay.Interest", v.Interest)
+}
+
+// PrincipalDecimal returns Principal as a decimal, zero if it is empty
+func (v *MarginRepay) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginRepay.Principal", v.Principal)
+}
+
+// TotalLiabilityOfBtcDecimal returns TotalLiabilityOfBtc as a decimal, zero if it is empty
+func (v *MarginSubAccount) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginSubAccount.TotalLiabilityOfBtc", v.TotalLiabilityOfBtc)
+}
+
+// MarginCallBarDecimal returns MarginCallBar as a decimal, zero if it is empty
+func (v *MarginTradeCoeffVo) MarginCallBarDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginTradeCoeffVo.MarginCallBar", v.MarginCallBar)
+}
+
+// BorrowedDecimal returns Borrowed as a decimal, zero if it is empty
+func (v *MarginUserAsset) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginUserAsset.Borrowed", v.Borrowed)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *MarginUserAsset) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginUserAsset.Free", v.Free)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *MarginUserAsset) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginUserAsset.Interest", v.Interest)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *MarginUserAsset) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginUserAsset.Locked", v.Locked)
+}
+
+// BorrowedDecimal returns Borrowed as a decimal, zero if it is empty
+func (v *MarginUserAssetVo) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginUserAssetVo.Borrowed", v.Borrowed)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *MarginUserAssetVo) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginUserAssetVo.Free", v.Free)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *MarginUserAssetVo) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginUserAssetVo.Interest", v.Interest)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *MarginUserAssetVo) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginUserAssetVo.Locked", v.Locked)
+}
+
+// MaxQuantityDecimal returns MaxQuantity as a decimal, zero if it is empty
+func (v *MarketLotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarketLotSizeFilter.MaxQuantity", v.MaxQuantity)
+}
+
+// MinQuantityDecimal returns MinQuantity as a decimal, zero if it is empty
+func (v *MarketLotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarketLotSizeFilter.MinQuantity", v.MinQuantity)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *MaxBorrowable) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MaxBorrowable.Amount", v.Amount)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *MaxTransferable) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MaxTransferable.Amount", v.Amount)
+}
+
+// WithdrawFeeDecimal returns WithdrawFee as a decimal, zero if it is empty
+func (v *Network) WithdrawFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Network.WithdrawFee", v.WithdrawFee)
+}
+
+// MaxNotionalDecimal returns MaxNotional as a decimal, zero if it is empty
+func (v *NotionalFilter) MaxNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("NotionalFilter.MaxNotional", v.MaxNotional)
+}
+
+// MinNotionalDecimal returns MinNotional as a decimal, zero if it is empty
+func (v *NotionalFilter) MinNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("NotionalFilter.MinNotional", v.MinNotional)
+}
+
+// CummulativeQuoteQuantityDecimal returns CummulativeQuoteQuantity as a decimal, zero if it is empty
+func (v *OCOOrderReport) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OCOOrderReport.CummulativeQuoteQuantity", v.CummulativeQuoteQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *OCOOrderReport) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OCOOrderReport.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// IcebergQuantityDecimal returns IcebergQuantity as a decimal, zero if it is empty
+func (v *OCOOrderReport) IcebergQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OCOOrderReport.IcebergQuantity", v.IcebergQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *OCOOrderReport) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OCOOrderReport.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *OCOOrderReport) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OCOOrderReport.Price", v.Price)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *OCOOrderReport) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OCOOrderReport.StopPrice", v.StopPrice)
+}
+
+// CummulativeQuoteQuantityDecimal returns CummulativeQuoteQuantity as a decimal, zero if it is empty
+func (v *Order) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.CummulativeQuoteQuantity", v.CummulativeQuoteQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *Order) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// IcebergQuantityDecimal returns IcebergQuantity as a decimal, zero if it is empty
+func (v *Order) IcebergQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.IcebergQuantity", v.IcebergQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *Order) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.OrigQuantity", v.OrigQuantity)
+}
+
+// OrigQuoteOrderQuantityDecimal returns OrigQuoteOrderQuantity as a decimal, zero if it is empty
+func (v *Order) OrigQuoteOrderQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.OrigQuoteOrderQuantity", v.OrigQuoteOrderQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *Order) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.Price", v.Price)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *Order) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.StopPrice", v.StopPrice)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *PayTradeItem) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PayTradeItem.Amount", v.Amount)
+}
+
+// ShareAmountDecimal returns ShareAmount as a decimal, zero if it is empty
+func (v *PoolShareInformation) ShareAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PoolShareInformation.ShareAmount", v.ShareAmount)
+}
+
+// AskPriceDecimal returns AskPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.AskPrice", v.AskPrice)
+}
+
+// AskQtyDecimal returns AskQty as a decimal, zero if it is empty
+func (v *PriceChangeStats) AskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.AskQty", v.AskQty)
+}
+
+// BidPriceDecimal returns BidPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.BidPrice", v.BidPrice)
+}
+
+// BidQtyDecimal returns BidQty as a decimal, zero if it is empty
+func (v *PriceChangeStats) BidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.BidQty", v.BidQty)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.HighPrice", v.HighPrice)
+}
+
+// LastPriceDecimal returns LastPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.LastPrice", v.LastPrice)
+}
+
+// LastQtyDecimal returns LastQty as a decimal, zero if it is empty
+func (v *PriceChangeStats) LastQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.LastQty", v.LastQty)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.OpenPrice", v.OpenPrice)
+}
+
+// PrevClosePriceDecimal returns PrevClosePrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) PrevClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.PrevClosePrice", v.PrevClosePrice)
+}
+
+// PriceChangeDecimal returns PriceChange as a decimal, zero if it is empty
+func (v *PriceChangeStats) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.PriceChange", v.PriceChange)
+}
+
+// PriceChangePercentDecimal returns PriceChangePercent as a decimal, zero if it is empty
+func (v *PriceChangeStats) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.PriceChangePercent", v.PriceChangePercent)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *PriceChangeStats) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.QuoteVolume", v.QuoteVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *PriceChangeStats) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.Volume", v.Volume)
+}
+
+// WeightedAvgPriceDecimal returns WeightedAvgPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.WeightedAvgPrice", v.WeightedAvgPrice)
+}
+
+// MaxPriceDecimal returns MaxPrice as a decimal, zero if it is empty
+func (v *PriceFilter) MaxPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceFilter.MaxPrice", v.MaxPrice)
+}
+
+// MinPriceDecimal returns MinPrice as a decimal, zero if it is empty
+func (v *PriceFilter) MinPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceFilter.MinPrice", v.MinPrice)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *SavingFixedProjectPosition) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFixedProjectPosition.Interest", v.Interest)
+}
+
+// InterestRateDecimal returns InterestRate as a decimal, zero if it is empty
+func (v *SavingFixedProjectPosition) InterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFixedProjectPosition.InterestRate", v.InterestRate)
+}
+
+// PrincipalDecimal returns Principal as a decimal, zero if it is empty
+func (v *SavingFixedProjectPosition) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFixedProjectPosition.Principal", v.Principal)
+}
+
+// AnnualInterestRateDecimal returns AnnualInterestRate as a decimal, zero if it is empty
+func (v *SavingFlexibleProductPosition) AnnualInterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFlexibleProductPosition.AnnualInterestRate", v.AnnualInterestRate)
+}
+
+// AvgAnnualInterestRateDecimal returns AvgAnnualInterestRate as a decimal, zero if it is empty
+func (v *SavingFlexibleProductPosition) AvgAnnualInterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFlexibleProductPosition.AvgAnnualInterestRate", v.AvgAnnualInterestRate)
+}
+
+// DailyInterestRateDecimal returns DailyInterestRate as a decimal, zero if it is empty
+func (v *SavingFlexibleProductPosition) DailyInterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFlexibleProductPosition.DailyInterestRate", v.DailyInterestRate)
+}
+
+// FreeAmountDecimal returns FreeAmount as a decimal, zero if it is empty
+func (v *SavingFlexibleProductPosition) FreeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFlexibleProductPosition.FreeAmount", v.FreeAmount)
+}
+
+// FreezeAmountDecimal returns FreezeAmount as a decimal, zero if it is empty
+func (v *SavingFlexibleProductPosition) FreezeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFlexibleProductPosition.FreezeAmount", v.FreezeAmount)
+}
+
+// LockedAmountDecimal returns LockedAmount as a decimal, zero if it is empty
+func (v *SavingFlexibleProductPosition) LockedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFlexibleProductPosition.LockedAmount", v.LockedAmount)
+}
+
+// RedeemingAmountDecimal returns RedeemingAmount as a decimal, zero if it is empty
+func (v *SavingFlexibleProductPosition) RedeemingAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFlexibleProductPosition.RedeemingAmount", v.RedeemingAmount)
+}
+
+// TotalAmountDecimal returns TotalAmount as a decimal, zero if it is empty
+func (v *SavingFlexibleProductPosition) TotalAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFlexibleProductPosition.TotalAmount", v.TotalAmount)
+}
+
+// TotalInterestDecimal returns TotalInterest as a decimal, zero if it is empty
+func (v *SavingFlexibleProductPosition) TotalInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFlexibleProductPosition.TotalInterest", v.TotalInterest)
+}
+
+// TotalPurchasedAmountDecimal returns TotalPurchasedAmount as a decimal, zero if it is empty
+func (v *SavingFlexibleProductPosition) TotalPurchasedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingFlexibleProductPosition.TotalPurchasedAmount", v.TotalPurchasedAmount)
+}
+
+// InterestPerLotDecimal returns InterestPerLot as a decimal, zero if it is empty
+func (v *SavingsFixedProduct) InterestPerLotDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingsFixedProduct.InterestPerLot", v.InterestPerLot)
+}
+
+// InterestRateDecimal returns InterestRate as a decimal, zero if it is empty
+func (v *SavingsFixedProduct) InterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingsFixedProduct.InterestRate", v.InterestRate)
+}
+
+// AvgAnnualInterestRateDecimal returns AvgAnnualInterestRate as a decimal, zero if it is empty
+func (v *SavingsFlexibleProduct) AvgAnnualInterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingsFlexibleProduct.AvgAnnualInterestRate", v.AvgAnnualInterestRate)
+}
+
+// DailyInterestPerThousandDecimal returns DailyInterestPerThousand as a decimal, zero if it is empty
+func (v *SavingsFlexibleProduct) DailyInterestPerThousandDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingsFlexibleProduct.DailyInterestPerThousand", v.DailyInterestPerThousand)
+}
+
+// MinPurchaseAmountDecimal returns MinPurchaseAmount as a decimal, zero if it is empty
+func (v *SavingsFlexibleProduct) MinPurchaseAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingsFlexibleProduct.MinPurchaseAmount", v.MinPurchaseAmount)
+}
+
+// PurchasedAmountDecimal returns PurchasedAmount as a decimal, zero if it is empty
+func (v *SavingsFlexibleProduct) PurchasedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SavingsFlexibleProduct.PurchasedAmount", v.PurchasedAmount)
+}
+
+// TotalAmountInBTCDecimal returns TotalAmountInBTC as a decimal, zero if it is empty
+func (v *SimpleEarnAccount) TotalAmountInBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnAccount.TotalAmountInBTC", v.TotalAmountInBTC)
+}
+
+// TotalAmountInUSDTDecimal returns TotalAmountInUSDT as a decimal, zero if it is empty
+func (v *SimpleEarnAccount) TotalAmountInUSDTDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnAccount.TotalAmountInUSDT", v.TotalAmountInUSDT)
+}
+
+// TotalFlexibleAmountInBTCDecimal returns TotalFlexibleAmountInBTC as a decimal, zero if it is empty
+func (v *SimpleEarnAccount) TotalFlexibleAmountInBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnAccount.TotalFlexibleAmountInBTC", v.TotalFlexibleAmountInBTC)
+}
+
+// TotalFlexibleAmountInUSDTDecimal returns TotalFlexibleAmountInUSDT as a decimal, zero if it is empty
+func (v *SimpleEarnAccount) TotalFlexibleAmountInUSDTDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnAccount.TotalFlexibleAmountInUSDT", v.TotalFlexibleAmountInUSDT)
+}
+
+// CollateralAmountDecimal returns CollateralAmount as a decimal, zero if it is empty
+func (v *SimpleEarnFlexiblePosition) CollateralAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnFlexiblePosition.CollateralAmount", v.CollateralAmount)
+}
+
+// LatestAnnualPercentageRateDecimal returns LatestAnnualPercentageRate as a decimal, zero if it is empty
+func (v *SimpleEarnFlexiblePosition) LatestAnnualPercentageRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnFlexiblePosition.LatestAnnualPercentageRate", v.LatestAnnualPercentageRate)
+}
+
+// TotalAmountDecimal returns TotalAmount as a decimal, zero if it is empty
+func (v *SimpleEarnFlexiblePosition) TotalAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnFlexiblePosition.TotalAmount", v.TotalAmount)
+}
+
+// YesterdayAirdropPercentageRateDecimal returns YesterdayAirdropPercentageRate as a decimal, zero if it is empty
+func (v *SimpleEarnFlexiblePosition) YesterdayAirdropPercentageRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnFlexiblePosition.YesterdayAirdropPercentageRate", v.YesterdayAirdropPercentageRate)
+}
+
+// AirDropPercentageRateDecimal returns AirDropPercentageRate as a decimal, zero if it is empty
+func (v *SimpleEarnFlexibleProduct) AirDropPercentageRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnFlexibleProduct.AirDropPercentageRate", v.AirDropPercentageRate)
+}
+
+// LatestAnnualPercentageRateDecimal returns LatestAnnualPercentageRate as a decimal, zero if it is empty
+func (v *SimpleEarnFlexibleProduct) LatestAnnualPercentageRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnFlexibleProduct.LatestAnnualPercentageRate", v.LatestAnnualPercentageRate)
+}
+
+// MinPurchaseAmountDecimal returns MinPurchaseAmount as a decimal, zero if it is empty
+func (v *SimpleEarnFlexibleProduct) MinPurchaseAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnFlexibleProduct.MinPurchaseAmount", v.MinPurchaseAmount)
+}
+
+// TotalAmountDecimal returns TotalAmount as a decimal, zero if it is empty
+func (v *SimpleEarnFlexibleSubscriptionPreviewResp) TotalAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnFlexibleSubscriptionPreviewResp.TotalAmount", v.TotalAmount)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *SimpleEarnLockedPosition) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnLockedPosition.Amount", v.Amount)
+}
+
+// EstExtraRewardAmtDecimal returns EstExtraRewardAmt as a decimal, zero if it is empty
+func (v *SimpleEarnLockedPosition) EstExtraRewardAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnLockedPosition.EstExtraRewardAmt", v.EstExtraRewardAmt)
+}
+
+// RedeemAmountEarlyDecimal returns RedeemAmountEarly as a decimal, zero if it is empty
+func (v *SimpleEarnLockedPosition) RedeemAmountEarlyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnLockedPosition.RedeemAmountEarly", v.RedeemAmountEarly)
+}
+
+// RedeemingAmtDecimal returns RedeemingAmt as a decimal, zero if it is empty
+func (v *SimpleEarnLockedPosition) RedeemingAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnLockedPosition.RedeemingAmt", v.RedeemingAmt)
+}
+
+// RewardAmtDecimal returns RewardAmt as a decimal, zero if it is empty
+func (v *SimpleEarnLockedPosition) RewardAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnLockedPosition.RewardAmt", v.RewardAmt)
+}
+
+// EstTotalExtraRewardAmtDecimal returns EstTotalExtraRewardAmt as a decimal, zero if it is empty
+func (v *SimpleEarnLockedSubscriptionPreviewResp) EstTotalExtraRewardAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnLockedSubscriptionPreviewResp.EstTotalExtraRewardAmt", v.EstTotalExtraRewardAmt)
+}
+
+// TotalRewardAmtDecimal returns TotalRewardAmt as a decimal, zero if it is empty
+func (v *SimpleEarnLockedSubscriptionPreviewResp) TotalRewardAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SimpleEarnLockedSubscriptionPreviewResp.TotalRewardAmt", v.TotalRewardAmt)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *SnapShotSpotBalance) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapShotSpotBalance.Free", v.Free)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *SnapShotSpotBalance) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapShotSpotBalance.Locked", v.Locked)
+}
+
+// MarginBalanceDecimal returns MarginBalance as a decimal, zero if it is empty
+func (v *SnapshotAssets) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotAssets.MarginBalance", v.MarginBalance)
+}
+
+// WalletBalanceDecimal returns WalletBalance as a decimal, zero if it is empty
+func (v *SnapshotAssets) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotAssets.WalletBalance", v.WalletBalance)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *SnapshotBalances) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotBalances.Free", v.Free)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *SnapshotBalances) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotBalances.Locked", v.Locked)
+}
+
+// TotalLiabilityOfBtcDecimal returns TotalLiabilityOfBtc as a decimal, zero if it is empty
+func (v *SnapshotData) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotData.TotalLiabilityOfBtc", v.TotalLiabilityOfBtc)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *SnapshotPositions) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotPositions.EntryPrice", v.EntryPrice)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *SnapshotPositions) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotPositions.MarkPrice", v.MarkPrice)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *SnapshotPositions) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotPositions.PositionAmt", v.PositionAmt)
+}
+
+// UnRealizedProfitDecimal returns UnRealizedProfit as a decimal, zero if it is empty
+func (v *SnapshotPositions) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotPositions.UnRealizedProfit", v.UnRealizedProfit)
+}
+
+// BorrowedDecimal returns Borrowed as a decimal, zero if it is empty
+func (v *SnapshotUserAssets) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotUserAssets.Borrowed", v.Borrowed)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *SnapshotUserAssets) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotUserAssets.Free", v.Free)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *SnapshotUserAssets) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotUserAssets.Interest", v.Interest)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *SnapshotUserAssets) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotUserAssets.Locked", v.Locked)
+}
+
+// TotalLiabilityOfBtcDecimal returns TotalLiabilityOfBtc as a decimal, zero if it is empty
+func (v *SnapshotVoData) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SnapshotVoData.TotalLiabilityOfBtc", v.TotalLiabilityOfBtc)
+}
+
+// CummulativeQuoteQtyDecimal returns CummulativeQuoteQty as a decimal, zero if it is empty
+func (v *SorOrderPlaceResult) CummulativeQuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SorOrderPlaceResult.CummulativeQuoteQty", v.CummulativeQuoteQty)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *SorOrderPlaceResult) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SorOrderPlaceResult.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *SorOrderPlaceResult) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SorOrderPlaceResult.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *SorOrderPlaceResult) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SorOrderPlaceResult.Price", v.Price)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *SpotRebateHistoryDataItem) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SpotRebateHistoryDataItem.Amount", v.Amount)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *StakingHistoryTransaction) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("StakingHistoryTransaction.Amount", v.Amount)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *StakingProductPosition) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("StakingProductPosition.Amount", v.Amount)
+}
+
+// EstimatedExtraRewardAmountDecimal returns EstimatedExtraRewardAmount as a decimal, zero if it is empty
+func (v *StakingProductPosition) EstimatedExtraRewardAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("StakingProductPosition.EstimatedExtraRewardAmount", v.EstimatedExtraRewardAmount)
+}
+
+// NextInterestPayDecimal returns NextInterestPay as a decimal, zero if it is empty
+func (v *StakingProductPosition) NextInterestPayDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("StakingProductPosition.NextInterestPay", v.NextInterestPay)
+}
+
+// RedeemAmountEarlyDecimal returns RedeemAmountEarly as a decimal, zero if it is empty
+func (v *StakingProductPosition) RedeemAmountEarlyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("StakingProductPosition.RedeemAmountEarly", v.RedeemAmountEarly)
+}
+
+// RedeemingAmountDecimal returns RedeemingAmount as a decimal, zero if it is empty
+func (v *StakingProductPosition) RedeemingAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("StakingProductPosition.RedeemingAmount", v.RedeemingAmount)
+}
+
+// RewardAmountDecimal returns RewardAmount as a decimal, zero if it is empty
+func (v *StakingProductPosition) RewardAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("StakingProductPosition.RewardAmount", v.RewardAmount)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *SubAccountAssetBalance) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountAssetBalance.Free", v.Free)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *SubAccountAssetBalance) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountAssetBalance.Locked", v.Locked)
+}
+
+// TotalMarginBalanceOfBTCDecimal returns TotalMarginBalanceOfBTC as a decimal, zero if it is empty
+func (v *SubAccountDeliveryAccountSummary) TotalMarginBalanceOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountDeliveryAccountSummary.TotalMarginBalanceOfBTC", v.TotalMarginBalanceOfBTC)
+}
+
+// TotalUnrealizedProfitOfBTCDecimal returns TotalUnrealizedProfitOfBTC as a decimal, zero if it is empty
+func (v *SubAccountDeliveryAccountSummary) TotalUnrealizedProfitOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountDeliveryAccountSummary.TotalUnrealizedProfitOfBTC", v.TotalUnrealizedProfitOfBTC)
+}
+
+// TotalWalletBalanceOfBTCDecimal returns TotalWalletBalanceOfBTC as a decimal, zero if it is empty
+func (v *SubAccountDeliveryAccountSummary) TotalWalletBalanceOfBTCDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountDeliveryAccountSummary.TotalWalletBalanceOfBTC", v.TotalWalletBalanceOfBTC)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *SubAccountDeliveryPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountDeliveryPosition.EntryPrice", v.EntryPrice)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *SubAccountDeliveryPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountDeliveryPosition.MarkPrice", v.MarkPrice)
+}
+
+// PositionAmountDecimal returns PositionAmount as a decimal, zero if it is empty
+func (v *SubAccountDeliveryPosition) PositionAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountDeliveryPosition.PositionAmount", v.PositionAmount)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *SubAccountDeliveryPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountDeliveryPosition.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *SubAccountDepositRecord) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountDepositRecord.Amount", v.Amount)
+}
+
+// MaxWithdrawAmountDecimal returns MaxWithdrawAmount as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccount) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccount.MaxWithdrawAmount", v.MaxWithdrawAmount)
+}
+
+// TotalInitialMarginDecimal returns TotalInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccount) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccount.TotalInitialMargin", v.TotalInitialMargin)
+}
+
+// TotalMaintenanceMarginDecimal returns TotalMaintenanceMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccount) TotalMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccount.TotalMaintenanceMargin", v.TotalMaintenanceMargin)
+}
+
+// TotalMarginBalanceDecimal returns TotalMarginBalance as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccount) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccount.TotalMarginBalance", v.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal returns TotalOpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccount) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccount.TotalOpenOrderInitialMargin", v.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal returns TotalPositionInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccount) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccount.TotalPositionInitialMargin", v.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal returns TotalUnrealizedProfit as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccount) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccount.TotalUnrealizedProfit", v.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal returns TotalWalletBalance as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccount) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccount.TotalWalletBalance", v.TotalWalletBalance)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountAsset.InitialMargin", v.InitialMargin)
+}
+
+// MaintenanceMarginDecimal returns MaintenanceMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountAsset) MaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountAsset.MaintenanceMargin", v.MaintenanceMargin)
+}
+
+// MarginBalanceDecimal returns MarginBalance as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountAsset.MarginBalance", v.MarginBalance)
+}
+
+// MaxWithdrawAmountDecimal returns MaxWithdrawAmount as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountAsset) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountAsset.MaxWithdrawAmount", v.MaxWithdrawAmount)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountAsset.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountAsset.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountAsset) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountAsset.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// WalletBalanceDecimal returns WalletBalance as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountAsset.WalletBalance", v.WalletBalance)
+}
+
+// TotalInitialMarginDecimal returns TotalInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountSummary) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountSummary.TotalInitialMargin", v.TotalInitialMargin)
+}
+
+// TotalMaintenanceMarginDecimal returns TotalMaintenanceMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountSummary) TotalMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountSummary.TotalMaintenanceMargin", v.TotalMaintenanceMargin)
+}
+
+// TotalMarginBalanceDecimal returns TotalMarginBalance as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountSummary) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountSummary.TotalMarginBalance", v.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal returns TotalOpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountSummary) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountSummary.TotalOpenOrderInitialMargin", v.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal returns TotalPositionInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountSummary) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountSummary.TotalPositionInitialMargin", v.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal returns TotalUnrealizedProfit as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountSummary) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountSummary.TotalUnrealizedProfit", v.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal returns TotalWalletBalance as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountSummary) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountSummary.TotalWalletBalance", v.TotalWalletBalance)
+}
+
+// MaxWithdrawAmountDecimal returns MaxWithdrawAmount as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountV2) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountV2.MaxWithdrawAmount", v.MaxWithdrawAmount)
+}
+
+// TotalInitialMarginDecimal returns TotalInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountV2) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountV2.TotalInitialMargin", v.TotalInitialMargin)
+}
+
+// TotalMaintenanceMarginDecimal returns TotalMaintenanceMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountV2) TotalMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountV2.TotalMaintenanceMargin", v.TotalMaintenanceMargin)
+}
+
+// TotalMarginBalanceDecimal returns TotalMarginBalance as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountV2) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountV2.TotalMarginBalance", v.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal returns TotalOpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountV2) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountV2.TotalOpenOrderInitialMargin", v.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal returns TotalPositionInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountV2) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountV2.TotalPositionInitialMargin", v.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal returns TotalUnrealizedProfit as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountV2) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountV2.TotalUnrealizedProfit", v.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal returns TotalWalletBalance as a decimal, zero if it is empty
+func (v *SubAccountFuturesAccountV2) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesAccountV2.TotalWalletBalance", v.TotalWalletBalance)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *SubAccountFuturesPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesPosition.EntryPrice", v.EntryPrice)
+}
+
+// LiquidationPriceDecimal returns LiquidationPrice as a decimal, zero if it is empty
+func (v *SubAccountFuturesPosition) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesPosition.LiquidationPrice", v.LiquidationPrice)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *SubAccountFuturesPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesPosition.MarkPrice", v.MarkPrice)
+}
+
+// MaxNotionalDecimal returns MaxNotional as a decimal, zero if it is empty
+func (v *SubAccountFuturesPosition) MaxNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesPosition.MaxNotional", v.MaxNotional)
+}
+
+// PositionAmountDecimal returns PositionAmount as a decimal, zero if it is empty
+func (v *SubAccountFuturesPosition) PositionAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesPosition.PositionAmount", v.PositionAmount)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *SubAccountFuturesPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesPosition.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// TotalInitialMarginDecimal returns TotalInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesSummaryCommon) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesSummaryCommon.TotalInitialMargin", v.TotalInitialMargin)
+}
+
+// TotalMaintenanceMarginDecimal returns TotalMaintenanceMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesSummaryCommon) TotalMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesSummaryCommon.TotalMaintenanceMargin", v.TotalMaintenanceMargin)
+}
+
+// TotalMarginBalanceDecimal returns TotalMarginBalance as a decimal, zero if it is empty
+func (v *SubAccountFuturesSummaryCommon) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesSummaryCommon.TotalMarginBalance", v.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal returns TotalOpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesSummaryCommon) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesSummaryCommon.TotalOpenOrderInitialMargin", v.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal returns TotalPositionInitialMargin as a decimal, zero if it is empty
+func (v *SubAccountFuturesSummaryCommon) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesSummaryCommon.TotalPositionInitialMargin", v.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal returns TotalUnrealizedProfit as a decimal, zero if it is empty
+func (v *SubAccountFuturesSummaryCommon) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesSummaryCommon.TotalUnrealizedProfit", v.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal returns TotalWalletBalance as a decimal, zero if it is empty
+func (v *SubAccountFuturesSummaryCommon) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesSummaryCommon.TotalWalletBalance", v.TotalWalletBalance)
+}
+
+// QtyDecimal returns Qty as a decimal, zero if it is empty
+func (v *SubAccountFuturesTransfer) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountFuturesTransfer.Qty", v.Qty)
+}
+
+// TotalLiabilityOfBtcDecimal returns TotalLiabilityOfBtc as a decimal, zero if it is empty
+func (v *SubAccountMarginAccountInfo) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountMarginAccountInfo.TotalLiabilityOfBtc", v.TotalLiabilityOfBtc)
+}
+
+// TotalLiabilityOfBtcDecimal returns TotalLiabilityOfBtc as a decimal, zero if it is empty
+func (v *SubAccountMarginAccountSummary) TotalLiabilityOfBtcDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountMarginAccountSummary.TotalLiabilityOfBtc", v.TotalLiabilityOfBtc)
+}
+
+// QtyDecimal returns Qty as a decimal, zero if it is empty
+func (v *SubAccountSpotTransfer) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountSpotTransfer.Qty", v.Qty)
+}
+
+// Recent30BtcMarginTotalDecimal returns Recent30BtcMarginTotal as a decimal, zero if it is empty
+func (v *SubAccountTransactionStatisticServiceResponse) Recent30BtcMarginTotalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountTransactionStatisticServiceResponse.Recent30BtcMarginTotal", v.Recent30BtcMarginTotal)
+}
+
+// Recent30BusdMarginTotalDecimal returns Recent30BusdMarginTotal as a decimal, zero if it is empty
+func (v *SubAccountTransactionStatisticServiceResponse) Recent30BusdMarginTotalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountTransactionStatisticServiceResponse.Recent30BusdMarginTotal", v.Recent30BusdMarginTotal)
+}
+
+// QtyDecimal returns Qty as a decimal, zero if it is empty
+func (v *SubAccountTransferHistory) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountTransferHistory.Qty", v.Qty)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *SubAccountUniversalTransferRecord) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubAccountUniversalTransferRecord.Amount", v.Amount)
+}
+
+// StrikePriceDecimal returns StrikePrice as a decimal, zero if it is empty
+func (v *SubscribeDualInvestmentResp) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubscribeDualInvestmentResp.StrikePrice", v.StrikePrice)
+}
+
+// SubscriptionAmountDecimal returns SubscriptionAmount as a decimal, zero if it is empty
+func (v *SubscribeDualInvestmentResp) SubscriptionAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SubscribeDualInvestmentResp.SubscriptionAmount", v.SubscriptionAmount)
+}
+
+// BaseQtyDecimal returns BaseQty as a decimal, zero if it is empty
+func (v *SwapRecord) BaseQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SwapRecord.BaseQty", v.BaseQty)
+}
+
+// FeeDecimal returns Fee as a decimal, zero if it is empty
+func (v *SwapRecord) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SwapRecord.Fee", v.Fee)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *SwapRecord) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SwapRecord.Price", v.Price)
+}
+
+// QuoteQtyDecimal returns QuoteQty as a decimal, zero if it is empty
+func (v *SwapRecord) QuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SwapRecord.QuoteQty", v.QuoteQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *SymbolPrice) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolPrice.Price", v.Price)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *SymbolTicker) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolTicker.HighPrice", v.HighPrice)
+}
+
+// LastPriceDecimal returns LastPrice as a decimal, zero if it is empty
+func (v *SymbolTicker) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolTicker.LastPrice", v.LastPrice)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *SymbolTicker) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolTicker.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *SymbolTicker) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolTicker.OpenPrice", v.OpenPrice)
+}
+
+// PriceChangeDecimal returns PriceChange as a decimal, zero if it is empty
+func (v *SymbolTicker) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolTicker.PriceChange", v.PriceChange)
+}
+
+// PriceChangePercentDecimal returns PriceChangePercent as a decimal, zero if it is empty
+func (v *SymbolTicker) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolTicker.PriceChangePercent", v.PriceChangePercent)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *SymbolTicker) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolTicker.QuoteVolume", v.QuoteVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *SymbolTicker) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolTicker.Volume", v.Volume)
+}
+
+// WeightedAvgPriceDecimal returns WeightedAvgPrice as a decimal, zero if it is empty
+func (v *SymbolTicker) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolTicker.WeightedAvgPrice", v.WeightedAvgPrice)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *Trade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *Trade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.Quantity", v.Quantity)
+}
+
+// QuoteQuantityDecimal returns QuoteQuantity as a decimal, zero if it is empty
+func (v *Trade) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.QuoteQuantity", v.QuoteQuantity)
+}
+
+// MakerCommissionDecimal returns MakerCommission as a decimal, zero if it is empty
+func (v *TradeFeeDetails) MakerCommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradeFeeDetails.MakerCommission", v.MakerCommission)
+}
+
+// TakerCommissionDecimal returns TakerCommission as a decimal, zero if it is empty
+func (v *TradeFeeDetails) TakerCommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradeFeeDetails.TakerCommission", v.TakerCommission)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *TradeV3) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradeV3.Commission", v.Commission)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *TradeV3) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradeV3.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *TradeV3) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradeV3.Quantity", v.Quantity)
+}
+
+// QuoteQuantityDecimal returns QuoteQuantity as a decimal, zero if it is empty
+func (v *TradeV3) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradeV3.QuoteQuantity", v.QuoteQuantity)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *TradingDayTicker) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradingDayTicker.HighPrice", v.HighPrice)
+}
+
+// LastPriceDecimal returns LastPrice as a decimal, zero if it is empty
+func (v *TradingDayTicker) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradingDayTicker.LastPrice", v.LastPrice)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *TradingDayTicker) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradingDayTicker.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *TradingDayTicker) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradingDayTicker.OpenPrice", v.OpenPrice)
+}
+
+// PriceChangeDecimal returns PriceChange as a decimal, zero if it is empty
+func (v *TradingDayTicker) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradingDayTicker.PriceChange", v.PriceChange)
+}
+
+// PriceChangePercentDecimal returns PriceChangePercent as a decimal, zero if it is empty
+func (v *TradingDayTicker) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradingDayTicker.PriceChangePercent", v.PriceChangePercent)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *TradingDayTicker) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradingDayTicker.QuoteVolume", v.QuoteVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *TradingDayTicker) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradingDayTicker.Volume", v.Volume)
+}
+
+// WeightedAvgPriceDecimal returns WeightedAvgPrice as a decimal, zero if it is empty
+func (v *TradingDayTicker) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradingDayTicker.WeightedAvgPrice", v.WeightedAvgPrice)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *UiKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UiKline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *UiKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UiKline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *UiKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UiKline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *UiKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UiKline.Open", v.Open)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *UiKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UiKline.QuoteVolume", v.QuoteVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal returns TakerBuyBaseAssetVolume as a decimal, zero if it is empty
+func (v *UiKline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UiKline.TakerBuyBaseAssetVolume", v.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal returns TakerBuyQuoteAssetVolume as a decimal, zero if it is empty
+func (v *UiKline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UiKline.TakerBuyQuoteAssetVolume", v.TakerBuyQuoteAssetVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *UiKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UiKline.Volume", v.Volume)
+}
+
+// BorrowedDecimal returns Borrowed as a decimal, zero if it is empty
+func (v *UserAsset) BorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserAsset.Borrowed", v.Borrowed)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *UserAsset) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserAsset.Free", v.Free)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *UserAsset) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserAsset.Interest", v.Interest)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *UserAsset) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserAsset.Locked", v.Locked)
+}
+
+// TotalServiceChargeAmountDecimal returns TotalServiceChargeAmount as a decimal, zero if it is empty
+func (v *UserAssetDribblet) TotalServiceChargeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserAssetDribblet.TotalServiceChargeAmount", v.TotalServiceChargeAmount)
+}
+
+// TotalTransferedAmountDecimal returns TotalTransferedAmount as a decimal, zero if it is empty
+func (v *UserAssetDribblet) TotalTransferedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserAssetDribblet.TotalTransferedAmount", v.TotalTransferedAmount)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *UserAssetDribbletDetail) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserAssetDribbletDetail.Amount", v.Amount)
+}
+
+// ServiceChargeAmountDecimal returns ServiceChargeAmount as a decimal, zero if it is empty
+func (v *UserAssetDribbletDetail) ServiceChargeAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserAssetDribbletDetail.ServiceChargeAmount", v.ServiceChargeAmount)
+}
+
+// TransferedAmountDecimal returns TransferedAmount as a decimal, zero if it is empty
+func (v *UserAssetDribbletDetail) TransferedAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserAssetDribbletDetail.TransferedAmount", v.TransferedAmount)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *UserAssetRecord) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserAssetRecord.Free", v.Free)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *UserAssetRecord) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserAssetRecord.Locked", v.Locked)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *UserUniversalTransfer) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserUniversalTransfer.Amount", v.Amount)
+}
+
+// BalanceDecimal returns Balance as a decimal, zero if it is empty
+func (v *WalletBalance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WalletBalance.Balance", v.Balance)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *Withdraw) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Withdraw.Amount", v.Amount)
+}
+
+// TransactionFeeDecimal returns TransactionFee as a decimal, zero if it is empty
+func (v *Withdraw) TransactionFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Withdraw.TransactionFee", v.TransactionFee)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *WsAccountUpdate) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAccountUpdate.Free", v.Free)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *WsAccountUpdate) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAccountUpdate.Locked", v.Locked)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsAggTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAggTradeEvent.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *WsAggTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAggTradeEvent.Quantity", v.Quantity)
+}
+
+// BestAskPriceDecimal returns BestAskPrice as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestAskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestAskPrice", v.BestAskPrice)
+}
+
+// BestAskQtyDecimal returns BestAskQty as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestAskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestAskQty", v.BestAskQty)
+}
+
+// BestBidPriceDecimal returns BestBidPrice as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestBidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestBidPrice", v.BestBidPrice)
+}
+
+// BestBidQtyDecimal returns BestBidQty as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestBidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestBidQty", v.BestBidQty)
+}
+
+// ActiveBuyQuoteVolumeDecimal returns ActiveBuyQuoteVolume as a decimal, zero if it is empty
+func (v *WsKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.ActiveBuyQuoteVolume", v.ActiveBuyQuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal returns ActiveBuyVolume as a decimal, zero if it is empty
+func (v *WsKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.ActiveBuyVolume", v.ActiveBuyVolume)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *WsKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *WsKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *WsKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *WsKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Open", v.Open)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.QuoteVolume", v.QuoteVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *WsKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Volume", v.Volume)
+}
+
+// AskPriceDecimal returns AskPrice as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.AskPrice", v.AskPrice)
+}
+
+// AskQtyDecimal returns AskQty as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) AskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.AskQty", v.AskQty)
+}
+
+// BaseVolumeDecimal returns BaseVolume as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) BaseVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.BaseVolume", v.BaseVolume)
+}
+
+// BidPriceDecimal returns BidPrice as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.BidPrice", v.BidPrice)
+}
+
+// BidQtyDecimal returns BidQty as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) BidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.BidQty", v.BidQty)
+}
+
+// CloseQtyDecimal returns CloseQty as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) CloseQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.CloseQty", v.CloseQty)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.HighPrice", v.HighPrice)
+}
+
+// LastPriceDecimal returns LastPrice as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.LastPrice", v.LastPrice)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.OpenPrice", v.OpenPrice)
+}
+
+// PrevClosePriceDecimal returns PrevClosePrice as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) PrevClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.PrevClosePrice", v.PrevClosePrice)
+}
+
+// PriceChangeDecimal returns PriceChange as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.PriceChange", v.PriceChange)
+}
+
+// PriceChangePercentDecimal returns PriceChangePercent as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.PriceChangePercent", v.PriceChangePercent)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.QuoteVolume", v.QuoteVolume)
+}
+
+// WeightedAvgPriceDecimal returns WeightedAvgPrice as a decimal, zero if it is empty
+func (v *WsMarketStatEvent) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketStatEvent.WeightedAvgPrice", v.WeightedAvgPrice)
+}
+
+// BaseVolumeDecimal returns BaseVolume as a decimal, zero if it is empty
+func (v *WsMiniMarketsStatEvent) BaseVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketsStatEvent.BaseVolume", v.BaseVolume)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *WsMiniMarketsStatEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketsStatEvent.HighPrice", v.HighPrice)
+}
+
+// LastPriceDecimal returns LastPrice as a decimal, zero if it is empty
+func (v *WsMiniMarketsStatEvent) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketsStatEvent.LastPrice", v.LastPrice)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *WsMiniMarketsStatEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketsStatEvent.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *WsMiniMarketsStatEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketsStatEvent.OpenPrice", v.OpenPrice)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsMiniMarketsStatEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketsStatEvent.QuoteVolume", v.QuoteVolume)
+}
+
+// FeeCostDecimal returns FeeCost as a decimal, zero if it is empty
+func (v *WsOrderUpdate) FeeCostDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.FeeCost", v.FeeCost)
+}
+
+// FilledQuoteVolumeDecimal returns FilledQuoteVolume as a decimal, zero if it is empty
+func (v *WsOrderUpdate) FilledQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.FilledQuoteVolume", v.FilledQuoteVolume)
+}
+
+// FilledVolumeDecimal returns FilledVolume as a decimal, zero if it is empty
+func (v *WsOrderUpdate) FilledVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.FilledVolume", v.FilledVolume)
+}
+
+// IceBergVolumeDecimal returns IceBergVolume as a decimal, zero if it is empty
+func (v *WsOrderUpdate) IceBergVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.IceBergVolume", v.IceBergVolume)
+}
+
+// LastPreventedQuantityDecimal returns LastPreventedQuantity as a decimal, zero if it is empty
+func (v *WsOrderUpdate) LastPreventedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.LastPreventedQuantity", v.LastPreventedQuantity)
+}
+
+// LatestPriceDecimal returns LatestPrice as a decimal, zero if it is empty
+func (v *WsOrderUpdate) LatestPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.LatestPrice", v.LatestPrice)
+}
+
+// LatestQuoteVolumeDecimal returns LatestQuoteVolume as a decimal, zero if it is empty
+func (v *WsOrderUpdate) LatestQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.LatestQuoteVolume", v.LatestQuoteVolume)
+}
+
+// LatestVolumeDecimal returns LatestVolume as a decimal, zero if it is empty
+func (v *WsOrderUpdate) LatestVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.LatestVolume", v.LatestVolume)
+}
+
+// PreventedExecutionPriceDecimal returns PreventedExecutionPrice as a decimal, zero if it is empty
+func (v *WsOrderUpdate) PreventedExecutionPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.PreventedExecutionPrice", v.PreventedExecutionPrice)
+}
+
+// PreventedExecutionQuantityDecimal returns PreventedExecutionQuantity as a decimal, zero if it is empty
+func (v *WsOrderUpdate) PreventedExecutionQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.PreventedExecutionQuantity", v.PreventedExecutionQuantity)
+}
+
+// PreventedExecutionQuoteQtyDecimal returns PreventedExecutionQuoteQty as a decimal, zero if it is empty
+func (v *WsOrderUpdate) PreventedExecutionQuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.PreventedExecutionQuoteQty", v.PreventedExecutionQuoteQty)
+}
+
+// PreventedQuantityDecimal returns PreventedQuantity as a decimal, zero if it is empty
+func (v *WsOrderUpdate) PreventedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.PreventedQuantity", v.PreventedQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsOrderUpdate) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.Price", v.Price)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsOrderUpdate) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.QuoteVolume", v.QuoteVolume)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *WsOrderUpdate) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.StopPrice", v.StopPrice)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *WsOrderUpdate) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderUpdate.Volume", v.Volume)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTradeEvent.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *WsTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTradeEvent.Quantity", v.Quantity)
+}
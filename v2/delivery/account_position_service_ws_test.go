@@ -0,0 +1,118 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *accountPositionServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+
+	s.requestID = "233b0b2c-6d18-4a0c-a8a3-0d6f8d0e5a47"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.accountPosition = &AccountPositionWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.accountPositionRequest = NewAccountPositionWsRequest().
+		Pair("BTCUSD")
+}
+
+func (s *accountPositionServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type accountPositionServiceWsTestSuite struct {
+	suite.Suite
+	apiKey    string
+	secretKey string
+	signedKey string
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	accountPosition        *AccountPositionWsService
+	accountPositionRequest *AccountPositionWsRequest
+}
+
+func TestAccountPositionServiceWs(t *testing.T) {
+	suite.Run(t, new(accountPositionServiceWsTestSuite))
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPosition() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(requestID string, data []byte) error {
+		req := websocket.WsApiRequest{}
+		s.Require().NoError(json.Unmarshal(data, &req))
+		s.Equal(websocket.AccountPositionDeliveryWsApiMethod, req.Method)
+		s.Equal("BTCUSD", req.Params["pair"])
+		s.Equal(s.apiKey, req.Params["apiKey"])
+		s.Contains(req.Params, "signature")
+		return nil
+	}).Times(1)
+
+	err := s.accountPosition.Do(s.requestID, s.accountPositionRequest)
+	s.NoError(err)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPosition_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.accountPosition.Do("", s.accountPositionRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPosition_EmptyApiKey() {
+	s.accountPosition.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.accountPosition.Do(s.requestID, s.accountPositionRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPositionSync() {
+	rawResponseData := []byte(`{"id":"233b0b2c-6d18-4a0c-a8a3-0d6f8d0e5a47","status":200,"result":[{"symbol":"BTCUSD_PERP","positionAmt":"1","entryPrice":"5000","markPrice":"5100","unRealizedProfit":"0.00000392","liquidationPrice":"0","leverage":"20","maxQty":"100","marginType":"cross","isolatedMargin":"0","isAutoAddMargin":"false","positionSide":"BOTH","notionalValue":"0.00196078","isolatedWallet":"0","updateTime":1612246150436}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.accountPosition.SyncDo(s.requestID, s.accountPositionRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal("BTCUSD_PERP", response.Result[0].Symbol)
+	s.Equal("1", response.Result[0].PositionAmt)
+	s.Equal("5100", response.Result[0].MarkPrice)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPositionSync_Error() {
+	rawResponseData := []byte(`{"id":"233b0b2c-6d18-4a0c-a8a3-0d6f8d0e5a47","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.accountPosition.SyncDo(s.requestID, s.accountPositionRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPositionSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.accountPosition.SyncDo(s.requestID, s.accountPositionRequest)
+	s.Nil(response)
+	s.Error(err)
+}
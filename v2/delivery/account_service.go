@@ -31,6 +31,8 @@ func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // Balance define user balance of your account
+//
+//decimalgen:accessors
 type Balance struct {
 	AccountAlias       string `json:"accountAlias"`
 	Asset              string `json:"asset"`
@@ -78,6 +80,8 @@ type Account struct {
 }
 
 // AccountAsset define account asset
+//
+//decimalgen:accessors
 type AccountAsset struct {
 	Asset                  string `json:"asset"`
 	WalletBalance          string `json:"walletBalance"`
@@ -94,6 +98,8 @@ type AccountAsset struct {
 }
 
 // AccountPosition define account position
+//
+//decimalgen:accessors
 type AccountPosition struct {
 	Symbol                 string `json:"symbol"`
 	PositionAmt            string `json:"positionAmt"`
@@ -0,0 +1,226 @@
+package delivery
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/google/uuid"
+)
+
+// WsAccountService queries account information and balances
+type WsAccountService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
+	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	RecvWindow int64
+	signers    common.SignerCache
+}
+
+// NewWsAccountService init WsAccountService, recvWindow defaults to 5000. Set TimeSync to sign its requests with a
+// time sync.
+func NewWsAccountService(apiKey, secretKey string, recvWindow ...int64) (*WsAccountService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	window := int64(5000)
+	if len(recvWindow) > 0 {
+		window = recvWindow[0]
+	}
+
+	return &WsAccountService{
+		c:          client,
+		ApiKey:     apiKey,
+		SecretKey:  secretKey,
+		KeyType:    common.KeyTypeHmac,
+		RecvWindow: window,
+	}, nil
+}
+
+// WsAccountInfoResponse define 'account.status' websocket API response
+type WsAccountInfoResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    Account            `json:"result"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// WsAccountBalanceResponse define 'account.balance' websocket API response
+type WsAccountBalanceResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    []*Balance         `json:"result"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// AccountRateLimit define rate limit usage of a websocket API response
+type AccountRateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int    `json:"intervalNum"`
+	Limit         int    `json:"limit"`
+	Count         int    `json:"count"`
+}
+
+// GetAccountInfo sends 'account.status' request
+func (s *WsAccountService) GetAccountInfo(requestID string) error {
+	rawData, err := s.buildRequest(requestID, websocket.AccountStatusDeliveryWsApiMethod)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncGetAccountInfo sends 'account.status' request and receives response
+func (s *WsAccountService) SyncGetAccountInfo(requestID string) (*WsAccountInfoResponse, error) {
+	rawData, err := s.buildRequest(requestID, websocket.AccountStatusDeliveryWsApiMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &WsAccountInfoResponse{}
+	if err := json.Unmarshal(response, info); err != nil {
+		return nil, err
+	}
+	if info.Error != nil {
+		info.Error.StatusCode = info.Status
+		s.TimeSync.CheckError(info.Error)
+		return nil, info.Error
+	}
+
+	return info, nil
+}
+
+// GetAccountBalance sends 'account.balance' request
+func (s *WsAccountService) GetAccountBalance(requestID string) error {
+	rawData, err := s.buildRequest(requestID, websocket.AccountBalanceDeliveryWsApiMethod)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncGetAccountBalance sends 'account.balance' request and receives response
+func (s *WsAccountService) SyncGetAccountBalance(requestID string) (*WsAccountBalanceResponse, error) {
+	rawData, err := s.buildRequest(requestID, websocket.AccountBalanceDeliveryWsApiMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := &WsAccountBalanceResponse{}
+	if err := json.Unmarshal(response, balance); err != nil {
+		return nil, err
+	}
+	if balance.Error != nil {
+		balance.Error.StatusCode = balance.Status
+		s.TimeSync.CheckError(balance.Error)
+		return nil, balance.Error
+	}
+
+	return balance, nil
+}
+
+func (s *WsAccountService) buildRequest(requestID string, method websocket.WsApiMethodType) ([]byte, error) {
+	return websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers),
+		method,
+		map[string]any{
+			"recvWindow": s.RecvWindow,
+		},
+	)
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *WsAccountService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *WsAccountService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *WsAccountService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *WsAccountService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// NewWsAccountService init WsAccountService with the keys of the client
+func (c *Client) NewWsAccountService(recvWindow ...int64) (*WsAccountService, error) {
+	service, err := NewWsAccountService(c.APIKey, c.SecretKey, recvWindow...)
+	if err != nil {
+		return nil, err
+	}
+	if c.KeyType != "" {
+		service.KeyType = c.KeyType
+	}
+	service.TimeOffset, service.TimeSync = c.TimeOffset, c.TimeSync
+	return service, nil
+}
+
+// GetAccountInfoWs Get account info by websocket like RESTful
+func (c *Client) GetAccountInfoWs(recvWindow ...int64) (*WsAccountInfoResponse, error) {
+	service, err := c.NewWsAccountService(recvWindow...)
+	if err != nil {
+		return nil, err
+	}
+	defer service.c.Close()
+
+	return service.SyncGetAccountInfo(uuid.New().String())
+}
+
+// GetAccountBalanceWs Get account balance by websocket like RESTful
+func (c *Client) GetAccountBalanceWs(recvWindow ...int64) (*WsAccountBalanceResponse, error) {
+	service, err := c.NewWsAccountService(recvWindow...)
+	if err != nil {
+		return nil, err
+	}
+	defer service.c.Close()
+
+	return service.SyncGetAccountBalance(uuid.New().String())
+}
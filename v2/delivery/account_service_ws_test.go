@@ -0,0 +1,154 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *accountServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+
+	s.requestID = "605a6d20-6588-4cb9-afa0-b0ab087507ba"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.account = &WsAccountService{
+		c:          s.client,
+		ApiKey:     s.apiKey,
+		SecretKey:  s.secretKey,
+		KeyType:    s.signedKey,
+		RecvWindow: 5000,
+	}
+}
+
+func (s *accountServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type accountServiceWsTestSuite struct {
+	suite.Suite
+	apiKey    string
+	secretKey string
+	signedKey string
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	account *WsAccountService
+}
+
+func TestAccountServiceWs(t *testing.T) {
+	suite.Run(t, new(accountServiceWsTestSuite))
+}
+
+func (s *accountServiceWsTestSuite) assertRequest(method websocket.WsApiMethodType) func(requestID string, data []byte) error {
+	return func(requestID string, data []byte) error {
+		req := websocket.WsApiRequest{}
+		s.Require().NoError(json.Unmarshal(data, &req))
+		s.Equal(method, req.Method)
+		s.Equal(float64(5000), req.Params["recvWindow"])
+		s.Equal(s.apiKey, req.Params["apiKey"])
+		s.Contains(req.Params, "signature")
+		return nil
+	}
+}
+
+func (s *accountServiceWsTestSuite) TestGetAccountInfo() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).
+		DoAndReturn(s.assertRequest(websocket.AccountStatusDeliveryWsApiMethod)).Times(1)
+
+	err := s.account.GetAccountInfo(s.requestID)
+	s.NoError(err)
+}
+
+func (s *accountServiceWsTestSuite) TestGetAccountInfo_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.account.GetAccountInfo("")
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *accountServiceWsTestSuite) TestSyncGetAccountInfo() {
+	rawResponseData := []byte(`{"id":"605a6d20-6588-4cb9-afa0-b0ab087507ba","status":200,"result":{"assets":[{"asset":"BTC","walletBalance":"0.00241969","unrealizedProfit":"0.00000000","marginBalance":"0.00241969","maintMargin":"0.00000000","initialMargin":"0.00000000","positionInitialMargin":"0.00000000","openOrderInitialMargin":"0.00000000","maxWithdrawAmount":"0.00241969","crossWalletBalance":"0.00241969","crossUnPnl":"0.00000000","availableBalance":"0.00241969"}],"positions":[],"canDeposit":true,"canTrade":true,"canWithdraw":true,"feeTier":2,"updateTime":0},"rateLimits":[{"rateLimitType":"REQUEST_WEIGHT","interval":"MINUTE","intervalNum":1,"limit":2400,"count":10}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.account.SyncGetAccountInfo(s.requestID)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result.Assets, 1)
+	s.Equal("BTC", response.Result.Assets[0].Asset)
+	s.Equal("0.00241969", response.Result.Assets[0].WalletBalance)
+	s.Equal(2, response.Result.FeeTier)
+	s.True(response.Result.CanTrade)
+	s.Equal([]AccountRateLimit{{
+		RateLimitType: "REQUEST_WEIGHT",
+		Interval:      "MINUTE",
+		IntervalNum:   1,
+		Limit:         2400,
+		Count:         10,
+	}}, response.RateLimit)
+}
+
+func (s *accountServiceWsTestSuite) TestSyncGetAccountInfo_Error() {
+	rawResponseData := []byte(`{"id":"605a6d20-6588-4cb9-afa0-b0ab087507ba","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.account.SyncGetAccountInfo(s.requestID)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *accountServiceWsTestSuite) TestGetAccountBalance() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).
+		DoAndReturn(s.assertRequest(websocket.AccountBalanceDeliveryWsApiMethod)).Times(1)
+
+	err := s.account.GetAccountBalance(s.requestID)
+	s.NoError(err)
+}
+
+func (s *accountServiceWsTestSuite) TestGetAccountBalance_EmptyApiKey() {
+	s.account.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.account.GetAccountBalance(s.requestID)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *accountServiceWsTestSuite) TestSyncGetAccountBalance() {
+	rawResponseData := []byte(`{"id":"605a6d20-6588-4cb9-afa0-b0ab087507ba","status":200,"result":[{"accountAlias":"SgsR","asset":"BTC","balance":"0.00250000","withdrawAvailable":"0.00250000","crossWalletBalance":"0.00241969","crossUnPnl":"0.00000000","availableBalance":"0.00241969","updateTime":1592468353979}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.account.SyncGetAccountBalance(s.requestID)
+	s.Require().NoError(err)
+	s.Equal([]*Balance{{
+		AccountAlias:       "SgsR",
+		Asset:              "BTC",
+		Balance:            "0.00250000",
+		WithdrawAvailable:  "0.00250000",
+		CrossWalletBalance: "0.00241969",
+		CrossUnPnl:         "0.00000000",
+		AvailableBalance:   "0.00241969",
+		UpdateTime:         1592468353979,
+	}}, response.Result)
+}
+
+func (s *accountServiceWsTestSuite) TestSyncGetAccountBalance_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.account.SyncGetAccountBalance(s.requestID)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -18,6 +18,9 @@ import (
 	"github.com/bitly/go-simplejson"
 )
 
+//go:generate go run ../internal/decimalgen
+//go:generate go run ../internal/streamgen
+
 // SideType define side type of order
 type SideType string
 
@@ -57,6 +60,9 @@ type WorkingType string
 // MarginType define margin type
 type MarginType string
 
+// PriceMatchType define priceMatch type
+type PriceMatchType string
+
 // UserDataEventType define user data event type
 type UserDataEventType string
 
@@ -138,6 +144,16 @@ const (
 	MarginTypeIsolated MarginType = "ISOLATED"
 	MarginTypeCrossed  MarginType = "CROSSED"
 
+	PriceMatchTypeOpponent   PriceMatchType = "OPPONENT"
+	PriceMatchTypeOpponent5  PriceMatchType = "OPPONENT_5"
+	PriceMatchTypeOpponent10 PriceMatchType = "OPPONENT_10"
+	PriceMatchTypeOpponent20 PriceMatchType = "OPPONENT_20"
+	PriceMatchTypeQueue      PriceMatchType = "QUEUE"
+	PriceMatchTypeQueue5     PriceMatchType = "QUEUE_5"
+	PriceMatchTypeQueue10    PriceMatchType = "QUEUE_10"
+	PriceMatchTypeQueue20    PriceMatchType = "QUEUE_20"
+	PriceMatchTypeNone       PriceMatchType = "NONE"
+
 	UserDataEventTypeListenKeyExpired    UserDataEventType = "listenKeyExpired"
 	UserDataEventTypeMarginCall          UserDataEventType = "MARGIN_CALL"
 	UserDataEventTypeAccountUpdate       UserDataEventType = "ACCOUNT_UPDATE"
@@ -241,6 +257,19 @@ type Client struct {
 
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+
+	// RateLimiter holds requests back before they exceed the limits, it can be shared by several clients
+	RateLimiter *common.RateLimiter
+	// RetryPolicy retries failed requests, requests are not retried if it is nil
+	RetryPolicy *common.RetryPolicy
+	// TimeSync keeps the offset to the server clock, if set its offset is used instead of TimeOffset
+	TimeSync *common.TimeSync
+	// SymbolRules holds the exchange filters orders are checked against before they're sent, if set
+	SymbolRules *common.SymbolRulesRegistry
+	// Signer signs the requests instead of SecretKey and KeyType, if set
+	Signer common.Signer
+
+	signers common.SignerCache
 }
 
 func (c *Client) debug(format string, v ...any) {
@@ -249,6 +278,18 @@ func (c *Client) debug(format string, v ...any) {
 	}
 }
 
+// signer returns Signer if it is set, otherwise the signer of SecretKey and KeyType
+func (c *Client) signer() (common.Signer, error) {
+	if c.Signer != nil {
+		return c.Signer, nil
+	}
+	kt := c.KeyType
+	if kt == "" {
+		kt = common.KeyTypeHmac
+	}
+	return c.signers.Signer(kt, c.SecretKey)
+}
+
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	// set request options from user
 	for _, opt := range opts {
@@ -264,7 +305,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.TimeSync.OffsetOr(c.TimeOffset))
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -280,22 +321,18 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
 		header.Set("X-MBX-APIKEY", c.APIKey)
 	}
-	kt := c.KeyType
-	if kt == "" {
-		kt = common.KeyTypeHmac
-	}
-	sf, err := common.SignFunc(kt)
-	if err != nil {
-		return err
-	}
 	if r.secType == secTypeSigned {
+		signer, err := c.signer()
+		if err != nil {
+			return err
+		}
 		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		sign, err := sf(c.SecretKey, raw)
+		sign, err := signer.Sign(raw)
 		if err != nil {
 			return err
 		}
 		v := url.Values{}
-		v.Set(signatureKey, *sign)
+		v.Set(signatureKey, sign)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -314,16 +351,28 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	if c.RetryPolicy == nil {
+		data, _, _, err = c.sendRequest(ctx, r, opts...)
+		return data, err
+	}
+	return c.callAPIWithRetry(ctx, r, opts...)
+}
+
+// sendRequest sends r once and returns the response body, status code and headers
+func (c *Client) sendRequest(ctx context.Context, r *request, opts ...RequestOption) (data []byte, statusCode int, header http.Header, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
+	if err = c.waitRateLimit(ctx, r); err != nil {
+		return []byte{}, 0, nil, err
+	}
 	c.debug("request: %#v\n", req)
 	f := c.do
 	if f == nil {
@@ -331,13 +380,16 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
+	if c.RateLimiter != nil {
+		c.RateLimiter.UpdateByHeader(common.RateLimitAPI(r.endpoint), res.Header)
+	}
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -360,9 +412,17 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, apiErr
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Header = res.Header
+		apiErr.Method = r.method
+		apiErr.Endpoint = r.endpoint
+		c.TimeSync.CheckError(apiErr)
+		if res.StatusCode == http.StatusTeapot {
+			return nil, res.StatusCode, res.Header, common.NewIPBannedError(res.Header, apiErr)
+		}
+		return nil, res.StatusCode, res.Header, apiErr
 	}
-	return data, nil
+	return data, res.StatusCode, res.Header, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -510,3 +570,103 @@ func (c *Client) NewGetFundingInfoService() *GetFundingInfoService {
 func (c *Client) NewFundingRateService() *FundingRateService {
 	return &FundingRateService{c: c}
 }
+
+// NewDepthService init depth service
+func (c *Client) NewDepthService() *DepthService {
+	return &DepthService{c: c}
+}
+
+// NewAggTradesService init aggregate trades service
+func (c *Client) NewAggTradesService() *AggTradesService {
+	return &AggTradesService{c: c}
+}
+
+// NewRecentTradesService init recent trades service
+func (c *Client) NewRecentTradesService() *RecentTradesService {
+	return &RecentTradesService{c: c}
+}
+
+// NewHistoricalTradesService init listing trades service
+func (c *Client) NewHistoricalTradesService() *HistoricalTradesService {
+	return &HistoricalTradesService{c: c}
+}
+
+// NewListAccountTradeService init account trade list service
+func (c *Client) NewListAccountTradeService() *ListAccountTradeService {
+	return &ListAccountTradeService{c: c}
+}
+
+// NewGetIncomeHistoryService init getting income history service
+func (c *Client) NewGetIncomeHistoryService() *GetIncomeHistoryService {
+	return &GetIncomeHistoryService{c: c}
+}
+
+// NewModifyOrderService init modifying order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewCreateBatchOrdersService init creating batch order service
+func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
+	return &CreateBatchOrdersService{c: c}
+}
+
+// NewCommissionRateService returns commission rate
+func (c *Client) NewCommissionRateService() *CommissionRateService {
+	return &CommissionRateService{c: c}
+}
+
+// NewGetLeverageBracketService init leverage bracket service
+func (c *Client) NewGetLeverageBracketService() *GetLeverageBracketService {
+	return &GetLeverageBracketService{c: c}
+}
+
+// NewGetOpenInterestService init open interest service
+func (c *Client) NewGetOpenInterestService() *GetOpenInterestService {
+	return &GetOpenInterestService{c: c}
+}
+
+// NewMarkPriceKlinesService init mark price klines service
+func (c *Client) NewMarkPriceKlinesService() *MarkPriceKlinesService {
+	return &MarkPriceKlinesService{c: c}
+}
+
+// NewIndexPriceKlinesService init index price klines service
+func (c *Client) NewIndexPriceKlinesService() *IndexPriceKlinesService {
+	return &IndexPriceKlinesService{c: c}
+}
+
+// NewPremiumIndexKlinesService init premium index klines service
+func (c *Client) NewPremiumIndexKlinesService() *PremiumIndexKlinesService {
+	return &PremiumIndexKlinesService{c: c}
+}
+
+// NewOpenInterestStatisticsService init open interest statistics service
+func (c *Client) NewOpenInterestStatisticsService() *OpenInterestStatisticsService {
+	return &OpenInterestStatisticsService{c: c}
+}
+
+// NewTopLongShortAccountRatioService init top trader long/short account ratio service
+func (c *Client) NewTopLongShortAccountRatioService() *TopLongShortAccountRatioService {
+	return &TopLongShortAccountRatioService{c: c}
+}
+
+// NewTopLongShortPositionRatioService init top trader long/short position ratio service
+func (c *Client) NewTopLongShortPositionRatioService() *TopLongShortPositionRatioService {
+	return &TopLongShortPositionRatioService{c: c}
+}
+
+// NewLongShortRatioService init global long/short account ratio service
+func (c *Client) NewLongShortRatioService() *LongShortRatioService {
+	return &LongShortRatioService{c: c}
+}
+
+// NewTakerBuySellVolumeService init taker buy/sell volume service
+func (c *Client) NewTakerBuySellVolumeService() *TakerBuySellVolumeService {
+	return &TakerBuySellVolumeService{c: c}
+}
+
+// NewBasisService init basis service
+func (c *Client) NewBasisService() *BasisService {
+	return &BasisService{c: c}
+}
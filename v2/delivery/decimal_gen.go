@@ -0,0 +1,1004 @@
+// Code generated by decimalgen; DO NOT EDIT.
+
+package delivery
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// AvailableBalanceDecimal returns AvailableBalance as a decimal, zero if it is empty
+func (v *AccountAsset) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.AvailableBalance", v.AvailableBalance)
+}
+
+// CrossUnPnlDecimal returns CrossUnPnl as a decimal, zero if it is empty
+func (v *AccountAsset) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.CrossUnPnl", v.CrossUnPnl)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *AccountAsset) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *AccountAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.InitialMargin", v.InitialMargin)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *AccountAsset) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.MaintMargin", v.MaintMargin)
+}
+
+// MarginBalanceDecimal returns MarginBalance as a decimal, zero if it is empty
+func (v *AccountAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.MarginBalance", v.MarginBalance)
+}
+
+// MaxWithdrawAmountDecimal returns MaxWithdrawAmount as a decimal, zero if it is empty
+func (v *AccountAsset) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.MaxWithdrawAmount", v.MaxWithdrawAmount)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *AccountAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *AccountAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *AccountAsset) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// WalletBalanceDecimal returns WalletBalance as a decimal, zero if it is empty
+func (v *AccountAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.WalletBalance", v.WalletBalance)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *AccountPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.EntryPrice", v.EntryPrice)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *AccountPosition) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.InitialMargin", v.InitialMargin)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *AccountPosition) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.MaintMargin", v.MaintMargin)
+}
+
+// MaxQtyDecimal returns MaxQty as a decimal, zero if it is empty
+func (v *AccountPosition) MaxQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.MaxQty", v.MaxQty)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *AccountPosition) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *AccountPosition) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.PositionAmt", v.PositionAmt)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *AccountPosition) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *AccountPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// BaseQuantityDecimal returns BaseQuantity as a decimal, zero if it is empty
+func (v *AccountTrade) BaseQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountTrade.BaseQuantity", v.BaseQuantity)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *AccountTrade) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountTrade.Commission", v.Commission)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *AccountTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountTrade.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *AccountTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountTrade.Quantity", v.Quantity)
+}
+
+// RealizedPnlDecimal returns RealizedPnl as a decimal, zero if it is empty
+func (v *AccountTrade) RealizedPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountTrade.RealizedPnl", v.RealizedPnl)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *AggTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AggTrade.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *AggTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AggTrade.Quantity", v.Quantity)
+}
+
+// AvailableBalanceDecimal returns AvailableBalance as a decimal, zero if it is empty
+func (v *Balance) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.AvailableBalance", v.AvailableBalance)
+}
+
+// BalanceDecimal returns Balance as a decimal, zero if it is empty
+func (v *Balance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.Balance", v.Balance)
+}
+
+// CrossUnPnlDecimal returns CrossUnPnl as a decimal, zero if it is empty
+func (v *Balance) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.CrossUnPnl", v.CrossUnPnl)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *Balance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// WithdrawAvailableDecimal returns WithdrawAvailable as a decimal, zero if it is empty
+func (v *Balance) WithdrawAvailableDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.WithdrawAvailable", v.WithdrawAvailable)
+}
+
+// AnnualizedBasisRateDecimal returns AnnualizedBasisRate as a decimal, zero if it is empty
+func (v *Basis) AnnualizedBasisRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Basis.AnnualizedBasisRate", v.AnnualizedBasisRate)
+}
+
+// BasisRateDecimal returns BasisRate as a decimal, zero if it is empty
+func (v *Basis) BasisRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Basis.BasisRate", v.BasisRate)
+}
+
+// FuturesPriceDecimal returns FuturesPrice as a decimal, zero if it is empty
+func (v *Basis) FuturesPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Basis.FuturesPrice", v.FuturesPrice)
+}
+
+// IndexPriceDecimal returns IndexPrice as a decimal, zero if it is empty
+func (v *Basis) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Basis.IndexPrice", v.IndexPrice)
+}
+
+// AskPriceDecimal returns AskPrice as a decimal, zero if it is empty
+func (v *BookTicker) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.AskPrice", v.AskPrice)
+}
+
+// AskQuantityDecimal returns AskQuantity as a decimal, zero if it is empty
+func (v *BookTicker) AskQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.AskQuantity", v.AskQuantity)
+}
+
+// BidPriceDecimal returns BidPrice as a decimal, zero if it is empty
+func (v *BookTicker) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.BidPrice", v.BidPrice)
+}
+
+// BidQuantityDecimal returns BidQuantity as a decimal, zero if it is empty
+func (v *BookTicker) BidQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.BidQuantity", v.BidQuantity)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *CancelOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *CancelOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// CumQuantityDecimal returns CumQuantity as a decimal, zero if it is empty
+func (v *CancelOrderResponse) CumQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.CumQuantity", v.CumQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *CancelOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *CancelOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CancelOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *CancelOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *CancelOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.StopPrice", v.StopPrice)
+}
+
+// MakerCommissionRateDecimal returns MakerCommissionRate as a decimal, zero if it is empty
+func (v *CommissionRate) MakerCommissionRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CommissionRate.MakerCommissionRate", v.MakerCommissionRate)
+}
+
+// TakerCommissionRateDecimal returns TakerCommissionRate as a decimal, zero if it is empty
+func (v *CommissionRate) TakerCommissionRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CommissionRate.TakerCommissionRate", v.TakerCommissionRate)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *CreateOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *CreateOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// CumQuantityDecimal returns CumQuantity as a decimal, zero if it is empty
+func (v *CreateOrderResponse) CumQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.CumQuantity", v.CumQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *CreateOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *CreateOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CreateOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *CreateOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *CreateOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.StopPrice", v.StopPrice)
+}
+
+// AdjustedFundingRateCapDecimal returns AdjustedFundingRateCap as a decimal, zero if it is empty
+func (v *FundingInfo) AdjustedFundingRateCapDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FundingInfo.AdjustedFundingRateCap", v.AdjustedFundingRateCap)
+}
+
+// AdjustedFundingRateFloorDecimal returns AdjustedFundingRateFloor as a decimal, zero if it is empty
+func (v *FundingInfo) AdjustedFundingRateFloorDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FundingInfo.AdjustedFundingRateFloor", v.AdjustedFundingRateFloor)
+}
+
+// FundingRateDecimal returns FundingRate as a decimal, zero if it is empty
+func (v *FundingRate) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FundingRate.FundingRate", v.FundingRate)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *FundingRate) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FundingRate.MarkPrice", v.MarkPrice)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *Kline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *Kline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *Kline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *Kline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Open", v.Open)
+}
+
+// QuoteAssetVolumeDecimal returns QuoteAssetVolume as a decimal, zero if it is empty
+func (v *Kline) QuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.QuoteAssetVolume", v.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal returns TakerBuyBaseAssetVolume as a decimal, zero if it is empty
+func (v *Kline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.TakerBuyBaseAssetVolume", v.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal returns TakerBuyQuoteAssetVolume as a decimal, zero if it is empty
+func (v *Kline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.TakerBuyQuoteAssetVolume", v.TakerBuyQuoteAssetVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *Kline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Volume", v.Volume)
+}
+
+// AveragePriceDecimal returns AveragePrice as a decimal, zero if it is empty
+func (v *LiquidationOrder) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LiquidationOrder.AveragePrice", v.AveragePrice)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *LiquidationOrder) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LiquidationOrder.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *LiquidationOrder) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LiquidationOrder.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *LiquidationOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LiquidationOrder.Price", v.Price)
+}
+
+// MaxQuantityDecimal returns MaxQuantity as a decimal, zero if it is empty
+func (v *LotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LotSizeFilter.MaxQuantity", v.MaxQuantity)
+}
+
+// MinQuantityDecimal returns MinQuantity as a decimal, zero if it is empty
+func (v *LotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LotSizeFilter.MinQuantity", v.MinQuantity)
+}
+
+// MaxQuantityDecimal returns MaxQuantity as a decimal, zero if it is empty
+func (v *MarketLotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarketLotSizeFilter.MaxQuantity", v.MaxQuantity)
+}
+
+// MinQuantityDecimal returns MinQuantity as a decimal, zero if it is empty
+func (v *MarketLotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarketLotSizeFilter.MinQuantity", v.MinQuantity)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// CumQuantityDecimal returns CumQuantity as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) CumQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.CumQuantity", v.CumQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.Price", v.Price)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.StopPrice", v.StopPrice)
+}
+
+// OpenInterestDecimal returns OpenInterest as a decimal, zero if it is empty
+func (v *OpenInterest) OpenInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OpenInterest.OpenInterest", v.OpenInterest)
+}
+
+// SumOpenInterestDecimal returns SumOpenInterest as a decimal, zero if it is empty
+func (v *OpenInterestStatistic) SumOpenInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OpenInterestStatistic.SumOpenInterest", v.SumOpenInterest)
+}
+
+// SumOpenInterestValueDecimal returns SumOpenInterestValue as a decimal, zero if it is empty
+func (v *OpenInterestStatistic) SumOpenInterestValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OpenInterestStatistic.SumOpenInterestValue", v.SumOpenInterestValue)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *Order) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.ActivatePrice", v.ActivatePrice)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *Order) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *Order) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *Order) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *Order) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *Order) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *Order) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.StopPrice", v.StopPrice)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *PositionRisk) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.EntryPrice", v.EntryPrice)
+}
+
+// LiquidationPriceDecimal returns LiquidationPrice as a decimal, zero if it is empty
+func (v *PositionRisk) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.LiquidationPrice", v.LiquidationPrice)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *PositionRisk) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.MarkPrice", v.MarkPrice)
+}
+
+// MaxQuantityDecimal returns MaxQuantity as a decimal, zero if it is empty
+func (v *PositionRisk) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.MaxQuantity", v.MaxQuantity)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *PositionRisk) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.PositionAmt", v.PositionAmt)
+}
+
+// UnRealizedProfitDecimal returns UnRealizedProfit as a decimal, zero if it is empty
+func (v *PositionRisk) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.UnRealizedProfit", v.UnRealizedProfit)
+}
+
+// BaseVolumeDecimal returns BaseVolume as a decimal, zero if it is empty
+func (v *PriceChangeStats) BaseVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.BaseVolume", v.BaseVolume)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.HighPrice", v.HighPrice)
+}
+
+// LastPriceDecimal returns LastPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.LastPrice", v.LastPrice)
+}
+
+// LastQuantityDecimal returns LastQuantity as a decimal, zero if it is empty
+func (v *PriceChangeStats) LastQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.LastQuantity", v.LastQuantity)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.OpenPrice", v.OpenPrice)
+}
+
+// PriceChangeDecimal returns PriceChange as a decimal, zero if it is empty
+func (v *PriceChangeStats) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.PriceChange", v.PriceChange)
+}
+
+// PriceChangePercentDecimal returns PriceChangePercent as a decimal, zero if it is empty
+func (v *PriceChangeStats) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.PriceChangePercent", v.PriceChangePercent)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *PriceChangeStats) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.Volume", v.Volume)
+}
+
+// WeightedAvgPriceDecimal returns WeightedAvgPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.WeightedAvgPrice", v.WeightedAvgPrice)
+}
+
+// MaxPriceDecimal returns MaxPrice as a decimal, zero if it is empty
+func (v *PriceFilter) MaxPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceFilter.MaxPrice", v.MaxPrice)
+}
+
+// MinPriceDecimal returns MinPrice as a decimal, zero if it is empty
+func (v *PriceFilter) MinPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceFilter.MinPrice", v.MinPrice)
+}
+
+// MaintMarginPercentDecimal returns MaintMarginPercent as a decimal, zero if it is empty
+func (v *Symbol) MaintMarginPercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Symbol.MaintMarginPercent", v.MaintMarginPercent)
+}
+
+// RequiredMarginPercentDecimal returns RequiredMarginPercent as a decimal, zero if it is empty
+func (v *Symbol) RequiredMarginPercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Symbol.RequiredMarginPercent", v.RequiredMarginPercent)
+}
+
+// MaxQuantityDecimal returns MaxQuantity as a decimal, zero if it is empty
+func (v *SymbolLeverage) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolLeverage.MaxQuantity", v.MaxQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *SymbolPrice) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolPrice.Price", v.Price)
+}
+
+// TakerBuyVolumeDecimal returns TakerBuyVolume as a decimal, zero if it is empty
+func (v *TakerBuySellVolume) TakerBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TakerBuySellVolume.TakerBuyVolume", v.TakerBuyVolume)
+}
+
+// TakerBuyVolumeValueDecimal returns TakerBuyVolumeValue as a decimal, zero if it is empty
+func (v *TakerBuySellVolume) TakerBuyVolumeValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TakerBuySellVolume.TakerBuyVolumeValue", v.TakerBuyVolumeValue)
+}
+
+// TakerSellVolumeDecimal returns TakerSellVolume as a decimal, zero if it is empty
+func (v *TakerBuySellVolume) TakerSellVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TakerBuySellVolume.TakerSellVolume", v.TakerSellVolume)
+}
+
+// TakerSellVolumeValueDecimal returns TakerSellVolumeValue as a decimal, zero if it is empty
+func (v *TakerBuySellVolume) TakerSellVolumeValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TakerBuySellVolume.TakerSellVolumeValue", v.TakerSellVolumeValue)
+}
+
+// BaseQuantityDecimal returns BaseQuantity as a decimal, zero if it is empty
+func (v *Trade) BaseQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.BaseQuantity", v.BaseQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *Trade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *Trade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.Quantity", v.Quantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsAggTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAggTradeEvent.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *WsAggTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAggTradeEvent.Quantity", v.Quantity)
+}
+
+// BalanceDecimal returns Balance as a decimal, zero if it is empty
+func (v *WsBalance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.Balance", v.Balance)
+}
+
+// BalanceChangeDecimal returns BalanceChange as a decimal, zero if it is empty
+func (v *WsBalance) BalanceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.BalanceChange", v.BalanceChange)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *WsBalance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// BestAskPriceDecimal returns BestAskPrice as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestAskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestAskPrice", v.BestAskPrice)
+}
+
+// BestAskQtyDecimal returns BestAskQty as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestAskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestAskQty", v.BestAskQty)
+}
+
+// BestBidPriceDecimal returns BestBidPrice as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestBidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestBidPrice", v.BestBidPrice)
+}
+
+// BestBidQtyDecimal returns BestBidQty as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestBidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestBidQty", v.BestBidQty)
+}
+
+// ActiveBuyQuoteVolumeDecimal returns ActiveBuyQuoteVolume as a decimal, zero if it is empty
+func (v *WsContinuousKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.ActiveBuyQuoteVolume", v.ActiveBuyQuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal returns ActiveBuyVolume as a decimal, zero if it is empty
+func (v *WsContinuousKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.ActiveBuyVolume", v.ActiveBuyVolume)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *WsContinuousKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *WsContinuousKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *WsContinuousKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *WsContinuousKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.Open", v.Open)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsContinuousKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.QuoteVolume", v.QuoteVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *WsContinuousKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.Volume", v.Volume)
+}
+
+// IndexPriceDecimal returns IndexPrice as a decimal, zero if it is empty
+func (v *WsIndexPriceEvent) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsIndexPriceEvent.IndexPrice", v.IndexPrice)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *WsIndexPriceKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsIndexPriceKline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *WsIndexPriceKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsIndexPriceKline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *WsIndexPriceKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsIndexPriceKline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *WsIndexPriceKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsIndexPriceKline.Open", v.Open)
+}
+
+// ActiveBuyQuoteVolumeDecimal returns ActiveBuyQuoteVolume as a decimal, zero if it is empty
+func (v *WsKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.ActiveBuyQuoteVolume", v.ActiveBuyQuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal returns ActiveBuyVolume as a decimal, zero if it is empty
+func (v *WsKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.ActiveBuyVolume", v.ActiveBuyVolume)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *WsKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *WsKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *WsKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *WsKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Open", v.Open)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.QuoteVolume", v.QuoteVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *WsKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Volume", v.Volume)
+}
+
+// AccumulatedFilledQtyDecimal returns AccumulatedFilledQty as a decimal, zero if it is empty
+func (v *WsLiquidationOrder) AccumulatedFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiquidationOrder.AccumulatedFilledQty", v.AccumulatedFilledQty)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *WsLiquidationOrder) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiquidationOrder.AvgPrice", v.AvgPrice)
+}
+
+// LastFilledQtyDecimal returns LastFilledQty as a decimal, zero if it is empty
+func (v *WsLiquidationOrder) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiquidationOrder.LastFilledQty", v.LastFilledQty)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *WsLiquidationOrder) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiquidationOrder.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsLiquidationOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiquidationOrder.Price", v.Price)
+}
+
+// EstimatedSettlePriceDecimal returns EstimatedSettlePrice as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) EstimatedSettlePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.EstimatedSettlePrice", v.EstimatedSettlePrice)
+}
+
+// FundingRateDecimal returns FundingRate as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.FundingRate", v.FundingRate)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.MarkPrice", v.MarkPrice)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *WsMarkPriceKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceKline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *WsMarkPriceKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceKline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *WsMarkPriceKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceKline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *WsMarkPriceKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceKline.Open", v.Open)
+}
+
+// BaseVolumeDecimal returns BaseVolume as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) BaseVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.BaseVolume", v.BaseVolume)
+}
+
+// ClosePriceDecimal returns ClosePrice as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) ClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.ClosePrice", v.ClosePrice)
+}
+
+// CloseQtyDecimal returns CloseQty as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) CloseQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.CloseQty", v.CloseQty)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.HighPrice", v.HighPrice)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.OpenPrice", v.OpenPrice)
+}
+
+// PriceChangeDecimal returns PriceChange as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.PriceChange", v.PriceChange)
+}
+
+// PriceChangePercentDecimal returns PriceChangePercent as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.PriceChangePercent", v.PriceChangePercent)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.QuoteVolume", v.QuoteVolume)
+}
+
+// WeightedAvgPriceDecimal returns WeightedAvgPrice as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.WeightedAvgPrice", v.WeightedAvgPrice)
+}
+
+// ClosePriceDecimal returns ClosePrice as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) ClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.ClosePrice", v.ClosePrice)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.HighPrice", v.HighPrice)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.OpenPrice", v.OpenPrice)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.QuoteVolume", v.QuoteVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.Volume", v.Volume)
+}
+
+// AccumulatedFilledQtyDecimal returns AccumulatedFilledQty as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) AccumulatedFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.AccumulatedFilledQty", v.AccumulatedFilledQty)
+}
+
+// ActivationPriceDecimal returns ActivationPrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) ActivationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.ActivationPrice", v.ActivationPrice)
+}
+
+// AsksNotionalDecimal returns AsksNotional as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) AsksNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.AsksNotional", v.AsksNotional)
+}
+
+// AveragePriceDecimal returns AveragePrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.AveragePrice", v.AveragePrice)
+}
+
+// BidsNotionalDecimal returns BidsNotional as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) BidsNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.BidsNotional", v.BidsNotional)
+}
+
+// CallbackRateDecimal returns CallbackRate as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) CallbackRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.CallbackRate", v.CallbackRate)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.Commission", v.Commission)
+}
+
+// LastFilledPriceDecimal returns LastFilledPrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.LastFilledPrice", v.LastFilledPrice)
+}
+
+// LastFilledQtyDecimal returns LastFilledQty as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.LastFilledQty", v.LastFilledQty)
+}
+
+// OriginalPriceDecimal returns OriginalPrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) OriginalPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.OriginalPrice", v.OriginalPrice)
+}
+
+// OriginalQtyDecimal returns OriginalQty as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) OriginalQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.OriginalQty", v.OriginalQty)
+}
+
+// RealizedPnLDecimal returns RealizedPnL as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) RealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.RealizedPnL", v.RealizedPnL)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.StopPrice", v.StopPrice)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *WsPosition) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.Amount", v.Amount)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *WsPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.EntryPrice", v.EntryPrice)
+}
+
+// MaintenanceMarginRequiredDecimal returns MaintenanceMarginRequired as a decimal, zero if it is empty
+func (v *WsPosition) MaintenanceMarginRequiredDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.MaintenanceMarginRequired", v.MaintenanceMarginRequired)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *WsPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.MarkPrice", v.MarkPrice)
+}
+
+// UnrealizedPnLDecimal returns UnrealizedPnL as a decimal, zero if it is empty
+func (v *WsPosition) UnrealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.UnrealizedPnL", v.UnrealizedPnL)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *WsUserDataEvent) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsUserDataEvent.CrossWalletBalance", v.CrossWalletBalance)
+}
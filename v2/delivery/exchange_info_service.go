@@ -51,6 +51,8 @@ type RateLimit struct {
 }
 
 // Symbol market symbol
+//
+//decimalgen:accessors
 type Symbol struct {
 	OrderType             []OrderType       `json:"OrderType"`
 	TimeInForce           []TimeInForceType `json:"timeInForce"`
@@ -78,6 +80,8 @@ type Symbol struct {
 }
 
 // LotSizeFilter define lot size filter of symbol
+//
+//decimalgen:accessors
 type LotSizeFilter struct {
 	MaxQuantity string `json:"maxQty"`
 	MinQuantity string `json:"minQty"`
@@ -85,6 +89,8 @@ type LotSizeFilter struct {
 }
 
 // PriceFilter define price filter of symbol
+//
+//decimalgen:accessors
 type PriceFilter struct {
 	MaxPrice string `json:"maxPrice"`
 	MinPrice string `json:"minPrice"`
@@ -99,6 +105,8 @@ type PercentPriceFilter struct {
 }
 
 // MarketLotSizeFilter define market lot size filter of symbol
+//
+//decimalgen:accessors
 type MarketLotSizeFilter struct {
 	MaxQuantity string `json:"maxQty"`
 	MinQuantity string `json:"minQty"`
@@ -10,6 +10,7 @@ type GetFundingInfoService struct {
 	c *Client
 }
 
+//decimalgen:accessors
 type FundingInfo struct {
 	Symbol                   string `json:"symbol"`
 	AdjustedFundingRateCap   string `json:"adjustedFundingRateCap"`
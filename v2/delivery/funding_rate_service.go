@@ -69,6 +69,8 @@ func (s *FundingRateService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // FundingRate define funding rate of mark price
+//
+//decimalgen:accessors
 type FundingRate struct {
 	Symbol      string `json:"symbol"`
 	FundingRate string `json:"fundingRate"`
@@ -0,0 +1,92 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// IndexPriceKlinesService list index price klines
+type IndexPriceKlinesService struct {
+	c         *Client
+	pair      string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *IndexPriceKlinesService) Pair(pair string) *IndexPriceKlinesService {
+	s.pair = pair
+	return s
+}
+
+// Interval set interval
+func (s *IndexPriceKlinesService) Interval(interval string) *IndexPriceKlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *IndexPriceKlinesService) Limit(limit int) *IndexPriceKlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *IndexPriceKlinesService) StartTime(startTime int64) *IndexPriceKlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *IndexPriceKlinesService) EndTime(endTime int64) *IndexPriceKlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *IndexPriceKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/indexPriceKlines",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("interval", s.interval)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	j, err := newJSON(data)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	num := len(j.MustArray())
+	res = make([]*Kline, num)
+	for i := 0; i < num; i++ {
+		item := j.GetIndex(i)
+		if len(item.MustArray()) < 11 {
+			err = fmt.Errorf("invalid kline response")
+			return []*Kline{}, err
+		}
+		res[i] = &Kline{
+			OpenTime:  item.GetIndex(0).MustInt64(),
+			Open:      item.GetIndex(1).MustString(),
+			High:      item.GetIndex(2).MustString(),
+			Low:       item.GetIndex(3).MustString(),
+			Close:     item.GetIndex(4).MustString(),
+			CloseTime: item.GetIndex(6).MustInt64(),
+		}
+	}
+	return res, nil
+}
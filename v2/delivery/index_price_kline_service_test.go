@@ -0,0 +1,64 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type indexPriceKlinesServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestIndexPriceKlinesService(t *testing.T) {
+	suite.Run(t, new(indexPriceKlinesServiceTestSuite))
+}
+
+func (s *indexPriceKlinesServiceTestSuite) TestIndexPriceKlines() {
+	data := []byte(`[
+		[
+			1591256400000,
+			"9392.06",
+			"9392.06",
+			"9391.69",
+			"9391.69",
+			"0",
+			1591256459999,
+			"0",
+			60,
+			"0",
+			"0",
+			"0"
+		]
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	interval := "1m"
+	limit := 10
+	startTime := int64(1591256400000)
+	endTime := int64(1591256459999)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":      pair,
+			"interval":  interval,
+			"limit":     limit,
+			"startTime": startTime,
+			"endTime":   endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	klines, err := s.client.NewIndexPriceKlinesService().Pair(pair).
+		Interval(interval).Limit(limit).StartTime(startTime).
+		EndTime(endTime).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*Kline{{
+		OpenTime:  1591256400000,
+		Open:      "9392.06",
+		High:      "9392.06",
+		Low:       "9391.69",
+		Close:     "9391.69",
+		CloseTime: 1591256459999,
+	}}, klines)
+}
@@ -0,0 +1,100 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// NewKlineFeed creates a feed of the klines of symbol and interval from startTime on. The history is backfilled with
+// KlinesService requests, then the feed follows the kline stream. Set the options of the feed and start it with Run.
+func (c *Client) NewKlineFeed(symbol, interval string, startTime int64) *common.KlineFeed[*Kline] {
+	return common.NewKlineFeed(common.KlineSource[*Kline]{
+		Fetch: func(ctx context.Context, startTime, endTime int64, limit int) ([]*Kline, error) {
+			return c.NewKlinesService().Symbol(symbol).Interval(interval).
+				StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		Serve: func(handler common.KlineHandler[*Kline], errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			return WsKlineServe(symbol, interval, func(event *WsKlineEvent) {
+				k := event.Kline
+				handler(&Kline{
+					OpenTime:                 k.StartTime,
+					Open:                     k.Open,
+					High:                     k.High,
+					Low:                      k.Low,
+					Close:                    k.Close,
+					Volume:                   k.Volume,
+					CloseTime:                k.EndTime,
+					QuoteAssetVolume:         k.QuoteVolume,
+					TradeNum:                 k.TradeNum,
+					TakerBuyBaseAssetVolume:  k.ActiveBuyVolume,
+					TakerBuyQuoteAssetVolume: k.ActiveBuyQuoteVolume,
+				}, k.IsFinal)
+			}, errHandler)
+		},
+		OpenTime:  klineOpenTime,
+		CloseTime: klineCloseTime,
+	}, interval, startTime)
+}
+
+// NewMarkPriceKlineFeed creates a feed of the mark price klines of symbol and interval from startTime on, backfilled
+// with MarkPriceKlinesService requests. The klines of the stream carry no volumes.
+func (c *Client) NewMarkPriceKlineFeed(symbol, interval string, startTime int64) *common.KlineFeed[*Kline] {
+	return common.NewKlineFeed(common.KlineSource[*Kline]{
+		Fetch: func(ctx context.Context, startTime, endTime int64, limit int) ([]*Kline, error) {
+			return c.NewMarkPriceKlinesService().Symbol(symbol).Interval(interval).
+				StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		Serve: func(handler common.KlineHandler[*Kline], errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			return WsMarkPriceKlineServe(symbol, interval, func(event *WsMarkPriceKlineEvent) {
+				k := event.Kline
+				handler(&Kline{
+					OpenTime:  k.StartTime,
+					Open:      k.Open,
+					High:      k.High,
+					Low:       k.Low,
+					Close:     k.Close,
+					CloseTime: k.EndTime,
+					TradeNum:  k.TradeNum,
+				}, k.IsFinal)
+			}, errHandler)
+		},
+		OpenTime:  klineOpenTime,
+		CloseTime: klineCloseTime,
+	}, interval, startTime)
+}
+
+// NewIndexPriceKlineFeed creates a feed of the index price klines of pair and interval from startTime on, backfilled
+// with IndexPriceKlinesService requests. The klines of the stream carry no volumes.
+func (c *Client) NewIndexPriceKlineFeed(pair, interval string, startTime int64) *common.KlineFeed[*Kline] {
+	return common.NewKlineFeed(common.KlineSource[*Kline]{
+		Fetch: func(ctx context.Context, startTime, endTime int64, limit int) ([]*Kline, error) {
+			return c.NewIndexPriceKlinesService().Pair(pair).Interval(interval).
+				StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		Serve: func(handler common.KlineHandler[*Kline], errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			return WsIndexPriceKlineServe(pair, interval, func(event *WsIndexPriceKlineEvent) {
+				k := event.Kline
+				handler(&Kline{
+					OpenTime:  k.StartTime,
+					Open:      k.Open,
+					High:      k.High,
+					Low:       k.Low,
+					Close:     k.Close,
+					CloseTime: k.EndTime,
+					TradeNum:  k.TradeNum,
+				}, k.IsFinal)
+			}, errHandler)
+		},
+		OpenTime:  klineOpenTime,
+		CloseTime: klineCloseTime,
+	}, interval, startTime)
+}
+
+func klineOpenTime(k *Kline) int64 {
+	return k.OpenTime
+}
+
+func klineCloseTime(k *Kline) int64 {
+	return k.CloseTime
+}
@@ -0,0 +1,57 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type leverageBracketServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestLeverageBracketService(t *testing.T) {
+	suite.Run(t, new(leverageBracketServiceTestSuite))
+}
+
+func (s *leverageBracketServiceTestSuite) TestGetLeverageBracket() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_PERP",
+			"notionalCoef": 1.50,
+			"brackets": [
+				{
+					"bracket": 1,
+					"initialLeverage": 125,
+					"qtyCap": 50,
+					"qtyFloor": 0,
+					"maintMarginRatio": 0.004,
+					"cum": 0.0
+				}
+			]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("symbol", symbol)
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetLeverageBracketService().Symbol(symbol).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*LeverageBracket{{
+		Symbol:       symbol,
+		NotionalCoef: 1.5,
+		Brackets: []Bracket{{
+			Bracket:          1,
+			InitialLeverage:  125,
+			QtyCap:           50,
+			QtyFloor:         0,
+			MaintMarginRatio: 0.004,
+			Cum:              0,
+		}},
+	}}, res)
+}
@@ -0,0 +1,64 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type markPriceKlinesServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarkPriceKlinesService(t *testing.T) {
+	suite.Run(t, new(markPriceKlinesServiceTestSuite))
+}
+
+func (s *markPriceKlinesServiceTestSuite) TestMarkPriceKlines() {
+	data := []byte(`[
+		[
+			1591256400000,
+			"9400.0",
+			"9400.0",
+			"9399.5",
+			"9399.5",
+			"0",
+			1591256459999,
+			"0",
+			60,
+			"0",
+			"0",
+			"0"
+		]
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200626"
+	interval := "1m"
+	limit := 10
+	startTime := int64(1591256400000)
+	endTime := int64(1591256459999)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    symbol,
+			"interval":  interval,
+			"limit":     limit,
+			"startTime": startTime,
+			"endTime":   endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	klines, err := s.client.NewMarkPriceKlinesService().Symbol(symbol).
+		Interval(interval).Limit(limit).StartTime(startTime).
+		EndTime(endTime).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*Kline{{
+		OpenTime:  1591256400000,
+		Open:      "9400.0",
+		High:      "9400.0",
+		Low:       "9399.5",
+		Close:     "9399.5",
+		CloseTime: 1591256459999,
+	}}, klines)
+}
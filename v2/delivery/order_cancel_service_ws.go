@@ -0,0 +1,180 @@
+package delivery
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OrderCancelWsService cancels order
+type OrderCancelWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
+	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	signers    common.SignerCache
+}
+
+// NewOrderCancelWsService init OrderCancelWsService
+func NewOrderCancelWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OrderCancelWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderCancelWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
+	}, nil
+}
+
+// OrderCancelWsRequest parameters for 'order.cancel' websocket API
+type OrderCancelWsRequest struct {
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// NewOrderCancelWsRequest init OrderCancelWsRequest
+func NewOrderCancelWsRequest() *OrderCancelWsRequest {
+	return &OrderCancelWsRequest{}
+}
+
+// Symbol set symbol
+func (s *OrderCancelWsRequest) Symbol(symbol string) *OrderCancelWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *OrderCancelWsRequest) OrderID(orderID int64) *OrderCancelWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *OrderCancelWsRequest) OrigClientOrderID(origClientOrderID string) *OrderCancelWsRequest {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// OrderCancelWsResponse define 'order.cancel' websocket API response
+type OrderCancelWsResponse struct {
+	Id     string              `json:"id"`
+	Status int                 `json:"status"`
+	Result CancelOrderResponse `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+func (s *OrderCancelWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OrderCancelWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+
+	return m
+}
+
+// Do - sends 'order.cancel' request
+func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers),
+		websocket.OrderCancelDeliveryWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'order.cancel' request and receives response
+func (s *OrderCancelWsService) SyncDo(requestID string, request *OrderCancelWsRequest) (*OrderCancelWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers),
+		websocket.OrderCancelDeliveryWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelOrderWsResponse := &OrderCancelWsResponse{}
+	if err := json.Unmarshal(response, cancelOrderWsResponse); err != nil {
+		return nil, err
+	}
+	if cancelOrderWsResponse.Error != nil {
+		cancelOrderWsResponse.Error.StatusCode = cancelOrderWsResponse.Status
+		s.TimeSync.CheckError(cancelOrderWsResponse.Error)
+		return nil, cancelOrderWsResponse.Error
+	}
+
+	return cancelOrderWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OrderCancelWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OrderCancelWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OrderCancelWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OrderCancelWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
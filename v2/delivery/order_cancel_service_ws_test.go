@@ -0,0 +1,118 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *orderCancelServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+
+	s.requestID = "5633b6a2-90a9-4192-83e7-925c90b6a2fd"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.orderCancel = &OrderCancelWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.orderCancelRequest = NewOrderCancelWsRequest().
+		Symbol("BTCUSD_PERP").
+		OrigClientOrderID("abc")
+}
+
+func (s *orderCancelServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type orderCancelServiceWsTestSuite struct {
+	suite.Suite
+	apiKey    string
+	secretKey string
+	signedKey string
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	orderCancel        *OrderCancelWsService
+	orderCancelRequest *OrderCancelWsRequest
+}
+
+func TestOrderCancelServiceWs(t *testing.T) {
+	suite.Run(t, new(orderCancelServiceWsTestSuite))
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancel() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(requestID string, data []byte) error {
+		req := websocket.WsApiRequest{}
+		s.Require().NoError(json.Unmarshal(data, &req))
+		s.Equal(websocket.OrderCancelDeliveryWsApiMethod, req.Method)
+		s.Equal("BTCUSD_PERP", req.Params["symbol"])
+		s.Equal("abc", req.Params["origClientOrderId"])
+		s.Equal(s.apiKey, req.Params["apiKey"])
+		s.Contains(req.Params, "signature")
+		return nil
+	}).Times(1)
+
+	err := s.orderCancel.Do(s.requestID, s.orderCancelRequest)
+	s.NoError(err)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancel_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderCancel.Do("", s.orderCancelRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancel_EmptyApiKey() {
+	s.orderCancel.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderCancel.Do(s.requestID, s.orderCancelRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancelSync() {
+	rawResponseData := []byte(`{"id":"5633b6a2-90a9-4192-83e7-925c90b6a2fd","status":200,"result":{"avgPrice":"0.0","clientOrderId":"abc","cumBase":"0","executedQty":"0","orderId":283194212,"origQty":"11","origType":"LIMIT","price":"5000","reduceOnly":false,"side":"BUY","positionSide":"BOTH","status":"CANCELED","stopPrice":"0","closePosition":false,"symbol":"BTCUSD_PERP","pair":"BTCUSD","timeInForce":"GTC","type":"LIMIT","activatePrice":"0","priceRate":"0","updateTime":1612246150436,"workingType":"CONTRACT_PRICE","priceProtect":false}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderCancel.SyncDo(s.requestID, s.orderCancelRequest)
+	s.Require().NoError(err)
+	s.Equal(int64(283194212), response.Result.OrderID)
+	s.Equal(OrderStatusTypeCanceled, response.Result.Status)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancelSync_Error() {
+	rawResponseData := []byte(`{"id":"5633b6a2-90a9-4192-83e7-925c90b6a2fd","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderCancel.SyncDo(s.requestID, s.orderCancelRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancelSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.orderCancel.SyncDo(s.requestID, s.orderCancelRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -0,0 +1,125 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *orderModifyServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+
+	s.requestID = "c8ba8a4e-55e4-4b15-a0f5-f6e4c8d8a0f1"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.orderModify = &OrderModifyWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.orderModifyRequest = NewOrderModifyWsRequest().
+		Symbol("BTCUSD_PERP").
+		OrderID(283194212).
+		Side(SideTypeBuy).
+		Quantity("12").
+		Price("5100")
+}
+
+func (s *orderModifyServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type orderModifyServiceWsTestSuite struct {
+	suite.Suite
+	apiKey    string
+	secretKey string
+	signedKey string
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	orderModify        *OrderModifyWsService
+	orderModifyRequest *OrderModifyWsRequest
+}
+
+func TestOrderModifyServiceWs(t *testing.T) {
+	suite.Run(t, new(orderModifyServiceWsTestSuite))
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModify() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(requestID string, data []byte) error {
+		req := websocket.WsApiRequest{}
+		s.Require().NoError(json.Unmarshal(data, &req))
+		s.Equal(websocket.OrderModifyDeliveryWsApiMethod, req.Method)
+		s.Equal("BTCUSD_PERP", req.Params["symbol"])
+		s.Equal(float64(283194212), req.Params["orderId"])
+		s.Equal("BUY", req.Params["side"])
+		s.Equal("12", req.Params["quantity"])
+		s.Equal("5100", req.Params["price"])
+		s.Equal(s.apiKey, req.Params["apiKey"])
+		s.Contains(req.Params, "signature")
+		return nil
+	}).Times(1)
+
+	err := s.orderModify.Do(s.requestID, s.orderModifyRequest)
+	s.NoError(err)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModify_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderModify.Do("", s.orderModifyRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModify_EmptyApiKey() {
+	s.orderModify.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderModify.Do(s.requestID, s.orderModifyRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModifySync() {
+	rawResponseData := []byte(`{"id":"c8ba8a4e-55e4-4b15-a0f5-f6e4c8d8a0f1","status":200,"result":{"avgPrice":"0.0","clientOrderId":"abc","cumBase":"0","executedQty":"0","orderId":283194212,"origQty":"12","origType":"LIMIT","price":"5100","reduceOnly":false,"side":"BUY","positionSide":"BOTH","status":"NEW","stopPrice":"0","closePosition":false,"symbol":"BTCUSD_PERP","pair":"BTCUSD","time":1612246150436,"timeInForce":"GTC","type":"LIMIT","activatePrice":"0","priceRate":"0","updateTime":1612246150436,"workingType":"CONTRACT_PRICE","priceProtect":false}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderModify.SyncDo(s.requestID, s.orderModifyRequest)
+	s.Require().NoError(err)
+	s.Equal(int64(283194212), response.Result.OrderID)
+	s.Equal("5100", response.Result.Price)
+	s.Equal("12", response.Result.OrigQuantity)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModifySync_Error() {
+	rawResponseData := []byte(`{"id":"c8ba8a4e-55e4-4b15-a0f5-f6e4c8d8a0f1","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderModify.SyncDo(s.requestID, s.orderModifyRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModifySync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.orderModify.SyncDo(s.requestID, s.orderModifyRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -0,0 +1,130 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *orderPlaceServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+
+	s.requestID = "a8627ea5-8b9f-452f-90ae-4136f2b442e2"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.orderPlace = &OrderPlaceWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.orderPlaceRequest = NewOrderPlaceWsRequest().
+		Symbol("BTCUSD_PERP").
+		Side(SideTypeBuy).
+		Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).
+		Quantity("11").
+		Price("5000").
+		NewClientOrderID("abc")
+}
+
+func (s *orderPlaceServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type orderPlaceServiceWsTestSuite struct {
+	suite.Suite
+	apiKey    string
+	secretKey string
+	signedKey string
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	orderPlace        *OrderPlaceWsService
+	orderPlaceRequest *OrderPlaceWsRequest
+}
+
+func TestOrderPlaceServiceWs(t *testing.T) {
+	suite.Run(t, new(orderPlaceServiceWsTestSuite))
+}
+
+func (s *orderPlaceServiceWsTestSuite) TestOrderPlace() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(requestID string, data []byte) error {
+		req := websocket.WsApiRequest{}
+		s.Require().NoError(json.Unmarshal(data, &req))
+		s.Equal(websocket.OrderPlaceDeliveryWsApiMethod, req.Method)
+		s.Equal("BTCUSD_PERP", req.Params["symbol"])
+		s.Equal("BUY", req.Params["side"])
+		s.Equal("LIMIT", req.Params["type"])
+		s.Equal("GTC", req.Params["timeInForce"])
+		s.Equal("11", req.Params["quantity"])
+		s.Equal("5000", req.Params["price"])
+		s.Equal("abc", req.Params["newClientOrderId"])
+		s.Equal(s.apiKey, req.Params["apiKey"])
+		s.Contains(req.Params, "signature")
+		return nil
+	}).Times(1)
+
+	err := s.orderPlace.Do(s.requestID, s.orderPlaceRequest)
+	s.NoError(err)
+}
+
+func (s *orderPlaceServiceWsTestSuite) TestOrderPlace_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderPlace.Do("", s.orderPlaceRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderPlaceServiceWsTestSuite) TestOrderPlace_EmptyApiKey() {
+	s.orderPlace.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderPlace.Do(s.requestID, s.orderPlaceRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderPlaceServiceWsTestSuite) TestOrderPlaceSync() {
+	rawResponseData := []byte(`{"id":"a8627ea5-8b9f-452f-90ae-4136f2b442e2","status":200,"result":{"avgPrice":"0.0","cumQty":"0","clientOrderId":"abc","cumBase":"0","executedQty":"0","orderId":283194212,"origQty":"11","origType":"LIMIT","price":"5000","reduceOnly":false,"side":"BUY","positionSide":"BOTH","status":"NEW","stopPrice":"0","closePosition":false,"symbol":"BTCUSD_PERP","pair":"BTCUSD","timeInForce":"GTC","type":"LIMIT","activatePrice":"0","priceRate":"0","updateTime":1612246150436,"workingType":"CONTRACT_PRICE","priceProtect":false}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderPlace.SyncDo(s.requestID, s.orderPlaceRequest)
+	s.Require().NoError(err)
+	s.Equal(int64(283194212), response.Result.OrderID)
+	s.Equal("abc", response.Result.ClientOrderID)
+	s.Equal(OrderStatusTypeNew, response.Result.Status)
+	s.Equal("BTCUSD", response.Result.Pair)
+}
+
+func (s *orderPlaceServiceWsTestSuite) TestOrderPlaceSync_Error() {
+	rawResponseData := []byte(`{"id":"a8627ea5-8b9f-452f-90ae-4136f2b442e2","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderPlace.SyncDo(s.requestID, s.orderPlaceRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *orderPlaceServiceWsTestSuite) TestOrderPlaceSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.orderPlace.SyncDo(s.requestID, s.orderPlaceRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/adshao/go-binance/v2/common"
 )
@@ -28,6 +30,7 @@ type CreateOrderService struct {
 	workingType      *WorkingType
 	priceProtect     *string
 	newOrderRespType NewOrderRespType
+	roundToFilters   bool
 }
 
 // Symbol set symbol
@@ -129,7 +132,16 @@ func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderServi
 	return s
 }
 
+// RoundToFilters round price, stop price, activation price and quantity down to tick and step size of SymbolRules before the order is sent
+func (s *CreateOrderService) RoundToFilters(roundToFilters bool) *CreateOrderService {
+	s.roundToFilters = roundToFilters
+	return s
+}
+
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	if err = s.Validate(ctx); err != nil {
+		return []byte{}, err
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -199,7 +211,37 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Validate check the order against the filters of SymbolRules of the client, it returns a *common.FilterError listing
+// the violations. It is called by Do, the order is not checked if SymbolRules is nil or the symbol is unknown.
+func (s *CreateOrderService) Validate(ctx context.Context) error {
+	if s.c.SymbolRules == nil {
+		return nil
+	}
+	rules, err := s.c.SymbolRules.Rules(ctx, s.symbol)
+	if err != nil || rules == nil {
+		return err
+	}
+	values := common.OrderValues{
+		Market:          strings.HasSuffix(string(s.orderType), string(OrderTypeMarket)),
+		Price:           stringValue(s.price),
+		StopPrice:       stringValue(s.stopPrice),
+		ActivationPrice: stringValue(s.activationPrice),
+		Quantity:        s.quantity,
+		ReduceOnly:      stringValue(s.reduceOnly) == "true" || stringValue(s.closePosition) == "true",
+	}
+	if s.roundToFilters {
+		rules.Round(&values)
+		setStringValue(&s.price, values.Price)
+		setStringValue(&s.stopPrice, values.StopPrice)
+		setStringValue(&s.activationPrice, values.ActivationPrice)
+		s.quantity = values.Quantity
+	}
+	return rules.Validate(values)
+}
+
 // CreateOrderResponse define create order response
+//
+//decimalgen:accessors
 type CreateOrderResponse struct {
 	ClientOrderID    string           `json:"clientOrderId"`
 	CumQuantity      string           `json:"cumQty"`
@@ -227,6 +269,132 @@ type CreateOrderResponse struct {
 	PriceProtect     bool             `json:"priceProtect"`
 }
 
+// ModifyOrderService modify a LIMIT order
+type ModifyOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	side              SideType
+	quantity          *string
+	price             *string
+	priceMatch        *PriceMatchType
+}
+
+// Symbol set symbol
+func (s *ModifyOrderService) Symbol(symbol string) *ModifyOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID will prevail over OrigClientOrderID
+func (s *ModifyOrderService) OrderID(orderID int64) *ModifyOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID is not necessary if OrderID is provided
+func (s *ModifyOrderService) OrigClientOrderID(origClientOrderID string) *ModifyOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Side set side
+func (s *ModifyOrderService) Side(side SideType) *ModifyOrderService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrderService) Quantity(quantity string) *ModifyOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// Price set price
+func (s *ModifyOrderService) Price(price string) *ModifyOrderService {
+	s.price = &price
+	return s
+}
+
+// PriceMatch set priceMatch
+func (s *ModifyOrderService) PriceMatch(priceMatch PriceMatchType) *ModifyOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// Do send request:
+//   - Either orderId or origClientOrderId must be sent, and the orderId will prevail if both are sent
+//   - Either quantity or price must be sent
+//   - The order will be cancelled by the amendment when it is partially filled and the new quantity <= executedQty,
+//     or when it is TimeInForceTypeGTX and the new price will cause it to be executed immediately
+func (s *ModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res *ModifyOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/dapi/v1/order",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol": s.symbol,
+		"side":   s.side,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ModifyOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ModifyOrderResponse define modify order response
+//
+//decimalgen:accessors
+type ModifyOrderResponse struct {
+	OrderID          int64            `json:"orderId"`
+	Symbol           string           `json:"symbol"`
+	Pair             string           `json:"pair"`
+	Status           OrderStatusType  `json:"status"`
+	ClientOrderID    string           `json:"clientOrderId"`
+	Price            string           `json:"price"`
+	AvgPrice         string           `json:"avgPrice"`
+	OrigQuantity     string           `json:"origQty"`
+	ExecutedQuantity string           `json:"executedQty"`
+	CumQuantity      string           `json:"cumQty"`
+	CumBase          string           `json:"cumBase"`
+	TimeInForce      TimeInForceType  `json:"timeInForce"`
+	Type             OrderType        `json:"type"`
+	ReduceOnly       bool             `json:"reduceOnly"`
+	ClosePosition    bool             `json:"closePosition"`
+	Side             SideType         `json:"side"`
+	PositionSide     PositionSideType `json:"positionSide"`
+	StopPrice        string           `json:"stopPrice"`
+	WorkingType      WorkingType      `json:"workingType"`
+	PriceProtect     bool             `json:"priceProtect"`
+	OrigType         OrderType        `json:"origType"`
+	PriceMatch       PriceMatchType   `json:"priceMatch"`
+	UpdateTime       int64            `json:"updateTime"`
+}
+
 // ListOpenOrdersService list opened orders
 type ListOpenOrdersService struct {
 	c      *Client
@@ -324,6 +492,8 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 }
 
 // Order define order info
+//
+//decimalgen:accessors
 type Order struct {
 	AvgPrice         string           `json:"avgPrice"`
 	ClientOrderID    string           `json:"clientOrderId"`
@@ -435,6 +605,18 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Iterator walks the orders created between startTime and endTime in milliseconds, in windows of 7 days and pages of
+// 100 orders. OrderID is ignored.
+func (s *ListOrdersService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Order] {
+	s.orderID = nil
+	return common.NewCursorIterator(startTime, endTime, 7*24*time.Hour, 100,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*Order, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx, opts...)
+		},
+		func(o *Order) int64 { return o.Time },
+		func(o *Order) int64 { return o.OrderID })
+}
+
 // CancelOrderService cancel an order
 type CancelOrderService struct {
 	c                 *Client
@@ -488,6 +670,8 @@ func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // CancelOrderResponse define response of canceling order
+//
+//decimalgen:accessors
 type CancelOrderResponse struct {
 	AvgPrice         string           `json:"avgPrice"`
 	ClientOrderID    string           `json:"clientOrderId"`
@@ -617,6 +801,8 @@ func (s *ListLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOp
 }
 
 // LiquidationOrder define liquidation order
+//
+//decimalgen:accessors
 type LiquidationOrder struct {
 	Symbol           string          `json:"symbol"`
 	Price            string          `json:"price"`
@@ -629,3 +815,126 @@ type LiquidationOrder struct {
 	Side             SideType        `json:"side"`
 	Time             int64           `json:"time"`
 }
+
+// CreateBatchOrdersService create multiple orders at once, at most 5 orders are allowed
+type CreateBatchOrdersService struct {
+	c      *Client
+	orders []*CreateOrderService
+}
+
+// CreateBatchOrdersResponse contains the response from CreateBatchOrders operation
+type CreateBatchOrdersResponse struct {
+	// Total number of messages in the response
+	N int
+	// List of orders which were placed successfully which can have a length between 0 and N
+	Orders []*Order
+	// List of errors of length N, where each item corresponds to a nil value if
+	// the order from that specific index was placed successfully OR an non-nil *APIError if there was an error with
+	// the order at that index
+	Errors []error
+}
+
+func newCreateBatchOrdersResponse(n int) *CreateBatchOrdersResponse {
+	return &CreateBatchOrdersResponse{
+		N:      n,
+		Errors: make([]error, n),
+	}
+}
+
+// OrderList set the list of orders, each of them is checked with Validate before sending
+func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request
+func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	orders := []params{}
+	for _, order := range s.orders {
+		if err = order.Validate(ctx); err != nil {
+			return nil, err
+		}
+		m := params{
+			"symbol":           order.symbol,
+			"side":             order.side,
+			"type":             order.orderType,
+			"quantity":         order.quantity,
+			"newOrderRespType": order.newOrderRespType,
+		}
+		if order.positionSide != nil {
+			m["positionSide"] = *order.positionSide
+		}
+		if order.timeInForce != nil {
+			m["timeInForce"] = *order.timeInForce
+		}
+		if order.reduceOnly != nil {
+			m["reduceOnly"] = *order.reduceOnly
+		}
+		if order.price != nil {
+			m["price"] = *order.price
+		}
+		if order.newClientOrderID != nil {
+			m["newClientOrderId"] = *order.newClientOrderID
+		} else {
+			m["newClientOrderId"] = common.GenerateSwapId()
+		}
+		if order.stopPrice != nil {
+			m["stopPrice"] = *order.stopPrice
+		}
+		if order.workingType != nil {
+			m["workingType"] = *order.workingType
+		}
+		if order.priceProtect != nil {
+			m["priceProtect"] = *order.priceProtect
+		}
+		if order.activationPrice != nil {
+			m["activationPrice"] = *order.activationPrice
+		}
+		if order.callbackRate != nil {
+			m["callbackRate"] = *order.callbackRate
+		}
+		if order.closePosition != nil {
+			m["closePosition"] = *order.closePosition
+		}
+		orders = append(orders, m)
+	}
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+	r.setFormParams(params{
+		"batchOrders": string(b),
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rawMessages := make([]*json.RawMessage, 0)
+	err = json.Unmarshal(data, &rawMessages)
+	if err != nil {
+		return nil, err
+	}
+	res = newCreateBatchOrdersResponse(len(rawMessages))
+	for i, j := range rawMessages {
+		// check if response is an API error
+		e := new(common.APIError)
+		if err := json.Unmarshal(*j, e); err != nil {
+			return nil, err
+		}
+		if e.Code != 0 || e.Message != "" {
+			res.Errors[i] = e
+			continue
+		}
+		o := new(Order)
+		if err := json.Unmarshal(*j, o); err != nil {
+			return nil, err
+		}
+		res.Orders = append(res.Orders, o)
+	}
+	return res, nil
+}
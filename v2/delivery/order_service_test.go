@@ -1,9 +1,11 @@
 package delivery
 
 import (
+	"encoding/json"
 	"strconv"
 	"testing"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -599,3 +601,206 @@ func (s *orderServiceTestSuite) assertLiquidationEqual(e, a *LiquidationOrder) {
 	r.Equal(e.Side, a.Side, "Side")
 	r.Equal(e.Time, a.Time, "Time")
 }
+
+func (s *orderServiceTestSuite) TestModifyOrder() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSD_PERP",
+		"pair": "BTCUSD",
+		"status": "NEW",
+		"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		"price": "30005",
+		"avgPrice": "0.0",
+		"origQty": "1",
+		"executedQty": "0",
+		"cumQty": "0",
+		"cumBase": "0",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"reduceOnly": false,
+		"closePosition": false,
+		"side": "BUY",
+		"positionSide": "LONG",
+		"stopPrice": "0",
+		"workingType": "CONTRACT_PRICE",
+		"priceProtect": false,
+		"origType": "LIMIT",
+		"priceMatch": "NONE",
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	symbol := "BTCUSD_PERP"
+	orderID := int64(20072994037)
+	side := SideTypeBuy
+	quantity := "1"
+	price := "30005"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":   symbol,
+			"orderId":  orderID,
+			"side":     side,
+			"quantity": quantity,
+			"price":    price,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyOrderService().Symbol(symbol).OrderID(orderID).
+		Side(side).Quantity(quantity).Price(price).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&ModifyOrderResponse{
+		OrderID:          orderID,
+		Symbol:           symbol,
+		Pair:             "BTCUSD",
+		Status:           OrderStatusTypeNew,
+		ClientOrderID:    "LJ9R4QZDihCaS8UAOOLpgW",
+		Price:            price,
+		AvgPrice:         "0.0",
+		OrigQuantity:     quantity,
+		ExecutedQuantity: "0",
+		CumQuantity:      "0",
+		CumBase:          "0",
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeLimit,
+		Side:             side,
+		PositionSide:     PositionSideTypeLong,
+		StopPrice:        "0",
+		WorkingType:      WorkingTypeContractPrice,
+		OrigType:         OrderTypeLimit,
+		PriceMatch:       PriceMatchTypeNone,
+		UpdateTime:       1629182711600,
+	}, res)
+}
+
+func (s *orderServiceTestSuite) TestModifyOrderPriceMatch() {
+	data := []byte(`{"orderId": 20072994037, "priceMatch": "QUEUE"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	symbol := "BTCUSD_PERP"
+	origClientOrderID := "LJ9R4QZDihCaS8UAOOLpgW"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":            symbol,
+			"origClientOrderId": origClientOrderID,
+			"side":              SideTypeSell,
+			"priceMatch":        PriceMatchTypeQueue,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyOrderService().Symbol(symbol).OrigClientOrderID(origClientOrderID).
+		Side(SideTypeSell).PriceMatch(PriceMatchTypeQueue).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(PriceMatchTypeQueue, res.PriceMatch)
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrders() {
+	data := []byte(`[
+		{
+			"code": -2014,
+			"msg": "API-key format invalid."
+		},
+		{
+			"clientOrderId": "testOrder",
+			"cumBase": "0",
+			"executedQty": "0",
+			"orderId": 22542179,
+			"avgPrice": "0.0",
+			"origQty": "10",
+			"price": "9000",
+			"reduceOnly": false,
+			"side": "BUY",
+			"positionSide": "BOTH",
+			"status": "NEW",
+			"stopPrice": "0",
+			"closePosition": false,
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"origType": "LIMIT",
+			"updateTime": 1566818724722,
+			"workingType": "CONTRACT_PRICE",
+			"priceProtect": false
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	orders := []*CreateOrderService{
+		s.client.NewCreateOrderService().Symbol("BTCUSD_PERP").Side(SideTypeSell).
+			Type(OrderTypeMarket).Quantity("1").NewClientOrderID("first"),
+		s.client.NewCreateOrderService().Symbol("BTCUSD_PERP").Side(SideTypeBuy).
+			Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("10").
+			Price("9000").NewClientOrderID("testOrder"),
+	}
+	s.assertReq(func(r *request) {
+		b, err := json.Marshal([]params{
+			{
+				"symbol":           "BTCUSD_PERP",
+				"side":             SideTypeSell,
+				"type":             OrderTypeMarket,
+				"quantity":         "1",
+				"newOrderRespType": NewOrderRespType(""),
+				"newClientOrderId": "first",
+			},
+			{
+				"symbol":           "BTCUSD_PERP",
+				"side":             SideTypeBuy,
+				"type":             OrderTypeLimit,
+				"timeInForce":      TimeInForceTypeGTC,
+				"quantity":         "10",
+				"price":            "9000",
+				"newOrderRespType": NewOrderRespType(""),
+				"newClientOrderId": "testOrder",
+			},
+		})
+		s.r().NoError(err)
+		e := newSignedRequest().setFormParam("batchOrders", string(b))
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateBatchOrdersService().OrderList(orders).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(2, res.N)
+	s.r().Equal([]error{
+		&common.APIError{
+			Code:    -2014,
+			Message: "API-key format invalid.",
+		},
+		nil,
+	}, res.Errors)
+	s.r().Len(res.Orders, 1)
+	s.assertOrderEqual(&Order{
+		AvgPrice:         "0.0",
+		ClientOrderID:    "testOrder",
+		CumBase:          "0",
+		ExecutedQuantity: "0",
+		OrderID:          22542179,
+		OrigQuantity:     "10",
+		OrigType:         OrderTypeLimit,
+		Price:            "9000",
+		Side:             SideTypeBuy,
+		PositionSide:     PositionSideTypeBoth,
+		Status:           OrderStatusTypeNew,
+		StopPrice:        "0",
+		Symbol:           "BTCUSD_PERP",
+		Pair:             "BTCUSD",
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeLimit,
+		UpdateTime:       1566818724722,
+		WorkingType:      WorkingTypeContractPrice,
+	}, res.Orders[0])
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrdersErrorWithoutMessage() {
+	data := []byte(`[{"code": -2022}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	orders := []*CreateOrderService{
+		s.client.NewCreateOrderService().Symbol("BTCUSD_PERP").Side(SideTypeSell).
+			Type(OrderTypeMarket).Quantity("1").ReduceOnly(true),
+	}
+	s.assertReq(func(r *request) {})
+	res, err := s.client.NewCreateBatchOrdersService().OrderList(orders).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]error{&common.APIError{Code: -2022}}, res.Errors)
+	s.r().Empty(res.Orders)
+}
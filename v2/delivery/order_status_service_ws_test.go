@@ -0,0 +1,119 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *orderStatusServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+
+	s.requestID = "0ce5d070-a5e5-4ff2-b57f-1556741a4204"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.orderStatus = &OrderStatusWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.orderStatusRequest = NewOrderStatusWsRequest().
+		Symbol("BTCUSD_PERP").
+		OrderID(283194212)
+}
+
+func (s *orderStatusServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type orderStatusServiceWsTestSuite struct {
+	suite.Suite
+	apiKey    string
+	secretKey string
+	signedKey string
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	orderStatus        *OrderStatusWsService
+	orderStatusRequest *OrderStatusWsRequest
+}
+
+func TestOrderStatusServiceWs(t *testing.T) {
+	suite.Run(t, new(orderStatusServiceWsTestSuite))
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatus() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(requestID string, data []byte) error {
+		req := websocket.WsApiRequest{}
+		s.Require().NoError(json.Unmarshal(data, &req))
+		s.Equal(websocket.OrderStatusDeliveryWsApiMethod, req.Method)
+		s.Equal("BTCUSD_PERP", req.Params["symbol"])
+		s.Equal(float64(283194212), req.Params["orderId"])
+		s.Equal(s.apiKey, req.Params["apiKey"])
+		s.Contains(req.Params, "signature")
+		return nil
+	}).Times(1)
+
+	err := s.orderStatus.Do(s.requestID, s.orderStatusRequest)
+	s.NoError(err)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatus_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderStatus.Do("", s.orderStatusRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatus_EmptyApiKey() {
+	s.orderStatus.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderStatus.Do(s.requestID, s.orderStatusRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatusSync() {
+	rawResponseData := []byte(`{"id":"0ce5d070-a5e5-4ff2-b57f-1556741a4204","status":200,"result":{"avgPrice":"0.0","clientOrderId":"abc","cumBase":"0","executedQty":"0","orderId":283194212,"origQty":"11","origType":"LIMIT","price":"5000","reduceOnly":false,"side":"BUY","positionSide":"BOTH","status":"NEW","stopPrice":"0","closePosition":false,"symbol":"BTCUSD_PERP","pair":"BTCUSD","time":1612246150436,"timeInForce":"GTC","type":"LIMIT","activatePrice":"0","priceRate":"0","updateTime":1612246150436,"workingType":"CONTRACT_PRICE","priceProtect":false}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderStatus.SyncDo(s.requestID, s.orderStatusRequest)
+	s.Require().NoError(err)
+	s.Equal(int64(283194212), response.Result.OrderID)
+	s.Equal(int64(1612246150436), response.Result.Time)
+	s.Equal(OrderTypeLimit, response.Result.Type)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatusSync_Error() {
+	rawResponseData := []byte(`{"id":"0ce5d070-a5e5-4ff2-b57f-1556741a4204","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderStatus.SyncDo(s.requestID, s.orderStatusRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatusSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.orderStatus.SyncDo(s.requestID, s.orderStatusRequest)
+	s.Nil(response)
+	s.Error(err)
+}
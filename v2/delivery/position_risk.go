@@ -51,6 +51,8 @@ func (s *GetPositionRiskService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // PositionRisk define position risk info
+//
+//decimalgen:accessors
 type PositionRisk struct {
 	Symbol           string `json:"symbol"`
 	PositionAmt      string `json:"positionAmt"`
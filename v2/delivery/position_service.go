@@ -49,6 +49,8 @@ func (s *ChangeLeverageService) Do(ctx context.Context, opts ...RequestOption) (
 }
 
 // SymbolLeverage define leverage info of symbol
+//
+//decimalgen:accessors
 type SymbolLeverage struct {
 	Leverage    int    `json:"leverage"`
 	MaxQuantity string `json:"maxQty"`
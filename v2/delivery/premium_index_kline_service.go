@@ -0,0 +1,92 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// PremiumIndexKlinesService list premium index klines
+type PremiumIndexKlinesService struct {
+	c         *Client
+	symbol    string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *PremiumIndexKlinesService) Symbol(symbol string) *PremiumIndexKlinesService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *PremiumIndexKlinesService) Interval(interval string) *PremiumIndexKlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *PremiumIndexKlinesService) Limit(limit int) *PremiumIndexKlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *PremiumIndexKlinesService) StartTime(startTime int64) *PremiumIndexKlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *PremiumIndexKlinesService) EndTime(endTime int64) *PremiumIndexKlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *PremiumIndexKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/premiumIndexKlines",
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("interval", s.interval)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	j, err := newJSON(data)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	num := len(j.MustArray())
+	res = make([]*Kline, num)
+	for i := 0; i < num; i++ {
+		item := j.GetIndex(i)
+		if len(item.MustArray()) < 11 {
+			err = fmt.Errorf("invalid kline response")
+			return []*Kline{}, err
+		}
+		res[i] = &Kline{
+			OpenTime:  item.GetIndex(0).MustInt64(),
+			Open:      item.GetIndex(1).MustString(),
+			High:      item.GetIndex(2).MustString(),
+			Low:       item.GetIndex(3).MustString(),
+			Close:     item.GetIndex(4).MustString(),
+			CloseTime: item.GetIndex(6).MustInt64(),
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,64 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type premiumIndexKlinesServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestPremiumIndexKlinesService(t *testing.T) {
+	suite.Run(t, new(premiumIndexKlinesServiceTestSuite))
+}
+
+func (s *premiumIndexKlinesServiceTestSuite) TestPremiumIndexKlines() {
+	data := []byte(`[
+		[
+			1591256400000,
+			"-0.00001010",
+			"0.00000216",
+			"-0.00001168",
+			"-0.00000525",
+			"0",
+			1591256459999,
+			"0",
+			60,
+			"0",
+			"0",
+			"0"
+		]
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	interval := "1m"
+	limit := 10
+	startTime := int64(1591256400000)
+	endTime := int64(1591256459999)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    symbol,
+			"interval":  interval,
+			"limit":     limit,
+			"startTime": startTime,
+			"endTime":   endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	klines, err := s.client.NewPremiumIndexKlinesService().Symbol(symbol).
+		Interval(interval).Limit(limit).StartTime(startTime).
+		EndTime(endTime).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*Kline{{
+		OpenTime:  1591256400000,
+		Open:      "-0.00001010",
+		High:      "0.00000216",
+		Low:       "-0.00001168",
+		Close:     "-0.00000525",
+		CloseTime: 1591256459999,
+	}}, klines)
+}
@@ -0,0 +1,151 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// DefaultRateLimits are the delivery limits used when they can't be loaded from exchange info
+var DefaultRateLimits = []common.RateLimitRule{
+	{Type: common.RateLimitTypeRequestWeight, Interval: time.Minute, Limit: 2400},
+	{Type: common.RateLimitTypeOrders, Interval: time.Minute, Limit: 1200},
+}
+
+// endpointWeights define request weight of endpoints, endpoints which are not listed weigh 1
+var endpointWeights = map[string]int64{
+	"GET /dapi/v1/trades":            5,
+	"GET /dapi/v1/historicalTrades":  20,
+	"GET /dapi/v1/aggTrades":         20,
+	"GET /dapi/v1/premiumIndex":      10,
+	"GET /dapi/v1/account":           5,
+	"GET /dapi/v1/income":            20,
+	"GET /dapi/v1/commissionRate":    20,
+	"GET /dapi/v1/positionSide/dual": 30,
+	"POST /dapi/v1/batchOrders":      5,
+	"PUT /dapi/v1/batchOrders":       5,
+}
+
+// klinesEndpoints are the endpoints weighted by their limit parameter like klines
+var klinesEndpoints = map[string]bool{
+	"GET /dapi/v1/klines":             true,
+	"GET /dapi/v1/continuousKlines":   true,
+	"GET /dapi/v1/indexPriceKlines":   true,
+	"GET /dapi/v1/markPriceKlines":    true,
+	"GET /dapi/v1/premiumIndexKlines": true,
+}
+
+// requestWeight returns request weight of r, weights depending on parameters are computed as documented
+func requestWeight(r *request) int64 {
+	key := r.method + " " + r.endpoint
+	hasSymbol := requestParam(r, "symbol") != ""
+	limit, _ := strconv.Atoi(r.query.Get("limit"))
+	switch {
+	case key == "GET /dapi/v1/depth":
+		switch {
+		case limit > 500:
+			return 20
+		case limit > 100, limit == 0:
+			// the default limit is 500
+			return 10
+		case limit > 50:
+			return 5
+		}
+		return 2
+	case klinesEndpoints[key]:
+		switch {
+		case limit == 0:
+			// the default limit is 500
+			return 5
+		case limit < 100:
+			return 1
+		case limit < 500:
+			return 2
+		case limit <= 1000:
+			return 5
+		}
+		return 10
+	case key == "GET /dapi/v1/openOrders", key == "GET /dapi/v1/ticker/24hr":
+		if hasSymbol {
+			return 1
+		}
+		return 40
+	case key == "GET /dapi/v1/allOrders", key == "GET /dapi/v1/userTrades":
+		if hasSymbol {
+			return 20
+		}
+		return 40
+	case key == "GET /dapi/v1/ticker/price":
+		if hasSymbol {
+			return 1
+		}
+		return 2
+	case key == "GET /dapi/v1/ticker/bookTicker":
+		if hasSymbol {
+			return 2
+		}
+		return 5
+	case key == "GET /dapi/v1/forceOrders":
+		if hasSymbol {
+			return 20
+		}
+		return 50
+	}
+	if weight, ok := endpointWeights[key]; ok {
+		return weight
+	}
+	return 1
+}
+
+// requestOrders returns the number of orders r counts for the ORDERS limits
+func requestOrders(r *request) int64 {
+	switch r.method + " " + r.endpoint {
+	case "POST /dapi/v1/order", "PUT /dapi/v1/order":
+		return 1
+	case "POST /dapi/v1/batchOrders", "PUT /dapi/v1/batchOrders":
+		if n := strings.Count(requestParam(r, "batchOrders"), "{"); n > 0 {
+			return int64(n)
+		}
+		return 1
+	}
+	return 0
+}
+
+// SyncRateLimits load the delivery limits of RateLimiter from exchange info
+func (c *Client) SyncRateLimits(ctx context.Context) error {
+	res, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	rules := make([]common.RateLimitRule, 0, len(res.RateLimits))
+	for _, limit := range res.RateLimits {
+		rule, err := common.NewRateLimitRule(limit.RateLimitType, limit.Interval, limit.IntervalNum, limit.Limit)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, rule)
+	}
+	c.RateLimiter.SetRules("dapi", rules)
+	return nil
+}
+
+// waitRateLimit reserves weight of r, the limits are loaded from exchange info before the first request
+func (c *Client) waitRateLimit(ctx context.Context, r *request) error {
+	if c.RateLimiter == nil {
+		return nil
+	}
+	api := common.RateLimitAPI(r.endpoint)
+	if api != "dapi" {
+		return nil
+	}
+	if !c.RateLimiter.HasRules(api) && r.endpoint != "/dapi/v1/exchangeInfo" {
+		if err := c.SyncRateLimits(ctx); err != nil {
+			c.debug("failed to sync rate limits: %s\n", err)
+			c.RateLimiter.SetRules(api, DefaultRateLimits)
+		}
+	}
+	return c.RateLimiter.Wait(ctx, api, requestWeight(r), requestOrders(r))
+}
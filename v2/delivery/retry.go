@@ -0,0 +1,108 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// callAPIWithRetry sends r and retries it according to RetryPolicy. Orders are retried only if they carry
+// a client order id, the order is looked up before each retry so that it can't be placed twice. If the order
+// has been placed, the response is built from the order status, see createOrderResponse.
+func (c *Client) callAPIWithRetry(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	var clientOrderID string
+	if r.method == http.MethodPost && r.endpoint == "/dapi/v1/order" {
+		clientOrderID = requestParam(r, "newClientOrderId")
+	}
+	retryable := r.method == http.MethodGet || clientOrderID != ""
+	header := r.header.Clone()
+	for attempt := 0; ; attempt++ {
+		r.header = header.Clone()
+		var statusCode int
+		var resHeader http.Header
+		data, statusCode, resHeader, err = c.sendRequest(ctx, r, opts...)
+		if err == nil || !retryable || attempt >= c.RetryPolicy.MaxRetries || ctx.Err() != nil ||
+			!common.IsRetryable(statusCode, err) {
+			return data, err
+		}
+		c.debug("retry request after error: %s\n", err)
+		if c.RetryPolicy.Wait(ctx, attempt, resHeader) != nil {
+			return data, err
+		}
+		if clientOrderID != "" {
+			orderData, found, qerr := c.queryOrder(ctx, requestParam(r, "symbol"), clientOrderID)
+			if qerr != nil {
+				// the order may have been placed, it is not sent again
+				return nil, err
+			}
+			if found {
+				orderData, qerr = createOrderResponse(orderData)
+				if qerr != nil {
+					return nil, err
+				}
+				return orderData, nil
+			}
+		}
+	}
+}
+
+// queryOrder looks up order by client order id, found is false if the order doesn't exist
+func (c *Client) queryOrder(ctx context.Context, symbol string, clientOrderID string) (data []byte, found bool, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/order",
+		secType:  secTypeSigned,
+	}
+	r.setParams(params{
+		"symbol":            symbol,
+		"origClientOrderId": clientOrderID,
+	})
+	data, _, _, err = c.sendRequest(ctx, r)
+	if err != nil {
+		var apiErr *common.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == common.ErrorCodeNoSuchOrder {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// createOrderResponse builds the response of a placed order from its status, the cumulative quantity is the
+// executed quantity
+func createOrderResponse(data []byte) ([]byte, error) {
+	o := new(Order)
+	if err := json.Unmarshal(data, o); err != nil {
+		return nil, err
+	}
+	res := &CreateOrderResponse{
+		ClientOrderID:    o.ClientOrderID,
+		CumQuantity:      o.ExecutedQuantity,
+		CumBase:          o.CumBase,
+		ExecutedQuantity: o.ExecutedQuantity,
+		OrderID:          o.OrderID,
+		AvgPrice:         o.AvgPrice,
+		OrigQuantity:     o.OrigQuantity,
+		Price:            o.Price,
+		ReduceOnly:       o.ReduceOnly,
+		Side:             o.Side,
+		PositionSide:     o.PositionSide,
+		Status:           o.Status,
+		StopPrice:        o.StopPrice,
+		ClosePosition:    o.ClosePosition,
+		Symbol:           o.Symbol,
+		Pair:             o.Pair,
+		TimeInForce:      o.TimeInForce,
+		Type:             o.Type,
+		OrigType:         o.OrigType,
+		ActivatePrice:    o.ActivatePrice,
+		PriceRate:        o.PriceRate,
+		UpdateTime:       o.UpdateTime,
+		WorkingType:      o.WorkingType,
+		PriceProtect:     o.PriceProtect,
+	}
+	return json.Marshal(res)
+}
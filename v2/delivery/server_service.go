@@ -3,6 +3,8 @@ package delivery
 import (
 	"context"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // PingService ping server
@@ -58,3 +60,11 @@ func (s *SetServerTimeService) Do(ctx context.Context, opts ...RequestOption) (t
 	s.c.TimeOffset = timeOffset
 	return timeOffset, nil
 }
+
+// NewTimeSync init time sync sampling the server time of the client, set it as TimeSync of the client and call Start
+// to keep the offset in sync. Pass it to the constructors of the websocket API services to use it for their requests.
+func (c *Client) NewTimeSync() *common.TimeSync {
+	return common.NewTimeSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	})
+}
@@ -0,0 +1,157 @@
+// Code generated by streamgen; DO NOT EDIT.
+
+package delivery
+
+import (
+	"context"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// WsAggTradeStream is WsAggTradeServe stopped when ctx is done, see ServeStream
+func WsAggTradeStream(ctx context.Context, symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAggTradeServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllBookTickerStream is WsAllBookTickerServe stopped when ctx is done, see ServeStream
+func WsAllBookTickerStream(ctx context.Context, handler WsBookTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllBookTickerServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllLiquidationOrderStream is WsAllLiquidationOrderServe stopped when ctx is done, see ServeStream
+func WsAllLiquidationOrderStream(ctx context.Context, handler WsLiquidationOrderHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllLiquidationOrderServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllMarketTickerStream is WsAllMarketTickerServe stopped when ctx is done, see ServeStream
+func WsAllMarketTickerStream(ctx context.Context, handler WsAllMarketTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllMarketTickerServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllMiniMarketTickerStream is WsAllMiniMarketTickerServe stopped when ctx is done, see ServeStream
+func WsAllMiniMarketTickerStream(ctx context.Context, handler WsAllMiniMarketTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllMiniMarketTickerServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsBookTickerStream is WsBookTickerServe stopped when ctx is done, see ServeStream
+func WsBookTickerStream(ctx context.Context, symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsBookTickerServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsContinuousKlineStream is WsContinuousKlineServe stopped when ctx is done, see ServeStream
+func WsContinuousKlineStream(ctx context.Context, pair string, contractType string, interval string, handler WsContinuousKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsContinuousKlineServe(pair, contractType, interval, handler, errHandler)
+	}, errHandler)
+}
+
+// WsDiffDepthStream is WsDiffDepthServe stopped when ctx is done, see ServeStream
+func WsDiffDepthStream(ctx context.Context, symbol string, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsDiffDepthServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsDiffDepthStreamWithRate is WsDiffDepthServeWithRate stopped when ctx is done, see ServeStream
+func WsDiffDepthStreamWithRate(ctx context.Context, symbol string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsDiffDepthServeWithRate(symbol, rate, handler, errHandler)
+	}, errHandler)
+}
+
+// WsIndexPriceKlineStream is WsIndexPriceKlineServe stopped when ctx is done, see ServeStream
+func WsIndexPriceKlineStream(ctx context.Context, pair string, interval string, handler WsIndexPriceKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsIndexPriceKlineServe(pair, interval, handler, errHandler)
+	}, errHandler)
+}
+
+// WsIndexPriceStream is WsIndexPriceServe stopped when ctx is done, see ServeStream
+func WsIndexPriceStream(ctx context.Context, symbol string, handler WsIndexPriceHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsIndexPriceServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsKlineStream is WsKlineServe stopped when ctx is done, see ServeStream
+func WsKlineStream(ctx context.Context, symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsKlineServe(symbol, interval, handler, errHandler)
+	}, errHandler)
+}
+
+// WsLiquidationOrderStream is WsLiquidationOrderServe stopped when ctx is done, see ServeStream
+func WsLiquidationOrderStream(ctx context.Context, symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsLiquidationOrderServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsMarkPriceKlineStream is WsMarkPriceKlineServe stopped when ctx is done, see ServeStream
+func WsMarkPriceKlineStream(ctx context.Context, symbol string, interval string, handler WsMarkPriceKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsMarkPriceKlineServe(symbol, interval, handler, errHandler)
+	}, errHandler)
+}
+
+// WsMarkPriceStream is WsMarkPriceServe stopped when ctx is done, see ServeStream
+func WsMarkPriceStream(ctx context.Context, symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsMarkPriceServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsMarketTickerStream is WsMarketTickerServe stopped when ctx is done, see ServeStream
+func WsMarketTickerStream(ctx context.Context, symbol string, handler WsMarketTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsMarketTickerServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsMiniMarketTickerStream is WsMiniMarketTickerServe stopped when ctx is done, see ServeStream
+func WsMiniMarketTickerStream(ctx context.Context, symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsMiniMarketTickerServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsPairMarkPriceStream is WsPairMarkPriceServe stopped when ctx is done, see ServeStream
+func WsPairMarkPriceStream(ctx context.Context, handler WsPairMarkPriceHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsPairMarkPriceServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsPartialDepthStream is WsPartialDepthServe stopped when ctx is done, see ServeStream
+func WsPartialDepthStream(ctx context.Context, symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsPartialDepthServe(symbol, levels, handler, errHandler)
+	}, errHandler)
+}
+
+// WsPartialDepthStreamWithRate is WsPartialDepthServeWithRate stopped when ctx is done, see ServeStream
+func WsPartialDepthStreamWithRate(ctx context.Context, symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsPartialDepthServeWithRate(symbol, levels, rate, handler, errHandler)
+	}, errHandler)
+}
+
+// WsUserDataStream is WsUserDataServe stopped when ctx is done, see ServeStream
+func WsUserDataStream(ctx context.Context, listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, handler, errHandler)
+	}, errHandler)
+}
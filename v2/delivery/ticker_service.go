@@ -51,6 +51,8 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // BookTicker define book ticker info.
+//
+//decimalgen:accessors
 type BookTicker struct {
 	Symbol      string `json:"symbol"`
 	Pair        string `json:"pair"`
@@ -105,6 +107,8 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // SymbolPrice define symbol, price and pair.
+//
+//decimalgen:accessors
 type SymbolPrice struct {
 	Symbol string `json:"symbol"`
 	Pair   string `json:"ps"`
@@ -156,6 +160,8 @@ func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOpt
 }
 
 // PriceChangeStats define price change stats.
+//
+//decimalgen:accessors
 type PriceChangeStats struct {
 	Symbol             string `json:"symbol"`
 	Pair               string `json:"pair"`
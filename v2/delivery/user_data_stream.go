@@ -0,0 +1,142 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// userDataOrderLookback is how long before a gap orders are requested, orders that old may still change during it
+const userDataOrderLookback = 24 * time.Hour
+
+// NewUserDataStream creates a user data stream which owns its listen key. It keeps the key alive and reconnects when
+// the key has expired or the connection failed. The orders and trades of symbols missed meanwhile are backfilled as
+// ORDER_TRADE_UPDATE events, nothing is backfilled without symbols. The ACCOUNT_UPDATE events are lost, a
+// *common.UserStreamGapError is passed to the ErrHandler after every reconnect so the balances and positions can be
+// requested again. Set the options of the stream and start it with Run.
+func (c *Client) NewUserDataStream(symbols ...string) *common.UserStream[*WsUserDataEvent] {
+	stream := common.NewUserStream(common.UserStreamSource[*WsUserDataEvent]{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Serve: func(listenKey string, handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			// the stream has to notice the drop to backfill it, so the connection doesn't reconnect by itself
+			cfg := newWsConfig(fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey))
+			cfg.Reconnect = false
+			return wsUserDataServe(cfg, handler, errHandler)
+		},
+		Expired: func(event *WsUserDataEvent) bool {
+			return event.Event == UserDataEventTypeListenKeyExpired
+		},
+	})
+	stream.Backfill = func(ctx context.Context, startTime, endTime int64) ([]*WsUserDataEvent, error) {
+		return c.backfillUserData(ctx, symbols, startTime, endTime)
+	}
+	return stream
+}
+
+// backfillUserData rebuilds the ORDER_TRADE_UPDATE events between startTime and endTime from the orders and trades
+// of symbols. Fields which the responses lack are empty.
+func (c *Client) backfillUserData(ctx context.Context, symbols []string, startTime, endTime int64) ([]*WsUserDataEvent, error) {
+	replay := &common.OrderReplay[*Order, *AccountTrade, *WsUserDataEvent]{
+		Orders: func(symbol string) *common.Iterator[*Order] {
+			return c.NewListOrdersService().Symbol(symbol).Iterator(startTime-userDataOrderLookback.Milliseconds(), endTime)
+		},
+		Trades: func(symbol string) *common.Iterator[*AccountTrade] {
+			return c.NewListAccountTradeService().Symbol(symbol).Iterator(startTime, endTime)
+		},
+		OrderInfo: func(o *Order) (id, createTime, updateTime int64) {
+			return o.OrderID, o.Time, o.UpdateTime
+		},
+		TradeInfo: func(t *AccountTrade) (id, orderID int64) {
+			return t.ID, t.OrderID
+		},
+		Created: func(o *Order) *WsUserDataEvent {
+			u := orderTradeUpdate(o)
+			u.ExecutionType = OrderExecutionTypeNew
+			u.Status = OrderStatusTypeNew
+			u.AccumulatedFilledQty = "0"
+			u.TradeTime = o.Time
+			return orderTradeUpdateEvent(u)
+		},
+		Ended: func(o *Order) (*WsUserDataEvent, bool) {
+			if o.Status != OrderStatusTypeCanceled && o.Status != OrderStatusTypeExpired {
+				return nil, false
+			}
+			u := orderTradeUpdate(o)
+			u.ExecutionType = OrderExecutionType(o.Status)
+			u.TradeTime = o.UpdateTime
+			return orderTradeUpdateEvent(u), true
+		},
+		Traded: func(t *AccountTrade, o *Order, found, last bool) *WsUserDataEvent {
+			u := WsOrderTradeUpdate{Symbol: t.Symbol, ID: t.OrderID, Side: t.Side, PositionSide: t.PositionSide}
+			if found {
+				u = orderTradeUpdate(o)
+				u.AccumulatedFilledQty = ""
+			}
+			u.Status = OrderStatusTypePartiallyFilled
+			if found && last && o.Status == OrderStatusTypeFilled {
+				u.Status = OrderStatusTypeFilled
+				u.AccumulatedFilledQty = o.ExecutedQuantity
+			}
+			u.ExecutionType = OrderExecutionTypeTrade
+			u.TradeID = t.ID
+			u.LastFilledQty = t.Quantity
+			u.LastFilledPrice = t.Price
+			u.MarginAsset = t.MarginAsset
+			u.CommissionAsset = t.CommissionAsset
+			u.Commission = t.Commission
+			u.RealizedPnL = t.RealizedPnl
+			u.IsMaker = t.Maker
+			u.TradeTime = t.Time
+			return orderTradeUpdateEvent(u)
+		},
+		Time: func(e *WsUserDataEvent) int64 {
+			return e.OrderTradeUpdate.TradeTime
+		},
+	}
+	return replay.Events(ctx, symbols, startTime)
+}
+
+// orderTradeUpdate returns the order update of the current state of o
+func orderTradeUpdate(o *Order) WsOrderTradeUpdate {
+	return WsOrderTradeUpdate{
+		Symbol:               o.Symbol,
+		ClientOrderID:        o.ClientOrderID,
+		Side:                 o.Side,
+		Type:                 o.Type,
+		TimeInForce:          o.TimeInForce,
+		OriginalQty:          o.OrigQuantity,
+		OriginalPrice:        o.Price,
+		AveragePrice:         o.AvgPrice,
+		StopPrice:            o.StopPrice,
+		Status:               o.Status,
+		ID:                   o.OrderID,
+		AccumulatedFilledQty: o.ExecutedQuantity,
+		IsReduceOnly:         o.ReduceOnly,
+		WorkingType:          o.WorkingType,
+		OriginalType:         o.OrigType,
+		PositionSide:         o.PositionSide,
+		IsClosingPosition:    o.ClosePosition,
+		ActivationPrice:      o.ActivatePrice,
+		CallbackRate:         o.PriceRate,
+		IsProtected:          o.PriceProtect,
+	}
+}
+
+func orderTradeUpdateEvent(u WsOrderTradeUpdate) *WsUserDataEvent {
+	return &WsUserDataEvent{
+		Event:            UserDataEventTypeOrderTradeUpdate,
+		Time:             u.TradeTime,
+		TransactionTime:  u.TradeTime,
+		OrderTradeUpdate: u,
+	}
+}
@@ -1,6 +1,10 @@
 package delivery
 
 import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -57,3 +61,54 @@ func (s *userStreamServiceTestSuite) TestCloseUserStream() {
 	err := s.client.NewCloseUserStreamService().ListenKey(listenKey).Do(newContext())
 	s.r().NoError(err)
 }
+
+func (s *userStreamServiceTestSuite) TestUserDataStreamBackfill() {
+	start := int64(1700000000000)
+	orders := []string{
+		fmt.Sprintf(`{"symbol":"BTCUSD_PERP","orderId":1,"origQty":"2","executedQty":"2","status":"FILLED","side":"BUY",
+			"time":%d,"updateTime":%d}`, start-1000, start+10000),
+		fmt.Sprintf(`{"symbol":"BTCUSD_PERP","orderId":2,"origQty":"1","executedQty":"0","status":"CANCELED","side":"SELL",
+			"time":%d,"updateTime":%d}`, start+5000, start+20000),
+	}
+	trades := []string{
+		fmt.Sprintf(`{"id":11,"symbol":"BTCUSD_PERP","orderId":1,"price":"10","qty":"1","marginAsset":"BTC","side":"BUY",
+			"time":%d}`, start+8000),
+		fmt.Sprintf(`{"id":12,"symbol":"BTCUSD_PERP","orderId":1,"price":"10","qty":"1","marginAsset":"BTC","side":"BUY",
+			"time":%d}`, start+10000),
+	}
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		startTime, _ := strconv.ParseInt(query.Get("startTime"), 10, 64)
+		endTime, _ := strconv.ParseInt(query.Get("endTime"), 10, 64)
+		rows := trades
+		if strings.HasSuffix(req.URL.Path, "/allOrders") {
+			rows = orders
+		}
+		var res []string
+		for _, row := range rows {
+			var t int64
+			fmt.Sscanf(row[strings.Index(row, `"time":`)+7:], "%d", &t)
+			if t >= startTime && t <= endTime {
+				res = append(res, row)
+			}
+		}
+		return newHTTPResponse([]byte("["+strings.Join(res, ",")+"]"), http.StatusOK), nil
+	}
+
+	stream := s.client.NewUserDataStream("BTCUSD_PERP")
+	events, err := stream.Backfill(newContext(), start, start+60000)
+	s.r().NoError(err)
+	var got []string
+	for _, e := range events {
+		s.r().Equal(UserDataEventTypeOrderTradeUpdate, e.Event)
+		u := e.OrderTradeUpdate
+		got = append(got, fmt.Sprintf("%d %d %s %s %s %s", u.TradeTime-start, u.ID, u.ExecutionType, u.Status,
+			u.AccumulatedFilledQty, u.MarginAsset))
+	}
+	s.r().Equal([]string{
+		"5000 2 NEW NEW 0 ",
+		"8000 1 TRADE PARTIALLY_FILLED  BTC",
+		"10000 1 TRADE FILLED 2 BTC",
+		"20000 2 CANCELED CANCELED 0 ",
+	}, got)
+}
@@ -7,6 +7,11 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // Endpoints
@@ -14,6 +19,14 @@ var (
 	BaseWsMainUrl    = "wss://dstream.binance.com/ws"
 	BaseWsTestnetUrl = "wss://dstream.binancefuture.com/ws"
 	BaseWsDemoURL    = "wss://dstream.binancefuture.com/ws"
+
+	BaseCombinedMainURL    = "wss://dstream.binance.com/stream?streams="
+	BaseCombinedTestnetURL = "wss://dstream.binancefuture.com/stream?streams="
+	BaseCombinedDemoURL    = "wss://dstream.binancefuture.com/stream?streams="
+
+	BaseWsApiMainURL    = "wss://ws-dapi.binance.com/ws-dapi/v1"
+	BaseWsApiTestnetURL = "wss://testnet.binancefuture.com/ws-dapi/v1"
+	BaseWsApiDemoURL    = "wss://testnet.binancefuture.com/ws-dapi/v1"
 )
 
 var (
@@ -26,10 +39,30 @@ var (
 	// UseTestnet switch all the WS streams from production to the testnet
 	UseTestnet = false
 	// UseDemo switch all the API endpoints from production to the demo
-	UseDemo  = false
-	ProxyUrl = ""
+	UseDemo = false
+	// WebsocketTimeoutReadWriteConnection is an interval for sending ping/pong messages if WebsocketKeepalive is enabled
+	// using for websocket API (read/write)
+	WebsocketTimeoutReadWriteConnection = time.Second * 10
+	ProxyUrl                            = ""
+	// WebsocketAutoReconnect makes market data streams redial with backoff after a disconnect
+	// instead of closing doneC
+	WebsocketAutoReconnect = false
+	// WebsocketReconnectHandler is called after a stream has been redialed if WebsocketAutoReconnect is enabled
+	WebsocketReconnectHandler WsReconnectHandler
+	// WebsocketReconnectMinInterval is the initial delay before redialing a dropped stream
+	WebsocketReconnectMinInterval = 100 * time.Millisecond
+	// WebsocketReconnectMaxInterval caps the delay between redial attempts
+	WebsocketReconnectMaxInterval = 10 * time.Second
 )
 
+// optionalTimeSync returns the time sync passed to a constructor, nil if there is none
+func optionalTimeSync(timeSync []*common.TimeSync) *common.TimeSync {
+	if len(timeSync) > 0 {
+		return timeSync[0]
+	}
+	return nil
+}
+
 // getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
 func getWsEndpoint() string {
 	if UseTestnet {
@@ -41,6 +74,28 @@ func getWsEndpoint() string {
 	return BaseWsMainUrl
 }
 
+// getCombinedEndpoint return the base endpoint of the combined stream according the UseTestnet flag
+func getCombinedEndpoint() string {
+	if UseTestnet {
+		return BaseCombinedTestnetURL
+	}
+	if UseDemo {
+		return BaseCombinedDemoURL
+	}
+	return BaseCombinedMainURL
+}
+
+// getWsApiEndpoint return the base endpoint of the API WS according the UseTestnet flag
+func getWsApiEndpoint() string {
+	if UseTestnet {
+		return BaseWsApiTestnetURL
+	}
+	if UseDemo {
+		return BaseWsApiDemoURL
+	}
+	return BaseWsApiMainURL
+}
+
 func getWsProxyUrl() *string {
 	if ProxyUrl == "" {
 		return nil
@@ -53,6 +108,8 @@ func SetWsProxyUrl(url string) {
 }
 
 // WsAggTradeEvent define websocket aggTrde event.
+//
+//decimalgen:accessors
 type WsAggTradeEvent struct {
 	Event            string `json:"e"`
 	Time             int64  `json:"E"`
@@ -86,6 +143,8 @@ func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHan
 }
 
 // WsIndexPriceEvent define websocket indexPriceUpdate event.
+//
+//decimalgen:accessors
 type WsIndexPriceEvent struct {
 	Event      string `json:"e"`
 	Time       int64  `json:"E"`
@@ -113,6 +172,8 @@ func WsIndexPriceServe(symbol string, handler WsIndexPriceHandler, errHandler Er
 }
 
 // WsMarkPriceEvent define websocket markPriceUpdate event.
+//
+//decimalgen:accessors
 type WsMarkPriceEvent struct {
 	Event                string `json:"e"`
 	Time                 int64  `json:"E"`
@@ -173,6 +234,8 @@ type WsKlineEvent struct {
 }
 
 // WsKline define websocket kline
+//
+//decimalgen:accessors
 type WsKline struct {
 	StartTime            int64  `json:"t"`
 	EndTime              int64  `json:"T"`
@@ -221,6 +284,8 @@ type WsContinuousKlineEvent struct {
 }
 
 // WsContinuousKline define websocket continuous kline
+//
+//decimalgen:accessors
 type WsContinuousKline struct {
 	StartTime            int64  `json:"t"`
 	EndTime              int64  `json:"T"`
@@ -267,6 +332,8 @@ type WsIndexPriceKlineEvent struct {
 }
 
 // WsIndexPriceKline define websocket index price kline
+//
+//decimalgen:accessors
 type WsIndexPriceKline struct {
 	StartTime   int64  `json:"t"`
 	EndTime     int64  `json:"T"`
@@ -308,6 +375,8 @@ type WsMarkPriceKlineEvent struct {
 }
 
 // WsMarkPriceKline define websocket market price kline
+//
+//decimalgen:accessors
 type WsMarkPriceKline struct {
 	StartTime   int64  `json:"t"`
 	EndTime     int64  `json:"T"`
@@ -342,6 +411,8 @@ func WsMarkPriceKlineServe(symbol string, interval string, handler WsMarkPriceKl
 }
 
 // WsMiniMarketTickerEvent define websocket mini market ticker event.
+//
+//decimalgen:accessors
 type WsMiniMarketTickerEvent struct {
 	Event       string `json:"e"`
 	Time        int64  `json:"E"`
@@ -397,6 +468,8 @@ func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler
 }
 
 // WsMarketTickerEvent define websocket market ticker event.
+//
+//decimalgen:accessors
 type WsMarketTickerEvent struct {
 	Event              string `json:"e"`
 	Time               int64  `json:"E"`
@@ -461,6 +534,8 @@ func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHand
 }
 
 // WsBookTickerEvent define websocket best book ticker event.
+//
+//decimalgen:accessors
 type WsBookTickerEvent struct {
 	Event           string `json:"e"`
 	UpdateID        int64  `json:"u"`
@@ -517,6 +592,8 @@ type WsLiquidationOrderEvent struct {
 }
 
 // WsLiquidationOrder define websocket liquidation order.
+//
+//decimalgen:accessors
 type WsLiquidationOrder struct {
 	Symbol               string          `json:"s"`
 	Pair                 string          `json:"ps"`
@@ -636,39 +713,46 @@ func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 			errHandler(err)
 			return
 		}
-		event := new(WsDepthEvent)
-		event.Event = j.Get("e").MustString()
-		event.Time = j.Get("E").MustInt64()
-		event.TransactionTime = j.Get("T").MustInt64()
-		event.Symbol = j.Get("s").MustString()
-		event.Pair = j.Get("ps").MustString()
-		event.FirstUpdateID = j.Get("U").MustInt64()
-		event.LastUpdateID = j.Get("u").MustInt64()
-		event.PrevLastUpdateID = j.Get("pu").MustInt64()
-		bidsLen := len(j.Get("b").MustArray())
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := j.Get("b").GetIndex(i)
-			event.Bids[i] = Bid{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
+		handler(newWsDepthEvent(j))
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// newWsDepthEvent parses depth event
+func newWsDepthEvent(j *simplejson.Json) *WsDepthEvent {
+	event := new(WsDepthEvent)
+	event.Event = j.Get("e").MustString()
+	event.Time = j.Get("E").MustInt64()
+	event.TransactionTime = j.Get("T").MustInt64()
+	event.Symbol = j.Get("s").MustString()
+	event.Pair = j.Get("ps").MustString()
+	event.FirstUpdateID = j.Get("U").MustInt64()
+	event.LastUpdateID = j.Get("u").MustInt64()
+	event.PrevLastUpdateID = j.Get("pu").MustInt64()
+	bidsLen := len(j.Get("b").MustArray())
+	event.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("b").GetIndex(i)
+		event.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
 		}
-		asksLen := len(j.Get("a").MustArray())
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := j.Get("a").GetIndex(i)
-			event.Asks[i] = Ask{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
+	}
+	asksLen := len(j.Get("a").MustArray())
+	event.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("a").GetIndex(i)
+		event.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
 		}
-		handler(event)
 	}
-	return wsServe(cfg, wsHandler, errHandler)
+	return event
 }
 
 // WsUserDataEvent define user data event
+//
+//decimalgen:accessors
 type WsUserDataEvent struct {
 	Event               UserDataEventType  `json:"e"`
 	Time                int64              `json:"E"`
@@ -725,6 +809,8 @@ type WsAccountUpdate struct {
 }
 
 // WsBalance define balance
+//
+//decimalgen:accessors
 type WsBalance struct {
 	Asset              string `json:"a"`
 	Balance            string `json:"wb"`
@@ -733,6 +819,8 @@ type WsBalance struct {
 }
 
 // WsPosition define position
+//
+//decimalgen:accessors
 type WsPosition struct {
 	Symbol                    string           `json:"s"`
 	Side                      PositionSideType `json:"ps"`
@@ -747,6 +835,8 @@ type WsPosition struct {
 }
 
 // WsOrderTradeUpdate define order trade update
+//
+//decimalgen:accessors
 type WsOrderTradeUpdate struct {
 	Symbol               string             `json:"s"`
 	ClientOrderID        string             `json:"c"`
@@ -794,7 +884,11 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 // WsUserDataServe serve user data handler with listen key
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	return wsUserDataServe(newWsConfig(endpoint), handler, errHandler)
+}
+
+// wsUserDataServe serves the user data stream of cfg
+func wsUserDataServe(cfg *WsConfig, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
@@ -806,3 +900,14 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
+
+// WsApiInitReadWriteConn create and serve connection
+func WsApiInitReadWriteConn() (*websocket.Conn, error) {
+	cfg := newWsConfig(getWsApiEndpoint())
+	conn, err := WsGetReadWriteConnection(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return conn, err
+}
@@ -1560,3 +1560,13 @@ func (s *websocketServiceTestSuite) assertOrderTradeUpdate(e, a WsOrderTradeUpda
 	r.Equal(e.CallbackRate, a.CallbackRate, "CallbackRate")
 	r.Equal(e.RealizedPnL, a.RealizedPnL, "RealizedPnL")
 }
+
+func (s *websocketServiceTestSuite) TestWsApiEndpoint() {
+	defer func() { UseTestnet, UseDemo = false, false }()
+
+	s.r().Equal(BaseWsApiMainURL, getWsApiEndpoint())
+	UseTestnet = true
+	s.r().Equal(BaseWsApiTestnetURL, getWsApiEndpoint())
+	UseTestnet, UseDemo = false, true
+	s.r().Equal(BaseWsApiDemoURL, getWsApiEndpoint())
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // ListDepositsService fetches deposit history.
@@ -104,8 +107,21 @@ func (s *ListDepositsService) Do(ctx context.Context, opts ...RequestOption) (re
 	return res, nil
 }
 
+// Iterator walks the deposits between startTime and endTime in milliseconds, in windows of 90 days and pages of 1000
+// deposits
+func (s *ListDepositsService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Deposit] {
+	return common.NewOffsetIterator(startTime, endTime, 90*24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, page, size int) ([]*Deposit, error) {
+			return s.StartTime(startTime).EndTime(endTime).Offset((page-1)*size).Limit(size).Do(ctx, opts...)
+		},
+		func(d *Deposit) string { return d.ID })
+}
+
 // Deposit represents a single deposit entry.
+//
+//decimalgen:accessors
 type Deposit struct {
+	ID            string `json:"id"`
 	Amount        string `json:"amount"`
 	Coin          string `json:"coin"`
 	Network       string `json:"network"`
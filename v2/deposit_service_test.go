@@ -1,7 +1,11 @@
 package binance
 
 import (
+	"net/http"
+	"net/url"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -17,6 +21,7 @@ func TestDepositService(t *testing.T) {
 func (s *depositServiceTestSuite) TestListDeposits() {
 	data := []byte(`[
     {
+        "id":"769800519366885376",
         "amount":"0.00999800",
         "coin":"PAXG",
         "network":"ETH",
@@ -30,6 +35,7 @@ func (s *depositServiceTestSuite) TestListDeposits() {
         "confirmTimes":"12/12"
     },
     {
+        "id":"769800519366885377",
         "amount":"0.50000000",
         "coin":"IOTA",
         "network":"IOTA",
@@ -70,6 +76,7 @@ func (s *depositServiceTestSuite) TestListDeposits() {
 
 	r.Len(deposits, 2)
 	s.assertDepositEqual(&Deposit{
+		ID:            "769800519366885376",
 		Amount:        "0.00999800",
 		Coin:          "PAXG",
 		Network:       "ETH",
@@ -83,6 +90,7 @@ func (s *depositServiceTestSuite) TestListDeposits() {
 		ConfirmTimes:  "12/12",
 	}, deposits[0])
 	s.assertDepositEqual(&Deposit{
+		ID:            "769800519366885377",
 		Amount:        "0.50000000",
 		Coin:          "IOTA",
 		Network:       "IOTA",
@@ -99,6 +107,7 @@ func (s *depositServiceTestSuite) TestListDeposits() {
 
 func (s *depositServiceTestSuite) assertDepositEqual(e, a *Deposit) {
 	r := s.r()
+	r.Equal(e.ID, a.ID, "ID")
 	r.Equal(e.Amount, a.Amount, "Amount")
 	r.Equal(e.Coin, a.Coin, "Coin")
 	r.Equal(e.Network, a.Network, "Network")
@@ -146,3 +155,34 @@ func (s *depositServiceTestSuite) TestGetDepositAddress() {
 	r.Equal("BTC", res.Coin)
 	r.Equal("https://btc.com/1HPn8Rx2y6nNSfagQBKy27GB99Vbzg89wv", res.URL)
 }
+
+func (s *depositServiceTestSuite) TestListDepositsIterator() {
+	var queries []url.Values
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		queries = append(queries, query)
+		data := `[]`
+		if query.Get("startTime") == "0" {
+			// the second deposit only differs in its status, it's the same deposit updated between the pages
+			data = `[{"id": "1", "amount": "0.1", "coin": "BTC", "status": 0, "txId": "tx1", "insertTime": 100},
+				{"id": "1", "amount": "0.1", "coin": "BTC", "status": 1, "txId": "tx1", "insertTime": 100}]`
+		}
+		return newHTTPResponse([]byte(data), http.StatusOK), nil
+	}
+
+	ninetyDays := int64(90 * 24 * time.Hour / time.Millisecond)
+	it := s.client.NewListDepositsService().Coin("BTC").Iterator(0, ninetyDays)
+	var txIDs []string
+	for it.Next(newContext()) {
+		txIDs = append(txIDs, it.Value().TxID)
+	}
+	s.r().NoError(it.Err())
+	s.r().Equal([]string{"tx1"}, txIDs)
+
+	s.r().Len(queries, 2)
+	s.r().Equal(strconv.FormatInt(ninetyDays-1, 10), queries[0].Get("endTime"))
+	s.r().Equal("0", queries[0].Get("offset"))
+	s.r().Equal("1000", queries[0].Get("limit"))
+	s.r().Equal(strconv.FormatInt(ninetyDays, 10), queries[1].Get("startTime"))
+	s.r().Equal(strconv.FormatInt(ninetyDays, 10), queries[1].Get("endTime"))
+}
@@ -53,6 +53,7 @@ type DualInvestmentProductListResponse struct {
 	List  []DualInvestmentProduct `json:"list"`
 }
 
+//decimalgen:accessors
 type DualInvestmentProduct struct {
 	ID                   string                   `json:"id"`
 	InvestCoin           string                   `json:"investCoin"`
@@ -154,6 +155,8 @@ type ListDualInvestmentPositionResponse struct {
 	Total int                          `json:"total"`
 	List  []ListDualInvestmentPosition `json:"list"`
 }
+
+//decimalgen:accessors
 type ListDualInvestmentPosition struct {
 	ID                 string                           `json:"id"`
 	InvestCoin         string                           `json:"investCoin"`
@@ -230,6 +233,7 @@ type GetDualInvestmentAccountsService struct {
 	c *Client
 }
 
+//decimalgen:accessors
 type GetDualInvestmentAccountsResp struct {
 	TotalAmountInBTC  string `json:"totalAmountInBTC"`
 	TotalAmountInUSDT string `json:"totalAmountInUSDT"`
@@ -269,6 +273,7 @@ type SubscribeDualInvestmentService struct {
 	autoCompoundPlan DualInvestmentCompoundPlan
 }
 
+//decimalgen:accessors
 type SubscribeDualInvestmentResp struct {
 	PositionID         int64                            `json:"positionId"`
 	InvestCoin         string                           `json:"investCoin"`
@@ -69,6 +69,8 @@ type DustResult struct {
 }
 
 // UserAssetDribblet represents one dust log row
+//
+//decimalgen:accessors
 type UserAssetDribblet struct {
 	OperateTime              int64                     `json:"operateTime"`
 	TotalTransferedAmount    string                    `json:"totalTransferedAmount"`    //Total transferred BNB amount for this exchange.
@@ -78,6 +80,8 @@ type UserAssetDribblet struct {
 }
 
 // DustLog represents one dust log information
+//
+//decimalgen:accessors
 type UserAssetDribbletDetail struct {
 	TransID             int    `json:"transId"`
 	ServiceChargeAmount string `json:"serviceChargeAmount"`
@@ -130,6 +134,8 @@ type DustTransferResponse struct {
 }
 
 // DustTransferResult represents the result of a dust transfer.
+//
+//decimalgen:accessors
 type DustTransferResult struct {
 	Amount              string `json:"amount"`
 	FromAsset           string `json:"fromAsset"`
@@ -164,6 +170,7 @@ func (s *ListDustService) Do(ctx context.Context) (res *ListDustResponse, err er
 	return res, nil
 }
 
+//decimalgen:accessors
 type ListDustDetail struct {
 	Asset            string `json:"asset"`
 	AssetFullName    string `json:"assetFullName"`
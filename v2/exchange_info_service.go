@@ -118,6 +118,8 @@ type Symbol struct {
 }
 
 // LotSizeFilter define lot size filter of symbol
+//
+//decimalgen:accessors
 type LotSizeFilter struct {
 	MaxQuantity string `json:"maxQty"`
 	MinQuantity string `json:"minQty"`
@@ -125,6 +127,8 @@ type LotSizeFilter struct {
 }
 
 // PriceFilter define price filter of symbol
+//
+//decimalgen:accessors
 type PriceFilter struct {
 	MaxPrice string `json:"maxPrice"`
 	MinPrice string `json:"minPrice"`
@@ -141,6 +145,8 @@ type PercentPriceBySideFilter struct {
 }
 
 // NotionalFilter define notional filter of symbol
+//
+//decimalgen:accessors
 type NotionalFilter struct {
 	MinNotional      string `json:"minNotional"`
 	ApplyMinToMarket bool   `json:"applyMinToMarket"`
@@ -155,6 +161,8 @@ type IcebergPartsFilter struct {
 }
 
 // MarketLotSizeFilter define market lot size filter of symbol
+//
+//decimalgen:accessors
 type MarketLotSizeFilter struct {
 	MaxQuantity string `json:"maxQty"`
 	MinQuantity string `json:"minQty"`
@@ -0,0 +1,165 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// ExchangeInfoWsService queries exchange info
+type ExchangeInfoWsService struct {
+	c websocket.Client
+}
+
+// NewExchangeInfoWsService init ExchangeInfoWsService
+func NewExchangeInfoWsService() (*ExchangeInfoWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExchangeInfoWsService{
+		c: client,
+	}, nil
+}
+
+// NewExchangeInfoWsServiceWithSession init ExchangeInfoWsService sending its requests on the connection of session
+func NewExchangeInfoWsServiceWithSession(session *SessionWsService) *ExchangeInfoWsService {
+	return &ExchangeInfoWsService{
+		c: session.c,
+	}
+}
+
+// ExchangeInfoWsRequest parameters for 'exchangeInfo' websocket API
+type ExchangeInfoWsRequest struct {
+	symbol             *string
+	symbols            []string
+	permissions        []string
+	showPermissionSets *bool
+}
+
+// NewExchangeInfoWsRequest init ExchangeInfoWsRequest
+func NewExchangeInfoWsRequest() *ExchangeInfoWsRequest {
+	return &ExchangeInfoWsRequest{}
+}
+
+func (s *ExchangeInfoWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *ExchangeInfoWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if len(s.symbols) != 0 {
+		m["symbols"] = s.symbols
+	}
+	if len(s.permissions) != 0 {
+		m["permissions"] = s.permissions
+	}
+	if s.showPermissionSets != nil {
+		m["showPermissionSets"] = *s.showPermissionSets
+	}
+	return m
+}
+
+// Do - sends 'exchangeInfo' request
+func (s *ExchangeInfoWsService) Do(requestID string, request *ExchangeInfoWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.ExchangeInfoSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'exchangeInfo' request and receives response
+func (s *ExchangeInfoWsService) SyncDo(requestID string, request *ExchangeInfoWsRequest) (*ExchangeInfoWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.ExchangeInfoSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeInfoWsResponse := &ExchangeInfoWsResponse{}
+	if err := json.Unmarshal(response, exchangeInfoWsResponse); err != nil {
+		return nil, err
+	}
+	if exchangeInfoWsResponse.Error != nil {
+		exchangeInfoWsResponse.Error.StatusCode = exchangeInfoWsResponse.Status
+		return nil, exchangeInfoWsResponse.Error
+	}
+
+	return exchangeInfoWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *ExchangeInfoWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *ExchangeInfoWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *ExchangeInfoWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *ExchangeInfoWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *ExchangeInfoWsRequest) Symbol(symbol string) *ExchangeInfoWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols
+func (s *ExchangeInfoWsRequest) Symbols(symbols []string) *ExchangeInfoWsRequest {
+	s.symbols = symbols
+	return s
+}
+
+// Permissions set permissions
+func (s *ExchangeInfoWsRequest) Permissions(permissions []string) *ExchangeInfoWsRequest {
+	s.permissions = permissions
+	return s
+}
+
+// ShowPermissionSets set showPermissionSets
+func (s *ExchangeInfoWsRequest) ShowPermissionSets(showPermissionSets bool) *ExchangeInfoWsRequest {
+	s.showPermissionSets = &showPermissionSets
+	return s
+}
+
+// ExchangeInfoWsResponse define 'exchangeInfo' websocket API response
+type ExchangeInfoWsResponse struct {
+	Id     string       `json:"id"`
+	Status int          `json:"status"`
+	Result ExchangeInfo `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
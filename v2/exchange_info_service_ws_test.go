@@ -0,0 +1,93 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *exchangeInfoServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.exchangeInfo = &ExchangeInfoWsService{
+		c: s.client,
+	}
+
+	s.exchangeInfoRequest = NewExchangeInfoWsRequest().
+		Symbol("BNBBTC")
+}
+
+func (s *exchangeInfoServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type exchangeInfoServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	exchangeInfo        *ExchangeInfoWsService
+	exchangeInfoRequest *ExchangeInfoWsRequest
+}
+
+func TestExchangeInfoServiceWs(t *testing.T) {
+	suite.Run(t, new(exchangeInfoServiceWsTestSuite))
+}
+
+func (s *exchangeInfoServiceWsTestSuite) TestExchangeInfo() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.exchangeInfo.Do(s.requestID, s.exchangeInfoRequest)
+	s.NoError(err)
+}
+
+func (s *exchangeInfoServiceWsTestSuite) TestExchangeInfo_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.exchangeInfo.Do("", s.exchangeInfoRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *exchangeInfoServiceWsTestSuite) TestExchangeInfoSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":{"timezone":"UTC","serverTime":1655969291181,"rateLimits":[{"rateLimitType":"REQUEST_WEIGHT","interval":"MINUTE","intervalNum":1,"limit":6000}],"exchangeFilters":[],"symbols":[{"symbol":"BNBBTC","status":"TRADING","baseAsset":"BNB","baseAssetPrecision":8,"quoteAsset":"BTC","quotePrecision":8,"orderTypes":["LIMIT","MARKET"],"filters":[{"filterType":"PRICE_FILTER","minPrice":"0.00000100","maxPrice":"100000.00000000","tickSize":"0.00000100"}]}]}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.exchangeInfo.SyncDo(s.requestID, s.exchangeInfoRequest)
+	s.Require().NoError(err)
+	s.Equal(int64(1655969291181), response.Result.ServerTime)
+	s.Require().Len(response.Result.RateLimits, 1)
+	s.Equal(int64(6000), response.Result.RateLimits[0].Limit)
+	s.Require().Len(response.Result.Symbols, 1)
+	s.Equal("BNB", response.Result.Symbols[0].BaseAsset)
+	s.Equal("0.00000100", response.Result.Symbols[0].PriceFilter().TickSize)
+}
+
+func (s *exchangeInfoServiceWsTestSuite) TestExchangeInfoSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.exchangeInfo.SyncDo(s.requestID, s.exchangeInfoRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *exchangeInfoServiceWsTestSuite) TestExchangeInfoSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.exchangeInfo.SyncDo(s.requestID, s.exchangeInfoRequest)
+	s.Nil(response)
+	s.Error(err)
+}
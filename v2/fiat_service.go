@@ -87,6 +87,8 @@ type FiatDepositWithdrawHistory struct {
 }
 
 // FiatDepositWithdrawHistoryItem define a fiat deposit/withdraw history item
+//
+//decimalgen:accessors
 type FiatDepositWithdrawHistoryItem struct {
 	OrderNo         string `json:"orderNo"`
 	FiatCurrency    string `json:"fiatCurrency"`
@@ -180,6 +182,8 @@ type FiatPaymentsHistory struct {
 }
 
 // FiatPaymentsHistoryItem define a fiat payments history item
+//
+//decimalgen:accessors
 type FiatPaymentsHistoryItem struct {
 	OrderNo        string `json:"orderNo"`
 	SourceAmount   string `json:"sourceAmount"`
@@ -0,0 +1,112 @@
+package futures
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *accountPositionServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+	s.timeOffset = 0
+
+	s.requestID = "605a6d20-6588-4cb9-afa0-b0ab087507ba"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.accountPosition = &AccountPositionWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.accountPositionRequest = NewAccountPositionWsRequest().
+		Symbol("BTCUSDT")
+}
+
+func (s *accountPositionServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type accountPositionServiceWsTestSuite struct {
+	suite.Suite
+	apiKey     string
+	secretKey  string
+	signedKey  string
+	timeOffset int64
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	accountPosition        *AccountPositionWsService
+	accountPositionRequest *AccountPositionWsRequest
+}
+
+func TestAccountPositionServiceWs(t *testing.T) {
+	suite.Run(t, new(accountPositionServiceWsTestSuite))
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPosition() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.accountPosition.Do(s.requestID, s.accountPositionRequest)
+	s.NoError(err)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPosition_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.accountPosition.Do("", s.accountPositionRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPosition_EmptySecretKey() {
+	s.accountPosition.SecretKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.accountPosition.Do(s.requestID, s.accountPositionRequest)
+	s.ErrorIs(err, websocket.ErrorSecretKeyIsNotSet)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPositionSync() {
+	rawResponseData := []byte(`{"id":"605a6d20-6588-4cb9-afa0-b0ab087507ba","status":200,"result":[{"symbol":"BTCUSDT","positionSide":"BOTH","positionAmt":"1.000","entryPrice":"0.00000","breakEvenPrice":"0.0","markPrice":"6679.50671178","unRealizedProfit":"0.00000000","liquidationPrice":"0","isolatedMargin":"0.00000000","notional":"0","marginAsset":"USDT","isolatedWallet":"0","initialMargin":"0","maintMargin":"0","positionInitialMargin":"0","openOrderInitialMargin":"0","adl":0,"bidNotional":"0","askNotional":"0","updateTime":0}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.accountPosition.SyncDo(s.requestID, s.accountPositionRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal("BTCUSDT", response.Result[0].Symbol)
+	s.Equal("1.000", response.Result[0].PositionAmt)
+	s.Equal("6679.50671178", response.Result[0].MarkPrice)
+	s.Equal("USDT", response.Result[0].MarginAsset)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPositionSync_Error() {
+	rawResponseData := []byte(`{"id":"605a6d20-6588-4cb9-afa0-b0ab087507ba","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.accountPosition.SyncDo(s.requestID, s.accountPositionRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestAccountPositionSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.accountPosition.SyncDo(s.requestID, s.accountPositionRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -33,6 +33,8 @@ func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // Balance define user balance of your account
+//
+//decimalgen:accessors
 type Balance struct {
 	AccountAlias       string `json:"accountAlias"`
 	Asset              string `json:"asset"`
@@ -70,6 +72,8 @@ func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // Account define account info
+//
+//decimalgen:accessors
 type Account struct {
 	Assets                      []*AccountAsset    `json:"assets"`
 	FeeTier                     int                `json:"feeTier"`
@@ -93,6 +97,8 @@ type Account struct {
 }
 
 // AccountAsset define account asset
+//
+//decimalgen:accessors
 type AccountAsset struct {
 	Asset                  string `json:"asset"`
 	InitialMargin          string `json:"initialMargin"`
@@ -111,6 +117,8 @@ type AccountAsset struct {
 }
 
 // AccountPosition define account position
+//
+//decimalgen:accessors
 type AccountPosition struct {
 	Isolated               bool             `json:"isolated"`
 	Leverage               string           `json:"leverage"`
@@ -156,6 +164,8 @@ func (s *GetAccountV3Service) Do(ctx context.Context, opts ...RequestOption) (re
 }
 
 // AccountV3 define account info
+//
+//decimalgen:accessors
 type AccountV3 struct {
 	TotalInitialMargin          string               `json:"totalInitialMargin"`
 	TotalMaintMargin            string               `json:"totalMaintMargin"`
@@ -173,6 +183,8 @@ type AccountV3 struct {
 }
 
 // AccountAssetV3 define account asset
+//
+//decimalgen:accessors
 type AccountAssetV3 struct {
 	Asset                  string `json:"asset"`
 	WalletBalance          string `json:"walletBalance"`
@@ -191,6 +203,8 @@ type AccountAssetV3 struct {
 }
 
 // AccountPositionV3 define account position
+//
+//decimalgen:accessors
 type AccountPositionV3 struct {
 	Symbol           string `json:"symbol"`
 	PositionSide     string `json:"positionSide"`
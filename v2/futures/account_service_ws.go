@@ -14,10 +14,16 @@ type WsAccountService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
 	RecvWindow int64
+	signers    common.SignerCache
 }
 
+// NewWsAccountService init WsAccountService, recvWindow defaults to 5000. Set TimeSync to sign its requests with a
+// time sync, the services created with a session use the time sync of the session.
 func NewWsAccountService(apiKey, secretKey string, recvWindow ...int64) (*WsAccountService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
@@ -43,6 +49,26 @@ func NewWsAccountService(apiKey, secretKey string, recvWindow ...int64) (*WsAcco
 	}, nil
 }
 
+// NewWsAccountServiceWithSession init WsAccountService sending its requests on the connection of session
+func NewWsAccountServiceWithSession(session *SessionWsService, recvWindow ...int64) *WsAccountService {
+	window := int64(5000)
+	if len(recvWindow) > 0 {
+		window = recvWindow[0]
+	}
+
+	return &WsAccountService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		RecvWindow: window,
+		session:    session,
+	}
+}
+
 type WsAccountV2InfoResponse struct {
 	ID        string             `json:"id"`
 	Status    int                `json:"status"`
@@ -100,6 +126,11 @@ func (s *WsAccountService) SyncGetAccountInfo(requestID string) (*WsAccountV2Inf
 	if err := json.Unmarshal(response, info); err != nil {
 		return nil, err
 	}
+	if info.Error != nil {
+		info.Error.StatusCode = info.Status
+		s.TimeSync.CheckError(info.Error)
+		return nil, info.Error
+	}
 
 	return info, nil
 }
@@ -132,6 +163,11 @@ func (s *WsAccountService) SyncGetAccountBalance(requestID string) (*WsAccountV2
 	if err := json.Unmarshal(response, balance); err != nil {
 		return nil, err
 	}
+	if balance.Error != nil {
+		balance.Error.StatusCode = balance.Status
+		s.TimeSync.CheckError(balance.Error)
+		return nil, balance.Error
+	}
 
 	return balance, nil
 }
@@ -142,9 +178,9 @@ func (s *WsAccountService) buildRequest(requestID string, method websocket.WsApi
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		method,
 		map[string]any{
 			"recvWindow": s.RecvWindow,
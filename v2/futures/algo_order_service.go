@@ -235,6 +235,8 @@ func (s *CreateAlgoOrderService) GoodTillDate(goodTillDate int64) *CreateAlgoOrd
 }
 
 // CreateAlgoOrderResp represents the response from creating an algorithmic order.
+//
+//decimalgen:accessors
 type CreateAlgoOrderResp struct {
 	AlgoId                  int64                   `json:"algoId"`
 	ClientAlgoId            string                  `json:"clientAlgoId"`
@@ -400,6 +402,8 @@ func (s *GetAlgoOrderService) ClientAlgoID(clientAlgoId string) *GetAlgoOrderSer
 }
 
 // GetAlgoOrderResp represents the response from getting an algorithmic order.
+//
+//decimalgen:accessors
 type GetAlgoOrderResp struct {
 	AlgoId                  int64                   `json:"algoId"`
 	ClientAlgoId            string                  `json:"clientAlgoId"`
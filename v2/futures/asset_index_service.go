@@ -11,6 +11,7 @@ type AssetIndexService struct {
 	symbol *string // for example, BTCUSD
 }
 
+//decimalgen:accessors
 type AssetIndex struct {
 	Symbol                string `json:"symbol"`
 	Time                  uint64 `json:"time"`
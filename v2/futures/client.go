@@ -18,6 +18,9 @@ import (
 	"github.com/adshao/go-binance/v2/common"
 )
 
+//go:generate go run ../internal/decimalgen
+//go:generate go run ../internal/streamgen
+
 // SideType define side type of order
 type SideType string
 
@@ -281,6 +284,19 @@ type Client struct {
 
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+
+	// RateLimiter holds requests back before they exceed the limits, it can be shared by several clients
+	RateLimiter *common.RateLimiter
+	// RetryPolicy retries failed requests, requests are not retried if it is nil
+	RetryPolicy *common.RetryPolicy
+	// TimeSync keeps the offset to the server clock, if set its offset is used instead of TimeOffset
+	TimeSync *common.TimeSync
+	// SymbolRules holds the exchange filters orders are checked against before they're sent, if set
+	SymbolRules *common.SymbolRulesRegistry
+	// Signer signs the requests instead of SecretKey and KeyType, if set
+	Signer common.Signer
+
+	signers common.SignerCache
 }
 
 func (c *Client) debug(format string, v ...any) {
@@ -289,6 +305,18 @@ func (c *Client) debug(format string, v ...any) {
 	}
 }
 
+// signer returns Signer if it is set, otherwise the signer of SecretKey and KeyType
+func (c *Client) signer() (common.Signer, error) {
+	if c.Signer != nil {
+		return c.Signer, nil
+	}
+	kt := c.KeyType
+	if kt == "" {
+		kt = common.KeyTypeHmac
+	}
+	return c.signers.Signer(kt, c.SecretKey)
+}
+
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	// set request options from user
 	for _, opt := range opts {
@@ -304,7 +332,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.TimeSync.OffsetOr(c.TimeOffset))
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -320,22 +348,18 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
 		header.Set("X-MBX-APIKEY", c.APIKey)
 	}
-	kt := c.KeyType
-	if kt == "" {
-		kt = common.KeyTypeHmac
-	}
-	sf, err := common.SignFunc(kt)
-	if err != nil {
-		return err
-	}
 	if r.secType == secTypeSigned {
+		signer, err := c.signer()
+		if err != nil {
+			return err
+		}
 		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		sign, err := sf(c.SecretKey, raw)
+		sign, err := signer.Sign(raw)
 		if err != nil {
 			return err
 		}
 		v := url.Values{}
-		v.Set(signatureKey, *sign)
+		v.Set(signatureKey, sign)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -354,16 +378,28 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	if c.RetryPolicy == nil {
+		data, _, header, err = c.sendRequest(ctx, r, opts...)
+		return data, header, err
+	}
+	return c.callAPIWithRetry(ctx, r, opts...)
+}
+
+// sendRequest sends r once and returns the response body, status code and headers
+func (c *Client) sendRequest(ctx context.Context, r *request, opts ...RequestOption) (data []byte, statusCode int, header *http.Header, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
+	if err = c.waitRateLimit(ctx, r); err != nil {
+		return []byte{}, 0, &http.Header{}, err
+	}
 	c.debug("request: %#v\n", req)
 	f := c.do
 	if f == nil {
@@ -371,15 +407,18 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
 	}
 
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
+	if c.RateLimiter != nil {
+		c.RateLimiter.UpdateByHeader(common.RateLimitAPI(r.endpoint), res.Header)
+	}
 
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -402,9 +441,17 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, &res.Header, apiErr
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Header = res.Header
+		apiErr.Method = r.method
+		apiErr.Endpoint = r.endpoint
+		c.TimeSync.CheckError(apiErr)
+		if res.StatusCode == http.StatusTeapot {
+			return nil, res.StatusCode, &res.Header, common.NewIPBannedError(res.Header, apiErr)
+		}
+		return nil, res.StatusCode, &res.Header, apiErr
 	}
-	return data, &res.Header, nil
+	return data, res.StatusCode, &res.Header, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -40,6 +40,8 @@ func (s *CommissionRateService) Do(ctx context.Context, opts ...RequestOption) (
 }
 
 // Commission Rate
+//
+//decimalgen:accessors
 type CommissionRate struct {
 	Symbol              string `json:"symbol"`
 	MakerCommissionRate string `json:"makerCommissionRate"`
@@ -105,6 +105,8 @@ func (s *ContinuousKlinesService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // ContinuousKline define ContinuousKline info
+//
+//decimalgen:accessors
 type ContinuousKline struct {
 	OpenTime                 int64  `json:"openTime"`
 	Open                     string `json:"open"`
@@ -12,6 +12,7 @@ type ListConvertExchangeInfoService struct {
 	toAsset   string
 }
 
+//decimalgen:accessors
 type ConvertExchangeInfo struct {
 	FromAsset          string `json:"fromAsset"`
 	ToAsset            string `json:"toAsset"`
@@ -98,6 +99,7 @@ func (c *CreateConvertQuoteService) ValidTime(validTime ConvertValidTime) *Creat
 	return c
 }
 
+//decimalgen:accessors
 type ConvertQuote struct {
 	QuoteId        string `json:"quoteId"`
 	Ratio          string `json:"ratio"`
@@ -200,6 +202,7 @@ func (c *ConvertStatusService) OrderId(orderId string) *ConvertStatusService {
 	return c
 }
 
+//decimalgen:accessors
 type ConvertStatusResult struct {
 	OrderId      string              `json:"orderId"`
 	OrderStatus  ConvertAcceptStatus `json:"orderStatus"`
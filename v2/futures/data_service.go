@@ -194,6 +194,7 @@ type TakerLongShortRatioService struct {
 	endTime   *uint64
 }
 
+//decimalgen:accessors
 type TakerLongShortRatio struct {
 	BuySellRatio string `json:"buySellRatio"`
 	BuyVol       string `json:"buyVol"`
@@ -266,6 +267,7 @@ type BasisService struct {
 	endTime      *uint64
 }
 
+//decimalgen:accessors
 type Basis struct {
 	Pair                string `json:"pair"`
 	IndexPrice          string `json:"indexPrice"`
@@ -0,0 +1,1654 @@
+// Code generated by decimalgen; DO NOT EDIT.
+
+package futures
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// AvailableBalanceDecimal returns AvailableBalance as a decimal, zero if it is empty
+func (v *Account) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.AvailableBalance", v.AvailableBalance)
+}
+
+// MaxWithdrawAmountDecimal returns MaxWithdrawAmount as a decimal, zero if it is empty
+func (v *Account) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.MaxWithdrawAmount", v.MaxWithdrawAmount)
+}
+
+// TotalCrossUnPnlDecimal returns TotalCrossUnPnl as a decimal, zero if it is empty
+func (v *Account) TotalCrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.TotalCrossUnPnl", v.TotalCrossUnPnl)
+}
+
+// TotalCrossWalletBalanceDecimal returns TotalCrossWalletBalance as a decimal, zero if it is empty
+func (v *Account) TotalCrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.TotalCrossWalletBalance", v.TotalCrossWalletBalance)
+}
+
+// TotalInitialMarginDecimal returns TotalInitialMargin as a decimal, zero if it is empty
+func (v *Account) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.TotalInitialMargin", v.TotalInitialMargin)
+}
+
+// TotalMaintMarginDecimal returns TotalMaintMargin as a decimal, zero if it is empty
+func (v *Account) TotalMaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.TotalMaintMargin", v.TotalMaintMargin)
+}
+
+// TotalMarginBalanceDecimal returns TotalMarginBalance as a decimal, zero if it is empty
+func (v *Account) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.TotalMarginBalance", v.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal returns TotalOpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *Account) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.TotalOpenOrderInitialMargin", v.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal returns TotalPositionInitialMargin as a decimal, zero if it is empty
+func (v *Account) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.TotalPositionInitialMargin", v.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal returns TotalUnrealizedProfit as a decimal, zero if it is empty
+func (v *Account) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.TotalUnrealizedProfit", v.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal returns TotalWalletBalance as a decimal, zero if it is empty
+func (v *Account) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.TotalWalletBalance", v.TotalWalletBalance)
+}
+
+// AvailableBalanceDecimal returns AvailableBalance as a decimal, zero if it is empty
+func (v *AccountAsset) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.AvailableBalance", v.AvailableBalance)
+}
+
+// CrossUnPnlDecimal returns CrossUnPnl as a decimal, zero if it is empty
+func (v *AccountAsset) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.CrossUnPnl", v.CrossUnPnl)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *AccountAsset) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *AccountAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.InitialMargin", v.InitialMargin)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *AccountAsset) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.MaintMargin", v.MaintMargin)
+}
+
+// MarginBalanceDecimal returns MarginBalance as a decimal, zero if it is empty
+func (v *AccountAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.MarginBalance", v.MarginBalance)
+}
+
+// MaxWithdrawAmountDecimal returns MaxWithdrawAmount as a decimal, zero if it is empty
+func (v *AccountAsset) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.MaxWithdrawAmount", v.MaxWithdrawAmount)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *AccountAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *AccountAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *AccountAsset) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// WalletBalanceDecimal returns WalletBalance as a decimal, zero if it is empty
+func (v *AccountAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAsset.WalletBalance", v.WalletBalance)
+}
+
+// AvailableBalanceDecimal returns AvailableBalance as a decimal, zero if it is empty
+func (v *AccountAssetV3) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAssetV3.AvailableBalance", v.AvailableBalance)
+}
+
+// CrossUnPnlDecimal returns CrossUnPnl as a decimal, zero if it is empty
+func (v *AccountAssetV3) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAssetV3.CrossUnPnl", v.CrossUnPnl)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *AccountAssetV3) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAssetV3.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *AccountAssetV3) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAssetV3.InitialMargin", v.InitialMargin)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *AccountAssetV3) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAssetV3.MaintMargin", v.MaintMargin)
+}
+
+// MarginBalanceDecimal returns MarginBalance as a decimal, zero if it is empty
+func (v *AccountAssetV3) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAssetV3.MarginBalance", v.MarginBalance)
+}
+
+// MaxWithdrawAmountDecimal returns MaxWithdrawAmount as a decimal, zero if it is empty
+func (v *AccountAssetV3) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAssetV3.MaxWithdrawAmount", v.MaxWithdrawAmount)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *AccountAssetV3) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAssetV3.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *AccountAssetV3) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAssetV3.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *AccountAssetV3) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAssetV3.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// WalletBalanceDecimal returns WalletBalance as a decimal, zero if it is empty
+func (v *AccountAssetV3) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountAssetV3.WalletBalance", v.WalletBalance)
+}
+
+// AskNotionalDecimal returns AskNotional as a decimal, zero if it is empty
+func (v *AccountPosition) AskNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.AskNotional", v.AskNotional)
+}
+
+// BidNotionalDecimal returns BidNotional as a decimal, zero if it is empty
+func (v *AccountPosition) BidNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.BidNotional", v.BidNotional)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *AccountPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.EntryPrice", v.EntryPrice)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *AccountPosition) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.InitialMargin", v.InitialMargin)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *AccountPosition) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.MaintMargin", v.MaintMargin)
+}
+
+// MaxNotionalDecimal returns MaxNotional as a decimal, zero if it is empty
+func (v *AccountPosition) MaxNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.MaxNotional", v.MaxNotional)
+}
+
+// NotionalDecimal returns Notional as a decimal, zero if it is empty
+func (v *AccountPosition) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.Notional", v.Notional)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *AccountPosition) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *AccountPosition) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.PositionAmt", v.PositionAmt)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *AccountPosition) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *AccountPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPosition.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *AccountPositionV3) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPositionV3.InitialMargin", v.InitialMargin)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *AccountPositionV3) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPositionV3.MaintMargin", v.MaintMargin)
+}
+
+// NotionalDecimal returns Notional as a decimal, zero if it is empty
+func (v *AccountPositionV3) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPositionV3.Notional", v.Notional)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *AccountPositionV3) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPositionV3.PositionAmt", v.PositionAmt)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *AccountPositionV3) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountPositionV3.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *AccountTrade) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountTrade.Commission", v.Commission)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *AccountTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountTrade.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *AccountTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountTrade.Quantity", v.Quantity)
+}
+
+// QuoteQuantityDecimal returns QuoteQuantity as a decimal, zero if it is empty
+func (v *AccountTrade) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountTrade.QuoteQuantity", v.QuoteQuantity)
+}
+
+// RealizedPnlDecimal returns RealizedPnl as a decimal, zero if it is empty
+func (v *AccountTrade) RealizedPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountTrade.RealizedPnl", v.RealizedPnl)
+}
+
+// AvailableBalanceDecimal returns AvailableBalance as a decimal, zero if it is empty
+func (v *AccountV3) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountV3.AvailableBalance", v.AvailableBalance)
+}
+
+// MaxWithdrawAmountDecimal returns MaxWithdrawAmount as a decimal, zero if it is empty
+func (v *AccountV3) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountV3.MaxWithdrawAmount", v.MaxWithdrawAmount)
+}
+
+// TotalCrossUnPnlDecimal returns TotalCrossUnPnl as a decimal, zero if it is empty
+func (v *AccountV3) TotalCrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountV3.TotalCrossUnPnl", v.TotalCrossUnPnl)
+}
+
+// TotalCrossWalletBalanceDecimal returns TotalCrossWalletBalance as a decimal, zero if it is empty
+func (v *AccountV3) TotalCrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountV3.TotalCrossWalletBalance", v.TotalCrossWalletBalance)
+}
+
+// TotalInitialMarginDecimal returns TotalInitialMargin as a decimal, zero if it is empty
+func (v *AccountV3) TotalInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountV3.TotalInitialMargin", v.TotalInitialMargin)
+}
+
+// TotalMaintMarginDecimal returns TotalMaintMargin as a decimal, zero if it is empty
+func (v *AccountV3) TotalMaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountV3.TotalMaintMargin", v.TotalMaintMargin)
+}
+
+// TotalMarginBalanceDecimal returns TotalMarginBalance as a decimal, zero if it is empty
+func (v *AccountV3) TotalMarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountV3.TotalMarginBalance", v.TotalMarginBalance)
+}
+
+// TotalOpenOrderInitialMarginDecimal returns TotalOpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *AccountV3) TotalOpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountV3.TotalOpenOrderInitialMargin", v.TotalOpenOrderInitialMargin)
+}
+
+// TotalPositionInitialMarginDecimal returns TotalPositionInitialMargin as a decimal, zero if it is empty
+func (v *AccountV3) TotalPositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountV3.TotalPositionInitialMargin", v.TotalPositionInitialMargin)
+}
+
+// TotalUnrealizedProfitDecimal returns TotalUnrealizedProfit as a decimal, zero if it is empty
+func (v *AccountV3) TotalUnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountV3.TotalUnrealizedProfit", v.TotalUnrealizedProfit)
+}
+
+// TotalWalletBalanceDecimal returns TotalWalletBalance as a decimal, zero if it is empty
+func (v *AccountV3) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AccountV3.TotalWalletBalance", v.TotalWalletBalance)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *AggTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AggTrade.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *AggTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AggTrade.Quantity", v.Quantity)
+}
+
+// AskRateDecimal returns AskRate as a decimal, zero if it is empty
+func (v *AssetIndex) AskRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AssetIndex.AskRate", v.AskRate)
+}
+
+// AutoExchangeAskRateDecimal returns AutoExchangeAskRate as a decimal, zero if it is empty
+func (v *AssetIndex) AutoExchangeAskRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AssetIndex.AutoExchangeAskRate", v.AutoExchangeAskRate)
+}
+
+// AutoExchangeBidRateDecimal returns AutoExchangeBidRate as a decimal, zero if it is empty
+func (v *AssetIndex) AutoExchangeBidRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AssetIndex.AutoExchangeBidRate", v.AutoExchangeBidRate)
+}
+
+// BidRateDecimal returns BidRate as a decimal, zero if it is empty
+func (v *AssetIndex) BidRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("AssetIndex.BidRate", v.BidRate)
+}
+
+// AvailableBalanceDecimal returns AvailableBalance as a decimal, zero if it is empty
+func (v *Balance) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.AvailableBalance", v.AvailableBalance)
+}
+
+// BalanceDecimal returns Balance as a decimal, zero if it is empty
+func (v *Balance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.Balance", v.Balance)
+}
+
+// CrossUnPnlDecimal returns CrossUnPnl as a decimal, zero if it is empty
+func (v *Balance) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.CrossUnPnl", v.CrossUnPnl)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *Balance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// MaxWithdrawAmountDecimal returns MaxWithdrawAmount as a decimal, zero if it is empty
+func (v *Balance) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.MaxWithdrawAmount", v.MaxWithdrawAmount)
+}
+
+// WeightInQuantityDecimal returns WeightInQuantity as a decimal, zero if it is empty
+func (v *BaseAssetList) WeightInQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BaseAssetList.WeightInQuantity", v.WeightInQuantity)
+}
+
+// AnnualizedBasisRateDecimal returns AnnualizedBasisRate as a decimal, zero if it is empty
+func (v *Basis) AnnualizedBasisRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Basis.AnnualizedBasisRate", v.AnnualizedBasisRate)
+}
+
+// BasisRateDecimal returns BasisRate as a decimal, zero if it is empty
+func (v *Basis) BasisRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Basis.BasisRate", v.BasisRate)
+}
+
+// FuturesPriceDecimal returns FuturesPrice as a decimal, zero if it is empty
+func (v *Basis) FuturesPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Basis.FuturesPrice", v.FuturesPrice)
+}
+
+// IndexPriceDecimal returns IndexPrice as a decimal, zero if it is empty
+func (v *Basis) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Basis.IndexPrice", v.IndexPrice)
+}
+
+// AskPriceDecimal returns AskPrice as a decimal, zero if it is empty
+func (v *BookTicker) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.AskPrice", v.AskPrice)
+}
+
+// AskQuantityDecimal returns AskQuantity as a decimal, zero if it is empty
+func (v *BookTicker) AskQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.AskQuantity", v.AskQuantity)
+}
+
+// BidPriceDecimal returns BidPrice as a decimal, zero if it is empty
+func (v *BookTicker) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.BidPrice", v.BidPrice)
+}
+
+// BidQuantityDecimal returns BidQuantity as a decimal, zero if it is empty
+func (v *BookTicker) BidQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("BookTicker.BidQuantity", v.BidQuantity)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *CancelOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// CumQuantityDecimal returns CumQuantity as a decimal, zero if it is empty
+func (v *CancelOrderResponse) CumQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.CumQuantity", v.CumQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *CancelOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *CancelOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CancelOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *CancelOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *CancelOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CancelOrderResponse.StopPrice", v.StopPrice)
+}
+
+// MakerCommissionRateDecimal returns MakerCommissionRate as a decimal, zero if it is empty
+func (v *CommissionRate) MakerCommissionRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CommissionRate.MakerCommissionRate", v.MakerCommissionRate)
+}
+
+// TakerCommissionRateDecimal returns TakerCommissionRate as a decimal, zero if it is empty
+func (v *CommissionRate) TakerCommissionRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CommissionRate.TakerCommissionRate", v.TakerCommissionRate)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *ContinuousKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ContinuousKline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *ContinuousKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ContinuousKline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *ContinuousKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ContinuousKline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *ContinuousKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ContinuousKline.Open", v.Open)
+}
+
+// QuoteAssetVolumeDecimal returns QuoteAssetVolume as a decimal, zero if it is empty
+func (v *ContinuousKline) QuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ContinuousKline.QuoteAssetVolume", v.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal returns TakerBuyBaseAssetVolume as a decimal, zero if it is empty
+func (v *ContinuousKline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ContinuousKline.TakerBuyBaseAssetVolume", v.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal returns TakerBuyQuoteAssetVolume as a decimal, zero if it is empty
+func (v *ContinuousKline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ContinuousKline.TakerBuyQuoteAssetVolume", v.TakerBuyQuoteAssetVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *ContinuousKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ContinuousKline.Volume", v.Volume)
+}
+
+// FromAssetMaxAmountDecimal returns FromAssetMaxAmount as a decimal, zero if it is empty
+func (v *ConvertExchangeInfo) FromAssetMaxAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertExchangeInfo.FromAssetMaxAmount", v.FromAssetMaxAmount)
+}
+
+// FromAssetMinAmountDecimal returns FromAssetMinAmount as a decimal, zero if it is empty
+func (v *ConvertExchangeInfo) FromAssetMinAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertExchangeInfo.FromAssetMinAmount", v.FromAssetMinAmount)
+}
+
+// ToAssetMaxAmountDecimal returns ToAssetMaxAmount as a decimal, zero if it is empty
+func (v *ConvertExchangeInfo) ToAssetMaxAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertExchangeInfo.ToAssetMaxAmount", v.ToAssetMaxAmount)
+}
+
+// ToAssetMinAmountDecimal returns ToAssetMinAmount as a decimal, zero if it is empty
+func (v *ConvertExchangeInfo) ToAssetMinAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertExchangeInfo.ToAssetMinAmount", v.ToAssetMinAmount)
+}
+
+// FromAmountDecimal returns FromAmount as a decimal, zero if it is empty
+func (v *ConvertQuote) FromAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertQuote.FromAmount", v.FromAmount)
+}
+
+// ToAmountDecimal returns ToAmount as a decimal, zero if it is empty
+func (v *ConvertQuote) ToAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertQuote.ToAmount", v.ToAmount)
+}
+
+// FromAmountDecimal returns FromAmount as a decimal, zero if it is empty
+func (v *ConvertStatusResult) FromAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertStatusResult.FromAmount", v.FromAmount)
+}
+
+// ToAmountDecimal returns ToAmount as a decimal, zero if it is empty
+func (v *ConvertStatusResult) ToAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ConvertStatusResult.ToAmount", v.ToAmount)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *CreateAlgoOrderResp) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateAlgoOrderResp.ActivatePrice", v.ActivatePrice)
+}
+
+// CallbackRateDecimal returns CallbackRate as a decimal, zero if it is empty
+func (v *CreateAlgoOrderResp) CallbackRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateAlgoOrderResp.CallbackRate", v.CallbackRate)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CreateAlgoOrderResp) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateAlgoOrderResp.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *CreateAlgoOrderResp) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateAlgoOrderResp.Quantity", v.Quantity)
+}
+
+// TriggerPriceDecimal returns TriggerPrice as a decimal, zero if it is empty
+func (v *CreateAlgoOrderResp) TriggerPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateAlgoOrderResp.TriggerPrice", v.TriggerPrice)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *CreateOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *CreateOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// CumQtyDecimal returns CumQty as a decimal, zero if it is empty
+func (v *CreateOrderResponse) CumQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.CumQty", v.CumQty)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *CreateOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *CreateOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CreateOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *CreateOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *CreateOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CreateOrderResponse.StopPrice", v.StopPrice)
+}
+
+// FundingRateDecimal returns FundingRate as a decimal, zero if it is empty
+func (v *FundingRate) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FundingRate.FundingRate", v.FundingRate)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *FundingRate) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FundingRate.MarkPrice", v.MarkPrice)
+}
+
+// AdjustedFundingRateCapDecimal returns AdjustedFundingRateCap as a decimal, zero if it is empty
+func (v *FundingRateInfo) AdjustedFundingRateCapDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FundingRateInfo.AdjustedFundingRateCap", v.AdjustedFundingRateCap)
+}
+
+// AdjustedFundingRateFloorDecimal returns AdjustedFundingRateFloor as a decimal, zero if it is empty
+func (v *FundingRateInfo) AdjustedFundingRateFloorDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("FundingRateInfo.AdjustedFundingRateFloor", v.AdjustedFundingRateFloor)
+}
+
+// ActualPriceDecimal returns ActualPrice as a decimal, zero if it is empty
+func (v *GetAlgoOrderResp) ActualPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetAlgoOrderResp.ActualPrice", v.ActualPrice)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *GetAlgoOrderResp) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetAlgoOrderResp.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *GetAlgoOrderResp) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetAlgoOrderResp.Quantity", v.Quantity)
+}
+
+// SlPriceDecimal returns SlPrice as a decimal, zero if it is empty
+func (v *GetAlgoOrderResp) SlPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetAlgoOrderResp.SlPrice", v.SlPrice)
+}
+
+// SlTriggerPriceDecimal returns SlTriggerPrice as a decimal, zero if it is empty
+func (v *GetAlgoOrderResp) SlTriggerPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetAlgoOrderResp.SlTriggerPrice", v.SlTriggerPrice)
+}
+
+// TpPriceDecimal returns TpPrice as a decimal, zero if it is empty
+func (v *GetAlgoOrderResp) TpPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetAlgoOrderResp.TpPrice", v.TpPrice)
+}
+
+// TpTriggerPriceDecimal returns TpTriggerPrice as a decimal, zero if it is empty
+func (v *GetAlgoOrderResp) TpTriggerPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetAlgoOrderResp.TpTriggerPrice", v.TpTriggerPrice)
+}
+
+// TriggerPriceDecimal returns TriggerPrice as a decimal, zero if it is empty
+func (v *GetAlgoOrderResp) TriggerPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("GetAlgoOrderResp.TriggerPrice", v.TriggerPrice)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *Kline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *Kline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *Kline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *Kline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Open", v.Open)
+}
+
+// QuoteAssetVolumeDecimal returns QuoteAssetVolume as a decimal, zero if it is empty
+func (v *Kline) QuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.QuoteAssetVolume", v.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal returns TakerBuyBaseAssetVolume as a decimal, zero if it is empty
+func (v *Kline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.TakerBuyBaseAssetVolume", v.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal returns TakerBuyQuoteAssetVolume as a decimal, zero if it is empty
+func (v *Kline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.TakerBuyQuoteAssetVolume", v.TakerBuyQuoteAssetVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *Kline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Volume", v.Volume)
+}
+
+// AveragePriceDecimal returns AveragePrice as a decimal, zero if it is empty
+func (v *LiquidationOrder) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LiquidationOrder.AveragePrice", v.AveragePrice)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *LiquidationOrder) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LiquidationOrder.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *LiquidationOrder) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LiquidationOrder.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *LiquidationOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LiquidationOrder.Price", v.Price)
+}
+
+// MaxQuantityDecimal returns MaxQuantity as a decimal, zero if it is empty
+func (v *LotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LotSizeFilter.MaxQuantity", v.MaxQuantity)
+}
+
+// MinQuantityDecimal returns MinQuantity as a decimal, zero if it is empty
+func (v *LotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LotSizeFilter.MinQuantity", v.MinQuantity)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *LvtKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LvtKline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *LvtKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LvtKline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *LvtKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LvtKline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *LvtKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LvtKline.Open", v.Open)
+}
+
+// MaxQuantityDecimal returns MaxQuantity as a decimal, zero if it is empty
+func (v *MarketLotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarketLotSizeFilter.MaxQuantity", v.MaxQuantity)
+}
+
+// MinQuantityDecimal returns MinQuantity as a decimal, zero if it is empty
+func (v *MarketLotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarketLotSizeFilter.MinQuantity", v.MinQuantity)
+}
+
+// NotionalDecimal returns Notional as a decimal, zero if it is empty
+func (v *MinNotionalFilter) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MinNotionalFilter.Notional", v.Notional)
+}
+
+// AveragePriceDecimal returns AveragePrice as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.AveragePrice", v.AveragePrice)
+}
+
+// CumulativeQuantityDecimal returns CumulativeQuantity as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) CumulativeQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.CumulativeQuantity", v.CumulativeQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OriginalQuantityDecimal returns OriginalQuantity as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) OriginalQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.OriginalQuantity", v.OriginalQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.Price", v.Price)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *ModifyOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ModifyOrderResponse.StopPrice", v.StopPrice)
+}
+
+// OpenInterestDecimal returns OpenInterest as a decimal, zero if it is empty
+func (v *OpenInterest) OpenInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OpenInterest.OpenInterest", v.OpenInterest)
+}
+
+// SumOpenInterestDecimal returns SumOpenInterest as a decimal, zero if it is empty
+func (v *OpenInterestStatistic) SumOpenInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OpenInterestStatistic.SumOpenInterest", v.SumOpenInterest)
+}
+
+// SumOpenInterestValueDecimal returns SumOpenInterestValue as a decimal, zero if it is empty
+func (v *OpenInterestStatistic) SumOpenInterestValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OpenInterestStatistic.SumOpenInterestValue", v.SumOpenInterestValue)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *Order) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.ActivatePrice", v.ActivatePrice)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *Order) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.AvgPrice", v.AvgPrice)
+}
+
+// CumQuantityDecimal returns CumQuantity as a decimal, zero if it is empty
+func (v *Order) CumQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.CumQuantity", v.CumQuantity)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *Order) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *Order) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *Order) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *Order) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *Order) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.StopPrice", v.StopPrice)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *PositionMarginHistory) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionMarginHistory.Amount", v.Amount)
+}
+
+// BreakEvenPriceDecimal returns BreakEvenPrice as a decimal, zero if it is empty
+func (v *PositionRisk) BreakEvenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.BreakEvenPrice", v.BreakEvenPrice)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *PositionRisk) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.EntryPrice", v.EntryPrice)
+}
+
+// LiquidationPriceDecimal returns LiquidationPrice as a decimal, zero if it is empty
+func (v *PositionRisk) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.LiquidationPrice", v.LiquidationPrice)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *PositionRisk) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.MarkPrice", v.MarkPrice)
+}
+
+// MaxNotionalValueDecimal returns MaxNotionalValue as a decimal, zero if it is empty
+func (v *PositionRisk) MaxNotionalValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.MaxNotionalValue", v.MaxNotionalValue)
+}
+
+// NotionalDecimal returns Notional as a decimal, zero if it is empty
+func (v *PositionRisk) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.Notional", v.Notional)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *PositionRisk) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.PositionAmt", v.PositionAmt)
+}
+
+// UnRealizedProfitDecimal returns UnRealizedProfit as a decimal, zero if it is empty
+func (v *PositionRisk) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRisk.UnRealizedProfit", v.UnRealizedProfit)
+}
+
+// AskNotionalDecimal returns AskNotional as a decimal, zero if it is empty
+func (v *PositionRiskV3) AskNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.AskNotional", v.AskNotional)
+}
+
+// BidNotionalDecimal returns BidNotional as a decimal, zero if it is empty
+func (v *PositionRiskV3) BidNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.BidNotional", v.BidNotional)
+}
+
+// BreakEvenPriceDecimal returns BreakEvenPrice as a decimal, zero if it is empty
+func (v *PositionRiskV3) BreakEvenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.BreakEvenPrice", v.BreakEvenPrice)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *PositionRiskV3) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.EntryPrice", v.EntryPrice)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *PositionRiskV3) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.InitialMargin", v.InitialMargin)
+}
+
+// LiquidationPriceDecimal returns LiquidationPrice as a decimal, zero if it is empty
+func (v *PositionRiskV3) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.LiquidationPrice", v.LiquidationPrice)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *PositionRiskV3) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.MaintMargin", v.MaintMargin)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *PositionRiskV3) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.MarkPrice", v.MarkPrice)
+}
+
+// NotionalDecimal returns Notional as a decimal, zero if it is empty
+func (v *PositionRiskV3) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.Notional", v.Notional)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *PositionRiskV3) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *PositionRiskV3) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.PositionAmt", v.PositionAmt)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *PositionRiskV3) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// UnRealizedProfitDecimal returns UnRealizedProfit as a decimal, zero if it is empty
+func (v *PositionRiskV3) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PositionRiskV3.UnRealizedProfit", v.UnRealizedProfit)
+}
+
+// EstimatedSettlePriceDecimal returns EstimatedSettlePrice as a decimal, zero if it is empty
+func (v *PremiumIndex) EstimatedSettlePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PremiumIndex.EstimatedSettlePrice", v.EstimatedSettlePrice)
+}
+
+// IndexPriceDecimal returns IndexPrice as a decimal, zero if it is empty
+func (v *PremiumIndex) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PremiumIndex.IndexPrice", v.IndexPrice)
+}
+
+// InterestRateDecimal returns InterestRate as a decimal, zero if it is empty
+func (v *PremiumIndex) InterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PremiumIndex.InterestRate", v.InterestRate)
+}
+
+// LastFundingRateDecimal returns LastFundingRate as a decimal, zero if it is empty
+func (v *PremiumIndex) LastFundingRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PremiumIndex.LastFundingRate", v.LastFundingRate)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *PremiumIndex) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PremiumIndex.MarkPrice", v.MarkPrice)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.HighPrice", v.HighPrice)
+}
+
+// LastPriceDecimal returns LastPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.LastPrice", v.LastPrice)
+}
+
+// LastQuantityDecimal returns LastQuantity as a decimal, zero if it is empty
+func (v *PriceChangeStats) LastQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.LastQuantity", v.LastQuantity)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.OpenPrice", v.OpenPrice)
+}
+
+// PrevClosePriceDecimal returns PrevClosePrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) PrevClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.PrevClosePrice", v.PrevClosePrice)
+}
+
+// PriceChangeDecimal returns PriceChange as a decimal, zero if it is empty
+func (v *PriceChangeStats) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.PriceChange", v.PriceChange)
+}
+
+// PriceChangePercentDecimal returns PriceChangePercent as a decimal, zero if it is empty
+func (v *PriceChangeStats) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.PriceChangePercent", v.PriceChangePercent)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *PriceChangeStats) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.QuoteVolume", v.QuoteVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *PriceChangeStats) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.Volume", v.Volume)
+}
+
+// WeightedAvgPriceDecimal returns WeightedAvgPrice as a decimal, zero if it is empty
+func (v *PriceChangeStats) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceChangeStats.WeightedAvgPrice", v.WeightedAvgPrice)
+}
+
+// MaxPriceDecimal returns MaxPrice as a decimal, zero if it is empty
+func (v *PriceFilter) MaxPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceFilter.MaxPrice", v.MaxPrice)
+}
+
+// MinPriceDecimal returns MinPrice as a decimal, zero if it is empty
+func (v *PriceFilter) MinPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceFilter.MinPrice", v.MinPrice)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *QueryOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("QueryOrderResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *QueryOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("QueryOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *QueryOrderResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("QueryOrderResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *QueryOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("QueryOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *QueryOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("QueryOrderResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *QueryOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("QueryOrderResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *QueryOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("QueryOrderResponse.StopPrice", v.StopPrice)
+}
+
+// LiquidationFeeDecimal returns LiquidationFee as a decimal, zero if it is empty
+func (v *Symbol) LiquidationFeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Symbol.LiquidationFee", v.LiquidationFee)
+}
+
+// MaintMarginPercentDecimal returns MaintMarginPercent as a decimal, zero if it is empty
+func (v *Symbol) MaintMarginPercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Symbol.MaintMarginPercent", v.MaintMarginPercent)
+}
+
+// RequiredMarginPercentDecimal returns RequiredMarginPercent as a decimal, zero if it is empty
+func (v *Symbol) RequiredMarginPercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Symbol.RequiredMarginPercent", v.RequiredMarginPercent)
+}
+
+// MaxNotionalValueDecimal returns MaxNotionalValue as a decimal, zero if it is empty
+func (v *SymbolConfig) MaxNotionalValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolConfig.MaxNotionalValue", v.MaxNotionalValue)
+}
+
+// MaxNotionalValueDecimal returns MaxNotionalValue as a decimal, zero if it is empty
+func (v *SymbolLeverage) MaxNotionalValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolLeverage.MaxNotionalValue", v.MaxNotionalValue)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *SymbolPrice) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("SymbolPrice.Price", v.Price)
+}
+
+// BuyVolDecimal returns BuyVol as a decimal, zero if it is empty
+func (v *TakerLongShortRatio) BuyVolDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TakerLongShortRatio.BuyVol", v.BuyVol)
+}
+
+// SellVolDecimal returns SellVol as a decimal, zero if it is empty
+func (v *TakerLongShortRatio) SellVolDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TakerLongShortRatio.SellVol", v.SellVol)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *Trade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *Trade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.Quantity", v.Quantity)
+}
+
+// QuoteQuantityDecimal returns QuoteQuantity as a decimal, zero if it is empty
+func (v *Trade) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.QuoteQuantity", v.QuoteQuantity)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *TradeV3) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradeV3.Commission", v.Commission)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *TradeV3) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradeV3.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *TradeV3) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradeV3.Quantity", v.Quantity)
+}
+
+// QuoteQuantityDecimal returns QuoteQuantity as a decimal, zero if it is empty
+func (v *TradeV3) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("TradeV3.QuoteQuantity", v.QuoteQuantity)
+}
+
+// AveragePriceDecimal returns AveragePrice as a decimal, zero if it is empty
+func (v *UserLiquidationOrder) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserLiquidationOrder.AveragePrice", v.AveragePrice)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *UserLiquidationOrder) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserLiquidationOrder.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *UserLiquidationOrder) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserLiquidationOrder.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UserLiquidationOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserLiquidationOrder.Price", v.Price)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *UserLiquidationOrder) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserLiquidationOrder.StopPrice", v.StopPrice)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsAggTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAggTradeEvent.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *WsAggTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAggTradeEvent.Quantity", v.Quantity)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *WsAlgoUpdate) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAlgoUpdate.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQuantityDecimal returns ExecutedQuantity as a decimal, zero if it is empty
+func (v *WsAlgoUpdate) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAlgoUpdate.ExecutedQuantity", v.ExecutedQuantity)
+}
+
+// OrderPriceDecimal returns OrderPrice as a decimal, zero if it is empty
+func (v *WsAlgoUpdate) OrderPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAlgoUpdate.OrderPrice", v.OrderPrice)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *WsAlgoUpdate) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAlgoUpdate.Quantity", v.Quantity)
+}
+
+// TriggerPriceDecimal returns TriggerPrice as a decimal, zero if it is empty
+func (v *WsAlgoUpdate) TriggerPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAlgoUpdate.TriggerPrice", v.TriggerPrice)
+}
+
+// AskRateDecimal returns AskRate as a decimal, zero if it is empty
+func (v *WsAssetIndexEvent) AskRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAssetIndexEvent.AskRate", v.AskRate)
+}
+
+// AutoExchangeAskRateDecimal returns AutoExchangeAskRate as a decimal, zero if it is empty
+func (v *WsAssetIndexEvent) AutoExchangeAskRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAssetIndexEvent.AutoExchangeAskRate", v.AutoExchangeAskRate)
+}
+
+// AutoExchangeBidRateDecimal returns AutoExchangeBidRate as a decimal, zero if it is empty
+func (v *WsAssetIndexEvent) AutoExchangeBidRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAssetIndexEvent.AutoExchangeBidRate", v.AutoExchangeBidRate)
+}
+
+// BidRateDecimal returns BidRate as a decimal, zero if it is empty
+func (v *WsAssetIndexEvent) BidRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsAssetIndexEvent.BidRate", v.BidRate)
+}
+
+// ClosePriceDecimal returns ClosePrice as a decimal, zero if it is empty
+func (v *WsBLVTKline) ClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBLVTKline.ClosePrice", v.ClosePrice)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *WsBLVTKline) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBLVTKline.HighPrice", v.HighPrice)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *WsBLVTKline) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBLVTKline.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *WsBLVTKline) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBLVTKline.OpenPrice", v.OpenPrice)
+}
+
+// BalanceDecimal returns Balance as a decimal, zero if it is empty
+func (v *WsBalance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.Balance", v.Balance)
+}
+
+// ChangeBalanceDecimal returns ChangeBalance as a decimal, zero if it is empty
+func (v *WsBalance) ChangeBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.ChangeBalance", v.ChangeBalance)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *WsBalance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// BestAskPriceDecimal returns BestAskPrice as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestAskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestAskPrice", v.BestAskPrice)
+}
+
+// BestAskQtyDecimal returns BestAskQty as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestAskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestAskQty", v.BestAskQty)
+}
+
+// BestBidPriceDecimal returns BestBidPrice as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestBidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestBidPrice", v.BestBidPrice)
+}
+
+// BestBidQtyDecimal returns BestBidQty as a decimal, zero if it is empty
+func (v *WsBookTickerEvent) BestBidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBookTickerEvent.BestBidQty", v.BestBidQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsCompositeIndexEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsCompositeIndexEvent.Price", v.Price)
+}
+
+// IndexPriceDecimal returns IndexPrice as a decimal, zero if it is empty
+func (v *WsComposition) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsComposition.IndexPrice", v.IndexPrice)
+}
+
+// WeightQtyDecimal returns WeightQty as a decimal, zero if it is empty
+func (v *WsComposition) WeightQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsComposition.WeightQty", v.WeightQty)
+}
+
+// ActiveBuyQuoteVolumeDecimal returns ActiveBuyQuoteVolume as a decimal, zero if it is empty
+func (v *WsContinuousKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.ActiveBuyQuoteVolume", v.ActiveBuyQuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal returns ActiveBuyVolume as a decimal, zero if it is empty
+func (v *WsContinuousKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.ActiveBuyVolume", v.ActiveBuyVolume)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *WsContinuousKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *WsContinuousKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *WsContinuousKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *WsContinuousKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.Open", v.Open)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsContinuousKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.QuoteVolume", v.QuoteVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *WsContinuousKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsContinuousKline.Volume", v.Volume)
+}
+
+// ActiveBuyQuoteVolumeDecimal returns ActiveBuyQuoteVolume as a decimal, zero if it is empty
+func (v *WsKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.ActiveBuyQuoteVolume", v.ActiveBuyQuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal returns ActiveBuyVolume as a decimal, zero if it is empty
+func (v *WsKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.ActiveBuyVolume", v.ActiveBuyVolume)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *WsKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *WsKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *WsKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *WsKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Open", v.Open)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.QuoteVolume", v.QuoteVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *WsKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Volume", v.Volume)
+}
+
+// AccumulatedFilledQtyDecimal returns AccumulatedFilledQty as a decimal, zero if it is empty
+func (v *WsLiquidationOrder) AccumulatedFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiquidationOrder.AccumulatedFilledQty", v.AccumulatedFilledQty)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *WsLiquidationOrder) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiquidationOrder.AvgPrice", v.AvgPrice)
+}
+
+// LastFilledQtyDecimal returns LastFilledQty as a decimal, zero if it is empty
+func (v *WsLiquidationOrder) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiquidationOrder.LastFilledQty", v.LastFilledQty)
+}
+
+// OrigQuantityDecimal returns OrigQuantity as a decimal, zero if it is empty
+func (v *WsLiquidationOrder) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiquidationOrder.OrigQuantity", v.OrigQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsLiquidationOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiquidationOrder.Price", v.Price)
+}
+
+// EstimatedSettlePriceDecimal returns EstimatedSettlePrice as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) EstimatedSettlePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.EstimatedSettlePrice", v.EstimatedSettlePrice)
+}
+
+// FundingRateDecimal returns FundingRate as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.FundingRate", v.FundingRate)
+}
+
+// IndexPriceDecimal returns IndexPrice as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.IndexPrice", v.IndexPrice)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.MarkPrice", v.MarkPrice)
+}
+
+// BaseVolumeDecimal returns BaseVolume as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) BaseVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.BaseVolume", v.BaseVolume)
+}
+
+// ClosePriceDecimal returns ClosePrice as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) ClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.ClosePrice", v.ClosePrice)
+}
+
+// CloseQtyDecimal returns CloseQty as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) CloseQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.CloseQty", v.CloseQty)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.HighPrice", v.HighPrice)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.OpenPrice", v.OpenPrice)
+}
+
+// PriceChangeDecimal returns PriceChange as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.PriceChange", v.PriceChange)
+}
+
+// PriceChangePercentDecimal returns PriceChangePercent as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.PriceChangePercent", v.PriceChangePercent)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.QuoteVolume", v.QuoteVolume)
+}
+
+// WeightedAvgPriceDecimal returns WeightedAvgPrice as a decimal, zero if it is empty
+func (v *WsMarketTickerEvent) WeightedAvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarketTickerEvent.WeightedAvgPrice", v.WeightedAvgPrice)
+}
+
+// ClosePriceDecimal returns ClosePrice as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) ClosePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.ClosePrice", v.ClosePrice)
+}
+
+// HighPriceDecimal returns HighPrice as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) HighPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.HighPrice", v.HighPrice)
+}
+
+// LowPriceDecimal returns LowPrice as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) LowPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.LowPrice", v.LowPrice)
+}
+
+// OpenPriceDecimal returns OpenPrice as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) OpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.OpenPrice", v.OpenPrice)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.QuoteVolume", v.QuoteVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *WsMiniMarketTickerEvent) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMiniMarketTickerEvent.Volume", v.Volume)
+}
+
+// AccumulatedFilledQtyDecimal returns AccumulatedFilledQty as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) AccumulatedFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.AccumulatedFilledQty", v.AccumulatedFilledQty)
+}
+
+// ActivationPriceDecimal returns ActivationPrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) ActivationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.ActivationPrice", v.ActivationPrice)
+}
+
+// AsksNotionalDecimal returns AsksNotional as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) AsksNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.AsksNotional", v.AsksNotional)
+}
+
+// AveragePriceDecimal returns AveragePrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.AveragePrice", v.AveragePrice)
+}
+
+// BidsNotionalDecimal returns BidsNotional as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) BidsNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.BidsNotional", v.BidsNotional)
+}
+
+// CallbackRateDecimal returns CallbackRate as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) CallbackRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.CallbackRate", v.CallbackRate)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.Commission", v.Commission)
+}
+
+// LastFilledPriceDecimal returns LastFilledPrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.LastFilledPrice", v.LastFilledPrice)
+}
+
+// LastFilledQtyDecimal returns LastFilledQty as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.LastFilledQty", v.LastFilledQty)
+}
+
+// OriginalPriceDecimal returns OriginalPrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) OriginalPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.OriginalPrice", v.OriginalPrice)
+}
+
+// OriginalQtyDecimal returns OriginalQty as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) OriginalQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.OriginalQty", v.OriginalQty)
+}
+
+// RealizedPnLDecimal returns RealizedPnL as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) RealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.RealizedPnL", v.RealizedPnL)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.StopPrice", v.StopPrice)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *WsPosition) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.Amount", v.Amount)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *WsPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.EntryPrice", v.EntryPrice)
+}
+
+// MaintenanceMarginRequiredDecimal returns MaintenanceMarginRequired as a decimal, zero if it is empty
+func (v *WsPosition) MaintenanceMarginRequiredDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.MaintenanceMarginRequired", v.MaintenanceMarginRequired)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *WsPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.MarkPrice", v.MarkPrice)
+}
+
+// UnrealizedPnLDecimal returns UnrealizedPnL as a decimal, zero if it is empty
+func (v *WsPosition) UnrealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.UnrealizedPnL", v.UnrealizedPnL)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *WsUserDataMarginCall) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsUserDataMarginCall.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// LastFilledPriceDecimal returns LastFilledPrice as a decimal, zero if it is empty
+func (v *WsUserDataTradeLite) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsUserDataTradeLite.LastFilledPrice", v.LastFilledPrice)
+}
+
+// LastFilledQtyDecimal returns LastFilledQty as a decimal, zero if it is empty
+func (v *WsUserDataTradeLite) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsUserDataTradeLite.LastFilledQty", v.LastFilledQty)
+}
+
+// OriginalPriceDecimal returns OriginalPrice as a decimal, zero if it is empty
+func (v *WsUserDataTradeLite) OriginalPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsUserDataTradeLite.OriginalPrice", v.OriginalPrice)
+}
+
+// OriginalQtyDecimal returns OriginalQty as a decimal, zero if it is empty
+func (v *WsUserDataTradeLite) OriginalQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsUserDataTradeLite.OriginalQty", v.OriginalQty)
+}
@@ -0,0 +1,162 @@
+package futures
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// DepthWsService queries order book
+type DepthWsService struct {
+	c websocket.Client
+}
+
+// NewDepthWsService init DepthWsService
+func NewDepthWsService() (*DepthWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DepthWsService{
+		c: client,
+	}, nil
+}
+
+// NewDepthWsServiceWithSession init DepthWsService sending its requests on the connection of session
+func NewDepthWsServiceWithSession(session *SessionWsService) *DepthWsService {
+	return &DepthWsService{
+		c: session.c,
+	}
+}
+
+// DepthWsRequest parameters for 'depth' websocket API
+type DepthWsRequest struct {
+	symbol string
+	limit  *int
+}
+
+// NewDepthWsRequest init DepthWsRequest
+func NewDepthWsRequest() *DepthWsRequest {
+	return &DepthWsRequest{}
+}
+
+func (s *DepthWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *DepthWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'depth' request
+func (s *DepthWsService) Do(requestID string, request *DepthWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.DepthFuturesWsApiMethod, request.buildParams())
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'depth' request and receives response
+func (s *DepthWsService) SyncDo(requestID string, request *DepthWsRequest) (*DepthWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.DepthFuturesWsApiMethod, request.buildParams())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	depthWsResponse := &DepthWsResponse{}
+	if err := json.Unmarshal(response, depthWsResponse); err != nil {
+		return nil, err
+	}
+	if depthWsResponse.Error != nil {
+		depthWsResponse.Error.StatusCode = depthWsResponse.Status
+		return nil, depthWsResponse.Error
+	}
+
+	return depthWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *DepthWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *DepthWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *DepthWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *DepthWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *DepthWsRequest) Symbol(symbol string) *DepthWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// Limit set limit
+func (s *DepthWsRequest) Limit(limit int) *DepthWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// DepthWsResponse define 'depth' websocket API response
+type DepthWsResponse struct {
+	Id     string        `json:"id"`
+	Status int           `json:"status"`
+	Result DepthResponse `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON parses bids and asks given as arrays
+func (r *DepthWsResponse) UnmarshalJSON(data []byte) error {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if len(raw.Result) == 0 {
+		return nil
+	}
+	res, err := parseDepthResponse(raw.Result)
+	if err != nil {
+		return err
+	}
+	r.Result = *res
+	return nil
+}
@@ -0,0 +1,94 @@
+package futures
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *depthServiceWsTestSuite) SetupTest() {
+	s.requestID = "51e2affb-0aba-4821-ba75-f2625006eb43"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.depth = &DepthWsService{
+		c: s.client,
+	}
+
+	s.depthRequest = NewDepthWsRequest().
+		Symbol("BTCUSDT").
+		Limit(5)
+}
+
+func (s *depthServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type depthServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	depth        *DepthWsService
+	depthRequest *DepthWsRequest
+}
+
+func TestDepthServiceWs(t *testing.T) {
+	suite.Run(t, new(depthServiceWsTestSuite))
+}
+
+func (s *depthServiceWsTestSuite) TestDepth() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.depth.Do(s.requestID, s.depthRequest)
+	s.NoError(err)
+}
+
+func (s *depthServiceWsTestSuite) TestDepth_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.depth.Do("", s.depthRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *depthServiceWsTestSuite) TestDepthSync() {
+	rawResponseData := []byte(`{"id":"51e2affb-0aba-4821-ba75-f2625006eb43","status":200,"result":{"lastUpdateId":1027024,"E":1589436922972,"T":1589436922959,"bids":[["4.00000000","431.00000000"]],"asks":[["4.00000200","12.00000000"],["4.00000300","9.00000000"]]}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.depth.SyncDo(s.requestID, s.depthRequest)
+	s.Require().NoError(err)
+	s.Equal(int64(1027024), response.Result.LastUpdateID)
+	s.Equal(int64(1589436922972), response.Result.Time)
+	s.Equal(int64(1589436922959), response.Result.TradeTime)
+	s.Equal([]Bid{{Price: "4.00000000", Quantity: "431.00000000"}}, response.Result.Bids)
+	s.Require().Len(response.Result.Asks, 2)
+	s.Equal(Ask{Price: "4.00000300", Quantity: "9.00000000"}, response.Result.Asks[1])
+}
+
+func (s *depthServiceWsTestSuite) TestDepthSync_Error() {
+	rawResponseData := []byte(`{"id":"51e2affb-0aba-4821-ba75-f2625006eb43","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.depth.SyncDo(s.requestID, s.depthRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *depthServiceWsTestSuite) TestDepthSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.depth.SyncDo(s.requestID, s.depthRequest)
+	s.Nil(response)
+	s.Error(err)
+}
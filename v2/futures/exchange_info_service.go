@@ -51,6 +51,8 @@ type RateLimit struct {
 }
 
 // Symbol market symbol
+//
+//decimalgen:accessors
 type Symbol struct {
 	Symbol                string            `json:"symbol"`
 	Pair                  string            `json:"pair"`
@@ -79,6 +81,8 @@ type Symbol struct {
 }
 
 // LotSizeFilter define lot size filter of symbol
+//
+//decimalgen:accessors
 type LotSizeFilter struct {
 	MaxQuantity string `json:"maxQty"`
 	MinQuantity string `json:"minQty"`
@@ -86,6 +90,8 @@ type LotSizeFilter struct {
 }
 
 // PriceFilter define price filter of symbol
+//
+//decimalgen:accessors
 type PriceFilter struct {
 	MaxPrice string `json:"maxPrice"`
 	MinPrice string `json:"minPrice"`
@@ -100,6 +106,8 @@ type PercentPriceFilter struct {
 }
 
 // MarketLotSizeFilter define market lot size filter of symbol
+//
+//decimalgen:accessors
 type MarketLotSizeFilter struct {
 	MaxQuantity string `json:"maxQty"`
 	MinQuantity string `json:"minQty"`
@@ -117,6 +125,8 @@ type MaxNumAlgoOrdersFilter struct {
 }
 
 // MinNotionalFilter define min notional filter of symbol
+//
+//decimalgen:accessors
 type MinNotionalFilter struct {
 	Notional string `json:"notional"`
 }
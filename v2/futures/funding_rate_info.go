@@ -34,6 +34,8 @@ func (s *FundingRateInfoService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // FundingRateInfo defines funding rate info for symbols
+//
+//decimalgen:accessors
 type FundingRateInfo struct {
 	Symbol                   string `json:"symbol"`
 	AdjustedFundingRateCap   string `json:"adjustedFundingRateCap"`
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // GetIncomeHistoryService get position margin history service
@@ -79,6 +82,17 @@ func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Iterator walks the income between startTime and endTime in milliseconds, in windows of 7 days and pages of 1000
+// records
+func (s *GetIncomeHistoryService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*IncomeHistory] {
+	return common.NewCursorIterator(startTime, endTime, 7*24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*IncomeHistory, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(int64(limit)).Do(ctx, opts...)
+		},
+		func(h *IncomeHistory) int64 { return h.Time },
+		func(h *IncomeHistory) IncomeHistory { return *h })
+}
+
 // IncomeHistory define position margin history info
 type IncomeHistory struct {
 	Asset      string `json:"asset"`
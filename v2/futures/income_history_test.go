@@ -1,6 +1,8 @@
 package futures
 
 import (
+	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -72,3 +74,28 @@ func (s *incomeHistoryServiceTestSuite) assertOrderEqual(e, a *IncomeHistory) {
 	r.Equal(e.TranID, a.TranID, "TranID")
 	r.Equal(e.TradeID, a.TradeID, "TradeID")
 }
+
+func (s *incomeHistoryServiceTestSuite) TestIncomeHistoryIterator() {
+	var queries []url.Values
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		queries = append(queries, req.URL.Query())
+		data := []byte(`[
+			{"symbol": "BTCUSDT", "incomeType": "COMMISSION", "income": "-0.01", "asset": "USDT", "time": 1000, "tranId": 1},
+			{"symbol": "BTCUSDT", "incomeType": "REALIZED_PNL", "income": "1.5", "asset": "USDT", "time": 1000, "tranId": 1}
+		]`)
+		return newHTTPResponse(data, http.StatusOK), nil
+	}
+
+	it := s.client.NewGetIncomeHistoryService().Symbol("BTCUSDT").Iterator(0, 5000)
+	var incomeTypes []string
+	for it.Next(newContext()) {
+		incomeTypes = append(incomeTypes, it.Value().IncomeType)
+	}
+	s.r().NoError(it.Err())
+	s.r().Equal([]string{"COMMISSION", "REALIZED_PNL"}, incomeTypes)
+	s.r().Len(queries, 1)
+	s.r().Equal("0", queries[0].Get("startTime"))
+	s.r().Equal("5000", queries[0].Get("endTime"))
+	s.r().Equal("1000", queries[0].Get("limit"))
+	s.r().Equal("BTCUSDT", queries[0].Get("symbol"))
+}
@@ -18,6 +18,7 @@ type IndexInfo struct {
 	BaseAssetList []*BaseAssetList `json:"baseAssetList"`
 }
 
+//decimalgen:accessors
 type BaseAssetList struct {
 	BaseAsset          string `json:"baseAsset"`
 	QuoteAsset         string `json:"quoteAsset"`
@@ -0,0 +1,110 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// NewKlineFeed creates a feed of the klines of symbol and interval from startTime on. The history is backfilled with
+// KlinesService requests, then the feed follows the kline stream. Set the options of the feed and start it with Run.
+func (c *Client) NewKlineFeed(symbol, interval string, startTime int64) *common.KlineFeed[*Kline] {
+	return common.NewKlineFeed(common.KlineSource[*Kline]{
+		Fetch: func(ctx context.Context, startTime, endTime int64, limit int) ([]*Kline, error) {
+			return c.NewKlinesService().Symbol(symbol).Interval(interval).
+				StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		Serve: func(handler common.KlineHandler[*Kline], errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			return WsKlineServe(symbol, interval, func(event *WsKlineEvent) {
+				handler(event.Kline.toKline(), event.Kline.IsFinal)
+			}, errHandler)
+		},
+		OpenTime:  klineOpenTime,
+		CloseTime: klineCloseTime,
+	}, interval, startTime)
+}
+
+// NewContinuousKlineFeed creates a feed of the continuous contract klines of pair, contractType and interval from
+// startTime on, backfilled with ContinuousKlinesService requests.
+func (c *Client) NewContinuousKlineFeed(pair, contractType, interval string, startTime int64) *common.KlineFeed[*ContinuousKline] {
+	args := &WsContinuousKlineSubscribeArgs{Pair: pair, ContractType: contractType, Interval: interval}
+	return common.NewKlineFeed(common.KlineSource[*ContinuousKline]{
+		Fetch: func(ctx context.Context, startTime, endTime int64, limit int) ([]*ContinuousKline, error) {
+			return c.NewContinuousKlinesService().Pair(pair).ContractType(contractType).Interval(interval).
+				StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		Serve: func(handler common.KlineHandler[*ContinuousKline], errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			return WsContinuousKlineServe(args, func(event *WsContinuousKlineEvent) {
+				handler(event.Kline.toContinuousKline(), event.Kline.IsFinal)
+			}, errHandler)
+		},
+		OpenTime:  func(k *ContinuousKline) int64 { return k.OpenTime },
+		CloseTime: func(k *ContinuousKline) int64 { return k.CloseTime },
+	}, interval, startTime)
+}
+
+// NewMarkPriceKlineFeed creates a feed of the mark price klines of symbol and interval from startTime on. There is no
+// stream of these klines, the feed polls MarkPriceKlinesService instead.
+func (c *Client) NewMarkPriceKlineFeed(symbol, interval string, startTime int64) *common.KlineFeed[*Kline] {
+	return common.NewKlineFeed(common.KlineSource[*Kline]{
+		Fetch: func(ctx context.Context, startTime, endTime int64, limit int) ([]*Kline, error) {
+			return c.NewMarkPriceKlinesService().Symbol(symbol).Interval(interval).
+				StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		OpenTime:  klineOpenTime,
+		CloseTime: klineCloseTime,
+	}, interval, startTime)
+}
+
+// NewIndexPriceKlineFeed creates a feed of the index price klines of pair and interval from startTime on. There is no
+// stream of these klines, the feed polls IndexPriceKlinesService instead.
+func (c *Client) NewIndexPriceKlineFeed(pair, interval string, startTime int64) *common.KlineFeed[*Kline] {
+	return common.NewKlineFeed(common.KlineSource[*Kline]{
+		Fetch: func(ctx context.Context, startTime, endTime int64, limit int) ([]*Kline, error) {
+			return c.NewIndexPriceKlinesService().Pair(pair).Interval(interval).
+				StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		OpenTime:  klineOpenTime,
+		CloseTime: klineCloseTime,
+	}, interval, startTime)
+}
+
+func klineOpenTime(k *Kline) int64 {
+	return k.OpenTime
+}
+
+func klineCloseTime(k *Kline) int64 {
+	return k.CloseTime
+}
+
+func (k *WsKline) toKline() *Kline {
+	return &Kline{
+		OpenTime:                 k.StartTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.EndTime,
+		QuoteAssetVolume:         k.QuoteVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.ActiveBuyVolume,
+		TakerBuyQuoteAssetVolume: k.ActiveBuyQuoteVolume,
+	}
+}
+
+func (k *WsContinuousKline) toContinuousKline() *ContinuousKline {
+	return &ContinuousKline{
+		OpenTime:                 k.StartTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.EndTime,
+		QuoteAssetVolume:         k.QuoteVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.ActiveBuyVolume,
+		TakerBuyQuoteAssetVolume: k.ActiveBuyQuoteVolume,
+	}
+}
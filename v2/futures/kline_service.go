@@ -97,6 +97,8 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 }
 
 // Kline define kline info
+//
+//decimalgen:accessors
 type Kline struct {
 	OpenTime                 int64  `json:"openTime"`
 	Open                     string `json:"open"`
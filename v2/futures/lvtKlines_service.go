@@ -15,6 +15,7 @@ type LvtKlinesService struct {
 	limit     *uint32
 }
 
+//decimalgen:accessors
 type LvtKline struct {
 	OpenTime      uint64
 	Open          string
@@ -44,6 +44,8 @@ func (s *PremiumIndexService) Do(ctx context.Context, opts ...RequestOption) (re
 }
 
 // PremiumIndex define premium index of mark price
+//
+//decimalgen:accessors
 type PremiumIndex struct {
 	Symbol               string `json:"symbol"`
 	MarkPrice            string `json:"markPrice"`
@@ -118,6 +120,8 @@ func (s *FundingRateService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // FundingRate define funding rate of mark price
+//
+//decimalgen:accessors
 type FundingRate struct {
 	Symbol      string `json:"symbol"`
 	FundingRate string `json:"fundingRate"`
@@ -39,6 +39,7 @@ func (s *GetOpenInterestService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+//decimalgen:accessors
 type OpenInterest struct {
 	OpenInterest string `json:"openInterest"`
 	Symbol       string `json:"symbol"`
@@ -119,6 +120,7 @@ func (s *OpenInterestStatisticsService) Do(ctx context.Context, opts ...RequestO
 	return res, nil
 }
 
+//decimalgen:accessors
 type OpenInterestStatistic struct {
 	Symbol               string `json:"symbol"`
 	SumOpenInterest      string `json:"sumOpenInterest"`
@@ -80,11 +80,15 @@ type OrderCancelWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewOrderCancelWsService init OrderCancelWsService
-func NewOrderCancelWsService(apiKey, secretKey string) (*OrderCancelWsService, error) {
+func NewOrderCancelWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OrderCancelWsService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -100,9 +104,24 @@ func NewOrderCancelWsService(apiKey, secretKey string) (*OrderCancelWsService, e
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewOrderCancelWsServiceWithSession init OrderCancelWsService sending its requests on the connection of session
+func NewOrderCancelWsServiceWithSession(session *SessionWsService) *OrderCancelWsService {
+	return &OrderCancelWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // Do - sends 'order.cancel' request
 func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelRequest) error {
 	rawData, err := websocket.CreateRequest(
@@ -110,9 +129,9 @@ func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelRequest)
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.CancelFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -134,9 +153,9 @@ func (s *OrderCancelWsService) SyncDo(requestID string, request *OrderCancelRequ
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.CancelFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -153,6 +172,11 @@ func (s *OrderCancelWsService) SyncDo(requestID string, request *OrderCancelRequ
 	if err := json.Unmarshal(response, cancelOrderWsResponse); err != nil {
 		return nil, err
 	}
+	if cancelOrderWsResponse.Error != nil {
+		cancelOrderWsResponse.Error.StatusCode = cancelOrderWsResponse.Status
+		s.TimeSync.CheckError(cancelOrderWsResponse.Error)
+		return nil, cancelOrderWsResponse.Error
+	}
 
 	return cancelOrderWsResponse, nil
 }
@@ -0,0 +1,129 @@
+package futures
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *orderModifyServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+	s.timeOffset = 0
+
+	s.requestID = "c8ba8a4e-55e4-4b15-a0f5-f6e4c8d8a0f1"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.orderModify = &OrderModifyWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.orderModifyRequest = NewOrderModifyWsRequest().
+		Symbol("BTCUSDT").
+		OrderID(328971409).
+		Side(SideTypeSell).
+		Quantity("0.085").
+		Price("44000")
+}
+
+func (s *orderModifyServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type orderModifyServiceWsTestSuite struct {
+	suite.Suite
+	apiKey     string
+	secretKey  string
+	signedKey  string
+	timeOffset int64
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	orderModify        *OrderModifyWsService
+	orderModifyRequest *OrderModifyWsRequest
+}
+
+func TestOrderModifyServiceWs(t *testing.T) {
+	suite.Run(t, new(orderModifyServiceWsTestSuite))
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModify() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(requestID string, data []byte) error {
+		req := websocket.WsApiRequest{}
+		s.Require().NoError(json.Unmarshal(data, &req))
+		s.Equal(websocket.OrderModifyFuturesWsApiMethod, req.Method)
+		s.Equal("BTCUSDT", req.Params["symbol"])
+		s.Equal(float64(328971409), req.Params["orderId"])
+		s.Equal("SELL", req.Params["side"])
+		s.Equal("0.085", req.Params["quantity"])
+		s.Equal("44000", req.Params["price"])
+		s.NotContains(req.Params, "priceMatch")
+		s.Contains(req.Params, "signature")
+		return nil
+	}).Times(1)
+
+	err := s.orderModify.Do(s.requestID, s.orderModifyRequest)
+	s.NoError(err)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModify_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderModify.Do("", s.orderModifyRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModify_EmptyApiKey() {
+	s.orderModify.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderModify.Do(s.requestID, s.orderModifyRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModifySync() {
+	rawResponseData := []byte(`{"id":"c8ba8a4e-55e4-4b15-a0f5-f6e4c8d8a0f1","status":200,"result":{"orderId":328971409,"symbol":"BTCUSDT","status":"NEW","clientOrderId":"xGHfltUMExx0TbQstQQfRX","price":"44000","avgPrice":"0.00","origQty":"0.085","executedQty":"0","cumQty":"0","cumQuote":"0","timeInForce":"GTC","type":"LIMIT","reduceOnly":false,"closePosition":false,"side":"SELL","positionSide":"SHORT","stopPrice":"0","workingType":"CONTRACT_PRICE","priceProtect":false,"origType":"LIMIT","priceMatch":"NONE","selfTradePreventionMode":"NONE","goodTillDate":0,"updateTime":1703426756190}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderModify.SyncDo(s.requestID, s.orderModifyRequest)
+	s.Require().NoError(err)
+	s.Equal(int64(328971409), response.Result.OrderID)
+	s.Equal("44000", response.Result.Price)
+	s.Equal("0.085", response.Result.OriginalQuantity)
+	s.Equal(OrderStatusTypeNew, response.Result.Status)
+	s.Equal(int64(1703426756190), response.Result.UpdateTime)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModifySync_Error() {
+	rawResponseData := []byte(`{"id":"c8ba8a4e-55e4-4b15-a0f5-f6e4c8d8a0f1","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderModify.SyncDo(s.requestID, s.orderModifyRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModifySync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.orderModify.SyncDo(s.requestID, s.orderModifyRequest)
+	s.Nil(response)
+	s.Error(err)
+}
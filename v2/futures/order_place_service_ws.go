@@ -14,11 +14,15 @@ type OrderPlaceWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewOrderPlaceWsService init OrderPlaceWsService
-func NewOrderPlaceWsService(apiKey, secretKey string) (*OrderPlaceWsService, error) {
+func NewOrderPlaceWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OrderPlaceWsService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -34,9 +38,24 @@ func NewOrderPlaceWsService(apiKey, secretKey string) (*OrderPlaceWsService, err
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewOrderPlaceWsServiceWithSession init OrderPlaceWsService sending its requests on the connection of session
+func NewOrderPlaceWsServiceWithSession(session *SessionWsService) *OrderPlaceWsService {
+	return &OrderPlaceWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // OrderPlaceWsRequest parameters for 'order.place' websocket API
 type OrderPlaceWsRequest struct {
 	symbol                  string
@@ -244,9 +263,9 @@ func (s *OrderPlaceWsService) Do(requestID string, request *OrderPlaceWsRequest)
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderPlaceFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -268,9 +287,9 @@ func (s *OrderPlaceWsService) SyncDo(requestID string, request *OrderPlaceWsRequ
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderPlaceFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -287,6 +306,11 @@ func (s *OrderPlaceWsService) SyncDo(requestID string, request *OrderPlaceWsRequ
 	if err := json.Unmarshal(response, createOrderWsResponse); err != nil {
 		return nil, err
 	}
+	if createOrderWsResponse.Error != nil {
+		createOrderWsResponse.Error.StatusCode = createOrderWsResponse.Status
+		s.TimeSync.CheckError(createOrderWsResponse.Error)
+		return nil, createOrderWsResponse.Error
+	}
 
 	return createOrderWsResponse, nil
 }
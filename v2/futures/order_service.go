@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/adshao/go-binance/v2/common"
 )
@@ -34,6 +35,7 @@ type CreateOrderService struct {
 	closePosition           *string
 	selfTradePreventionMode *SelfTradePreventionMode
 	goodTillDate            int64
+	roundToFilters          bool
 }
 
 // Symbol set symbol
@@ -153,7 +155,16 @@ func (s *CreateOrderService) GoodTillDate(goodTillDate int64) *CreateOrderServic
 	return s
 }
 
+// RoundToFilters round price, stop price, activation price and quantity down to tick and step size of SymbolRules before the order is sent
+func (s *CreateOrderService) RoundToFilters(roundToFilters bool) *CreateOrderService {
+	s.roundToFilters = roundToFilters
+	return s
+}
+
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	if err = s.Validate(ctx); err != nil {
+		return []byte{}, &http.Header{}, err
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -237,7 +248,37 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Validate check the order against the filters of SymbolRules of the client, it returns a *common.FilterError listing
+// the violations. It is called by Do, the order is not checked if SymbolRules is nil or the symbol is unknown.
+func (s *CreateOrderService) Validate(ctx context.Context) error {
+	if s.c.SymbolRules == nil {
+		return nil
+	}
+	rules, err := s.c.SymbolRules.Rules(ctx, s.symbol)
+	if err != nil || rules == nil {
+		return err
+	}
+	values := common.OrderValues{
+		Market:          strings.HasSuffix(string(s.orderType), string(OrderTypeMarket)),
+		Price:           stringValue(s.price),
+		StopPrice:       stringValue(s.stopPrice),
+		ActivationPrice: stringValue(s.activationPrice),
+		Quantity:        s.quantity,
+		ReduceOnly:      stringValue(s.reduceOnly) == "true" || stringValue(s.closePosition) == "true",
+	}
+	if s.roundToFilters {
+		rules.Round(&values)
+		setStringValue(&s.price, values.Price)
+		setStringValue(&s.stopPrice, values.StopPrice)
+		setStringValue(&s.activationPrice, values.ActivationPrice)
+		s.quantity = values.Quantity
+	}
+	return rules.Validate(values)
+}
+
 // CreateOrderResponse define create order response
+//
+//decimalgen:accessors
 type CreateOrderResponse struct {
 	Symbol                  string           `json:"symbol"`                      //
 	OrderID                 int64            `json:"orderId"`                     //
@@ -378,6 +419,7 @@ func (s *ModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+//decimalgen:accessors
 type ModifyOrderResponse struct {
 	OrderID                 int64            `json:"orderId"`
 	Symbol                  string           `json:"symbol"`
@@ -544,6 +586,8 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 }
 
 // Order define order info
+//
+//decimalgen:accessors
 type Order struct {
 	Symbol                  string           `json:"symbol"`
 	OrderID                 int64            `json:"orderId"`
@@ -646,6 +690,18 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Iterator walks the orders created between startTime and endTime in milliseconds, in windows of 7 days and pages of
+// 1000 orders. OrderID is ignored.
+func (s *ListOrdersService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Order] {
+	s.orderID = nil
+	return common.NewCursorIterator(startTime, endTime, 7*24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*Order, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx, opts...)
+		},
+		func(o *Order) int64 { return o.Time },
+		func(o *Order) int64 { return o.OrderID })
+}
+
 // CancelOrderService cancel an order
 type CancelOrderService struct {
 	c                 *Client
@@ -699,6 +755,8 @@ func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // CancelOrderResponse define response of canceling order
+//
+//decimalgen:accessors
 type CancelOrderResponse struct {
 	ClientOrderID           string                  `json:"clientOrderId"`
 	CumQuantity             string                  `json:"cumQty"` // deprecated: use ExecutedQuantity instead
@@ -873,6 +931,8 @@ func (s *ListLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOp
 }
 
 // LiquidationOrder define liquidation order
+//
+//decimalgen:accessors
 type LiquidationOrder struct {
 	Symbol           string          `json:"symbol"`
 	Price            string          `json:"price"`
@@ -960,6 +1020,8 @@ func (s *ListUserLiquidationOrdersService) Do(ctx context.Context, opts ...Reque
 }
 
 // UserLiquidationOrder defines user's liquidation order
+//
+//decimalgen:accessors
 type UserLiquidationOrder struct {
 	OrderId          int64            `json:"orderId"`
 	Symbol           string           `json:"symbol"`
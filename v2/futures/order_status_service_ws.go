@@ -14,11 +14,15 @@ type OrderStatusWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewOrderStatusWsService init OrderStatusWsService
-func NewOrderStatusWsService(apiKey, secretKey string) (*OrderStatusWsService, error) {
+func NewOrderStatusWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OrderStatusWsService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -34,9 +38,24 @@ func NewOrderStatusWsService(apiKey, secretKey string) (*OrderStatusWsService, e
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewOrderStatusWsServiceWithSession init OrderStatusWsService sending its requests on the connection of session
+func NewOrderStatusWsServiceWithSession(session *SessionWsService) *OrderStatusWsService {
+	return &OrderStatusWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // OrderStatusWsRequest parameters for 'order.status' websocket API
 type OrderStatusWsRequest struct {
 	symbol            string
@@ -69,6 +88,8 @@ func (s *OrderStatusWsRequest) OrigClientOrderID(origClientOrderID string) *Orde
 }
 
 // QueryOrderResponse define query order response
+//
+//decimalgen:accessors
 type QueryOrderResponse struct {
 	AvgPrice      string `json:"avgPrice"`
 	ClientOrderID string `json:"clientOrderId"`
@@ -134,9 +155,9 @@ func (s *OrderStatusWsService) Do(requestID string, request *OrderStatusWsReques
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderStatusFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -158,9 +179,9 @@ func (s *OrderStatusWsService) SyncDo(requestID string, request *OrderStatusWsRe
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderStatusFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -177,6 +198,11 @@ func (s *OrderStatusWsService) SyncDo(requestID string, request *OrderStatusWsRe
 	if err := json.Unmarshal(response, queryOrderWsResponse); err != nil {
 		return nil, err
 	}
+	if queryOrderWsResponse.Error != nil {
+		queryOrderWsResponse.Error.StatusCode = queryOrderWsResponse.Status
+		s.TimeSync.CheckError(queryOrderWsResponse.Error)
+		return nil, queryOrderWsResponse.Error
+	}
 
 	return queryOrderWsResponse, nil
 }
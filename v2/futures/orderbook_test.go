@@ -0,0 +1,109 @@
+package futures
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderBookTestSuite struct {
+	baseTestSuite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+	handlerC    chan WsHandler
+	snapshots   chan []byte
+	symbols     chan string
+}
+
+func TestOrderBook(t *testing.T) {
+	suite.Run(t, new(orderBookTestSuite))
+}
+
+func (s *orderBookTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsServe = wsServe
+	s.handlerC = make(chan WsHandler, 1)
+	s.snapshots = make(chan []byte, 10)
+	s.symbols = make(chan string, 10)
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		s.handlerC <- handler
+		return doneC, stopC, nil
+	}
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		s.symbols <- req.URL.Query().Get("symbol")
+		return newHTTPResponse(<-s.snapshots, http.StatusOK), nil
+	}
+}
+
+func (s *orderBookTestSuite) TearDownTest() {
+	wsServe = s.origWsServe
+}
+
+func (s *orderBookTestSuite) waitUpdate(updateC chan int64) int64 {
+	select {
+	case id := <-updateC:
+		return id
+	case <-time.After(5 * time.Second):
+		s.FailNow("order book was not updated")
+	}
+	return 0
+}
+
+func (s *orderBookTestSuite) TestOrderBook() {
+	updateC := make(chan int64, 10)
+	errC := make(chan error, 10)
+	book := s.client.NewOrderBook("BTCUSDT").Limit(5).OnUpdate(func(book *OrderBook) {
+		updateC <- book.LastUpdateID()
+	}).OnError(func(err error) {
+		errC <- err
+	})
+	s.r().NoError(book.Start(context.Background()))
+	defer func() {
+		book.Stop()
+		<-book.Done()
+	}()
+	handler := <-s.handlerC
+	s.False(book.IsSynced())
+
+	// buffered until the snapshot arrives
+	handler([]byte(`{"e":"depthUpdate","E":1,"T":1,"s":"BTCUSDT","U":150,"u":155,"pu":149,"b":[["100.0","5"]],"a":[]}`))
+	handler([]byte(`{"e":"depthUpdate","E":2,"T":2,"s":"BTCUSDT","U":156,"u":160,"pu":155,"b":[["101.0","10"]],"a":[["102.0","0"]]}`))
+	s.Equal("BTCUSDT", <-s.symbols)
+	s.snapshots <- []byte(`{"lastUpdateId":158,"E":2,"T":2,"bids":[["101.0","1"],["100.0","1"]],"asks":[["102.0","1"],["103.0","2"]]}`)
+	s.Equal(int64(160), s.waitUpdate(updateC))
+	s.True(book.IsSynced())
+
+	bid, ok := book.BestBid()
+	s.True(ok)
+	s.Equal(Bid{Price: "101.0", Quantity: "10"}, bid)
+	ask, ok := book.BestAsk()
+	s.True(ok)
+	s.Equal(Ask{Price: "103.0", Quantity: "2"}, ask)
+
+	handler([]byte(`{"e":"depthUpdate","E":3,"T":3,"s":"BTCUSDT","U":161,"u":163,"pu":160,"b":[["101.5","3"]],"a":[["102.5","4"]]}`))
+	s.Equal(int64(163), s.waitUpdate(updateC))
+	s.Equal([]Bid{{Price: "101.5", Quantity: "3"}, {Price: "101.0", Quantity: "10"}}, book.Bids(2))
+	s.Equal([]Ask{{Price: "102.5", Quantity: "4"}, {Price: "103.0", Quantity: "2"}}, book.Asks(0))
+
+	// pu not matching the previous event triggers a resync from a new snapshot
+	handler([]byte(`{"e":"depthUpdate","E":4,"T":4,"s":"BTCUSDT","U":165,"u":166,"pu":164,"b":[["99.0","7"]],"a":[]}`))
+	s.Equal(ErrOrderBookGap, <-errC)
+	s.Equal("BTCUSDT", <-s.symbols)
+	s.snapshots <- []byte(`{"lastUpdateId":165,"E":4,"T":4,"bids":[["98.0","1"]],"asks":[["104.0","1"]]}`)
+	s.Equal(int64(166), s.waitUpdate(updateC))
+	s.Equal([]Bid{{Price: "99.0", Quantity: "7"}, {Price: "98.0", Quantity: "1"}}, book.Bids(0))
+	s.Equal([]Ask{{Price: "104.0", Quantity: "1"}}, book.Asks(0))
+}
+
+func (s *orderBookTestSuite) TestInvalidRate() {
+	err := s.client.NewOrderBook("BTCUSDT").UpdateSpeed(time.Minute).Start(context.Background())
+	s.r().Error(err)
+}
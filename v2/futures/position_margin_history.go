@@ -80,6 +80,8 @@ func (s *GetPositionMarginHistoryService) Do(ctx context.Context, opts ...Reques
 }
 
 // PositionMarginHistory define position margin history info
+//
+//decimalgen:accessors
 type PositionMarginHistory struct {
 	Amount       string `json:"amount"`
 	Asset        string `json:"asset"`
@@ -41,6 +41,8 @@ func (s *GetPositionRiskService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // PositionRisk define position risk info
+//
+//decimalgen:accessors
 type PositionRisk struct {
 	EntryPrice       string `json:"entryPrice"`
 	BreakEvenPrice   string `json:"breakEvenPrice"`
@@ -103,6 +105,8 @@ func (s *GetPositionRiskV3Service) Do(ctx context.Context, opts ...RequestOption
 }
 
 // PositionRiskV3 define position risk info
+//
+//decimalgen:accessors
 type PositionRiskV3 struct {
 	Symbol                 string `json:"symbol"`
 	PositionSide           string `json:"positionSide"`
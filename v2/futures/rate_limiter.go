@@ -0,0 +1,157 @@
+package futures
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// DefaultRateLimits are the futures limits used when they can't be loaded from exchange info
+var DefaultRateLimits = []common.RateLimitRule{
+	{Type: common.RateLimitTypeRequestWeight, Interval: time.Minute, Limit: 2400},
+	{Type: common.RateLimitTypeOrders, Interval: time.Minute, Limit: 1200},
+	{Type: common.RateLimitTypeOrders, Interval: 10 * time.Second, Limit: 300},
+}
+
+// endpointWeights define request weight of endpoints, endpoints which are not listed weigh 1
+var endpointWeights = map[string]int64{
+	"GET /fapi/v1/trades":                 5,
+	"GET /fapi/v1/historicalTrades":       20,
+	"GET /fapi/v1/aggTrades":              20,
+	"GET /fapi/v1/allOrders":              5,
+	"GET /fapi/v1/userTrades":             5,
+	"GET /fapi/v1/income":                 30,
+	"GET /fapi/v1/commissionRate":         20,
+	"GET /fapi/v1/accountConfig":          5,
+	"GET /fapi/v1/symbolConfig":           5,
+	"GET /fapi/v1/multiAssetsMargin":      30,
+	"GET /fapi/v1/positionSide/dual":      30,
+	"GET /fapi/v2/account":                5,
+	"GET /fapi/v3/account":                5,
+	"GET /fapi/v2/balance":                5,
+	"GET /fapi/v3/balance":                5,
+	"GET /fapi/v2/positionRisk":           5,
+	"GET /fapi/v3/positionRisk":           5,
+	"POST /fapi/v1/batchOrders":           5,
+	"PUT /fapi/v1/batchOrders":            5,
+	"GET /fapi/v1/positionMargin/history": 1,
+}
+
+// klinesEndpoints are the endpoints weighted by their limit parameter like klines
+var klinesEndpoints = map[string]bool{
+	"GET /fapi/v1/klines":             true,
+	"GET /fapi/v1/continuousKlines":   true,
+	"GET /fapi/v1/indexPriceKlines":   true,
+	"GET /fapi/v1/markPriceKlines":    true,
+	"GET /fapi/v1/premiumIndexKlines": true,
+}
+
+// requestWeight returns request weight of r, weights depending on parameters are computed as documented
+func requestWeight(r *request) int64 {
+	key := r.method + " " + r.endpoint
+	hasSymbol := requestParam(r, "symbol") != ""
+	limit, _ := strconv.Atoi(r.query.Get("limit"))
+	switch {
+	case key == "GET /fapi/v1/depth":
+		switch {
+		case limit > 500:
+			return 20
+		case limit > 100, limit == 0:
+			// the default limit is 500
+			return 10
+		case limit > 50:
+			return 5
+		}
+		return 2
+	case klinesEndpoints[key]:
+		switch {
+		case limit == 0:
+			// the default limit is 500
+			return 5
+		case limit < 100:
+			return 1
+		case limit < 500:
+			return 2
+		case limit <= 1000:
+			return 5
+		}
+		return 10
+	case key == "GET /fapi/v1/openOrders", key == "GET /fapi/v1/ticker/24hr":
+		if hasSymbol {
+			return 1
+		}
+		return 40
+	case key == "GET /fapi/v1/ticker/price", key == "GET /fapi/v2/ticker/price":
+		if hasSymbol {
+			return 1
+		}
+		return 2
+	case key == "GET /fapi/v1/ticker/bookTicker":
+		if hasSymbol {
+			return 2
+		}
+		return 5
+	case key == "GET /fapi/v1/forceOrders":
+		if hasSymbol {
+			return 20
+		}
+		return 50
+	}
+	if weight, ok := endpointWeights[key]; ok {
+		return weight
+	}
+	return 1
+}
+
+// requestOrders returns the number of orders r counts for the ORDERS limits
+func requestOrders(r *request) int64 {
+	switch r.method + " " + r.endpoint {
+	case "POST /fapi/v1/order", "PUT /fapi/v1/order":
+		return 1
+	case "POST /fapi/v1/batchOrders", "PUT /fapi/v1/batchOrders":
+		if n := strings.Count(requestParam(r, "batchOrders"), "{"); n > 0 {
+			return int64(n)
+		}
+		return 1
+	}
+	return 0
+}
+
+// SyncRateLimits load the futures limits of RateLimiter from exchange info
+func (c *Client) SyncRateLimits(ctx context.Context) error {
+	res, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	rules := make([]common.RateLimitRule, 0, len(res.RateLimits))
+	for _, limit := range res.RateLimits {
+		rule, err := common.NewRateLimitRule(limit.RateLimitType, limit.Interval, limit.IntervalNum, limit.Limit)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, rule)
+	}
+	c.RateLimiter.SetRules("fapi", rules)
+	return nil
+}
+
+// waitRateLimit reserves weight of r, the limits are loaded from exchange info before the first request
+func (c *Client) waitRateLimit(ctx context.Context, r *request) error {
+	if c.RateLimiter == nil {
+		return nil
+	}
+	api := common.RateLimitAPI(r.endpoint)
+	if api != "fapi" {
+		return nil
+	}
+	if !c.RateLimiter.HasRules(api) && r.endpoint != "/fapi/v1/exchangeInfo" {
+		if err := c.SyncRateLimits(ctx); err != nil {
+			c.debug("failed to sync rate limits: %s\n", err)
+			c.RateLimiter.SetRules(api, DefaultRateLimits)
+		}
+	}
+	return c.RateLimiter.Wait(ctx, api, requestWeight(r), requestOrders(r))
+}
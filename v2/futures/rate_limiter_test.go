@@ -0,0 +1,46 @@
+package futures
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type rateLimiterTestSuite struct {
+	baseTestSuite
+}
+
+func TestRateLimiter(t *testing.T) {
+	suite.Run(t, new(rateLimiterTestSuite))
+}
+
+func (s *rateLimiterTestSuite) TestRequestWeight() {
+	for _, c := range []struct {
+		method   string
+		endpoint string
+		params   params
+		weight   int64
+		orders   int64
+	}{
+		{http.MethodGet, "/fapi/v1/depth", params{}, 10, 0},
+		{http.MethodGet, "/fapi/v1/depth", params{"limit": 20}, 2, 0},
+		{http.MethodGet, "/fapi/v1/depth", params{"limit": 1000}, 20, 0},
+		{http.MethodGet, "/fapi/v1/klines", params{"limit": 99}, 1, 0},
+		{http.MethodGet, "/fapi/v1/klines", params{"limit": 1500}, 10, 0},
+		{http.MethodGet, "/fapi/v1/openOrders", params{}, 40, 0},
+		{http.MethodGet, "/fapi/v1/openOrders", params{"symbol": "BTCUSDT"}, 1, 0},
+		{http.MethodGet, "/fapi/v2/account", params{}, 5, 0},
+		{http.MethodPost, "/fapi/v1/order", params{}, 1, 1},
+		{http.MethodPost, "/fapi/v1/batchOrders", params{"batchOrders": `[{"symbol":"A"},{"symbol":"B"}]`}, 5, 2},
+	} {
+		r := &request{method: c.method, endpoint: c.endpoint}
+		if c.method == http.MethodGet {
+			r.setParams(c.params)
+		} else {
+			r.setFormParams(c.params)
+		}
+		s.Equal(c.weight, requestWeight(r), c.endpoint)
+		s.Equal(c.orders, requestOrders(r), c.endpoint)
+	}
+}
@@ -74,6 +74,14 @@ func (r *request) validate() (err error) {
 	return nil
 }
 
+// requestParam returns param key of r from the query or the form
+func requestParam(r *request, key string) string {
+	if v := r.query.Get(key); v != "" {
+		return v
+	}
+	return r.form.Get(key)
+}
+
 // RequestOption define option type for request
 type RequestOption func(*request)
 
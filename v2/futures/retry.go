@@ -0,0 +1,109 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// callAPIWithRetry sends r and retries it according to RetryPolicy. Orders are retried only if they carry
+// a client order id, the order is looked up before each retry so that it can't be placed twice. If the order
+// has been placed, the response is built from the order status, see createOrderResponse.
+func (c *Client) callAPIWithRetry(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	var clientOrderID string
+	if r.method == http.MethodPost && r.endpoint == "/fapi/v1/order" {
+		clientOrderID = requestParam(r, "newClientOrderId")
+	}
+	retryable := r.method == http.MethodGet || clientOrderID != ""
+	reqHeader := r.header.Clone()
+	for attempt := 0; ; attempt++ {
+		r.header = reqHeader.Clone()
+		var statusCode int
+		data, statusCode, header, err = c.sendRequest(ctx, r, opts...)
+		if err == nil || !retryable || attempt >= c.RetryPolicy.MaxRetries || ctx.Err() != nil ||
+			!common.IsRetryable(statusCode, err) {
+			return data, header, err
+		}
+		c.debug("retry request after error: %s\n", err)
+		if c.RetryPolicy.Wait(ctx, attempt, *header) != nil {
+			return data, header, err
+		}
+		if clientOrderID != "" {
+			orderData, orderHeader, found, qerr := c.queryOrder(ctx, requestParam(r, "symbol"), clientOrderID)
+			if qerr != nil {
+				// the order may have been placed, it is not sent again
+				return nil, header, err
+			}
+			if found {
+				orderData, qerr = createOrderResponse(orderData)
+				if qerr != nil {
+					return nil, header, err
+				}
+				return orderData, orderHeader, nil
+			}
+		}
+	}
+}
+
+// queryOrder looks up order by client order id, found is false if the order doesn't exist
+func (c *Client) queryOrder(ctx context.Context, symbol string, clientOrderID string) (data []byte, header *http.Header, found bool, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/order",
+		secType:  secTypeSigned,
+	}
+	r.setParams(params{
+		"symbol":            symbol,
+		"origClientOrderId": clientOrderID,
+	})
+	data, _, header, err = c.sendRequest(ctx, r)
+	if err != nil {
+		var apiErr *common.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == common.ErrorCodeNoSuchOrder {
+			return nil, header, false, nil
+		}
+		return nil, header, false, err
+	}
+	return data, header, true, nil
+}
+
+// createOrderResponse builds the response of a placed order from its status, the cumulative quantity is the
+// executed quantity
+func createOrderResponse(data []byte) ([]byte, error) {
+	o := new(Order)
+	if err := json.Unmarshal(data, o); err != nil {
+		return nil, err
+	}
+	res := &CreateOrderResponse{
+		Symbol:                  o.Symbol,
+		OrderID:                 o.OrderID,
+		ClientOrderID:           o.ClientOrderID,
+		Price:                   o.Price,
+		OrigQuantity:            o.OrigQuantity,
+		ExecutedQuantity:        o.ExecutedQuantity,
+		CumQuote:                o.CumQuote,
+		ReduceOnly:              o.ReduceOnly,
+		Status:                  o.Status,
+		StopPrice:               o.StopPrice,
+		TimeInForce:             o.TimeInForce,
+		Type:                    o.Type,
+		Side:                    o.Side,
+		UpdateTime:              o.UpdateTime,
+		WorkingType:             o.WorkingType,
+		ActivatePrice:           o.ActivatePrice,
+		PriceRate:               o.PriceRate,
+		AvgPrice:                o.AvgPrice,
+		PositionSide:            o.PositionSide,
+		ClosePosition:           o.ClosePosition,
+		PriceProtect:            o.PriceProtect,
+		PriceMatch:              o.PriceMatch,
+		SelfTradePreventionMode: o.SelfTradePreventionMode,
+		GoodTillDate:            o.GoodTillDate,
+		CumQty:                  o.ExecutedQuantity,
+		OrigType:                o.OrigType,
+	}
+	return json.Marshal(res)
+}
@@ -0,0 +1,62 @@
+package futures
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type retryTestSuite struct {
+	baseTestSuite
+	requests  []string
+	responses []*http.Response
+}
+
+func TestRetry(t *testing.T) {
+	suite.Run(t, new(retryTestSuite))
+}
+
+func (s *retryTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.requests = nil
+	s.client.RetryPolicy = &common.RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		s.requests = append(s.requests, req.Method+" "+req.URL.Path)
+		s.r().NotEmpty(s.responses, "unexpected request")
+		res := s.responses[0]
+		s.responses = s.responses[1:]
+		return res, nil
+	}
+}
+
+func (s *retryTestSuite) TestRetryGet() {
+	s.responses = []*http.Response{
+		newHTTPResponse([]byte(`{"code":-1001,"msg":"Internal error; unable to process your request. Please try again."}`), http.StatusBadRequest),
+		newHTTPResponse([]byte(`{"serverTime":1499827319559}`), http.StatusOK),
+	}
+	serverTime, err := s.client.NewServerTimeService().Do(context.Background())
+	s.r().NoError(err)
+	s.Equal(int64(1499827319559), serverTime)
+	s.Len(s.requests, 2)
+}
+
+func (s *retryTestSuite) TestRetryOrderPlaced() {
+	s.responses = []*http.Response{
+		newHTTPResponse([]byte(`{"code":-1007,"msg":"Timeout waiting for response from backend server. Send status unknown; execution status unknown."}`), http.StatusServiceUnavailable),
+		newHTTPResponse([]byte(`{"symbol":"BTCUSDT","orderId":1,"clientOrderId":"myOrder1","status":"FILLED","executedQty":"1",
+			"cumQuote":"30000","avgPrice":"30000","updateTime":1499827319559}`), http.StatusOK),
+	}
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeMarket).
+		Quantity("1").NewClientOrderID("myOrder1").Do(context.Background())
+	s.r().NoError(err)
+	s.Equal(int64(1), res.OrderID)
+	s.Equal(OrderStatusTypeFilled, res.Status)
+	s.Equal("1", res.CumQty)
+	s.Equal("30000", res.AvgPrice)
+	s.Equal(int64(1499827319559), res.UpdateTime)
+	s.Equal([]string{"POST /fapi/v1/order", "GET /fapi/v1/order"}, s.requests)
+}
@@ -0,0 +1,269 @@
+// Code generated by streamgen; DO NOT EDIT.
+
+package futures
+
+import (
+	"context"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// WsAggTradeStream is WsAggTradeServe stopped when ctx is done, see ServeStream
+func WsAggTradeStream(ctx context.Context, symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAggTradeServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllAssetIndexStream is WsAllAssetIndexServe stopped when ctx is done, see ServeStream
+func WsAllAssetIndexStream(ctx context.Context, handler WsAllAssetIndexHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllAssetIndexServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllBookTickerStream is WsAllBookTickerServe stopped when ctx is done, see ServeStream
+func WsAllBookTickerStream(ctx context.Context, handler WsBookTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllBookTickerServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllLiquidationOrderStream is WsAllLiquidationOrderServe stopped when ctx is done, see ServeStream
+func WsAllLiquidationOrderStream(ctx context.Context, handler WsLiquidationOrderHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllLiquidationOrderServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllMarkPriceStream is WsAllMarkPriceServe stopped when ctx is done, see ServeStream
+func WsAllMarkPriceStream(ctx context.Context, handler WsAllMarkPriceHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllMarkPriceServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllMarkPriceStreamWithRate is WsAllMarkPriceServeWithRate stopped when ctx is done, see ServeStream
+func WsAllMarkPriceStreamWithRate(ctx context.Context, rate time.Duration, handler WsAllMarkPriceHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllMarkPriceServeWithRate(rate, handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllMarketTickerStream is WsAllMarketTickerServe stopped when ctx is done, see ServeStream
+func WsAllMarketTickerStream(ctx context.Context, handler WsAllMarketTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllMarketTickerServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllMiniMarketTickerStream is WsAllMiniMarketTickerServe stopped when ctx is done, see ServeStream
+func WsAllMiniMarketTickerStream(ctx context.Context, handler WsAllMiniMarketTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllMiniMarketTickerServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAssetIndexStream is WsAssetIndexServe stopped when ctx is done, see ServeStream
+func WsAssetIndexStream(ctx context.Context, symbol string, handler WsAssetIndexHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAssetIndexServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsBLVTInfoStream is WsBLVTInfoServe stopped when ctx is done, see ServeStream
+func WsBLVTInfoStream(ctx context.Context, name string, handler WsBLVTInfoHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsBLVTInfoServe(name, handler, errHandler)
+	}, errHandler)
+}
+
+// WsBLVTKlineStream is WsBLVTKlineServe stopped when ctx is done, see ServeStream
+func WsBLVTKlineStream(ctx context.Context, name string, interval string, handler WsBLVTKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsBLVTKlineServe(name, interval, handler, errHandler)
+	}, errHandler)
+}
+
+// WsBookTickerStream is WsBookTickerServe stopped when ctx is done, see ServeStream
+func WsBookTickerStream(ctx context.Context, symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsBookTickerServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedAggTradeStream is WsCombinedAggTradeServe stopped when ctx is done, see ServeStream
+func WsCombinedAggTradeStream(ctx context.Context, symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedAggTradeServe(symbols, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedBookTickerStream is WsCombinedBookTickerServe stopped when ctx is done, see ServeStream
+func WsCombinedBookTickerStream(ctx context.Context, symbols []string, handler WsBookTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedBookTickerServe(symbols, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedContinuousKlineStream is WsCombinedContinuousKlineServe stopped when ctx is done, see ServeStream
+func WsCombinedContinuousKlineStream(ctx context.Context, subscribeArgsList []*WsContinuousKlineSubscribeArgs, handler WsContinuousKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedContinuousKlineServe(subscribeArgsList, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedDepthStream is WsCombinedDepthServe stopped when ctx is done, see ServeStream
+func WsCombinedDepthStream(ctx context.Context, symbolLevels map[string]string, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedDepthServe(symbolLevels, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedDiffDepthStream is WsCombinedDiffDepthServe stopped when ctx is done, see ServeStream
+func WsCombinedDiffDepthStream(ctx context.Context, symbols []string, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedDiffDepthServe(symbols, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedKlineStream is WsCombinedKlineServe stopped when ctx is done, see ServeStream
+func WsCombinedKlineStream(ctx context.Context, symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedKlineServe(symbolIntervalPair, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedKlineStreamMultiInterval is WsCombinedKlineServeMultiInterval stopped when ctx is done, see ServeStream
+func WsCombinedKlineStreamMultiInterval(ctx context.Context, symbolIntervals map[string][]string, handler WsKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedKlineServeMultiInterval(symbolIntervals, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedMarkPriceStream is WsCombinedMarkPriceServe stopped when ctx is done, see ServeStream
+func WsCombinedMarkPriceStream(ctx context.Context, symbols []string, handler WsMarkPriceHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedMarkPriceServe(symbols, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedMarkPriceStreamWithRate is WsCombinedMarkPriceServeWithRate stopped when ctx is done, see ServeStream
+func WsCombinedMarkPriceStreamWithRate(ctx context.Context, symbolLevels map[string]time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedMarkPriceServeWithRate(symbolLevels, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCompositiveIndexStream is WsCompositiveIndexServe stopped when ctx is done, see ServeStream
+func WsCompositiveIndexStream(ctx context.Context, symbol string, handler WsCompositeIndexHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCompositiveIndexServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsContinuousKlineStream is WsContinuousKlineServe stopped when ctx is done, see ServeStream
+func WsContinuousKlineStream(ctx context.Context, subscribeArgs *WsContinuousKlineSubscribeArgs, handler WsContinuousKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsContinuousKlineServe(subscribeArgs, handler, errHandler)
+	}, errHandler)
+}
+
+// WsContractInfoStream is WsContractInfoServe stopped when ctx is done, see ServeStream
+func WsContractInfoStream(ctx context.Context, handler WsContractInfoHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsContractInfoServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsDiffDepthStream is WsDiffDepthServe stopped when ctx is done, see ServeStream
+func WsDiffDepthStream(ctx context.Context, symbol string, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsDiffDepthServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsDiffDepthStreamWithRate is WsDiffDepthServeWithRate stopped when ctx is done, see ServeStream
+func WsDiffDepthStreamWithRate(ctx context.Context, symbol string, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsDiffDepthServeWithRate(symbol, rate, handler, errHandler)
+	}, errHandler)
+}
+
+// WsKlineStream is WsKlineServe stopped when ctx is done, see ServeStream
+func WsKlineStream(ctx context.Context, symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsKlineServe(symbol, interval, handler, errHandler)
+	}, errHandler)
+}
+
+// WsLiquidationOrderStream is WsLiquidationOrderServe stopped when ctx is done, see ServeStream
+func WsLiquidationOrderStream(ctx context.Context, symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsLiquidationOrderServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsMarkPriceStream is WsMarkPriceServe stopped when ctx is done, see ServeStream
+func WsMarkPriceStream(ctx context.Context, symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsMarkPriceServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsMarkPriceStreamWithRate is WsMarkPriceServeWithRate stopped when ctx is done, see ServeStream
+func WsMarkPriceStreamWithRate(ctx context.Context, symbol string, rate time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsMarkPriceServeWithRate(symbol, rate, handler, errHandler)
+	}, errHandler)
+}
+
+// WsMarketTickerStream is WsMarketTickerServe stopped when ctx is done, see ServeStream
+func WsMarketTickerStream(ctx context.Context, symbol string, handler WsMarketTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsMarketTickerServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsMiniMarketTickerStream is WsMiniMarketTickerServe stopped when ctx is done, see ServeStream
+func WsMiniMarketTickerStream(ctx context.Context, symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsMiniMarketTickerServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsPartialDepthStream is WsPartialDepthServe stopped when ctx is done, see ServeStream
+func WsPartialDepthStream(ctx context.Context, symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsPartialDepthServe(symbol, levels, handler, errHandler)
+	}, errHandler)
+}
+
+// WsPartialDepthStreamWithRate is WsPartialDepthServeWithRate stopped when ctx is done, see ServeStream
+func WsPartialDepthStreamWithRate(ctx context.Context, symbol string, levels int, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsPartialDepthServeWithRate(symbol, levels, rate, handler, errHandler)
+	}, errHandler)
+}
+
+// WsUserDataStream is WsUserDataServe stopped when ctx is done, see ServeStream
+func WsUserDataStream(ctx context.Context, listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, handler, errHandler)
+	}, errHandler)
+}
+
+// WsUserDataStreamMultiple is WsUserDataServeMultiple stopped when ctx is done, see ServeStream
+func WsUserDataStreamMultiple(ctx context.Context, configs []WsPrivateStreamConfig, handler WsUserDataHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServeMultiple(configs, handler, errHandler)
+	}, errHandler)
+}
+
+// WsUserDataStreamWithEvents is WsUserDataServeWithEvents stopped when ctx is done, see ServeStream
+func WsUserDataStreamWithEvents(ctx context.Context, listenKey string, events []string, handler WsUserDataHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServeWithEvents(listenKey, events, handler, errHandler)
+	}, errHandler)
+}
@@ -12,6 +12,8 @@ type SymbolConfigService struct {
 }
 
 // SymbolConfig define futures symbol configuration
+//
+//decimalgen:accessors
 type SymbolConfig struct {
 	Symbol           string `json:"symbol"`
 	MarginType       string `json:"marginType"`
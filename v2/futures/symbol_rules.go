@@ -0,0 +1,73 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/shopspring/decimal"
+)
+
+// NewSymbolRulesRegistry init symbol rules registry loading the filters from exchange info, set it as SymbolRules
+// of the client to check orders before they're sent
+func (c *Client) NewSymbolRulesRegistry() *common.SymbolRulesRegistry {
+	return common.NewSymbolRulesRegistry(func(ctx context.Context) ([]*common.SymbolRules, error) {
+		res, err := c.NewExchangeInfoService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rules := make([]*common.SymbolRules, 0, len(res.Symbols))
+		for i := range res.Symbols {
+			rules = append(rules, res.Symbols[i].Rules())
+		}
+		return rules, nil
+	})
+}
+
+// Rules return the filters of symbol orders are checked against
+func (s *Symbol) Rules() *common.SymbolRules {
+	rules := &common.SymbolRules{
+		Symbol: s.Symbol,
+		Status: s.Status,
+	}
+	if f := s.PriceFilter(); f != nil {
+		rules.MinPrice = toDecimal(f.MinPrice)
+		rules.MaxPrice = toDecimal(f.MaxPrice)
+		rules.TickSize = toDecimal(f.TickSize)
+	}
+	if f := s.LotSizeFilter(); f != nil {
+		rules.MinQty = toDecimal(f.MinQuantity)
+		rules.MaxQty = toDecimal(f.MaxQuantity)
+		rules.StepSize = toDecimal(f.StepSize)
+	}
+	if f := s.MarketLotSizeFilter(); f != nil {
+		rules.MarketMinQty = toDecimal(f.MinQuantity)
+		rules.MarketMaxQty = toDecimal(f.MaxQuantity)
+		rules.MarketStepSize = toDecimal(f.StepSize)
+	}
+	if f := s.MinNotionalFilter(); f != nil {
+		rules.MinNotional = toDecimal(f.Notional)
+	}
+	return rules
+}
+
+// toDecimal convert a filter value to decimal, invalid values disable the rule
+func toDecimal(s string) decimal.Decimal {
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func setStringValue(s **string, v string) {
+	if *s != nil {
+		*s = &v
+	}
+}
@@ -0,0 +1,74 @@
+package futures
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type symbolRulesTestSuite struct {
+	baseTestSuite
+	orders []*http.Request
+}
+
+func TestSymbolRules(t *testing.T) {
+	suite.Run(t, new(symbolRulesTestSuite))
+}
+
+func (s *symbolRulesTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.orders = nil
+	s.client.SymbolRules = s.client.NewSymbolRulesRegistry()
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/fapi/v1/exchangeInfo" {
+			return newHTTPResponse([]byte(`{"symbols":[{"symbol":"BTCUSDT","status":"TRADING","filters":[
+				{"filterType":"PRICE_FILTER","minPrice":"556.80","maxPrice":"4529764","tickSize":"0.10"},
+				{"filterType":"LOT_SIZE","minQty":"0.001","maxQty":"1000","stepSize":"0.001"},
+				{"filterType":"MARKET_LOT_SIZE","minQty":"0.001","maxQty":"120","stepSize":"0.001"},
+				{"filterType":"MIN_NOTIONAL","notional":"100"}
+			]}]}`), http.StatusOK), nil
+		}
+		s.r().NoError(req.ParseForm())
+		s.orders = append(s.orders, req)
+		return newHTTPResponse([]byte(`{"symbol":"BTCUSDT","orderId":1}`), http.StatusOK), nil
+	}
+}
+
+func (s *symbolRulesTestSuite) TestCreateOrderValidate() {
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Price("60000").Quantity("0.001").Do(context.Background())
+	s.r().ErrorIs(err, common.ErrFilterFailure)
+	s.Empty(s.orders)
+
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeMarket).
+		Quantity("150").Do(context.Background())
+	s.r().ErrorIs(err, common.ErrFilterFailure)
+	s.Empty(s.orders)
+}
+
+func (s *symbolRulesTestSuite) TestCreateOrderRoundToFilters() {
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).Type("TRAILING_STOP_MARKET").
+		ActivationPrice("60000.55").CallbackRate("1").Quantity("0.01234").RoundToFilters(true).Do(context.Background())
+	s.r().NoError(err)
+	s.r().Len(s.orders, 1)
+	s.Equal("60000.5", s.orders[0].Form.Get("activationPrice"))
+	s.Equal("0.012", s.orders[0].Form.Get("quantity"))
+}
+
+func (s *symbolRulesTestSuite) TestCreateOrderValidateReduceOnly() {
+	// closing a small position is accepted below the minimum notional
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Price("60000").Quantity("0.001").ReduceOnly(true).Do(context.Background())
+	s.r().NoError(err)
+	s.r().Len(s.orders, 1)
+	s.Equal("true", s.orders[0].Form.Get("reduceOnly"))
+
+	// the other filters still apply
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Price("60000.05").Quantity("0.001").ReduceOnly(true).Do(context.Background())
+	s.r().ErrorIs(err, common.ErrFilterFailure)
+	s.r().Len(s.orders, 1)
+}
@@ -0,0 +1,91 @@
+package futures
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *bookTickerServiceWsTestSuite) SetupTest() {
+	s.requestID = "9d32157c-a556-4d27-9866-66760a174b57"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.bookTicker = &BookTickerWsService{
+		c: s.client,
+	}
+}
+
+func (s *bookTickerServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type bookTickerServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	bookTicker *BookTickerWsService
+}
+
+func TestBookTickerServiceWs(t *testing.T) {
+	suite.Run(t, new(bookTickerServiceWsTestSuite))
+}
+
+func (s *bookTickerServiceWsTestSuite) TestBookTicker() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.bookTicker.Do(s.requestID, NewBookTickerWsRequest().Symbol("BTCUSDT"))
+	s.NoError(err)
+}
+
+func (s *bookTickerServiceWsTestSuite) TestBookTicker_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.bookTicker.Do("", NewBookTickerWsRequest())
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *bookTickerServiceWsTestSuite) TestBookTickerSync() {
+	rawResponseData := []byte(`{"id":"9d32157c-a556-4d27-9866-66760a174b57","status":200,"result":{"lastUpdateId":1027024,"symbol":"BTCUSDT","bidPrice":"4.00000000","bidQty":"431.00000000","askPrice":"4.00000200","askQty":"9.00000000","time":1589437530011}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.bookTicker.SyncDo(s.requestID, NewBookTickerWsRequest().Symbol("BTCUSDT"))
+	s.Require().NoError(err)
+	s.Equal([]*BookTicker{{
+		Symbol:       "BTCUSDT",
+		BidPrice:     "4.00000000",
+		BidQuantity:  "431.00000000",
+		AskPrice:     "4.00000200",
+		AskQuantity:  "9.00000000",
+		Time:         1589437530011,
+		LastUpdateId: 1027024,
+	}}, response.Result)
+}
+
+func (s *bookTickerServiceWsTestSuite) TestBookTickerSync_Error() {
+	rawResponseData := []byte(`{"id":"9d32157c-a556-4d27-9866-66760a174b57","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.bookTicker.SyncDo(s.requestID, NewBookTickerWsRequest().Symbol("FOOBAR"))
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+}
+
+func (s *bookTickerServiceWsTestSuite) TestBookTickerSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.bookTicker.SyncDo(s.requestID, NewBookTickerWsRequest())
+	s.Nil(response)
+	s.Error(err)
+}
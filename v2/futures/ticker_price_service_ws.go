@@ -0,0 +1,148 @@
+package futures
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// TickerPriceWsService queries latest price for a symbol or symbols
+type TickerPriceWsService struct {
+	c websocket.Client
+}
+
+// NewTickerPriceWsService init TickerPriceWsService
+func NewTickerPriceWsService() (*TickerPriceWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TickerPriceWsService{
+		c: client,
+	}, nil
+}
+
+// NewTickerPriceWsServiceWithSession init TickerPriceWsService sending its requests on the connection of session
+func NewTickerPriceWsServiceWithSession(session *SessionWsService) *TickerPriceWsService {
+	return &TickerPriceWsService{
+		c: session.c,
+	}
+}
+
+// TickerPriceWsRequest parameters for 'ticker.price' websocket API
+type TickerPriceWsRequest struct {
+	symbol *string
+}
+
+// NewTickerPriceWsRequest init TickerPriceWsRequest
+func NewTickerPriceWsRequest() *TickerPriceWsRequest {
+	return &TickerPriceWsRequest{}
+}
+
+func (s *TickerPriceWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *TickerPriceWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	return m
+}
+
+// Do - sends 'ticker.price' request
+func (s *TickerPriceWsService) Do(requestID string, request *TickerPriceWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TickerPriceFuturesWsApiMethod, request.buildParams())
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'ticker.price' request and receives response
+func (s *TickerPriceWsService) SyncDo(requestID string, request *TickerPriceWsRequest) (*TickerPriceWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TickerPriceFuturesWsApiMethod, request.buildParams())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	tickerPriceWsResponse := &TickerPriceWsResponse{}
+	if err := json.Unmarshal(response, tickerPriceWsResponse); err != nil {
+		return nil, err
+	}
+	if tickerPriceWsResponse.Error != nil {
+		tickerPriceWsResponse.Error.StatusCode = tickerPriceWsResponse.Status
+		return nil, tickerPriceWsResponse.Error
+	}
+
+	return tickerPriceWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *TickerPriceWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *TickerPriceWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *TickerPriceWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *TickerPriceWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *TickerPriceWsRequest) Symbol(symbol string) *TickerPriceWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// TickerPriceWsResponse define 'ticker.price' websocket API response
+type TickerPriceWsResponse struct {
+	Id     string         `json:"id"`
+	Status int            `json:"status"`
+	Result []*SymbolPrice `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON accepts the result of a single symbol as well as a list
+func (r *TickerPriceWsResponse) UnmarshalJSON(data []byte) error {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if len(raw.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(common.ToJSONList(raw.Result), &r.Result)
+}
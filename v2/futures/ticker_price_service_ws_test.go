@@ -0,0 +1,83 @@
+package futures
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *tickerPriceServiceWsTestSuite) SetupTest() {
+	s.requestID = "9d32157c-a556-4d27-9866-66760a174b57"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.tickerPrice = &TickerPriceWsService{
+		c: s.client,
+	}
+}
+
+func (s *tickerPriceServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type tickerPriceServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	tickerPrice *TickerPriceWsService
+}
+
+func TestTickerPriceServiceWs(t *testing.T) {
+	suite.Run(t, new(tickerPriceServiceWsTestSuite))
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestTickerPrice() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.tickerPrice.Do(s.requestID, NewTickerPriceWsRequest().Symbol("BTCUSDT"))
+	s.NoError(err)
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestTickerPrice_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.tickerPrice.Do("", NewTickerPriceWsRequest())
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestTickerPriceSync_Symbol() {
+	rawResponseData := []byte(`{"id":"9d32157c-a556-4d27-9866-66760a174b57","status":200,"result":{"symbol":"BTCUSDT","price":"6000.01","time":1589437530011}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.tickerPrice.SyncDo(s.requestID, NewTickerPriceWsRequest().Symbol("BTCUSDT"))
+	s.Require().NoError(err)
+	s.Equal([]*SymbolPrice{{Symbol: "BTCUSDT", Price: "6000.01", Time: 1589437530011}}, response.Result)
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestTickerPriceSync_AllSymbols() {
+	rawResponseData := []byte(`{"id":"9d32157c-a556-4d27-9866-66760a174b57","status":200,"result":[{"symbol":"BTCUSDT","price":"6000.01","time":1589437530011},{"symbol":"ETHUSDT","price":"200.30","time":1589437530012}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.tickerPrice.SyncDo(s.requestID, NewTickerPriceWsRequest())
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 2)
+	s.Equal("ETHUSDT", response.Result[1].Symbol)
+	s.Equal("200.30", response.Result[1].Price)
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestTickerPriceSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.tickerPrice.SyncDo(s.requestID, NewTickerPriceWsRequest())
+	s.Nil(response)
+	s.Error(err)
+}
@@ -43,6 +43,8 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // BookTicker define book ticker info
+//
+//decimalgen:accessors
 type BookTicker struct {
 	Symbol       string `json:"symbol"`
 	BidPrice     string `json:"bidPrice"`
@@ -88,9 +90,12 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // SymbolPrice define symbol and price pair
+//
+//decimalgen:accessors
 type SymbolPrice struct {
 	Symbol string `json:"symbol"`
 	Price  string `json:"price"`
+	Time   int64  `json:"time"`
 }
 
 // ListPriceChangeStatsService show stats of price change in last 24 hours for all symbols
@@ -128,6 +133,8 @@ func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOpt
 }
 
 // PriceChangeStats define price change stats
+//
+//decimalgen:accessors
 type PriceChangeStats struct {
 	Symbol             string `json:"symbol"`
 	PriceChange        string `json:"priceChange"`
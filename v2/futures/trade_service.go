@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // HistoricalTradesService trades
@@ -60,6 +63,8 @@ func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // Trade define trade info
+//
+//decimalgen:accessors
 type Trade struct {
 	ID            int64  `json:"id"`
 	Price         string `json:"price"`
@@ -70,6 +75,8 @@ type Trade struct {
 }
 
 // TradeV3 define v3 trade info
+//
+//decimalgen:accessors
 type TradeV3 struct {
 	ID              int64  `json:"id"`
 	Symbol          string `json:"symbol"`
@@ -157,6 +164,8 @@ func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res [
 }
 
 // AggTrade define aggregate trade info
+//
+//decimalgen:accessors
 type AggTrade struct {
 	AggTradeID   int64  `json:"a"`
 	Price        string `json:"p"`
@@ -290,7 +299,21 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Iterator walks the trades between startTime and endTime in milliseconds, in windows of 7 days and pages of 1000
+// trades. FromID is ignored.
+func (s *ListAccountTradeService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*AccountTrade] {
+	s.fromID = nil
+	return common.NewCursorIterator(startTime, endTime, 7*24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*AccountTrade, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx, opts...)
+		},
+		func(t *AccountTrade) int64 { return t.Time },
+		func(t *AccountTrade) int64 { return t.ID })
+}
+
 // AccountTrade define account trade
+//
+//decimalgen:accessors
 type AccountTrade struct {
 	Buyer           bool             `json:"buyer"`
 	Commission      string           `json:"commission"`
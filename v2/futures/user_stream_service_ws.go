@@ -0,0 +1,264 @@
+package futures
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// StartUserStreamWsService creates listen key for user stream service
+type StartUserStreamWsService struct {
+	c      websocket.Client
+	ApiKey string
+}
+
+// NewStartUserStreamWsService init StartUserStreamWsService
+func NewStartUserStreamWsService(apiKey string) (*StartUserStreamWsService, error) {
+	client, err := newUserStreamWsClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &StartUserStreamWsService{
+		c:      client,
+		ApiKey: apiKey,
+	}, nil
+}
+
+// NewStartUserStreamWsServiceWithSession init StartUserStreamWsService sending its requests on the connection of session
+func NewStartUserStreamWsServiceWithSession(session *SessionWsService) *StartUserStreamWsService {
+	return &StartUserStreamWsService{
+		c:      session.c,
+		ApiKey: session.ApiKey,
+	}
+}
+
+// Do - sends 'userDataStream.start' request
+func (s *StartUserStreamWsService) Do(requestID string) error {
+	return writeUserStreamRequest(s.c, requestID, s.ApiKey, websocket.UserDataStreamStartFuturesWsApiMethod)
+}
+
+// SyncDo - sends 'userDataStream.start' request and receives response
+func (s *StartUserStreamWsService) SyncDo(requestID string) (*UserStreamWsResponse, error) {
+	res := &UserStreamWsResponse{}
+	if err := syncUserStreamRequest(s.c, requestID, s.ApiKey, websocket.UserDataStreamStartFuturesWsApiMethod, res); err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		res.Error.StatusCode = res.Status
+		return nil, res.Error
+	}
+
+	return res, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *StartUserStreamWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *StartUserStreamWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *StartUserStreamWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *StartUserStreamWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// KeepaliveUserStreamWsService extends the validity of the listen key of the account by 60 minutes
+type KeepaliveUserStreamWsService struct {
+	c      websocket.Client
+	ApiKey string
+}
+
+// NewKeepaliveUserStreamWsService init KeepaliveUserStreamWsService
+func NewKeepaliveUserStreamWsService(apiKey string) (*KeepaliveUserStreamWsService, error) {
+	client, err := newUserStreamWsClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeepaliveUserStreamWsService{
+		c:      client,
+		ApiKey: apiKey,
+	}, nil
+}
+
+// NewKeepaliveUserStreamWsServiceWithSession init KeepaliveUserStreamWsService sending its requests on the connection of session
+func NewKeepaliveUserStreamWsServiceWithSession(session *SessionWsService) *KeepaliveUserStreamWsService {
+	return &KeepaliveUserStreamWsService{
+		c:      session.c,
+		ApiKey: session.ApiKey,
+	}
+}
+
+// Do - sends 'userDataStream.ping' request
+func (s *KeepaliveUserStreamWsService) Do(requestID string) error {
+	return writeUserStreamRequest(s.c, requestID, s.ApiKey, websocket.UserDataStreamPingFuturesWsApiMethod)
+}
+
+// SyncDo - sends 'userDataStream.ping' request and receives response
+func (s *KeepaliveUserStreamWsService) SyncDo(requestID string) (*UserStreamWsResponse, error) {
+	res := &UserStreamWsResponse{}
+	if err := syncUserStreamRequest(s.c, requestID, s.ApiKey, websocket.UserDataStreamPingFuturesWsApiMethod, res); err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		res.Error.StatusCode = res.Status
+		return nil, res.Error
+	}
+
+	return res, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *KeepaliveUserStreamWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *KeepaliveUserStreamWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *KeepaliveUserStreamWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *KeepaliveUserStreamWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// CloseUserStreamWsService closes the user data stream of the account
+type CloseUserStreamWsService struct {
+	c      websocket.Client
+	ApiKey string
+}
+
+// NewCloseUserStreamWsService init CloseUserStreamWsService
+func NewCloseUserStreamWsService(apiKey string) (*CloseUserStreamWsService, error) {
+	client, err := newUserStreamWsClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CloseUserStreamWsService{
+		c:      client,
+		ApiKey: apiKey,
+	}, nil
+}
+
+// NewCloseUserStreamWsServiceWithSession init CloseUserStreamWsService sending its requests on the connection of session
+func NewCloseUserStreamWsServiceWithSession(session *SessionWsService) *CloseUserStreamWsService {
+	return &CloseUserStreamWsService{
+		c:      session.c,
+		ApiKey: session.ApiKey,
+	}
+}
+
+// Do - sends 'userDataStream.stop' request
+func (s *CloseUserStreamWsService) Do(requestID string) error {
+	return writeUserStreamRequest(s.c, requestID, s.ApiKey, websocket.UserDataStreamStopFuturesWsApiMethod)
+}
+
+// SyncDo - sends 'userDataStream.stop' request and receives response
+func (s *CloseUserStreamWsService) SyncDo(requestID string) (*CloseUserStreamWsResponse, error) {
+	res := &CloseUserStreamWsResponse{}
+	if err := syncUserStreamRequest(s.c, requestID, s.ApiKey, websocket.UserDataStreamStopFuturesWsApiMethod, res); err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		res.Error.StatusCode = res.Status
+		return nil, res.Error
+	}
+
+	return res, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *CloseUserStreamWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *CloseUserStreamWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *CloseUserStreamWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *CloseUserStreamWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// UserStreamWsResponse define 'userDataStream.start' and 'userDataStream.ping' websocket API response
+type UserStreamWsResponse struct {
+	Id     string           `json:"id"`
+	Status int              `json:"status"`
+	Result UserStreamResult `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UserStreamResult define listen key of the user data stream
+type UserStreamResult struct {
+	ListenKey string `json:"listenKey"`
+}
+
+// CloseUserStreamWsResponse define 'userDataStream.stop' websocket API response
+type CloseUserStreamWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result struct{} `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+func newUserStreamWsClient() (websocket.Client, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	return websocket.NewClient(conn)
+}
+
+func writeUserStreamRequest(c websocket.Client, requestID, apiKey string, method websocket.WsApiMethodType) error {
+	rawData, err := websocket.CreateApiKeyRequest(requestID, apiKey, method, map[string]any{})
+	if err != nil {
+		return err
+	}
+
+	return c.Write(requestID, rawData)
+}
+
+func syncUserStreamRequest(c websocket.Client, requestID, apiKey string, method websocket.WsApiMethodType, res any) error {
+	rawData, err := websocket.CreateApiKeyRequest(requestID, apiKey, method, map[string]any{})
+	if err != nil {
+		return err
+	}
+
+	response, err := c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(response, res)
+}
@@ -0,0 +1,125 @@
+package futures
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *userStreamServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.requestID = "d3df8a61-98ea-4fe0-8f4e-0fcea5d418b0"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+}
+
+func (s *userStreamServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type userStreamServiceWsTestSuite struct {
+	suite.Suite
+	apiKey string
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+}
+
+func TestUserStreamServiceWs(t *testing.T) {
+	suite.Run(t, new(userStreamServiceWsTestSuite))
+}
+
+func (s *userStreamServiceWsTestSuite) assertRequest(method websocket.WsApiMethodType) func(requestID string, data []byte) error {
+	return func(requestID string, data []byte) error {
+		req := websocket.WsApiRequest{}
+		s.Require().NoError(json.Unmarshal(data, &req))
+		s.Equal(method, req.Method)
+		s.Equal(map[string]any{"apiKey": s.apiKey}, req.Params)
+		return nil
+	}
+}
+
+func (s *userStreamServiceWsTestSuite) TestStartUserStream() {
+	service := &StartUserStreamWsService{c: s.client, ApiKey: s.apiKey}
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).
+		DoAndReturn(s.assertRequest(websocket.UserDataStreamStartFuturesWsApiMethod)).Times(1)
+
+	err := service.Do(s.requestID)
+	s.NoError(err)
+}
+
+func (s *userStreamServiceWsTestSuite) TestStartUserStream_EmptyApiKey() {
+	service := &StartUserStreamWsService{c: s.client}
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := service.Do(s.requestID)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *userStreamServiceWsTestSuite) TestStartUserStreamSync() {
+	service := &StartUserStreamWsService{c: s.client, ApiKey: s.apiKey}
+	rawResponseData := []byte(`{"id":"d3df8a61-98ea-4fe0-8f4e-0fcea5d418b0","status":200,"result":{"listenKey":"xs0mRXdAKlIPDRFrlPcw0qI41Eh3ixNntmymGyhrhgqo7L6FuLaWArTD7RLP"}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := service.SyncDo(s.requestID)
+	s.Require().NoError(err)
+	s.Equal("xs0mRXdAKlIPDRFrlPcw0qI41Eh3ixNntmymGyhrhgqo7L6FuLaWArTD7RLP", response.Result.ListenKey)
+}
+
+func (s *userStreamServiceWsTestSuite) TestKeepaliveUserStream() {
+	service := &KeepaliveUserStreamWsService{c: s.client, ApiKey: s.apiKey}
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).
+		DoAndReturn(s.assertRequest(websocket.UserDataStreamPingFuturesWsApiMethod)).Times(1)
+
+	err := service.Do(s.requestID)
+	s.NoError(err)
+}
+
+func (s *userStreamServiceWsTestSuite) TestKeepaliveUserStreamSync_Error() {
+	service := &KeepaliveUserStreamWsService{c: s.client, ApiKey: s.apiKey}
+	rawResponseData := []byte(`{"id":"d3df8a61-98ea-4fe0-8f4e-0fcea5d418b0","status":400,"error":{"code":-1125,"msg":"This listenKey does not exist."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := service.SyncDo(s.requestID)
+	s.Nil(response)
+	s.Require().Error(err)
+	s.Equal(400, err.(*common.APIError).StatusCode)
+	s.Equal(int64(-1125), err.(*common.APIError).Code)
+}
+
+func (s *userStreamServiceWsTestSuite) TestCloseUserStream() {
+	service := &CloseUserStreamWsService{c: s.client, ApiKey: s.apiKey}
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).
+		DoAndReturn(s.assertRequest(websocket.UserDataStreamStopFuturesWsApiMethod)).Times(1)
+
+	err := service.Do(s.requestID)
+	s.NoError(err)
+}
+
+func (s *userStreamServiceWsTestSuite) TestCloseUserStreamSync() {
+	service := &CloseUserStreamWsService{c: s.client, ApiKey: s.apiKey}
+	rawResponseData := []byte(`{"id":"d3df8a61-98ea-4fe0-8f4e-0fcea5d418b0","status":200,"result":{}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := service.SyncDo(s.requestID)
+	s.Require().NoError(err)
+	s.Equal(200, response.Status)
+}
+
+func (s *userStreamServiceWsTestSuite) TestCloseUserStreamSync_WriteError() {
+	service := &CloseUserStreamWsService{c: s.client, ApiKey: s.apiKey}
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := service.SyncDo(s.requestID)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -1,11 +1,16 @@
 package futures
 
 import (
+	"context"
 	"net/http"
 	"net/url"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/gorilla/websocket"
+	"github.com/jpillora/backoff"
 )
 
 // WsHandler handle raw websocket message
@@ -14,79 +19,175 @@ type WsHandler func(message []byte)
 // ErrHandler handles errors
 type ErrHandler func(err error)
 
+// WsReconnectHandler is notified after a dropped stream has been redialed
+type WsReconnectHandler func(endpoint string, attempts int)
+
 // WsConfig webservice configuration
 type WsConfig struct {
 	Endpoint string
 	Proxy    *string
+	// Reconnect redials Endpoint with backoff when the connection drops instead of closing doneC
+	Reconnect bool
+	// ReconnectHandler is called every time Reconnect restores the connection
+	ReconnectHandler WsReconnectHandler
 }
 
 func newWsConfig(endpoint string) *WsConfig {
 	return &WsConfig{
-		Endpoint: endpoint,
-		Proxy:    getWsProxyUrl(),
+		Endpoint:         endpoint,
+		Proxy:            getWsProxyUrl(),
+		Reconnect:        WebsocketAutoReconnect,
+		ReconnectHandler: WebsocketReconnectHandler,
 	}
 }
 
-var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	proxy := http.ProxyFromEnvironment
-	if cfg.Proxy != nil {
-		u, err := url.Parse(*cfg.Proxy)
-		if err != nil {
-			return nil, nil, err
-		}
-		proxy = http.ProxyURL(u)
-	}
-	Dialer := websocket.Dialer{
-		Proxy:             proxy,
-		HandshakeTimeout:  45 * time.Second,
-		EnableCompression: true,
-	}
+// ServeStream starts the stream of serve and returns its handle, the stream is stopped when ctx is done, e.g.
+//
+//	stream, err := ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+//		return WsMarkPriceServe("BTCUSDT", wsMarkPriceHandler, errHandler)
+//	}, nil)
+//
+// errHandler receives the errors of the stream if it isn't nil, the error which ended the stream is returned by Err.
+func ServeStream(ctx context.Context, serve func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error), errHandler ErrHandler) (*common.Stream, error) {
+	return common.ServeStream(ctx, func(errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return serve(errHandler)
+	}, errHandler)
+}
 
-	c, _, err := Dialer.Dial(cfg.Endpoint, nil)
+var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	c, err := wsDial(cfg)
 	if err != nil {
 		return nil, nil, err
 	}
-	c.SetReadLimit(655350)
 	doneC = make(chan struct{})
 	stopC = make(chan struct{})
 	go func() {
 		// This function will exit either on error from
-		// websocket.Conn.ReadMessage or when the stopC channel is
-		// closed by the client.
+		// websocket.Conn.ReadMessage (unless cfg.Reconnect is set)
+		// or when the stopC channel is closed by the client.
 		defer close(doneC)
-		if WebsocketKeepalive {
-			keepAlive(c, WebsocketTimeout)
-		}
 		// Wait for the stopC channel to be closed.  We do that in a
 		// separate goroutine because ReadMessage is a blocking
 		// operation.
+		var mu sync.Mutex
 		silent := false
+		quitC := make(chan struct{})
 		go func() {
 			select {
 			case <-stopC:
+				mu.Lock()
 				silent = true
+				mu.Unlock()
+				close(quitC)
 			case <-doneC:
 			}
+			mu.Lock()
 			c.Close()
+			mu.Unlock()
 		}()
+
+		b := &backoff.Backoff{
+			Min:    WebsocketReconnectMinInterval,
+			Max:    WebsocketReconnectMaxInterval,
+			Factor: 1.8,
+		}
 		for {
-			_, message, err := c.ReadMessage()
-			if err != nil {
-				if !silent {
-					errHandler(err)
+			mu.Lock()
+			conn := c
+			mu.Unlock()
+
+			err := wsReadLoop(conn, handler)
+			mu.Lock()
+			stopped := silent
+			mu.Unlock()
+			if stopped {
+				return
+			}
+			errHandler(err)
+			// the failed connection is replaced or ends the stream, either way it is done
+			conn.Close()
+			if !cfg.Reconnect {
+				return
+			}
+
+			attempts := 0
+			for {
+				select {
+				case <-quitC:
+					return
+				case <-time.After(b.Duration()):
 				}
+				attempts++
+				conn, err = wsDial(cfg)
+				if err == nil {
+					break
+				}
+				errHandler(err)
+			}
+			b.Reset()
+
+			mu.Lock()
+			if silent {
+				mu.Unlock()
+				conn.Close()
 				return
 			}
-			handler(message)
+			c = conn
+			mu.Unlock()
+
+			if cfg.ReconnectHandler != nil {
+				cfg.ReconnectHandler(cfg.Endpoint, attempts)
+			}
 		}
 	}()
 	return
 }
 
-func keepAlive(c *websocket.Conn, timeout time.Duration) {
+// wsDial opens a new stream connection for cfg
+func wsDial(cfg *WsConfig) (*websocket.Conn, error) {
+	proxy := http.ProxyFromEnvironment
+	if cfg.Proxy != nil {
+		u, err := url.Parse(*cfg.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		proxy = http.ProxyURL(u)
+	}
+	Dialer := websocket.Dialer{
+		Proxy:             proxy,
+		HandshakeTimeout:  45 * time.Second,
+		EnableCompression: true,
+	}
+
+	c, _, err := Dialer.Dial(cfg.Endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.SetReadLimit(655350)
+	return c, nil
+}
+
+// wsReadLoop passes every message read from c to handler until reading fails
+func wsReadLoop(c *websocket.Conn, handler WsHandler) error {
+	if WebsocketKeepalive {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		keepAlive(ctx, c, WebsocketTimeout)
+	}
+	for {
+		_, message, err := c.ReadMessage()
+		if err != nil {
+			return err
+		}
+		handler(message)
+	}
+}
+
+func keepAlive(ctx context.Context, c *websocket.Conn, timeout time.Duration) {
 	ticker := time.NewTicker(timeout)
 
-	lastResponse := time.Now()
+	var lastResponse int64
+	atomic.StoreInt64(&lastResponse, time.Now().Unix())
 
 	c.SetPingHandler(func(pingData string) error {
 		// Respond with Pong using the server's PING payload
@@ -99,7 +200,7 @@ func keepAlive(c *websocket.Conn, timeout time.Duration) {
 			return err
 		}
 
-		lastResponse = time.Now()
+		atomic.StoreInt64(&lastResponse, time.Now().Unix())
 
 		return nil
 	})
@@ -107,10 +208,14 @@ func keepAlive(c *websocket.Conn, timeout time.Duration) {
 	go func() {
 		defer ticker.Stop()
 		for {
-			<-ticker.C
-			if time.Since(lastResponse) > timeout {
-				c.Close()
+			select {
+			case <-ctx.Done():
 				return
+			case <-ticker.C:
+				if time.Since(time.Unix(atomic.LoadInt64(&lastResponse), 0)) > timeout {
+					c.Close()
+					return
+				}
 			}
 		}
 	}()
@@ -7,6 +7,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/bitly/go-simplejson"
 	"github.com/gorilla/websocket"
 )
 
@@ -59,8 +61,25 @@ var (
 	// using for websocket API (read/write)
 	WebsocketTimeoutReadWriteConnection = time.Second * 10
 	ProxyUrl                            = ""
+	// WebsocketAutoReconnect makes market data streams redial with backoff after a disconnect
+	// instead of closing doneC
+	WebsocketAutoReconnect = false
+	// WebsocketReconnectHandler is called after a stream has been redialed if WebsocketAutoReconnect is enabled
+	WebsocketReconnectHandler WsReconnectHandler
+	// WebsocketReconnectMinInterval is the initial delay before redialing a dropped stream
+	WebsocketReconnectMinInterval = 100 * time.Millisecond
+	// WebsocketReconnectMaxInterval caps the delay between redial attempts
+	WebsocketReconnectMaxInterval = 10 * time.Second
 )
 
+// optionalTimeSync returns the time sync passed to a constructor, nil if there is none
+func optionalTimeSync(timeSync []*common.TimeSync) *common.TimeSync {
+	if len(timeSync) > 0 {
+		return timeSync[0]
+	}
+	return nil
+}
+
 func getWsProxyUrl() *string {
 	if ProxyUrl == "" {
 		return nil
@@ -150,6 +169,8 @@ func getWsPrivateEndpoint() string {
 }
 
 // WsAggTradeEvent define websocket aggTrde event.
+//
+//decimalgen:accessors
 type WsAggTradeEvent struct {
 	Event            string `json:"e"`
 	Time             int64  `json:"E"`
@@ -218,6 +239,8 @@ func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHan
 }
 
 // WsMarkPriceEvent define websocket markPriceUpdate event.
+//
+//decimalgen:accessors
 type WsMarkPriceEvent struct {
 	Event                string `json:"e"`
 	Time                 int64  `json:"E"`
@@ -375,6 +398,8 @@ type WsKlineEvent struct {
 }
 
 // WsKline define websocket kline
+//
+//decimalgen:accessors
 type WsKline struct {
 	StartTime            int64  `json:"t"`
 	EndTime              int64  `json:"T"`
@@ -495,6 +520,8 @@ type WsContinuousKlineEvent struct {
 }
 
 // WsContinuousKline define websocket continuous kline
+//
+//decimalgen:accessors
 type WsContinuousKline struct {
 	StartTime            int64  `json:"t"`
 	EndTime              int64  `json:"T"`
@@ -575,6 +602,8 @@ func WsCombinedContinuousKlineServe(subscribeArgsList []*WsContinuousKlineSubscr
 }
 
 // WsMiniMarketTickerEvent define websocket mini market ticker event.
+//
+//decimalgen:accessors
 type WsMiniMarketTickerEvent struct {
 	Event       string `json:"e"`
 	Time        int64  `json:"E"`
@@ -629,6 +658,8 @@ func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler
 }
 
 // WsMarketTickerEvent define websocket market ticker event.
+//
+//decimalgen:accessors
 type WsMarketTickerEvent struct {
 	Event              string `json:"e"`
 	Time               int64  `json:"E"`
@@ -692,6 +723,8 @@ func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHand
 }
 
 // WsBookTickerEvent define websocket best book ticker event.
+//
+//decimalgen:accessors
 type WsBookTickerEvent struct {
 	Event           string `json:"e"`
 	UpdateID        int64  `json:"u"`
@@ -771,6 +804,8 @@ type WsLiquidationOrderEvent struct {
 }
 
 // WsLiquidationOrder define websocket liquidation order.
+//
+//decimalgen:accessors
 type WsLiquidationOrder struct {
 	Symbol               string          `json:"s"`
 	Side                 SideType        `json:"S"`
@@ -978,35 +1013,40 @@ func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 			errHandler(err)
 			return
 		}
-		event := new(WsDepthEvent)
-		event.Event = j.Get("e").MustString()
-		event.Time = j.Get("E").MustInt64()
-		event.TransactionTime = j.Get("T").MustInt64()
-		event.Symbol = j.Get("s").MustString()
-		event.FirstUpdateID = j.Get("U").MustInt64()
-		event.LastUpdateID = j.Get("u").MustInt64()
-		event.PrevLastUpdateID = j.Get("pu").MustInt64()
-		bidsLen := len(j.Get("b").MustArray())
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := j.Get("b").GetIndex(i)
-			event.Bids[i] = Bid{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
+		handler(newWsDepthEvent(j))
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// newWsDepthEvent parses depth event
+func newWsDepthEvent(j *simplejson.Json) *WsDepthEvent {
+	event := new(WsDepthEvent)
+	event.Event = j.Get("e").MustString()
+	event.Time = j.Get("E").MustInt64()
+	event.TransactionTime = j.Get("T").MustInt64()
+	event.Symbol = j.Get("s").MustString()
+	event.FirstUpdateID = j.Get("U").MustInt64()
+	event.LastUpdateID = j.Get("u").MustInt64()
+	event.PrevLastUpdateID = j.Get("pu").MustInt64()
+	bidsLen := len(j.Get("b").MustArray())
+	event.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("b").GetIndex(i)
+		event.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
 		}
-		asksLen := len(j.Get("a").MustArray())
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := j.Get("a").GetIndex(i)
-			event.Asks[i] = Ask{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
+	}
+	asksLen := len(j.Get("a").MustArray())
+	event.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("a").GetIndex(i)
+		event.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
 		}
-		handler(event)
 	}
-	return wsServe(cfg, wsHandler, errHandler)
+	return event
 }
 
 // WsBLVTInfoEvent define websocket BLVT info event
@@ -1056,6 +1096,8 @@ type WsBLVTKlineEvent struct {
 }
 
 // WsBLVTKline BLVT kline
+//
+//decimalgen:accessors
 type WsBLVTKline struct {
 	StartTime       int64  `json:"t"`
 	CloseTime       int64  `json:"T"`
@@ -1091,6 +1133,8 @@ func WsBLVTKlineServe(name string, interval string, handler WsBLVTKlineHandler,
 }
 
 // WsCompositeIndexEvent websocket composite index event
+//
+//decimalgen:accessors
 type WsCompositeIndexEvent struct {
 	Event         string          `json:"e"`
 	Time          int64           `json:"E"`
@@ -1101,6 +1145,8 @@ type WsCompositeIndexEvent struct {
 }
 
 // WsComposition websocket composite index event composition
+//
+//decimalgen:accessors
 type WsComposition struct {
 	BaseAsset    string `json:"b"`
 	QuoteAsset   string `json:"q"`
@@ -1165,6 +1211,8 @@ func WsContractInfoServe(handler WsContractInfoHandler, errHandler ErrHandler) (
 }
 
 // WsAssetIndexEvent define websocket asset index event
+//
+//decimalgen:accessors
 type WsAssetIndexEvent struct {
 	Event                 string `json:"e"`
 	Time                  int64  `json:"E"`
@@ -1256,6 +1304,7 @@ type WsUserDataAlgoUpdate struct {
 	AlgoUpdate WsAlgoUpdate `json:"o"`
 }
 
+//decimalgen:accessors
 type WsAlgoUpdate struct {
 	ClientAlgoID     string              `json:"caid"` // Client Algo Id
 	AlgoID           int64               `json:"aid"`  // Algo Id
@@ -1292,6 +1341,7 @@ type WsUserDataAccountUpdate struct {
 	AccountUpdate WsAccountUpdate `json:"a"`
 }
 
+//decimalgen:accessors
 type WsUserDataMarginCall struct {
 	CrossWalletBalance  string       `json:"cw"`
 	MarginCallPositions []WsPosition `json:"p"`
@@ -1301,6 +1351,7 @@ type WsUserDataOrderTradeUpdate struct {
 	OrderTradeUpdate WsOrderTradeUpdate `json:"o"`
 }
 
+//decimalgen:accessors
 type WsUserDataTradeLite struct {
 	Symbol          string   `json:"s"`
 	OriginalQty     string   `json:"q"`
@@ -1362,6 +1413,8 @@ type WsAccountUpdate struct {
 }
 
 // WsBalance define balance
+//
+//decimalgen:accessors
 type WsBalance struct {
 	Asset              string `json:"a"`
 	Balance            string `json:"wb"`
@@ -1370,6 +1423,8 @@ type WsBalance struct {
 }
 
 // WsPosition define position
+//
+//decimalgen:accessors
 type WsPosition struct {
 	Symbol                    string           `json:"s"`
 	Side                      PositionSideType `json:"ps"`
@@ -1384,6 +1439,8 @@ type WsPosition struct {
 }
 
 // WsOrderTradeUpdate define order trade update
+//
+//decimalgen:accessors
 type WsOrderTradeUpdate struct {
 	Symbol               string             `json:"s"`   // Symbol
 	ClientOrderID        string             `json:"c"`   // Client order ID
@@ -1439,7 +1496,11 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 // WsUserDataServe serve user data handler with listen key
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s?listenKey=%s", getWsPrivateEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	return wsUserDataServe(newWsConfig(endpoint), handler, errHandler)
+}
+
+// wsUserDataServe serves the user data stream of cfg
+func wsUserDataServe(cfg *WsConfig, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
@@ -1528,3 +1589,11 @@ func getWsApiEndpoint() string {
 	}
 	return BaseWsApiMainURL
 }
+
+// wsApiRawResponse define websocket API response with undecoded result, used by responses whose result needs parsing
+type wsApiRawResponse struct {
+	Id     string           `json:"id"`
+	Status int              `json:"status"`
+	Result json.RawMessage  `json:"result"`
+	Error  *common.APIError `json:"error,omitempty"`
+}
@@ -1,6 +1,7 @@
 package futures
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -395,6 +396,29 @@ func (s *websocketServiceTestSuite) TestCombinedMarkPriceServeWithInvalidRate()
 	}
 }
 
+func (s *websocketServiceTestSuite) TestKlineStream() {
+	data := []byte(`{"e": "kline", "E": 123456789, "s": "BTCUSDT", "k": {"s": "BTCUSDT", "i": "1m", "c": "0.0020"}}`)
+	s.mockWsServe(data, errors.New("fake error"))
+	defer s.assertWsServe()
+
+	var events []*WsKlineEvent
+	var errs []error
+	stream, err := WsKlineStream(context.Background(), "BTCUSDT", "1m", func(event *WsKlineEvent) {
+		events = append(events, event)
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	s.r().NoError(err)
+	s.r().Len(events, 1)
+	s.r().Equal("0.0020", events[0].Kline.Close)
+	s.r().EqualError(errs[0], "fake error")
+
+	s.r().NoError(stream.Close())
+	s.r().NoError(stream.Close())
+	<-stream.Done()
+	s.r().NoError(stream.Err())
+}
+
 func (s *websocketServiceTestSuite) TestKlineServe() {
 	data := []byte(`{
 		"e": "kline",
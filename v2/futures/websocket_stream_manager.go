@@ -0,0 +1,171 @@
+package futures
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	gorilla "github.com/gorilla/websocket"
+)
+
+var (
+	// WsStreamManagerMaxStreams is the number of streams a single connection can listen to
+	WsStreamManagerMaxStreams = 1024
+	// WsStreamManagerMessagesPerSecond is the number of messages which can be sent over a single connection per second
+	WsStreamManagerMessagesPerSecond = 10
+)
+
+// WsStreamManager holds combined stream connections which are subscribed and unsubscribed at runtime.
+// Streams are spread over several connections when the per connection limit is reached.
+// Depth and book ticker streams are kept on the public endpoint, all other streams on the market endpoint.
+type WsStreamManager struct {
+	m          *websocket.StreamManager
+	errHandler ErrHandler
+}
+
+// NewWsStreamManager init stream manager, errHandler receives connection and decoding errors
+func NewWsStreamManager(errHandler ErrHandler) *WsStreamManager {
+	idleTimeout := WebsocketTimeout
+	if !WebsocketKeepalive {
+		idleTimeout = 0
+	}
+	publicEndpoint := strings.TrimSuffix(getCombinedPublicEndpoint(), "?streams=")
+	marketEndpoint := strings.TrimSuffix(getCombinedMarketEndpoint(), "?streams=")
+	endpoint := func(stream string) string {
+		if strings.Contains(stream, "@depth") || strings.Contains(stream, "bookTicker") {
+			return publicEndpoint
+		}
+		return marketEndpoint
+	}
+	return &WsStreamManager{
+		m: websocket.NewStreamManager(websocket.StreamManagerConfig{
+			Endpoint: endpoint,
+			Dial: func(endpoint string) (*gorilla.Conn, error) {
+				return wsDial(newWsConfig(endpoint))
+			},
+			MaxStreamsPerConn:    WsStreamManagerMaxStreams,
+			MaxMessagesPerSecond: WsStreamManagerMessagesPerSecond,
+			IdleTimeout:          idleTimeout,
+			ErrHandler:           errHandler,
+		}),
+		errHandler: errHandler,
+	}
+}
+
+// Subscribe subscribes to a raw stream like btcusdt@depth, handler receives the payload of the stream
+func (m *WsStreamManager) Subscribe(stream string, handler WsHandler) error {
+	return m.m.Subscribe(map[string]websocket.StreamHandler{
+		stream: websocket.StreamHandler(handler),
+	})
+}
+
+// Unsubscribe unsubscribes from the streams
+func (m *WsStreamManager) Unsubscribe(streams ...string) error {
+	return m.m.Unsubscribe(streams...)
+}
+
+// ListSubscriptions returns the streams subscribed on the server side
+func (m *WsStreamManager) ListSubscriptions() ([]string, error) {
+	return m.m.ListSubscriptions()
+}
+
+// Streams returns the subscribed streams
+func (m *WsStreamManager) Streams() []string {
+	return m.m.Streams()
+}
+
+// Close closes all connections of the manager
+func (m *WsStreamManager) Close() error {
+	return m.m.Close()
+}
+
+// SubscribeDiffDepth subscribes to diff. depth stream of symbol and returns its stream name
+func (m *WsStreamManager) SubscribeDiffDepth(symbol string, handler WsDepthHandler) (string, error) {
+	return m.subscribeDepth(fmt.Sprintf("%s@depth", strings.ToLower(symbol)), handler)
+}
+
+// SubscribePartialDepth subscribes to partial depth stream of symbol with levels 5, 10 or 20 and returns its stream name
+func (m *WsStreamManager) SubscribePartialDepth(symbol string, levels int, handler WsDepthHandler) (string, error) {
+	if levels != 5 && levels != 10 && levels != 20 {
+		return "", errors.New("Invalid levels")
+	}
+	return m.subscribeDepth(fmt.Sprintf("%s@depth%d", strings.ToLower(symbol), levels), handler)
+}
+
+func (m *WsStreamManager) subscribeDepth(stream string, handler WsDepthHandler) (string, error) {
+	return stream, m.Subscribe(stream, func(message []byte) {
+		j, err := newJSON(message)
+		if err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(newWsDepthEvent(j))
+	})
+}
+
+// SubscribeKline subscribes to kline stream of symbol and interval and returns its stream name
+func (m *WsStreamManager) SubscribeKline(symbol string, interval string, handler WsKlineHandler) (string, error) {
+	stream := fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval)
+	return stream, m.Subscribe(stream, func(message []byte) {
+		event := new(WsKlineEvent)
+		if err := json.Unmarshal(message, event); err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeAggTrade subscribes to aggregate trade stream of symbol and returns its stream name
+func (m *WsStreamManager) SubscribeAggTrade(symbol string, handler WsAggTradeHandler) (string, error) {
+	stream := fmt.Sprintf("%s@aggTrade", strings.ToLower(symbol))
+	return stream, m.Subscribe(stream, func(message []byte) {
+		event := new(WsAggTradeEvent)
+		if err := json.Unmarshal(message, event); err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeMarkPrice subscribes to mark price stream of symbol and returns its stream name
+func (m *WsStreamManager) SubscribeMarkPrice(symbol string, handler WsMarkPriceHandler) (string, error) {
+	stream := fmt.Sprintf("%s@markPrice", strings.ToLower(symbol))
+	return stream, m.Subscribe(stream, func(message []byte) {
+		event := new(WsMarkPriceEvent)
+		if err := json.Unmarshal(message, event); err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeBookTicker subscribes to book ticker stream of symbol and returns its stream name
+func (m *WsStreamManager) SubscribeBookTicker(symbol string, handler WsBookTickerHandler) (string, error) {
+	stream := fmt.Sprintf("%s@bookTicker", strings.ToLower(symbol))
+	return stream, m.Subscribe(stream, func(message []byte) {
+		event := new(WsBookTickerEvent)
+		if err := json.Unmarshal(message, event); err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeMarketTicker subscribes to 24hr ticker stream of symbol and returns its stream name
+func (m *WsStreamManager) SubscribeMarketTicker(symbol string, handler WsMarketTickerHandler) (string, error) {
+	stream := fmt.Sprintf("%s@ticker", strings.ToLower(symbol))
+	return stream, m.Subscribe(stream, func(message []byte) {
+		event := new(WsMarketTickerEvent)
+		if err := json.Unmarshal(message, event); err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(event)
+	})
+}
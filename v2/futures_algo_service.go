@@ -259,6 +259,7 @@ func (s *ListOpenFuturesAlgoOrdersService) Do(ctx context.Context, opts ...Reque
 	return res, nil
 }
 
+//decimalgen:accessors
 type FuturesAlgoOrder struct {
 	//策略订单ID
 	AlgoId           int64                      `json:"algoId"`
@@ -465,6 +466,8 @@ func (s *GetFuturesAlgoSubOrdersService) Do(ctx context.Context, opts ...Request
 }
 
 // FutureAlgoSubOrder definen sub order of future algo order
+//
+//decimalgen:accessors
 type FuturesAlgoSubOrder struct {
 	AlgoId           int64           `json:"algoId"`
 	OrderId          int64           `json:"orderId"`
@@ -482,6 +485,7 @@ type FuturesAlgoSubOrder struct {
 	OriginQuantity   string          `json:"origQty"`
 }
 
+//decimalgen:accessors
 type GetFuturesAlgoSubOrdersResponse struct {
 	Total            int64                  `json:"total"`
 	ExecutedQuantity string                 `json:"executedQty"`
@@ -138,6 +138,8 @@ type FuturesTransferHistory struct {
 }
 
 // FuturesTransfer define futures transfer history item
+//
+//decimalgen:accessors
 type FuturesTransfer struct {
 	Asset     string                    `json:"asset"`
 	TranID    int64                     `json:"tranId"`
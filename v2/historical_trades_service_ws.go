@@ -0,0 +1,154 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// HistoricalTradesWsService queries historical trades
+type HistoricalTradesWsService struct {
+	c websocket.Client
+}
+
+// NewHistoricalTradesWsService init HistoricalTradesWsService
+func NewHistoricalTradesWsService() (*HistoricalTradesWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HistoricalTradesWsService{
+		c: client,
+	}, nil
+}
+
+// NewHistoricalTradesWsServiceWithSession init HistoricalTradesWsService sending its requests on the connection of session
+func NewHistoricalTradesWsServiceWithSession(session *SessionWsService) *HistoricalTradesWsService {
+	return &HistoricalTradesWsService{
+		c: session.c,
+	}
+}
+
+// HistoricalTradesWsRequest parameters for 'trades.historical' websocket API
+type HistoricalTradesWsRequest struct {
+	symbol string
+	fromID *int64
+	limit  *int
+}
+
+// NewHistoricalTradesWsRequest init HistoricalTradesWsRequest
+func NewHistoricalTradesWsRequest() *HistoricalTradesWsRequest {
+	return &HistoricalTradesWsRequest{}
+}
+
+func (s *HistoricalTradesWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *HistoricalTradesWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.fromID != nil {
+		m["fromId"] = *s.fromID
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'trades.historical' request
+func (s *HistoricalTradesWsService) Do(requestID string, request *HistoricalTradesWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TradesHistoricalSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'trades.historical' request and receives response
+func (s *HistoricalTradesWsService) SyncDo(requestID string, request *HistoricalTradesWsRequest) (*HistoricalTradesWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TradesHistoricalSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	historicalTradesWsResponse := &HistoricalTradesWsResponse{}
+	if err := json.Unmarshal(response, historicalTradesWsResponse); err != nil {
+		return nil, err
+	}
+	if historicalTradesWsResponse.Error != nil {
+		historicalTradesWsResponse.Error.StatusCode = historicalTradesWsResponse.Status
+		return nil, historicalTradesWsResponse.Error
+	}
+
+	return historicalTradesWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *HistoricalTradesWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *HistoricalTradesWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *HistoricalTradesWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *HistoricalTradesWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *HistoricalTradesWsRequest) Symbol(symbol string) *HistoricalTradesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// FromID set fromId
+func (s *HistoricalTradesWsRequest) FromID(fromID int64) *HistoricalTradesWsRequest {
+	s.fromID = &fromID
+	return s
+}
+
+// Limit set limit
+func (s *HistoricalTradesWsRequest) Limit(limit int) *HistoricalTradesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// HistoricalTradesWsResponse define 'trades.historical' websocket API response
+type HistoricalTradesWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result []*Trade `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
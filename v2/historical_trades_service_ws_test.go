@@ -0,0 +1,92 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *historicalTradesServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.historicalTrades = &HistoricalTradesWsService{
+		c: s.client,
+	}
+
+	s.historicalTradesRequest = NewHistoricalTradesWsRequest().
+		Symbol("BNBBTC").
+		FromID(0).
+		Limit(1)
+}
+
+func (s *historicalTradesServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type historicalTradesServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	historicalTrades        *HistoricalTradesWsService
+	historicalTradesRequest *HistoricalTradesWsRequest
+}
+
+func TestHistoricalTradesServiceWs(t *testing.T) {
+	suite.Run(t, new(historicalTradesServiceWsTestSuite))
+}
+
+func (s *historicalTradesServiceWsTestSuite) TestHistoricalTrades() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.historicalTrades.Do(s.requestID, s.historicalTradesRequest)
+	s.NoError(err)
+}
+
+func (s *historicalTradesServiceWsTestSuite) TestHistoricalTrades_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.historicalTrades.Do("", s.historicalTradesRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *historicalTradesServiceWsTestSuite) TestHistoricalTradesSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":[{"id":0,"price":"0.00005000","qty":"40.00000000","quoteQty":"0.00200000","time":1500004800376,"isBuyerMaker":true,"isBestMatch":true}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.historicalTrades.SyncDo(s.requestID, s.historicalTradesRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal("0.00005000", response.Result[0].Price)
+	s.Equal(int64(1500004800376), response.Result[0].Time)
+}
+
+func (s *historicalTradesServiceWsTestSuite) TestHistoricalTradesSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.historicalTrades.SyncDo(s.requestID, s.historicalTradesRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *historicalTradesServiceWsTestSuite) TestHistoricalTradesSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.historicalTrades.SyncDo(s.requestID, s.historicalTradesRequest)
+	s.Nil(response)
+	s.Error(err)
+}
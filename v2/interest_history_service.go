@@ -93,6 +93,7 @@ func (s *InterestHistoryService) Do(ctx context.Context) (*InterestHistory, erro
 // InterestHistory represents a response from InterestHistoryService.
 type InterestHistory []InterestHistoryElement
 
+//decimalgen:accessors
 type InterestHistoryElement struct {
 	Asset       string      `json:"asset"`
 	Interest    string      `json:"interest"`
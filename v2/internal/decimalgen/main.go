@@ -0,0 +1,194 @@
+// Command decimalgen generates the Decimal accessors of the price, quantity and amount fields of the response
+// models in the package of the current directory. It is run by go generate:
+//
+//	//go:generate go run ../internal/decimalgen
+//
+// Only the response and event types marked by a directive in their doc comment are considered:
+//
+//	// Order define order info
+//	//decimalgen:accessors
+//	type Order struct {
+//
+// For every exported string field like Price of a marked struct it writes a method PriceDecimal to decimal_gen.go,
+// unless the type already has a field or method of that name.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	output = "decimal_gen.go"
+	// marker is the directive marking the types which get accessors
+	marker = "//decimalgen:accessors"
+)
+
+var (
+	// monetary matches the names of price, quantity and amount fields
+	monetary = regexp.MustCompile(`Price|Qty|Quantity|Amount|Amt|Volume|Vol$|Balance|Commission|Fee|Margin|Profit|PnL|Pnl|` +
+		`Notional|Equity|Interest|Open$|High$|Low$|Close$|Free$|Locked$|Borrowed$|Principal|Debt|Loan|Collateral|Cost|` +
+		`FundingRate|BasisRate|CallbackRate|BidRate|AskRate|MintRate|RedeemRate|LiquidateRate|PercentageRate|BtcValue|` +
+		`MarkValue|Available$|Liability|Liabilities|Withdrawable|Transferable|Delta$|Gamma$|Theta$|Vega$|IV$`)
+	// enum matches the names of fields holding assets, enums or ids instead of numbers
+	enum = regexp.MustCompile(`^Is|Asset$|Assets$|Type$|Side$|Status$|Symbol$|Currency$|Coin$|Mode$|Match$|ID$|Id$|Time$|` +
+		`Level$|Unit$|Name$|Protect$|Tag$|Key$|Direction$|Network$|Address$|Period$|Interval$|Flag$|Method$|Source$|Note$`)
+)
+
+type field struct {
+	typ, name string
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("decimalgen: ")
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != output
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("expected one package, found %d", len(pkgs))
+	}
+	var pkg *ast.Package
+	for _, p := range pkgs {
+		pkg = p
+	}
+
+	// names of the fields and methods of every type, the accessors must not collide with them
+	taken := make(map[string]map[string]bool)
+	take := func(typ, name string) {
+		if taken[typ] == nil {
+			taken[typ] = make(map[string]bool)
+		}
+		taken[typ][name] = true
+	}
+	var fields []field
+	marked := make(map[string]bool)
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil && len(decl.Recv.List) == 1 {
+					take(receiverType(decl.Recv.List[0].Type), decl.Name.Name)
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					ts, ok := spec.(*ast.TypeSpec)
+					if !ok || !ts.Name.IsExported() || ts.TypeParams != nil {
+						continue
+					}
+					st, ok := ts.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+					doc := ts.Doc
+					if doc == nil && len(decl.Specs) == 1 {
+						doc = decl.Doc
+					}
+					for _, f := range st.Fields.List {
+						for _, name := range f.Names {
+							take(ts.Name.Name, name.Name)
+						}
+					}
+					if !hasMarker(doc) {
+						continue
+					}
+					marked[ts.Name.Name] = true
+					for _, f := range st.Fields.List {
+						ident, ok := f.Type.(*ast.Ident)
+						if !ok || ident.Name != "string" {
+							continue
+						}
+						for _, name := range f.Names {
+							if name.IsExported() && monetary.MatchString(name.Name) && !enum.MatchString(name.Name) {
+								fields = append(fields, field{typ: ts.Name.Name, name: name.Name})
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	for _, f := range fields {
+		delete(marked, f.typ)
+	}
+	for typ := range marked {
+		log.Printf("%s is marked but has no price, quantity or amount fields", typ)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		if fields[i].typ != fields[j].typ {
+			return fields[i].typ < fields[j].typ
+		}
+		return fields[i].name < fields[j].name
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by decimalgen; DO NOT EDIT.\n\npackage %s\n\n", pkg.Name)
+	parse := "common.ParseDecimal"
+	if pkg.Name == "common" {
+		parse = "ParseDecimal"
+		buf.WriteString("import \"github.com/shopspring/decimal\"\n")
+	} else {
+		buf.WriteString("import (\n\t\"github.com/shopspring/decimal\"\n\n\t\"github.com/adshao/go-binance/v2/common\"\n)\n")
+	}
+	n := 0
+	for _, f := range fields {
+		method := f.name + "Decimal"
+		if taken[f.typ][method] {
+			continue
+		}
+		take(f.typ, method)
+		fmt.Fprintf(&buf, "\n// %s returns %s as a decimal, zero if it is empty\n", method, f.name)
+		fmt.Fprintf(&buf, "func (v *%s) %s() (decimal.Decimal, error) {\n", f.typ, method)
+		fmt.Fprintf(&buf, "\treturn %s(%q, v.%s)\n}\n", parse, f.typ+"."+f.name, f.name)
+		n++
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("%d accessors written to %s", n, output)
+}
+
+// hasMarker reports whether the doc comment holds the marker directive
+func hasMarker(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, c := range doc.List {
+		if c.Text == marker {
+			return true
+		}
+	}
+	return false
+}
+
+// receiverType returns the name of the type of a method receiver
+func receiverType(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverType(expr.X)
+	case *ast.IndexExpr:
+		return receiverType(expr.X)
+	case *ast.IndexListExpr:
+		return receiverType(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	}
+	return ""
+}
@@ -0,0 +1,180 @@
+// Command streamgen generates the context-based variants of the websocket streams in the package of the current
+// directory. It is run by go generate:
+//
+//	//go:generate go run ../internal/streamgen
+//
+// For every exported function like WsKlineServe returning doneC and stopC it writes a function WsKlineStream to
+// stream_gen.go, which takes a context and returns a *common.Stream started by ServeStream, unless the name is taken.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const output = "stream_gen.go"
+
+type stream struct {
+	name, serve string
+	params      []string // the parameters with their types
+	args        []string // the arguments passed on to serve
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("streamgen: ")
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != output
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("expected one package, found %d", len(pkgs))
+	}
+	var pkg *ast.Package
+	for _, p := range pkgs {
+		pkg = p
+	}
+
+	// names declared at package level, the streams must not collide with them
+	taken := make(map[string]bool)
+	for _, file := range pkg.Files {
+		for name := range file.Scope.Objects {
+			taken[name] = true
+		}
+	}
+	imports := map[string]string{"context": "context", "common": "github.com/adshao/go-binance/v2/common"}
+	var streams []stream
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || !fn.Name.IsExported() || !strings.HasPrefix(fn.Name.Name, "Ws") ||
+				!strings.Contains(fn.Name.Name, "Serve") || !returnsChannels(fn.Type) {
+				continue
+			}
+			s := stream{name: strings.Replace(fn.Name.Name, "Serve", "Stream", 1), serve: fn.Name.Name}
+			if taken[s.name] {
+				log.Printf("%s skipped, %s is taken", s.serve, s.name)
+				continue
+			}
+			hasErrHandler := false
+			for _, p := range fn.Type.Params.List {
+				var typ bytes.Buffer
+				if err := printer.Fprint(&typ, fset, p.Type); err != nil {
+					log.Fatal(err)
+				}
+				ast.Inspect(p.Type, func(n ast.Node) bool {
+					if sel, ok := n.(*ast.SelectorExpr); ok {
+						if ident, ok := sel.X.(*ast.Ident); ok {
+							imports[ident.Name] = importPath(file, ident.Name)
+						}
+					}
+					return true
+				})
+				_, variadic := p.Type.(*ast.Ellipsis)
+				for _, name := range p.Names {
+					switch name.Name {
+					case "ctx", "doneC", "stopC", "err":
+						log.Fatalf("%s: parameter %s collides with the generated code", s.serve, name.Name)
+					}
+					s.params = append(s.params, name.Name+" "+typ.String())
+					arg := name.Name
+					if variadic {
+						arg += "..."
+					}
+					s.args = append(s.args, arg)
+					if name.Name == "errHandler" && typ.String() == "ErrHandler" {
+						hasErrHandler = true
+					}
+				}
+			}
+			if !hasErrHandler {
+				log.Printf("%s skipped, it has no errHandler", s.serve)
+				continue
+			}
+			taken[s.name] = true
+			streams = append(streams, s)
+		}
+	}
+	sort.Slice(streams, func(i, j int) bool {
+		return streams[i].name < streams[j].name
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by streamgen; DO NOT EDIT.\n\npackage %s\n\nimport (\n", pkg.Name)
+	names := make([]string, 0, len(imports))
+	for name := range imports {
+		names = append(names, name)
+	}
+	// the standard library comes first
+	sort.Slice(names, func(i, j int) bool {
+		if isStd(imports[names[i]]) != isStd(imports[names[j]]) {
+			return isStd(imports[names[i]])
+		}
+		return imports[names[i]] < imports[names[j]]
+	})
+	for i, name := range names {
+		if i > 0 && isStd(imports[names[i-1]]) && !isStd(imports[name]) {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "\t%q\n", imports[name])
+	}
+	buf.WriteString(")\n")
+	for _, s := range streams {
+		fmt.Fprintf(&buf, "\n// %s is %s stopped when ctx is done, see ServeStream\n", s.name, s.serve)
+		fmt.Fprintf(&buf, "func %s(ctx context.Context, %s) (*common.Stream, error) {\n", s.name, strings.Join(s.params, ", "))
+		buf.WriteString("\treturn ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {\n")
+		fmt.Fprintf(&buf, "\t\treturn %s(%s)\n", s.serve, strings.Join(s.args, ", "))
+		buf.WriteString("\t}, errHandler)\n}\n")
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("%d streams written to %s", len(streams), output)
+}
+
+// returnsChannels reports whether the function returns doneC, stopC chan struct{} and an error
+func returnsChannels(typ *ast.FuncType) bool {
+	if typ.Results == nil || len(typ.Results.List) != 2 {
+		return false
+	}
+	names := typ.Results.List[0].Names
+	ch, ok := typ.Results.List[0].Type.(*ast.ChanType)
+	return ok && len(names) == 2 && names[0].Name == "doneC" && names[1].Name == "stopC" && ch.Dir == ast.SEND|ast.RECV
+}
+
+// isStd reports whether path is a package of the standard library
+func isStd(path string) bool {
+	return !strings.Contains(strings.Split(path, "/")[0], ".")
+}
+
+// importPath returns the path of the package imported by file under name
+func importPath(file *ast.File, name string) string {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if spec.Name != nil && spec.Name.Name == name || spec.Name == nil && path[strings.LastIndex(path, "/")+1:] == name {
+			return path
+		}
+	}
+	log.Fatalf("package %s isn't imported by %s", name, file.Name.Name)
+	return ""
+}
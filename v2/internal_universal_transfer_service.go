@@ -192,6 +192,7 @@ type InternalUniversalTransferHistoryResponse struct {
 	TotalCount int                          `json:"totalCount"`
 }
 
+//decimalgen:accessors
 type InternalUniversalTransfer struct {
 	TranId          int64  `json:"tranId"`
 	ClientTranId    string `json:"clientTranId"`
@@ -0,0 +1,46 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// NewKlineFeed creates a feed of the klines of symbol and interval from startTime on. The history is backfilled with
+// KlinesService requests, then the feed follows the kline stream. Set the options of the feed and start it with Run:
+//
+//	feed := client.NewKlineFeed("BTCUSDT", "1m", startTime)
+//	err := feed.Run(ctx, func(kline *binance.Kline, closed bool) {
+//		...
+//	})
+func (c *Client) NewKlineFeed(symbol, interval string, startTime int64) *common.KlineFeed[*Kline] {
+	return common.NewKlineFeed(common.KlineSource[*Kline]{
+		Fetch: func(ctx context.Context, startTime, endTime int64, limit int) ([]*Kline, error) {
+			return c.NewKlinesService().Symbol(symbol).Interval(interval).
+				StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		Serve: func(handler common.KlineHandler[*Kline], errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			return WsKlineServe(symbol, interval, func(event *WsKlineEvent) {
+				handler(event.Kline.toKline(), event.Kline.IsFinal)
+			}, errHandler)
+		},
+		OpenTime:  func(k *Kline) int64 { return k.OpenTime },
+		CloseTime: func(k *Kline) int64 { return k.CloseTime },
+	}, interval, startTime)
+}
+
+func (k *WsKline) toKline() *Kline {
+	return &Kline{
+		OpenTime:                 k.StartTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.EndTime,
+		QuoteAssetVolume:         k.QuoteVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.ActiveBuyVolume,
+		TakerBuyQuoteAssetVolume: k.ActiveBuyQuoteVolume,
+	}
+}
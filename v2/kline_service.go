@@ -77,6 +77,11 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 	if err != nil {
 		return []*Kline{}, err
 	}
+	return parseKlines(data)
+}
+
+// parseKlines parses klines given as arrays
+func parseKlines(data []byte) (res []*Kline, err error) {
 	j, err := newJSON(data)
 	if err != nil {
 		return []*Kline{}, err
@@ -107,6 +112,8 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 }
 
 // Kline define kline info
+//
+//decimalgen:accessors
 type Kline struct {
 	OpenTime                 int64  `json:"openTime"`
 	Open                     string `json:"open"`
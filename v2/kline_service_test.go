@@ -1,6 +1,11 @@
 package binance
 
 import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -98,6 +103,51 @@ func (s *klineServiceTestSuite) TestKlines() {
 	s.assertKlineEqual(kline2, klines[1])
 }
 
+func (s *klineServiceTestSuite) TestKlineFeed() {
+	minute := int64(60000)
+	var mu sync.Mutex
+	var starts []string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		mu.Lock()
+		starts = append(starts, query.Get("startTime"))
+		mu.Unlock()
+		startTime, _ := strconv.ParseInt(query.Get("startTime"), 10, 64)
+		endTime, _ := strconv.ParseInt(query.Get("endTime"), 10, 64)
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		var klines []string
+		for open := startTime; open <= endTime && len(klines) < limit; open += minute {
+			klines = append(klines, fmt.Sprintf(`[%d, "1", "2", "0.5", "1.5", "10", %d, "15", 3, "4", "6", "0"]`,
+				open, open+minute-1))
+		}
+		return newHTTPResponse([]byte("["+strings.Join(klines, ",")+"]"), http.StatusOK), nil
+	}
+
+	feed := s.client.NewKlineFeed("BTCUSDT", "1m", 0)
+	feed.EndTime = 5*minute - 1
+	feed.Limit = 2
+	var opens []int64
+	err := feed.Run(newContext(), func(kline *Kline, closed bool) {
+		s.r().True(closed)
+		opens = append(opens, kline.OpenTime)
+	})
+	s.r().NoError(err)
+	s.r().Equal([]int64{0, minute, 2 * minute, 3 * minute, 4 * minute}, opens)
+	s.r().ElementsMatch([]string{"0", strconv.FormatInt(2*minute, 10), strconv.FormatInt(4*minute, 10)}, starts)
+}
+
+func (s *klineServiceTestSuite) TestKlineDecimal() {
+	k := &Kline{Open: "0.01634790", Volume: "", Close: "0.0163479a"}
+	open, err := k.OpenDecimal()
+	s.r().NoError(err)
+	s.r().Equal("0.0163479", open.String())
+	volume, err := k.VolumeDecimal()
+	s.r().NoError(err)
+	s.r().True(volume.IsZero())
+	_, err = k.CloseDecimal()
+	s.r().EqualError(err, `Kline.Close: invalid decimal "0.0163479a"`)
+}
+
 func (s *klineServiceTestSuite) assertKlineEqual(e, a *Kline) {
 	r := s.r()
 	r.Equal(e.OpenTime, a.OpenTime, "OpenTime")
@@ -0,0 +1,196 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// KlinesWsService queries klines
+type KlinesWsService struct {
+	c websocket.Client
+}
+
+// NewKlinesWsService init KlinesWsService
+func NewKlinesWsService() (*KlinesWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KlinesWsService{
+		c: client,
+	}, nil
+}
+
+// NewKlinesWsServiceWithSession init KlinesWsService sending its requests on the connection of session
+func NewKlinesWsServiceWithSession(session *SessionWsService) *KlinesWsService {
+	return &KlinesWsService{
+		c: session.c,
+	}
+}
+
+// KlinesWsRequest parameters for 'klines' websocket API
+type KlinesWsRequest struct {
+	symbol    string
+	interval  string
+	startTime *int64
+	endTime   *int64
+	timeZone  *string
+	limit     *int
+}
+
+// NewKlinesWsRequest init KlinesWsRequest
+func NewKlinesWsRequest() *KlinesWsRequest {
+	return &KlinesWsRequest{}
+}
+
+func (s *KlinesWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *KlinesWsRequest) buildParams() params {
+	m := params{
+		"symbol":   s.symbol,
+		"interval": s.interval,
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.timeZone != nil {
+		m["timeZone"] = *s.timeZone
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'klines' request
+func (s *KlinesWsService) Do(requestID string, request *KlinesWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.KlinesSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'klines' request and receives response
+func (s *KlinesWsService) SyncDo(requestID string, request *KlinesWsRequest) (*KlinesWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.KlinesSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	klinesWsResponse := &KlinesWsResponse{}
+	if err := json.Unmarshal(response, klinesWsResponse); err != nil {
+		return nil, err
+	}
+	if klinesWsResponse.Error != nil {
+		klinesWsResponse.Error.StatusCode = klinesWsResponse.Status
+		return nil, klinesWsResponse.Error
+	}
+
+	return klinesWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *KlinesWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *KlinesWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *KlinesWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *KlinesWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *KlinesWsRequest) Symbol(symbol string) *KlinesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *KlinesWsRequest) Interval(interval string) *KlinesWsRequest {
+	s.interval = interval
+	return s
+}
+
+// StartTime set startTime
+func (s *KlinesWsRequest) StartTime(startTime int64) *KlinesWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *KlinesWsRequest) EndTime(endTime int64) *KlinesWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// TimeZone set timeZone
+func (s *KlinesWsRequest) TimeZone(timeZone string) *KlinesWsRequest {
+	s.timeZone = &timeZone
+	return s
+}
+
+// Limit set limit
+func (s *KlinesWsRequest) Limit(limit int) *KlinesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// KlinesWsResponse define 'klines' websocket API response
+type KlinesWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result []*Kline `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON parses klines given as arrays
+func (r *KlinesWsResponse) UnmarshalJSON(data []byte) (err error) {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if len(raw.Result) == 0 {
+		return nil
+	}
+	r.Result, err = parseKlines(raw.Result)
+	return err
+}
@@ -0,0 +1,103 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *klinesServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.klines = &KlinesWsService{
+		c: s.client,
+	}
+
+	s.klinesRequest = NewKlinesWsRequest().
+		Symbol("BNBBTC").
+		Interval("1h").
+		StartTime(1655969280000).
+		Limit(1)
+}
+
+func (s *klinesServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type klinesServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	klines        *KlinesWsService
+	klinesRequest *KlinesWsRequest
+}
+
+func TestKlinesServiceWs(t *testing.T) {
+	suite.Run(t, new(klinesServiceWsTestSuite))
+}
+
+func (s *klinesServiceWsTestSuite) TestKlines() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.klines.Do(s.requestID, s.klinesRequest)
+	s.NoError(err)
+}
+
+func (s *klinesServiceWsTestSuite) TestKlines_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.klines.Do("", s.klinesRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *klinesServiceWsTestSuite) TestKlinesSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":[[1655971200000,"0.01086000","0.01086600","0.01083600","0.01083800","2290.53800000",1655974799999,"24.85074442",2283,"1171.64000000","12.71225884","0"]]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.klines.SyncDo(s.requestID, s.klinesRequest)
+	s.Require().NoError(err)
+	s.Equal([]*Kline{{
+		OpenTime:                 1655971200000,
+		Open:                     "0.01086000",
+		High:                     "0.01086600",
+		Low:                      "0.01083600",
+		Close:                    "0.01083800",
+		Volume:                   "2290.53800000",
+		CloseTime:                1655974799999,
+		QuoteAssetVolume:         "24.85074442",
+		TradeNum:                 2283,
+		TakerBuyBaseAssetVolume:  "1171.64000000",
+		TakerBuyQuoteAssetVolume: "12.71225884",
+	}}, response.Result)
+}
+
+func (s *klinesServiceWsTestSuite) TestKlinesSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.klines.SyncDo(s.requestID, s.klinesRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *klinesServiceWsTestSuite) TestKlinesSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.klines.SyncDo(s.requestID, s.klinesRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -55,6 +55,7 @@ type LiquidityPoolDetail struct {
 	Share      *PoolShareInformation `json:"share"`
 }
 
+//decimalgen:accessors
 type PoolShareInformation struct {
 	ShareAmount     string            `json:"shareAmount"`
 	SharePercentage string            `json:"sharePercentage"`
@@ -116,6 +117,7 @@ func (s *AddLiquidityPreviewService) OperationType(operationType LiquidityOperat
 	return s
 }
 
+//decimalgen:accessors
 type AddLiquidityPreviewResponse struct {
 	QuoteAsset string `json:"quoteAsset"`
 	BaseAsset  string `json:"baseAsset"` // only existed when type is COMBINATION
@@ -178,6 +180,7 @@ func (s *GetSwapQuoteService) BaseAsset(baseAsset string) *GetSwapQuoteService {
 	return s
 }
 
+//decimalgen:accessors
 type GetSwapQuoteResponse struct {
 	QuoteAsset string `json:"quoteAsset"`
 	BaseAsset  string `json:"baseAsset"`
@@ -277,6 +280,7 @@ type GetUserSwapRecordsService struct {
 	resultSize *int64
 }
 
+//decimalgen:accessors
 type SwapRecord struct {
 	SwapId     int64          `json:"swapId"`
 	SwapTime   int64          `json:"swapTime"`
@@ -585,6 +589,7 @@ func (s *QueryClaimedRewardHistoryService) ResultSize(resultSize int64) *QueryCl
 	return s
 }
 
+//decimalgen:accessors
 type ClaimedRewardHistory struct {
 	PoolId        int               `json:"poolId"`
 	PoolName      string            `json:"poolName"`
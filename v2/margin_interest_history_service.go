@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // MarginInterestHistoryService fetches the margin interest history
@@ -90,12 +93,27 @@ func (s *MarginInterestHistoryService) Do(ctx context.Context) (*MarginInterestH
 	return res, nil
 }
 
+// Iterator walks the interest records between startTime and endTime in milliseconds, in windows of 30 days and pages
+// of 100 records
+func (s *MarginInterestHistoryService) Iterator(startTime, endTime int64) *common.Iterator[MarginInterestHistoryRow] {
+	return common.NewOffsetIterator(startTime, endTime, 30*24*time.Hour, 100,
+		func(ctx context.Context, startTime, endTime int64, page, size int) ([]MarginInterestHistoryRow, error) {
+			res, err := s.StartTime(startTime).EndTime(endTime).Current(int64(page)).Size(int64(size)).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return res.Rows, nil
+		},
+		func(r MarginInterestHistoryRow) int64 { return r.TxId })
+}
+
 // MarginInterestHistory represents the response
 type MarginInterestHistory struct {
 	Rows  []MarginInterestHistoryRow `json:"rows"`
 	Total int64                      `json:"total"`
 }
 
+//decimalgen:accessors
 type MarginInterestHistoryRow struct {
 	TxId                int64  `json:"txId"`
 	InterestAccuredTime int64  `json:"interestAccuredTime"`
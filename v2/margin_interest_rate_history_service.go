@@ -73,6 +73,7 @@ func (s *MarginInterestRateHistoryService) Do(ctx context.Context) (*MarginInter
 // MarginInterestRateHistory represents the response
 type MarginInterestRateHistory []MarginInterestRateHistoryElement
 
+//decimalgen:accessors
 type MarginInterestRateHistoryElement struct {
 	Timestamp         int64  `json:"timestamp"`
 	VipLevel          int64  `json:"vipLevel"`
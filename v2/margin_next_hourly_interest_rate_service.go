@@ -53,6 +53,7 @@ func (s *MarginNextHourlyInterestRateService) Do(ctx context.Context) (*MarginNe
 // MarginNextHourlyInterestRate represents the response
 type MarginNextHourlyInterestRate []MarginNextHourlyInterestRateElement
 
+//decimalgen:accessors
 type MarginNextHourlyInterestRateElement struct {
 	Asset                  string `json:"asset"`
 	NextHourlyInterestRate string `json:"nextHourlyInterestRate"`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/adshao/go-binance/v2/common"
 )
@@ -504,7 +505,21 @@ func (s *ListMarginOrdersService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Iterator walks the margin orders created between startTime and endTime in milliseconds, in windows of 24 hours and
+// pages of 500 orders. OrderID is ignored.
+func (s *ListMarginOrdersService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Order] {
+	s.orderID = nil
+	return common.NewCursorIterator(startTime, endTime, 24*time.Hour, 500,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*Order, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx, opts...)
+		},
+		func(o *Order) int64 { return o.Time },
+		func(o *Order) int64 { return o.OrderID })
+}
+
 // CancelMarginOrderResponse define response of canceling order
+//
+//decimalgen:accessors
 type CancelMarginOrderResponse struct {
 	Symbol                   string          `json:"symbol"`
 	OrigClientOrderID        string          `json:"origClientOrderId"`
@@ -522,6 +537,8 @@ type CancelMarginOrderResponse struct {
 }
 
 // CancelAllMarginOrdersResponse define response of canceling order
+//
+//decimalgen:accessors
 type CancelAllMarginOrdersResponse struct {
 	Symbol                   string                         `json:"symbol"`
 	OrigClientOrderID        string                         `json:"origClientOrderId"`
@@ -549,6 +566,8 @@ type CancelAllMarginOrdersResponse struct {
 }
 
 // CancelAllMarginOrdersReport may be returned in an array of MarginOCOOrderReport in a CreateMarginOCOResponse
+//
+//decimalgen:accessors
 type CancelAllMarginOrdersReport struct {
 	Symbol                   string          `json:"symbol"`
 	OrderID                  int64           `json:"orderId"`
@@ -748,6 +767,8 @@ func (s *CreateMarginOCOService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // CreateMarginOCOResponse define create order response
+//
+//decimalgen:accessors
 type CreateMarginOCOResponse struct {
 	OrderListID           int64                   `json:"orderListId"`
 	ContingencyType       string                  `json:"contingencyType"`
@@ -771,6 +792,8 @@ type MarginOCOOrder struct {
 }
 
 // MarginOCOOrderReport may be returned in an array of MarginOCOOrderReport in a CreateMarginOCOResponse
+//
+//decimalgen:accessors
 type MarginOCOOrderReport struct {
 	Symbol                   string          `json:"symbol"`
 	OrderID                  int64           `json:"orderId"`
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // MarginTransferService transfer between spot account and margin account
@@ -371,11 +374,26 @@ func (s *ListMarginBorrowRepayService) Do(ctx context.Context, opts ...RequestOp
 	return res, nil
 }
 
+// Iterator walks the borrow or repay records between startTime and endTime in milliseconds, in windows of 30 days and
+// pages of 100 records
+func (s *ListMarginBorrowRepayService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[MarginBorrowRepay] {
+	return common.NewOffsetIterator(startTime, endTime, 30*24*time.Hour, 100,
+		func(ctx context.Context, startTime, endTime int64, page, size int) ([]MarginBorrowRepay, error) {
+			res, err := s.StartTime(startTime).EndTime(endTime).Current(int64(page)).Size(int64(size)).Do(ctx, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return res.Rows, nil
+		},
+		func(r MarginBorrowRepay) int64 { return r.TxID })
+}
+
 type MarginBorrowRepayResponse struct {
 	Rows  []MarginBorrowRepay `json:"rows"`
 	Total int64               `json:"total"`
 }
 
+//decimalgen:accessors
 type MarginBorrowRepay struct {
 	Type           string `json:"type"`           // AUTO,MANUAL for Cross Margin Borrow; MANUAL，AUTO，BNB_AUTO_REPAY，AUTO_BORROW_REPAY, POINT_AUTO_REPAY for Cross Margin Repay; AUTO，MANUAL for Isolated Margin Borrow/Repay;
 	IsolatedSymbol string `json:"isolatedSymbol"` // "BNBUSDT"  isolated symbol, will not be returned for crossed margin
@@ -473,6 +491,20 @@ func (s *ListMarginLoansService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Iterator walks the loan records between startTime and endTime in milliseconds, in windows of 30 days and pages of
+// 100 records
+func (s *ListMarginLoansService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[MarginLoan] {
+	return common.NewOffsetIterator(startTime, endTime, 30*24*time.Hour, 100,
+		func(ctx context.Context, startTime, endTime int64, page, size int) ([]MarginLoan, error) {
+			res, err := s.StartTime(startTime).EndTime(endTime).Current(int64(page)).Size(int64(size)).Do(ctx, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return res.Rows, nil
+		},
+		func(l MarginLoan) int64 { return l.TxID })
+}
+
 // MarginLoanResponse define margin loan response
 type MarginLoanResponse struct {
 	Rows  []MarginLoan `json:"rows"`
@@ -480,7 +512,10 @@ type MarginLoanResponse struct {
 }
 
 // MarginLoan define margin loan
+//
+//decimalgen:accessors
 type MarginLoan struct {
+	TxID      int64                `json:"txId"`
 	Asset     string               `json:"asset"`
 	Principal string               `json:"principal"`
 	Timestamp int64                `json:"timestamp"`
@@ -570,6 +605,20 @@ func (s *ListMarginRepaysService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Iterator walks the repay records between startTime and endTime in milliseconds, in windows of 30 days and pages of
+// 100 records
+func (s *ListMarginRepaysService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[MarginRepay] {
+	return common.NewOffsetIterator(startTime, endTime, 30*24*time.Hour, 100,
+		func(ctx context.Context, startTime, endTime int64, page, size int) ([]MarginRepay, error) {
+			res, err := s.StartTime(startTime).EndTime(endTime).Current(int64(page)).Size(int64(size)).Do(ctx, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return res.Rows, nil
+		},
+		func(r MarginRepay) int64 { return r.TxID })
+}
+
 // MarginRepayResponse define margin repay response
 type MarginRepayResponse struct {
 	Rows  []MarginRepay `json:"rows"`
@@ -577,6 +626,8 @@ type MarginRepayResponse struct {
 }
 
 // MarginRepay define margin repay
+//
+//decimalgen:accessors
 type MarginRepay struct {
 	Asset     string                `json:"asset"`
 	Amount    string                `json:"amount"`
@@ -625,6 +676,8 @@ func (s *GetIsolatedMarginAccountService) Do(ctx context.Context, opts ...Reques
 }
 
 // IsolatedMarginAccount defines isolated user assets of margin account
+//
+//decimalgen:accessors
 type IsolatedMarginAccount struct {
 	TotalAssetOfBTC     string                `json:"totalAssetOfBtc"`
 	TotalLiabilityOfBTC string                `json:"totalLiabilityOfBtc"`
@@ -633,6 +686,8 @@ type IsolatedMarginAccount struct {
 }
 
 // IsolatedMarginAsset defines isolated margin asset information, like margin level, liquidation price... etc
+//
+//decimalgen:accessors
 type IsolatedMarginAsset struct {
 	Symbol     string            `json:"symbol"`
 	QuoteAsset IsolatedUserAsset `json:"quoteAsset"`
@@ -650,6 +705,8 @@ type IsolatedMarginAsset struct {
 }
 
 // IsolatedUserAsset defines isolated user assets of the margin account
+//
+//decimalgen:accessors
 type IsolatedUserAsset struct {
 	Asset         string `json:"asset"`
 	Borrowed      string `json:"borrowed"`
@@ -689,6 +746,8 @@ func (s *GetMarginAccountService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // MarginAccount define margin account info
+//
+//decimalgen:accessors
 type MarginAccount struct {
 	Created                    bool        `json:"created"`
 	BorrowEnabled              bool        `json:"borrowEnabled"`
@@ -706,6 +765,8 @@ type MarginAccount struct {
 }
 
 // UserAsset define user assets of margin account
+//
+//decimalgen:accessors
 type UserAsset struct {
 	Asset    string `json:"asset"`
 	Borrowed string `json:"borrowed"`
@@ -868,6 +929,8 @@ func (s *GetMarginPriceIndexService) Do(ctx context.Context, opts ...RequestOpti
 }
 
 // MarginPriceIndex define margin price index
+//
+//decimalgen:accessors
 type MarginPriceIndex struct {
 	CalcTime int64  `json:"calcTime"`
 	Price    string `json:"price"`
@@ -956,6 +1019,18 @@ func (s *ListMarginTradesService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Iterator walks the margin trades between startTime and endTime in milliseconds, in windows of 24 hours and pages of
+// 1000 trades. FromID is ignored.
+func (s *ListMarginTradesService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*TradeV3] {
+	s.fromID = nil
+	return common.NewCursorIterator(startTime, endTime, 24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*TradeV3, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx, opts...)
+		},
+		func(t *TradeV3) int64 { return t.Time },
+		func(t *TradeV3) int64 { return t.ID })
+}
+
 // GetMaxBorrowableService get max borrowable of asset
 // https://developers.binance.com/docs/margin_trading/borrow-and-repay/Query-Max-Borrow
 type GetMaxBorrowableService struct {
@@ -1000,6 +1075,8 @@ func (s *GetMaxBorrowableService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // MaxBorrowable define max borrowable response
+//
+//decimalgen:accessors
 type MaxBorrowable struct {
 	Amount      string `json:"amount"`      // account's currently max borrowable amount with sufficient system availability
 	BorrowLimit string `json:"borrowLimit"` // max borrowable amount limited by the account level
@@ -1038,6 +1115,8 @@ func (s *GetMaxTransferableService) Do(ctx context.Context, opts ...RequestOptio
 }
 
 // MaxTransferable define max transferable response
+//
+//decimalgen:accessors
 type MaxTransferable struct {
 	Amount string `json:"amount"`
 }
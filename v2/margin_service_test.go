@@ -253,6 +253,7 @@ func (s *marginTestSuite) TestListMarginLoans() {
 	data := []byte(`{
 		"rows": [
 		  {
+			"txId": 12807067523,
 			"asset": "BNB",
 			"principal": "0.84624403",
 			"timestamp": 1555056425000,
@@ -287,6 +288,7 @@ func (s *marginTestSuite) TestListMarginLoans() {
 	e := &MarginLoanResponse{
 		Rows: []MarginLoan{
 			{
+				TxID:      12807067523,
 				Asset:     asset,
 				Principal: "0.84624403",
 				Timestamp: 1555056425000,
@@ -309,6 +311,7 @@ func (s *marginTestSuite) assertMarginLoanResponseEqual(e, a *MarginLoanResponse
 
 func (s *marginTestSuite) assertMarginLoanEqual(e, a *MarginLoan) {
 	r := s.r()
+	r.Equal(e.TxID, a.TxID, "TxID")
 	r.Equal(e.Asset, a.Asset, "Asset")
 	r.Equal(e.Principal, a.Principal, "Principal")
 	r.Equal(e.Timestamp, a.Timestamp, "Timestamp")
@@ -0,0 +1,234 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// MyTradesWsService queries trades of a symbol
+type MyTradesWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
+	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
+}
+
+// NewMyTradesWsService init MyTradesWsService
+func NewMyTradesWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*MyTradesWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MyTradesWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
+	}, nil
+}
+
+// NewMyTradesWsServiceWithSession init MyTradesWsService sending its requests on the connection of session
+func NewMyTradesWsServiceWithSession(session *SessionWsService) *MyTradesWsService {
+	return &MyTradesWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
+// MyTradesWsRequest parameters for 'myTrades' websocket API
+type MyTradesWsRequest struct {
+	symbol     string
+	orderID    *int64
+	startTime  *int64
+	endTime    *int64
+	fromID     *int64
+	limit      *int
+	recvWindow *uint16
+}
+
+// NewMyTradesWsRequest init MyTradesWsRequest
+func NewMyTradesWsRequest() *MyTradesWsRequest {
+	return &MyTradesWsRequest{}
+}
+
+func (s *MyTradesWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *MyTradesWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.fromID != nil {
+		m["fromId"] = *s.fromID
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'myTrades' request
+func (s *MyTradesWsService) Do(requestID string, request *MyTradesWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.MyTradesSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'myTrades' request and receives response
+func (s *MyTradesWsService) SyncDo(requestID string, request *MyTradesWsRequest) (*MyTradesWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.MyTradesSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	myTradesWsResponse := &MyTradesWsResponse{}
+	if err := json.Unmarshal(response, myTradesWsResponse); err != nil {
+		return nil, err
+	}
+	if myTradesWsResponse.Error != nil {
+		myTradesWsResponse.Error.StatusCode = myTradesWsResponse.Status
+		s.TimeSync.CheckError(myTradesWsResponse.Error)
+		return nil, myTradesWsResponse.Error
+	}
+
+	return myTradesWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *MyTradesWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *MyTradesWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *MyTradesWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *MyTradesWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *MyTradesWsRequest) Symbol(symbol string) *MyTradesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *MyTradesWsRequest) OrderID(orderID int64) *MyTradesWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set startTime
+func (s *MyTradesWsRequest) StartTime(startTime int64) *MyTradesWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *MyTradesWsRequest) EndTime(endTime int64) *MyTradesWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// FromID set fromID
+func (s *MyTradesWsRequest) FromID(fromID int64) *MyTradesWsRequest {
+	s.fromID = &fromID
+	return s
+}
+
+// Limit set limit
+func (s *MyTradesWsRequest) Limit(limit int) *MyTradesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *MyTradesWsRequest) RecvWindow(recvWindow uint16) *MyTradesWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// MyTradesWsResponse define 'myTrades' websocket API response
+type MyTradesWsResponse struct {
+	Id     string     `json:"id"`
+	Status int        `json:"status"`
+	Result []*TradeV3 `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
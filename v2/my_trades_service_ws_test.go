@@ -0,0 +1,111 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *myTradesServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+	s.timeOffset = 0
+
+	s.requestID = "7e806254-d83e-4a1f-8d4a-b1bd2a8d7345"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.myTrades = &MyTradesWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.myTradesRequest = NewMyTradesWsRequest().
+		Symbol("BTCUSDT").
+		FromID(1650)
+}
+
+func (s *myTradesServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type myTradesServiceWsTestSuite struct {
+	suite.Suite
+	apiKey     string
+	secretKey  string
+	signedKey  string
+	timeOffset int64
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	myTrades        *MyTradesWsService
+	myTradesRequest *MyTradesWsRequest
+}
+
+func TestMyTradesServiceWs(t *testing.T) {
+	suite.Run(t, new(myTradesServiceWsTestSuite))
+}
+
+func (s *myTradesServiceWsTestSuite) TestMyTrades() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.myTrades.Do(s.requestID, s.myTradesRequest)
+	s.NoError(err)
+}
+
+func (s *myTradesServiceWsTestSuite) TestMyTrades_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.myTrades.Do("", s.myTradesRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *myTradesServiceWsTestSuite) TestMyTrades_EmptyApiKey() {
+	s.myTrades.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.myTrades.Do(s.requestID, s.myTradesRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *myTradesServiceWsTestSuite) TestMyTradesSync() {
+	rawResponseData := []byte(`{"id":"7e806254-d83e-4a1f-8d4a-b1bd2a8d7345","status":200,"result":[{"symbol":"BTCUSDT","id":1650,"orderId":12569099453,"orderListId":-1,"price":"23416.50000000","qty":"0.00212000","quoteQty":"49.64298000","commission":"0.00000000","commissionAsset":"BNB","time":1660801715793,"isBuyer":false,"isMaker":true,"isBestMatch":true}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.myTrades.SyncDo(s.requestID, s.myTradesRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal(int64(1650), response.Result[0].ID)
+	s.True(response.Result[0].IsMaker)
+}
+
+func (s *myTradesServiceWsTestSuite) TestMyTradesSync_Error() {
+	rawResponseData := []byte(`{"id":"7e806254-d83e-4a1f-8d4a-b1bd2a8d7345","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.myTrades.SyncDo(s.requestID, s.myTradesRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *myTradesServiceWsTestSuite) TestMyTradesSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.myTrades.SyncDo(s.requestID, s.myTradesRequest)
+	s.Nil(response)
+	s.Error(err)
+}
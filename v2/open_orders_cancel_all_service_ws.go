@@ -0,0 +1,194 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OpenOrdersCancelAllWsService cancels all open orders and order lists of a symbol
+type OpenOrdersCancelAllWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
+	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
+}
+
+// NewOpenOrdersCancelAllWsService init OpenOrdersCancelAllWsService
+func NewOpenOrdersCancelAllWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OpenOrdersCancelAllWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenOrdersCancelAllWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
+	}, nil
+}
+
+// NewOpenOrdersCancelAllWsServiceWithSession init OpenOrdersCancelAllWsService sending its requests on the connection of session
+func NewOpenOrdersCancelAllWsServiceWithSession(session *SessionWsService) *OpenOrdersCancelAllWsService {
+	return &OpenOrdersCancelAllWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
+// OpenOrdersCancelAllWsRequest parameters for 'openOrders.cancelAll' websocket API
+type OpenOrdersCancelAllWsRequest struct {
+	symbol     string
+	recvWindow *uint16
+}
+
+// NewOpenOrdersCancelAllWsRequest init OpenOrdersCancelAllWsRequest
+func NewOpenOrdersCancelAllWsRequest() *OpenOrdersCancelAllWsRequest {
+	return &OpenOrdersCancelAllWsRequest{}
+}
+
+func (s *OpenOrdersCancelAllWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OpenOrdersCancelAllWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'openOrders.cancelAll' request
+func (s *OpenOrdersCancelAllWsService) Do(requestID string, request *OpenOrdersCancelAllWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.OpenOrdersCancelAllSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'openOrders.cancelAll' request and receives response
+func (s *OpenOrdersCancelAllWsService) SyncDo(requestID string, request *OpenOrdersCancelAllWsRequest) (*CancelOpenOrdersWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.OpenOrdersCancelAllSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelOpenOrdersWsResponse := &CancelOpenOrdersWsResponse{}
+	if err := json.Unmarshal(response, cancelOpenOrdersWsResponse); err != nil {
+		return nil, err
+	}
+	if cancelOpenOrdersWsResponse.Error != nil {
+		cancelOpenOrdersWsResponse.Error.StatusCode = cancelOpenOrdersWsResponse.Status
+		s.TimeSync.CheckError(cancelOpenOrdersWsResponse.Error)
+		return nil, cancelOpenOrdersWsResponse.Error
+	}
+
+	return cancelOpenOrdersWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OpenOrdersCancelAllWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OpenOrdersCancelAllWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OpenOrdersCancelAllWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OpenOrdersCancelAllWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *OpenOrdersCancelAllWsRequest) Symbol(symbol string) *OpenOrdersCancelAllWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OpenOrdersCancelAllWsRequest) RecvWindow(recvWindow uint16) *OpenOrdersCancelAllWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// CancelOpenOrdersWsResponse define 'openOrders.cancelAll' websocket API response
+type CancelOpenOrdersWsResponse struct {
+	Id     string                 `json:"id"`
+	Status int                    `json:"status"`
+	Result CancelOpenOrdersResult `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// CancelOpenOrdersResult define canceled orders and order lists of 'openOrders.cancelAll'
+type CancelOpenOrdersResult struct {
+	CancelOpenOrdersResponse
+}
+
+// UnmarshalJSON splits the canceled orders and order lists
+func (r *CancelOpenOrdersResult) UnmarshalJSON(data []byte) error {
+	return r.parse(data)
+}
@@ -0,0 +1,110 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *openOrdersCancelAllServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+	s.timeOffset = 0
+
+	s.requestID = "f83625c6-40ba-4f60-908a-7c9d92dc9307"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.openOrdersCancelAll = &OpenOrdersCancelAllWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.openOrdersCancelAllRequest = NewOpenOrdersCancelAllWsRequest().
+		Symbol("BTCUSDT")
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type openOrdersCancelAllServiceWsTestSuite struct {
+	suite.Suite
+	apiKey     string
+	secretKey  string
+	signedKey  string
+	timeOffset int64
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	openOrdersCancelAll        *OpenOrdersCancelAllWsService
+	openOrdersCancelAllRequest *OpenOrdersCancelAllWsRequest
+}
+
+func TestOpenOrdersCancelAllServiceWs(t *testing.T) {
+	suite.Run(t, new(openOrdersCancelAllServiceWsTestSuite))
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestOpenOrdersCancelAll() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.openOrdersCancelAll.Do(s.requestID, s.openOrdersCancelAllRequest)
+	s.NoError(err)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestOpenOrdersCancelAll_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.openOrdersCancelAll.Do("", s.openOrdersCancelAllRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestOpenOrdersCancelAll_EmptyApiKey() {
+	s.openOrdersCancelAll.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.openOrdersCancelAll.Do(s.requestID, s.openOrdersCancelAllRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestOpenOrdersCancelAllSync() {
+	rawResponseData := []byte(`{"id":"f83625c6-40ba-4f60-908a-7c9d92dc9307","status":200,"result":[{"symbol":"BTCUSDT","origClientOrderId":"KZJijIsAFf5BU5oxkWTAU3","orderId":0,"orderListId":-1,"clientOrderId":"8epSIUMvNCknntXcSzWs7H","transactTime":1684804350068,"price":"0.10000000","origQty":"1.00000000","executedQty":"0.00000000","cummulativeQuoteQty":"0.00000000","status":"CANCELED","timeInForce":"GTC","type":"LIMIT","side":"BUY","selfTradePreventionMode":"NONE"},{"orderListId":1929,"contingencyType":"OCO","listStatusType":"ALL_DONE","listOrderStatus":"ALL_DONE","listClientOrderId":"2inzWQdDvZLHbbAmAozX2N","transactionTime":1585230948299,"symbol":"BTCUSDT","orders":[{"symbol":"BTCUSDT","orderId":20,"clientOrderId":"CwOOIPHSmYywx6jZX77TdL"},{"symbol":"BTCUSDT","orderId":21,"clientOrderId":"461cPg51vQjV3zIMOXNz39"}],"orderReports":[]}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.openOrdersCancelAll.SyncDo(s.requestID, s.openOrdersCancelAllRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result.Orders, 1)
+	s.Require().Len(response.Result.OCOOrders, 1)
+	s.Equal(int64(1929), response.Result.OCOOrders[0].OrderListID)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestOpenOrdersCancelAllSync_Error() {
+	rawResponseData := []byte(`{"id":"f83625c6-40ba-4f60-908a-7c9d92dc9307","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.openOrdersCancelAll.SyncDo(s.requestID, s.openOrdersCancelAllRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestOpenOrdersCancelAllSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.openOrdersCancelAll.SyncDo(s.requestID, s.openOrdersCancelAllRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -0,0 +1,185 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OpenOrdersStatusWsService queries open orders of a symbol or of all symbols
+type OpenOrdersStatusWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
+	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
+}
+
+// NewOpenOrdersStatusWsService init OpenOrdersStatusWsService
+func NewOpenOrdersStatusWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OpenOrdersStatusWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenOrdersStatusWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
+	}, nil
+}
+
+// NewOpenOrdersStatusWsServiceWithSession init OpenOrdersStatusWsService sending its requests on the connection of session
+func NewOpenOrdersStatusWsServiceWithSession(session *SessionWsService) *OpenOrdersStatusWsService {
+	return &OpenOrdersStatusWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
+// OpenOrdersStatusWsRequest parameters for 'openOrders.status' websocket API
+type OpenOrdersStatusWsRequest struct {
+	symbol     *string
+	recvWindow *uint16
+}
+
+// NewOpenOrdersStatusWsRequest init OpenOrdersStatusWsRequest
+func NewOpenOrdersStatusWsRequest() *OpenOrdersStatusWsRequest {
+	return &OpenOrdersStatusWsRequest{}
+}
+
+func (s *OpenOrdersStatusWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OpenOrdersStatusWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'openOrders.status' request
+func (s *OpenOrdersStatusWsService) Do(requestID string, request *OpenOrdersStatusWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.OpenOrdersStatusSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'openOrders.status' request and receives response
+func (s *OpenOrdersStatusWsService) SyncDo(requestID string, request *OpenOrdersStatusWsRequest) (*OpenOrdersStatusWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
+		websocket.OpenOrdersStatusSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	openOrdersStatusWsResponse := &OpenOrdersStatusWsResponse{}
+	if err := json.Unmarshal(response, openOrdersStatusWsResponse); err != nil {
+		return nil, err
+	}
+	if openOrdersStatusWsResponse.Error != nil {
+		openOrdersStatusWsResponse.Error.StatusCode = openOrdersStatusWsResponse.Status
+		s.TimeSync.CheckError(openOrdersStatusWsResponse.Error)
+		return nil, openOrdersStatusWsResponse.Error
+	}
+
+	return openOrdersStatusWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OpenOrdersStatusWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OpenOrdersStatusWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OpenOrdersStatusWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OpenOrdersStatusWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *OpenOrdersStatusWsRequest) Symbol(symbol string) *OpenOrdersStatusWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OpenOrdersStatusWsRequest) RecvWindow(recvWindow uint16) *OpenOrdersStatusWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// OpenOrdersStatusWsResponse define 'openOrders.status' websocket API response
+type OpenOrdersStatusWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result []*Order `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
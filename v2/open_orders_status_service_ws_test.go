@@ -0,0 +1,109 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *openOrdersStatusServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+	s.timeOffset = 0
+
+	s.requestID = "fc8de1c2-7ee8-4057-a81d-5f17828bc1a4"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.openOrdersStatus = &OpenOrdersStatusWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.openOrdersStatusRequest = NewOpenOrdersStatusWsRequest().
+		Symbol("BTCUSDT")
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type openOrdersStatusServiceWsTestSuite struct {
+	suite.Suite
+	apiKey     string
+	secretKey  string
+	signedKey  string
+	timeOffset int64
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	openOrdersStatus        *OpenOrdersStatusWsService
+	openOrdersStatusRequest *OpenOrdersStatusWsRequest
+}
+
+func TestOpenOrdersStatusServiceWs(t *testing.T) {
+	suite.Run(t, new(openOrdersStatusServiceWsTestSuite))
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TestOpenOrdersStatus() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.openOrdersStatus.Do(s.requestID, s.openOrdersStatusRequest)
+	s.NoError(err)
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TestOpenOrdersStatus_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.openOrdersStatus.Do("", s.openOrdersStatusRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TestOpenOrdersStatus_EmptyApiKey() {
+	s.openOrdersStatus.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.openOrdersStatus.Do(s.requestID, s.openOrdersStatusRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TestOpenOrdersStatusSync() {
+	rawResponseData := []byte(`{"id":"fc8de1c2-7ee8-4057-a81d-5f17828bc1a4","status":200,"result":[{"symbol":"BTCUSDT","orderId":12569099453,"orderListId":-1,"clientOrderId":"4d96324ff9d44481926157","price":"23416.10000000","origQty":"0.00847000","executedQty":"0.00720000","cummulativeQuoteQty":"172.43931000","status":"PARTIALLY_FILLED","timeInForce":"GTC","type":"LIMIT","side":"SELL","stopPrice":"0.00000000","icebergQty":"0.00000000","time":1660801715639,"updateTime":1660801717945,"isWorking":true,"origQuoteOrderQty":"0.00000000"}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.openOrdersStatus.SyncDo(s.requestID, s.openOrdersStatusRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal(OrderStatusTypePartiallyFilled, response.Result[0].Status)
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TestOpenOrdersStatusSync_Error() {
+	rawResponseData := []byte(`{"id":"fc8de1c2-7ee8-4057-a81d-5f17828bc1a4","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.openOrdersStatus.SyncDo(s.requestID, s.openOrdersStatusRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TestOpenOrdersStatusSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.openOrdersStatus.SyncDo(s.requestID, s.openOrdersStatusRequest)
+	s.Nil(response)
+	s.Error(err)
+}
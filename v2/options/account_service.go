@@ -33,6 +33,7 @@ func (s *AccountService) Do(ctx context.Context, opts ...RequestOption) (res *Ac
 	return res, nil
 }
 
+//decimalgen:accessors
 type Asset struct {
 	Asset         string `json:"asset"`
 	MarginBalance string `json:"marginBalance"`
@@ -42,6 +43,7 @@ type Asset struct {
 	UnrealizedPNL string `json:"unrealizedPNL"`
 }
 
+//decimalgen:accessors
 type Greek struct {
 	Underlying string `json:"underlying"`
 	Delta      string `json:"delta"`
@@ -17,6 +17,9 @@ import (
 	"github.com/bitly/go-simplejson"
 )
 
+//go:generate go run ../internal/decimalgen
+//go:generate go run ../internal/streamgen
+
 // SideType define side type of order
 type SideType string
 
@@ -254,6 +257,13 @@ type Client struct {
 
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+
+	// TimeSync keeps the offset to the server clock, if set its offset is used instead of TimeOffset
+	TimeSync *common.TimeSync
+	// Signer signs the requests instead of SecretKey and KeyType, if set
+	Signer common.Signer
+
+	signers common.SignerCache
 }
 
 func (c *Client) debug(format string, v ...any) {
@@ -262,6 +272,18 @@ func (c *Client) debug(format string, v ...any) {
 	}
 }
 
+// signer returns Signer if it is set, otherwise the signer of SecretKey and KeyType
+func (c *Client) signer() (common.Signer, error) {
+	if c.Signer != nil {
+		return c.Signer, nil
+	}
+	kt := c.KeyType
+	if kt == "" {
+		kt = common.KeyTypeHmac
+	}
+	return c.signers.Signer(kt, c.SecretKey)
+}
+
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	// set request options from user
 	for _, opt := range opts {
@@ -277,7 +299,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.TimeSync.OffsetOr(c.TimeOffset))
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -293,22 +315,18 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
 		header.Set("X-MBX-APIKEY", c.APIKey)
 	}
-	kt := c.KeyType
-	if kt == "" {
-		kt = common.KeyTypeHmac
-	}
-	sf, err := common.SignFunc(kt)
-	if err != nil {
-		return err
-	}
 	if r.secType == secTypeSigned {
+		signer, err := c.signer()
+		if err != nil {
+			return err
+		}
 		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		sign, err := sf(c.SecretKey, raw)
+		sign, err := signer.Sign(raw)
 		if err != nil {
 			return err
 		}
 		v := url.Values{}
-		v.Set(signatureKey, *sign)
+		v.Set(signatureKey, sign)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -375,6 +393,11 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Header = res.Header
+		apiErr.Method = r.method
+		apiErr.Endpoint = r.endpoint
+		c.TimeSync.CheckError(apiErr)
 		return nil, &res.Header, apiErr
 	}
 	return data, &res.Header, nil
@@ -539,3 +562,39 @@ func (c *Client) NewKeepaliveUserStreamService() *KeepaliveUserStreamService {
 func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
 	return &CloseUserStreamService{c: c}
 }
+
+// NewSetMMPService init setting market maker protection service
+// POST /eapi/v1/mmpSet
+func (c *Client) NewSetMMPService() *SetMMPService {
+	return &SetMMPService{c: c}
+}
+
+// NewGetMMPService init getting market maker protection service
+// GET /eapi/v1/mmp
+func (c *Client) NewGetMMPService() *GetMMPService {
+	return &GetMMPService{c: c}
+}
+
+// NewResetMMPService init resetting market maker protection service
+// POST /eapi/v1/mmpReset
+func (c *Client) NewResetMMPService() *ResetMMPService {
+	return &ResetMMPService{c: c}
+}
+
+// NewSetCountdownCancelAllService init setting auto-cancel countdown service
+// POST /eapi/v1/countdownCancelAll
+func (c *Client) NewSetCountdownCancelAllService() *SetCountdownCancelAllService {
+	return &SetCountdownCancelAllService{c: c}
+}
+
+// NewGetCountdownCancelAllService init getting auto-cancel countdown service
+// GET /eapi/v1/countdownCancelAll
+func (c *Client) NewGetCountdownCancelAllService() *GetCountdownCancelAllService {
+	return &GetCountdownCancelAllService{c: c}
+}
+
+// NewCountdownCancelAllHeartBeatService init auto-cancel countdown heartbeat service
+// POST /eapi/v1/countdownCancelAllHeartBeat
+func (c *Client) NewCountdownCancelAllHeartBeatService() *CountdownCancelAllHeartBeatService {
+	return &CountdownCancelAllHeartBeatService{c: c}
+}
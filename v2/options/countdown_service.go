@@ -0,0 +1,227 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// SetCountdownCancelAllService set the auto-cancel countdown of an underlying, all open orders of the underlying are
+// cancelled if no heartbeat is received within the countdown time
+// POST /eapi/v1/countdownCancelAll
+type SetCountdownCancelAllService struct {
+	c             *Client
+	underlying    string
+	countdownTime int64
+}
+
+// CountdownCancelAllRsp define response of setting countdown
+type CountdownCancelAllRsp struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// Underlying set underlying
+func (s *SetCountdownCancelAllService) Underlying(underlying string) *SetCountdownCancelAllService {
+	s.underlying = underlying
+	return s
+}
+
+// CountdownTime set countdown time in milliseconds, at least 5000, 0 disables the countdown
+func (s *SetCountdownCancelAllService) CountdownTime(countdownTime int64) *SetCountdownCancelAllService {
+	s.countdownTime = countdownTime
+	return s
+}
+
+// Do send request
+func (s *SetCountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAllRsp, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"underlying":    s.underlying,
+		"countdownTime": s.countdownTime,
+	})
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAllRsp)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAll define auto-cancel countdown config of an underlying
+type CountdownCancelAll struct {
+	Underlying    string `json:"underlying"`
+	CountdownTime int64  `json:"countdownTime"`
+}
+
+// GetCountdownCancelAllService get the auto-cancel countdown configs
+// GET /eapi/v1/countdownCancelAll
+type GetCountdownCancelAllService struct {
+	c          *Client
+	underlying string
+}
+
+// Underlying set underlying, the configs of all underlyings are returned if it is not set
+func (s *GetCountdownCancelAllService) Underlying(underlying string) *GetCountdownCancelAllService {
+	s.underlying = underlying
+	return s
+}
+
+// Do send request
+func (s *GetCountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res []*CountdownCancelAll, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	if s.underlying != "" {
+		r.setParam("underlying", s.underlying)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*CountdownCancelAll, 0)
+	err = json.Unmarshal(common.ToJSONList(data), &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAllHeartBeatService reset the countdowns of the underlyings
+// POST /eapi/v1/countdownCancelAllHeartBeat
+type CountdownCancelAllHeartBeatService struct {
+	c           *Client
+	underlyings []string
+}
+
+// CountdownCancelAllHeartBeatRsp define response of heartbeat, it lists the underlyings whose countdown was reset
+type CountdownCancelAllHeartBeatRsp struct {
+	Underlyings []string `json:"underlyings"`
+}
+
+// Underlyings set underlyings
+func (s *CountdownCancelAllHeartBeatService) Underlyings(underlyings ...string) *CountdownCancelAllHeartBeatService {
+	s.underlyings = underlyings
+	return s
+}
+
+// Do send request
+func (s *CountdownCancelAllHeartBeatService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAllHeartBeatRsp, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/countdownCancelAllHeartBeat",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"underlyings": strings.Join(s.underlyings, ","),
+	})
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAllHeartBeatRsp)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownHeartbeat sends countdownCancelAllHeartBeat for the underlyings in the background. Interval must be
+// shorter than the countdown time set with SetCountdownCancelAllService, otherwise the orders are cancelled between
+// two heartbeats.
+type CountdownHeartbeat struct {
+	c           *Client
+	underlyings []string
+
+	// Interval between heartbeats
+	Interval time.Duration
+	// ErrHandler receives errors of background heartbeats
+	ErrHandler func(err error)
+
+	mu    sync.Mutex
+	stopC chan struct{}
+	doneC chan struct{}
+}
+
+// NewCountdownHeartbeat init countdown heartbeat for the underlyings
+func (c *Client) NewCountdownHeartbeat(interval time.Duration, underlyings ...string) *CountdownHeartbeat {
+	return &CountdownHeartbeat{
+		c:           c,
+		underlyings: underlyings,
+		Interval:    interval,
+	}
+}
+
+// Beat sends a heartbeat
+func (h *CountdownHeartbeat) Beat(ctx context.Context) error {
+	_, err := h.c.NewCountdownCancelAllHeartBeatService().Underlyings(h.underlyings...).Do(ctx)
+	return err
+}
+
+// Start sends a heartbeat and keeps sending them in the background until Stop is called or ctx is done. Interval must
+// be positive.
+func (h *CountdownHeartbeat) Start(ctx context.Context) error {
+	if h.Interval <= 0 {
+		return fmt.Errorf("invalid countdown heartbeat interval %s", h.Interval)
+	}
+	if err := h.Beat(ctx); err != nil {
+		return err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.stopC != nil {
+		return errors.New("countdown heartbeat already started")
+	}
+	h.stopC = make(chan struct{})
+	h.doneC = make(chan struct{})
+	go h.run(ctx, h.stopC, h.doneC)
+	return nil
+}
+
+// Stop stops sending heartbeats and waits for the heartbeat in flight, the countdown itself is left as it is
+func (h *CountdownHeartbeat) Stop() {
+	h.mu.Lock()
+	stopC, doneC := h.stopC, h.doneC
+	h.stopC, h.doneC = nil, nil
+	h.mu.Unlock()
+	if stopC != nil {
+		close(stopC)
+		<-doneC
+	}
+}
+
+func (h *CountdownHeartbeat) run(ctx context.Context, stopC, doneC chan struct{}) {
+	defer close(doneC)
+	ticker := time.NewTicker(h.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopC:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := h.Beat(ctx); err != nil && h.ErrHandler != nil {
+			h.ErrHandler(err)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package options
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type countdownServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCountdownService(t *testing.T) {
+	suite.Run(t, new(countdownServiceTestSuite))
+}
+
+func (s *countdownServiceTestSuite) TestSetCountdownCancelAll() {
+	data := []byte(`{"code": 0, "msg": "success"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"underlying":    "BTCUSDT",
+			"countdownTime": 30000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewSetCountdownCancelAllService().Underlying("BTCUSDT").
+		CountdownTime(30000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&CountdownCancelAllRsp{Code: 0, Msg: "success"}, res)
+}
+
+func (s *countdownServiceTestSuite) TestGetCountdownCancelAll() {
+	data := []byte(`{"underlying": "BTCUSDT", "countdownTime": 30000}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("underlying", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetCountdownCancelAllService().Underlying("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*CountdownCancelAll{{Underlying: "BTCUSDT", CountdownTime: 30000}}, res)
+}
+
+func (s *countdownServiceTestSuite) TestGetCountdownCancelAll_AllUnderlyings() {
+	data := []byte(`[{"underlying": "BTCUSDT", "countdownTime": 30000}, {"underlying": "ETHUSDT", "countdownTime": 0}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+
+	res, err := s.client.NewGetCountdownCancelAllService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 2)
+	s.r().Equal("ETHUSDT", res[1].Underlying)
+}
+
+func (s *countdownServiceTestSuite) TestCountdownCancelAllHeartBeat() {
+	data := []byte(`{"underlyings": ["BTCUSDT", "ETHUSDT"]}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParam("underlyings", "BTCUSDT,ETHUSDT")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCountdownCancelAllHeartBeatService().
+		Underlyings("BTCUSDT", "ETHUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]string{"BTCUSDT", "ETHUSDT"}, res.Underlyings)
+}
+
+// mockHeartbeats answers the requests with a heartbeat response, or an error after the first failAfter requests
+func (s *countdownServiceTestSuite) mockHeartbeats(failAfter int32) *int32 {
+	var count int32
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&count, 1)
+		statusCode, body := http.StatusOK, `{"underlyings": ["BTCUSDT"]}`
+		if failAfter >= 0 && n > failAfter {
+			statusCode, body = http.StatusBadRequest, `{"code": -1021, "msg": "Timestamp for this request is outside of the recvWindow."}`
+		}
+		return &http.Response{
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+			StatusCode: statusCode,
+		}, nil
+	}
+	return &count
+}
+
+func (s *countdownServiceTestSuite) TestCountdownHeartbeat() {
+	count := s.mockHeartbeats(-1)
+	h := s.client.NewCountdownHeartbeat(10*time.Millisecond, "BTCUSDT")
+
+	s.r().NoError(h.Start(newContext()))
+	s.r().Error(h.Start(newContext()))
+	s.r().Eventually(func() bool {
+		return atomic.LoadInt32(count) >= 4
+	}, time.Second, 5*time.Millisecond)
+	h.Stop()
+
+	stopped := atomic.LoadInt32(count)
+	time.Sleep(30 * time.Millisecond)
+	s.r().Equal(stopped, atomic.LoadInt32(count))
+	h.Stop()
+}
+
+func (s *countdownServiceTestSuite) TestCountdownHeartbeat_ContextDone() {
+	count := s.mockHeartbeats(-1)
+	h := s.client.NewCountdownHeartbeat(10*time.Millisecond, "BTCUSDT")
+
+	ctx, cancel := context.WithCancel(newContext())
+	s.r().NoError(h.Start(ctx))
+	cancel()
+	time.Sleep(30 * time.Millisecond)
+	s.r().Equal(int32(1), atomic.LoadInt32(count))
+	h.Stop()
+}
+
+func (s *countdownServiceTestSuite) TestCountdownHeartbeat_Error() {
+	s.mockHeartbeats(0)
+	h := s.client.NewCountdownHeartbeat(10*time.Millisecond, "BTCUSDT")
+	s.r().ErrorIs(h.Start(newContext()), common.ErrTimestampOutsideRecvWindow)
+}
+
+func (s *countdownServiceTestSuite) TestCountdownHeartbeat_InvalidInterval() {
+	count := s.mockHeartbeats(-1)
+	h := s.client.NewCountdownHeartbeat(0, "BTCUSDT")
+	s.r().Error(h.Start(newContext()))
+	s.r().Equal(int32(0), atomic.LoadInt32(count))
+	h.Stop()
+}
+
+func (s *countdownServiceTestSuite) TestCountdownHeartbeat_ErrHandler() {
+	s.mockHeartbeats(1)
+	h := s.client.NewCountdownHeartbeat(10*time.Millisecond, "BTCUSDT")
+	errC := make(chan error, 1)
+	h.ErrHandler = func(err error) {
+		select {
+		case errC <- err:
+		default:
+		}
+	}
+
+	s.r().NoError(h.Start(newContext()))
+	defer h.Stop()
+	select {
+	case err := <-errC:
+		s.r().ErrorIs(err, common.ErrTimestampOutsideRecvWindow)
+	case <-time.After(time.Second):
+		s.Fail("no heartbeat error")
+	}
+}
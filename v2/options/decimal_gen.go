@@ -0,0 +1,774 @@
+// Code generated by decimalgen; DO NOT EDIT.
+
+package options
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// AvailableDecimal returns Available as a decimal, zero if it is empty
+func (v *Asset) AvailableDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Asset.Available", v.Available)
+}
+
+// EquityDecimal returns Equity as a decimal, zero if it is empty
+func (v *Asset) EquityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Asset.Equity", v.Equity)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *Asset) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Asset.Locked", v.Locked)
+}
+
+// MarginBalanceDecimal returns MarginBalance as a decimal, zero if it is empty
+func (v *Asset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Asset.MarginBalance", v.MarginBalance)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *Bill) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Bill.Amount", v.Amount)
+}
+
+// RealStrikePriceDecimal returns RealStrikePrice as a decimal, zero if it is empty
+func (v *ExerciseHistory) RealStrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ExerciseHistory.RealStrikePrice", v.RealStrikePrice)
+}
+
+// StrikePriceDecimal returns StrikePrice as a decimal, zero if it is empty
+func (v *ExerciseHistory) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ExerciseHistory.StrikePrice", v.StrikePrice)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *ExerciseRecord) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ExerciseRecord.Amount", v.Amount)
+}
+
+// ExercisePriceDecimal returns ExercisePrice as a decimal, zero if it is empty
+func (v *ExerciseRecord) ExercisePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ExerciseRecord.ExercisePrice", v.ExercisePrice)
+}
+
+// FeeDecimal returns Fee as a decimal, zero if it is empty
+func (v *ExerciseRecord) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ExerciseRecord.Fee", v.Fee)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *ExerciseRecord) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ExerciseRecord.MarkPrice", v.MarkPrice)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *ExerciseRecord) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("ExerciseRecord.Quantity", v.Quantity)
+}
+
+// DeltaDecimal returns Delta as a decimal, zero if it is empty
+func (v *Greek) DeltaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Greek.Delta", v.Delta)
+}
+
+// GammaDecimal returns Gamma as a decimal, zero if it is empty
+func (v *Greek) GammaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Greek.Gamma", v.Gamma)
+}
+
+// ThetaDecimal returns Theta as a decimal, zero if it is empty
+func (v *Greek) ThetaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Greek.Theta", v.Theta)
+}
+
+// VegaDecimal returns Vega as a decimal, zero if it is empty
+func (v *Greek) VegaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Greek.Vega", v.Vega)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *HistoricalTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("HistoricalTrade.Price", v.Price)
+}
+
+// QtyDecimal returns Qty as a decimal, zero if it is empty
+func (v *HistoricalTrade) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("HistoricalTrade.Qty", v.Qty)
+}
+
+// QuoteQtyDecimal returns QuoteQty as a decimal, zero if it is empty
+func (v *HistoricalTrade) QuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("HistoricalTrade.QuoteQty", v.QuoteQty)
+}
+
+// IndexPriceDecimal returns IndexPrice as a decimal, zero if it is empty
+func (v *Index) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Index.IndexPrice", v.IndexPrice)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *Kline) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Amount", v.Amount)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *Kline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *Kline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *Kline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *Kline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Open", v.Open)
+}
+
+// TakerAmountDecimal returns TakerAmount as a decimal, zero if it is empty
+func (v *Kline) TakerAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.TakerAmount", v.TakerAmount)
+}
+
+// TakerVolumeDecimal returns TakerVolume as a decimal, zero if it is empty
+func (v *Kline) TakerVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.TakerVolume", v.TakerVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *Kline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Kline.Volume", v.Volume)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *LastTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LastTrade.Price", v.Price)
+}
+
+// QtyDecimal returns Qty as a decimal, zero if it is empty
+func (v *LastTrade) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LastTrade.Qty", v.Qty)
+}
+
+// MaxQuantityDecimal returns MaxQuantity as a decimal, zero if it is empty
+func (v *LotSizeFilter) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LotSizeFilter.MaxQuantity", v.MaxQuantity)
+}
+
+// MinQuantityDecimal returns MinQuantity as a decimal, zero if it is empty
+func (v *LotSizeFilter) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LotSizeFilter.MinQuantity", v.MinQuantity)
+}
+
+// QtyLimitDecimal returns QtyLimit as a decimal, zero if it is empty
+func (v *MMP) QtyLimitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MMP.QtyLimit", v.QtyLimit)
+}
+
+// AskIVDecimal returns AskIV as a decimal, zero if it is empty
+func (v *Mark) AskIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Mark.AskIV", v.AskIV)
+}
+
+// BidIVDecimal returns BidIV as a decimal, zero if it is empty
+func (v *Mark) BidIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Mark.BidIV", v.BidIV)
+}
+
+// DeltaDecimal returns Delta as a decimal, zero if it is empty
+func (v *Mark) DeltaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Mark.Delta", v.Delta)
+}
+
+// GammaDecimal returns Gamma as a decimal, zero if it is empty
+func (v *Mark) GammaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Mark.Gamma", v.Gamma)
+}
+
+// HighPriceLimitDecimal returns HighPriceLimit as a decimal, zero if it is empty
+func (v *Mark) HighPriceLimitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Mark.HighPriceLimit", v.HighPriceLimit)
+}
+
+// LowPriceLimitDecimal returns LowPriceLimit as a decimal, zero if it is empty
+func (v *Mark) LowPriceLimitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Mark.LowPriceLimit", v.LowPriceLimit)
+}
+
+// MarkIVDecimal returns MarkIV as a decimal, zero if it is empty
+func (v *Mark) MarkIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Mark.MarkIV", v.MarkIV)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *Mark) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Mark.MarkPrice", v.MarkPrice)
+}
+
+// RiskFreeInterestDecimal returns RiskFreeInterest as a decimal, zero if it is empty
+func (v *Mark) RiskFreeInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Mark.RiskFreeInterest", v.RiskFreeInterest)
+}
+
+// ThetaDecimal returns Theta as a decimal, zero if it is empty
+func (v *Mark) ThetaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Mark.Theta", v.Theta)
+}
+
+// VegaDecimal returns Vega as a decimal, zero if it is empty
+func (v *Mark) VegaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Mark.Vega", v.Vega)
+}
+
+// SumOpenInterestDecimal returns SumOpenInterest as a decimal, zero if it is empty
+func (v *OpenInterest) SumOpenInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OpenInterest.SumOpenInterest", v.SumOpenInterest)
+}
+
+// SumOpenInterestUsdDecimal returns SumOpenInterestUsd as a decimal, zero if it is empty
+func (v *OpenInterest) SumOpenInterestUsdDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OpenInterest.SumOpenInterestUsd", v.SumOpenInterestUsd)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *OptionSymbol) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OptionSymbol.InitialMargin", v.InitialMargin)
+}
+
+// MaintenanceMarginDecimal returns MaintenanceMargin as a decimal, zero if it is empty
+func (v *OptionSymbol) MaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OptionSymbol.MaintenanceMargin", v.MaintenanceMargin)
+}
+
+// MakerFeeRateDecimal returns MakerFeeRate as a decimal, zero if it is empty
+func (v *OptionSymbol) MakerFeeRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OptionSymbol.MakerFeeRate", v.MakerFeeRate)
+}
+
+// MaxQtyDecimal returns MaxQty as a decimal, zero if it is empty
+func (v *OptionSymbol) MaxQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OptionSymbol.MaxQty", v.MaxQty)
+}
+
+// MinInitialMarginDecimal returns MinInitialMargin as a decimal, zero if it is empty
+func (v *OptionSymbol) MinInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OptionSymbol.MinInitialMargin", v.MinInitialMargin)
+}
+
+// MinMaintenanceMarginDecimal returns MinMaintenanceMargin as a decimal, zero if it is empty
+func (v *OptionSymbol) MinMaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OptionSymbol.MinMaintenanceMargin", v.MinMaintenanceMargin)
+}
+
+// MinQtyDecimal returns MinQty as a decimal, zero if it is empty
+func (v *OptionSymbol) MinQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OptionSymbol.MinQty", v.MinQty)
+}
+
+// StrikePriceDecimal returns StrikePrice as a decimal, zero if it is empty
+func (v *OptionSymbol) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OptionSymbol.StrikePrice", v.StrikePrice)
+}
+
+// TakerFeeRateDecimal returns TakerFeeRate as a decimal, zero if it is empty
+func (v *OptionSymbol) TakerFeeRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("OptionSymbol.TakerFeeRate", v.TakerFeeRate)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *Order) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *Order) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.ExecutedQty", v.ExecutedQty)
+}
+
+// FeeDecimal returns Fee as a decimal, zero if it is empty
+func (v *Order) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.Fee", v.Fee)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *Order) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *Order) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Order.Quantity", v.Quantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *PL) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PL.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *PL) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PL.Quantity", v.Quantity)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *Position) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Position.EntryPrice", v.EntryPrice)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *Position) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Position.MarkPrice", v.MarkPrice)
+}
+
+// MarkValueDecimal returns MarkValue as a decimal, zero if it is empty
+func (v *Position) MarkValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Position.MarkValue", v.MarkValue)
+}
+
+// PositionCostDecimal returns PositionCost as a decimal, zero if it is empty
+func (v *Position) PositionCostDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Position.PositionCost", v.PositionCost)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *Position) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Position.Quantity", v.Quantity)
+}
+
+// ReducibleQtyDecimal returns ReducibleQty as a decimal, zero if it is empty
+func (v *Position) ReducibleQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Position.ReducibleQty", v.ReducibleQty)
+}
+
+// StrikePriceDecimal returns StrikePrice as a decimal, zero if it is empty
+func (v *Position) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Position.StrikePrice", v.StrikePrice)
+}
+
+// MaxPriceDecimal returns MaxPrice as a decimal, zero if it is empty
+func (v *PriceFilter) MaxPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceFilter.MaxPrice", v.MaxPrice)
+}
+
+// MinPriceDecimal returns MinPrice as a decimal, zero if it is empty
+func (v *PriceFilter) MinPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("PriceFilter.MinPrice", v.MinPrice)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *Ticker) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.Amount", v.Amount)
+}
+
+// AskPriceDecimal returns AskPrice as a decimal, zero if it is empty
+func (v *Ticker) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.AskPrice", v.AskPrice)
+}
+
+// BidPriceDecimal returns BidPrice as a decimal, zero if it is empty
+func (v *Ticker) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.BidPrice", v.BidPrice)
+}
+
+// ExercisePriceDecimal returns ExercisePrice as a decimal, zero if it is empty
+func (v *Ticker) ExercisePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.ExercisePrice", v.ExercisePrice)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *Ticker) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.High", v.High)
+}
+
+// LastPriceDecimal returns LastPrice as a decimal, zero if it is empty
+func (v *Ticker) LastPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.LastPrice", v.LastPrice)
+}
+
+// LastQtyDecimal returns LastQty as a decimal, zero if it is empty
+func (v *Ticker) LastQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.LastQty", v.LastQty)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *Ticker) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *Ticker) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.Open", v.Open)
+}
+
+// PriceChangeDecimal returns PriceChange as a decimal, zero if it is empty
+func (v *Ticker) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.PriceChange", v.PriceChange)
+}
+
+// PriceChangePercentDecimal returns PriceChangePercent as a decimal, zero if it is empty
+func (v *Ticker) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.PriceChangePercent", v.PriceChangePercent)
+}
+
+// StrikePriceDecimal returns StrikePrice as a decimal, zero if it is empty
+func (v *Ticker) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.StrikePrice", v.StrikePrice)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *Ticker) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Ticker.Volume", v.Volume)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *Trade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.Price", v.Price)
+}
+
+// QtyDecimal returns Qty as a decimal, zero if it is empty
+func (v *Trade) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.Qty", v.Qty)
+}
+
+// QuoteQtyDecimal returns QuoteQty as a decimal, zero if it is empty
+func (v *Trade) QuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Trade.QuoteQty", v.QuoteQty)
+}
+
+// FeeDecimal returns Fee as a decimal, zero if it is empty
+func (v *UserTrade) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserTrade.Fee", v.Fee)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UserTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserTrade.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *UserTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserTrade.Quantity", v.Quantity)
+}
+
+// RealizedProfitDecimal returns RealizedProfit as a decimal, zero if it is empty
+func (v *UserTrade) RealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UserTrade.RealizedProfit", v.RealizedProfit)
+}
+
+// BalanceDecimal returns Balance as a decimal, zero if it is empty
+func (v *WsBalance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.Balance", v.Balance)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *WsBalance) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.InitialMargin", v.InitialMargin)
+}
+
+// MaintenanceMarginDecimal returns MaintenanceMargin as a decimal, zero if it is empty
+func (v *WsBalance) MaintenanceMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.MaintenanceMargin", v.MaintenanceMargin)
+}
+
+// UnrealizedPnLDecimal returns UnrealizedPnL as a decimal, zero if it is empty
+func (v *WsBalance) UnrealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.UnrealizedPnL", v.UnrealizedPnL)
+}
+
+// FeeDecimal returns Fee as a decimal, zero if it is empty
+func (v *WsFilled) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFilled.Fee", v.Fee)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsFilled) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFilled.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *WsFilled) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFilled.Quantity", v.Quantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsIndexEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsIndexEvent.Price", v.Price)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *WsKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Close", v.Close)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *WsKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.High", v.High)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *WsKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Low", v.Low)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *WsKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Open", v.Open)
+}
+
+// QuoteVolumeDecimal returns QuoteVolume as a decimal, zero if it is empty
+func (v *WsKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.QuoteVolume", v.QuoteVolume)
+}
+
+// TakerQuoteVolumeDecimal returns TakerQuoteVolume as a decimal, zero if it is empty
+func (v *WsKline) TakerQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.TakerQuoteVolume", v.TakerQuoteVolume)
+}
+
+// TakerVolumeDecimal returns TakerVolume as a decimal, zero if it is empty
+func (v *WsKline) TakerVolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.TakerVolume", v.TakerVolume)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *WsKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsKline.Volume", v.Volume)
+}
+
+// AskIVDecimal returns AskIV as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) AskIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.AskIV", v.AskIV)
+}
+
+// BidIVDecimal returns BidIV as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) BidIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.BidIV", v.BidIV)
+}
+
+// DeltaDecimal returns Delta as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) DeltaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.Delta", v.Delta)
+}
+
+// GammaDecimal returns Gamma as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) GammaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.Gamma", v.Gamma)
+}
+
+// IndexPriceDecimal returns IndexPrice as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.IndexPrice", v.IndexPrice)
+}
+
+// MarkIVDecimal returns MarkIV as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) MarkIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.MarkIV", v.MarkIV)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.MarkPrice", v.MarkPrice)
+}
+
+// ThetaDecimal returns Theta as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) ThetaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.Theta", v.Theta)
+}
+
+// VegaDecimal returns Vega as a decimal, zero if it is empty
+func (v *WsMarkPriceEvent) VegaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarkPriceEvent.Vega", v.Vega)
+}
+
+// OpenInterestDecimal returns OpenInterest as a decimal, zero if it is empty
+func (v *WsOpenInterestEvent) OpenInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOpenInterestEvent.OpenInterest", v.OpenInterest)
+}
+
+// MinQuantityDecimal returns MinQuantity as a decimal, zero if it is empty
+func (v *WsOptionPairEvent) MinQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOptionPairEvent.MinQuantity", v.MinQuantity)
+}
+
+// StrikePriceDecimal returns StrikePrice as a decimal, zero if it is empty
+func (v *WsOptionPairEvent) StrikePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOptionPairEvent.StrikePrice", v.StrikePrice)
+}
+
+// ExecutedCostDecimal returns ExecutedCost as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) ExecutedCostDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.ExecutedCost", v.ExecutedCost)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.ExecutedQty", v.ExecutedQty)
+}
+
+// FeeDecimal returns Fee as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) FeeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.Fee", v.Fee)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.Quantity", v.Quantity)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *WsPosition) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.AvgPrice", v.AvgPrice)
+}
+
+// CountQtyDecimal returns CountQty as a decimal, zero if it is empty
+func (v *WsPosition) CountQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.CountQty", v.CountQty)
+}
+
+// ReduleQtyDecimal returns ReduleQty as a decimal, zero if it is empty
+func (v *WsPosition) ReduleQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.ReduleQty", v.ReduleQty)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *WsTickerEvent) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.Amount", v.Amount)
+}
+
+// AskIVDecimal returns AskIV as a decimal, zero if it is empty
+func (v *WsTickerEvent) AskIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.AskIV", v.AskIV)
+}
+
+// AskOpenPriceDecimal returns AskOpenPrice as a decimal, zero if it is empty
+func (v *WsTickerEvent) AskOpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.AskOpenPrice", v.AskOpenPrice)
+}
+
+// AskQtyDecimal returns AskQty as a decimal, zero if it is empty
+func (v *WsTickerEvent) AskQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.AskQty", v.AskQty)
+}
+
+// BidIVDecimal returns BidIV as a decimal, zero if it is empty
+func (v *WsTickerEvent) BidIVDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.BidIV", v.BidIV)
+}
+
+// BidOpenPriceDecimal returns BidOpenPrice as a decimal, zero if it is empty
+func (v *WsTickerEvent) BidOpenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.BidOpenPrice", v.BidOpenPrice)
+}
+
+// BidQtyDecimal returns BidQty as a decimal, zero if it is empty
+func (v *WsTickerEvent) BidQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.BidQty", v.BidQty)
+}
+
+// CloseDecimal returns Close as a decimal, zero if it is empty
+func (v *WsTickerEvent) CloseDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.Close", v.Close)
+}
+
+// DeltaDecimal returns Delta as a decimal, zero if it is empty
+func (v *WsTickerEvent) DeltaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.Delta", v.Delta)
+}
+
+// ExercisPriceDecimal returns ExercisPrice as a decimal, zero if it is empty
+func (v *WsTickerEvent) ExercisPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.ExercisPrice", v.ExercisPrice)
+}
+
+// GammaDecimal returns Gamma as a decimal, zero if it is empty
+func (v *WsTickerEvent) GammaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.Gamma", v.Gamma)
+}
+
+// HighDecimal returns High as a decimal, zero if it is empty
+func (v *WsTickerEvent) HighDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.High", v.High)
+}
+
+// HighPriceLimitDecimal returns HighPriceLimit as a decimal, zero if it is empty
+func (v *WsTickerEvent) HighPriceLimitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.HighPriceLimit", v.HighPriceLimit)
+}
+
+// LastQtyDecimal returns LastQty as a decimal, zero if it is empty
+func (v *WsTickerEvent) LastQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.LastQty", v.LastQty)
+}
+
+// LowDecimal returns Low as a decimal, zero if it is empty
+func (v *WsTickerEvent) LowDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.Low", v.Low)
+}
+
+// LowPriceLimitDecimal returns LowPriceLimit as a decimal, zero if it is empty
+func (v *WsTickerEvent) LowPriceLimitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.LowPriceLimit", v.LowPriceLimit)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *WsTickerEvent) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.MarkPrice", v.MarkPrice)
+}
+
+// OpenDecimal returns Open as a decimal, zero if it is empty
+func (v *WsTickerEvent) OpenDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.Open", v.Open)
+}
+
+// PriceChangeDecimal returns PriceChange as a decimal, zero if it is empty
+func (v *WsTickerEvent) PriceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.PriceChange", v.PriceChange)
+}
+
+// PriceChangePercentDecimal returns PriceChangePercent as a decimal, zero if it is empty
+func (v *WsTickerEvent) PriceChangePercentDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.PriceChangePercent", v.PriceChangePercent)
+}
+
+// RiskFreeInterestDecimal returns RiskFreeInterest as a decimal, zero if it is empty
+func (v *WsTickerEvent) RiskFreeInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.RiskFreeInterest", v.RiskFreeInterest)
+}
+
+// ThetaDecimal returns Theta as a decimal, zero if it is empty
+func (v *WsTickerEvent) ThetaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.Theta", v.Theta)
+}
+
+// VegaDecimal returns Vega as a decimal, zero if it is empty
+func (v *WsTickerEvent) VegaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.Vega", v.Vega)
+}
+
+// VolumeDecimal returns Volume as a decimal, zero if it is empty
+func (v *WsTickerEvent) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTickerEvent.Volume", v.Volume)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTradeEvent.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *WsTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsTradeEvent.Quantity", v.Quantity)
+}
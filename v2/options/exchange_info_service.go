@@ -65,6 +65,8 @@ type OptionAsset struct {
 }
 
 // Option Symbol
+//
+//decimalgen:accessors
 type OptionSymbol struct {
 	ContractId           int64            `json:"contractId"`
 	ExpiryDate           int64            `json:"expiryDate"`
@@ -89,6 +91,8 @@ type OptionSymbol struct {
 }
 
 // LotSizeFilter define lot size filter of symbol
+//
+//decimalgen:accessors
 type LotSizeFilter struct {
 	MaxQuantity string `json:"maxQty"`
 	MinQuantity string `json:"minQty"`
@@ -96,6 +100,8 @@ type LotSizeFilter struct {
 }
 
 // PriceFilter define price filter of symbol
+//
+//decimalgen:accessors
 type PriceFilter struct {
 	MaxPrice string `json:"maxPrice"`
 	MinPrice string `json:"minPrice"`
@@ -6,6 +6,7 @@ import (
 	"net/http"
 )
 
+//decimalgen:accessors
 type ExerciseHistory struct {
 	Symbol          string `json:"symbol"`
 	StrikePrice     string `json:"strikePrice"`
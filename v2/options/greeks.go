@@ -0,0 +1,293 @@
+package options
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// NetGreeks define net delta, gamma, vega and theta of positions
+type NetGreeks struct {
+	Delta float64
+	Gamma float64
+	Vega  float64
+	Theta float64
+}
+
+func (g NetGreeks) add(o NetGreeks, quantity float64) NetGreeks {
+	return NetGreeks{
+		Delta: g.Delta + o.Delta*quantity,
+		Gamma: g.Gamma + o.Gamma*quantity,
+		Vega:  g.Vega + o.Vega*quantity,
+		Theta: g.Theta + o.Theta*quantity,
+	}
+}
+
+// GreeksKey define the group of the net Greeks, the underlying (e.g. BTCUSDT) and the expiry date (e.g. 240628)
+type GreeksKey struct {
+	Underlying string
+	Expiry     string
+}
+
+// GreeksChangeHandler receives the net Greeks of a key after they changed
+type GreeksChangeHandler func(key GreeksKey, greeks NetGreeks)
+
+// GreeksAggregator joins positions with the Greeks of the mark price and computes the net Greeks per underlying and
+// expiry. It is loaded with Load, or SetSymbols, SetPositions and SetMarks, and kept up to date with UpdateMarkPrice
+// as WsMarkPriceHandler and UpdateUserData as WsUserDataHandler. The Greeks of the mark price are per unit of
+// quantity. The underlying of a symbol is taken from the symbols of the exchange info, or else from the quote asset
+// of its position.
+type GreeksAggregator struct {
+	// OnChange is called with the new net Greeks of every key changed by an update
+	OnChange GreeksChangeHandler
+	// ErrHandler receives the errors of UpdateMarkPrice and UpdateUserData, e.g. a number which doesn't parse. The
+	// symbols of the errors are skipped.
+	ErrHandler func(err error)
+
+	mu          sync.Mutex
+	underlyings map[string]string
+	positions   map[string]float64
+	keys        map[string]GreeksKey
+	greeks      map[string]NetGreeks
+	net         map[GreeksKey]NetGreeks
+}
+
+// NewGreeksAggregator init GreeksAggregator
+func NewGreeksAggregator() *GreeksAggregator {
+	return &GreeksAggregator{
+		underlyings: make(map[string]string),
+		positions:   make(map[string]float64),
+		keys:        make(map[string]GreeksKey),
+		greeks:      make(map[string]NetGreeks),
+		net:         make(map[GreeksKey]NetGreeks),
+	}
+}
+
+// Load loads the symbols, the positions and the mark price Greeks with c
+func (a *GreeksAggregator) Load(ctx context.Context, c *Client) error {
+	info, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	positions, err := c.NewPositionService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	marks, err := c.NewMarkService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	a.SetSymbols(info.OptionSymbols)
+	if err := a.SetMarks(marks); err != nil {
+		return err
+	}
+	return a.SetPositions(positions)
+}
+
+// SetSymbols sets the underlyings of the symbols
+func (a *GreeksAggregator) SetSymbols(symbols []OptionSymbol) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, s := range symbols {
+		a.underlyings[s.Symbol] = s.Underlying
+	}
+}
+
+// SetPositions replaces all positions. The positions which fail are skipped, the first error is returned.
+func (a *GreeksAggregator) SetPositions(positions []*Position) error {
+	return a.update(func(changed map[GreeksKey]struct{}, report func(err error)) {
+		for symbol := range a.positions {
+			a.setPosition(symbol, 0, changed)
+		}
+		for _, p := range positions {
+			if _, ok := a.underlyings[p.Symbol]; !ok && p.QuoteAsset != "" {
+				// the underlying is the base asset of the symbol quoted in the quote asset, e.g. BTCUSDT
+				a.underlyings[p.Symbol] = strings.SplitN(p.Symbol, "-", 2)[0] + p.QuoteAsset
+			}
+			quantity, err := parseFloat(p.Symbol, "quantity", p.Quantity)
+			if err != nil {
+				report(err)
+				continue
+			}
+			if p.Side == "SHORT" && quantity > 0 {
+				quantity = -quantity
+			}
+			report(a.setPosition(p.Symbol, quantity, changed))
+		}
+	})
+}
+
+// SetMarks sets the Greeks of the symbols. The symbols which fail are skipped, the first error is returned.
+func (a *GreeksAggregator) SetMarks(marks []*Mark) error {
+	return a.update(func(changed map[GreeksKey]struct{}, report func(err error)) {
+		for _, m := range marks {
+			report(a.setGreeks(m.Symbol, m.Delta, m.Gamma, m.Vega, m.Theta, changed))
+		}
+	})
+}
+
+// UpdateMarkPrice updates the Greeks of the symbols, it can be used as WsMarkPriceHandler
+func (a *GreeksAggregator) UpdateMarkPrice(events []*WsMarkPriceEvent) {
+	a.handleErr(a.update(func(changed map[GreeksKey]struct{}, report func(err error)) {
+		for _, e := range events {
+			report(a.setGreeks(e.Symbol, e.Delta, e.Gamma, e.Vega, e.Theta, changed))
+		}
+	}))
+}
+
+// UpdateUserData updates the positions of ACCOUNT_UPDATE events, it can be used as WsUserDataHandler
+func (a *GreeksAggregator) UpdateUserData(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeAccountUpdate {
+		return
+	}
+	a.handleErr(a.update(func(changed map[GreeksKey]struct{}, report func(err error)) {
+		for _, p := range event.AUPosition {
+			quantity, err := parseFloat(p.Symbol, "quantity", p.CountQty)
+			if err != nil {
+				report(err)
+				continue
+			}
+			report(a.setPosition(p.Symbol, quantity, changed))
+		}
+	}))
+}
+
+func (a *GreeksAggregator) handleErr(err error) {
+	if err != nil && a.ErrHandler != nil {
+		a.ErrHandler(err)
+	}
+}
+
+// Snapshot returns the net Greeks of all keys with positions
+func (a *GreeksAggregator) Snapshot() map[GreeksKey]NetGreeks {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	snapshot := make(map[GreeksKey]NetGreeks, len(a.net))
+	for key, greeks := range a.net {
+		snapshot[key] = greeks
+	}
+	return snapshot
+}
+
+// Get returns the net Greeks of key
+func (a *GreeksAggregator) Get(key GreeksKey) NetGreeks {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.net[key]
+}
+
+// Underlying returns the net Greeks of an underlying over all expiries
+func (a *GreeksAggregator) Underlying(underlying string) (res NetGreeks) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for key, greeks := range a.net {
+		if key.Underlying == underlying {
+			res = res.add(greeks, 1)
+		}
+	}
+	return res
+}
+
+// update applies f, recomputes the keys it changed and calls OnChange outside of the lock. f reports its errors, the
+// first one is returned.
+func (a *GreeksAggregator) update(f func(changed map[GreeksKey]struct{}, report func(err error))) error {
+	var firstErr error
+	a.mu.Lock()
+	changed := make(map[GreeksKey]struct{})
+	f(changed, func(err error) {
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	})
+	updates := make(map[GreeksKey]NetGreeks)
+	for key := range changed {
+		old, existed := a.net[key]
+		greeks, exists := a.compute(key)
+		if exists {
+			a.net[key] = greeks
+		} else {
+			delete(a.net, key)
+		}
+		if old != greeks || existed != exists {
+			updates[key] = greeks
+		}
+	}
+	onChange := a.OnChange
+	a.mu.Unlock()
+	if onChange != nil {
+		for key, greeks := range updates {
+			onChange(key, greeks)
+		}
+	}
+	return firstErr
+}
+
+func (a *GreeksAggregator) compute(key GreeksKey) (res NetGreeks, exists bool) {
+	for symbol, quantity := range a.positions {
+		if a.keys[symbol] == key {
+			res = res.add(a.greeks[symbol], quantity)
+			exists = true
+		}
+	}
+	return res, exists
+}
+
+// keyOf returns the key of a symbol like BTC-240628-70000-C
+func (a *GreeksAggregator) keyOf(symbol string) (GreeksKey, error) {
+	parts := strings.Split(symbol, "-")
+	if len(parts) != 4 {
+		return GreeksKey{}, fmt.Errorf("invalid option symbol %s", symbol)
+	}
+	underlying, ok := a.underlyings[symbol]
+	if !ok {
+		return GreeksKey{}, fmt.Errorf("unknown underlying of %s, the symbols are set by SetSymbols", symbol)
+	}
+	return GreeksKey{Underlying: underlying, Expiry: parts[1]}, nil
+}
+
+func (a *GreeksAggregator) setPosition(symbol string, quantity float64, changed map[GreeksKey]struct{}) error {
+	key, ok := a.keys[symbol]
+	if !ok {
+		var err error
+		if key, err = a.keyOf(symbol); err != nil {
+			return err
+		}
+	}
+	if quantity == 0 {
+		delete(a.positions, symbol)
+		delete(a.keys, symbol)
+	} else {
+		a.positions[symbol] = quantity
+		a.keys[symbol] = key
+	}
+	changed[key] = struct{}{}
+	return nil
+}
+
+func (a *GreeksAggregator) setGreeks(symbol, delta, gamma, vega, theta string, changed map[GreeksKey]struct{}) error {
+	var values [4]float64
+	for i, s := range []string{delta, gamma, vega, theta} {
+		var err error
+		if values[i], err = parseFloat(symbol, greekNames[i], s); err != nil {
+			return err
+		}
+	}
+	a.greeks[symbol] = NetGreeks{Delta: values[0], Gamma: values[1], Vega: values[2], Theta: values[3]}
+	if _, ok := a.positions[symbol]; ok {
+		changed[a.keys[symbol]] = struct{}{}
+	}
+	return nil
+}
+
+var greekNames = [4]string{"delta", "gamma", "vega", "theta"}
+
+// parseFloat parses the value of field of symbol
+func parseFloat(symbol, field, s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s of %s: %w", field, symbol, err)
+	}
+	return f, nil
+}
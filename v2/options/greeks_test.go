@@ -0,0 +1,178 @@
+package options
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type greeksAggregatorTestSuite struct {
+	baseTestSuite
+}
+
+func TestGreeksAggregator(t *testing.T) {
+	suite.Run(t, new(greeksAggregatorTestSuite))
+}
+
+var (
+	btcJunKey = GreeksKey{Underlying: "BTCUSDT", Expiry: "240628"}
+	btcSepKey = GreeksKey{Underlying: "BTCUSDT", Expiry: "240927"}
+	ethJunKey = GreeksKey{Underlying: "ETHUSDT", Expiry: "240628"}
+)
+
+func (s *greeksAggregatorTestSuite) newAggregator() (*GreeksAggregator, map[GreeksKey]NetGreeks) {
+	a := NewGreeksAggregator()
+	changes := make(map[GreeksKey]NetGreeks)
+	a.OnChange = func(key GreeksKey, greeks NetGreeks) {
+		changes[key] = greeks
+	}
+	a.SetSymbols([]OptionSymbol{
+		{Symbol: "BTC-240628-70000-C", Underlying: "BTCUSDT"},
+		{Symbol: "BTC-240628-60000-P", Underlying: "BTCUSDT"},
+		{Symbol: "ETH-240628-4000-C", Underlying: "ETHUSDT"},
+	})
+	s.r().NoError(a.SetMarks([]*Mark{
+		{Symbol: "BTC-240628-70000-C", Delta: "0.5", Gamma: "0.01", Vega: "10", Theta: "-20"},
+		{Symbol: "BTC-240628-60000-P", Delta: "-0.3", Gamma: "0.02", Vega: "8", Theta: "-10"},
+		{Symbol: "BTC-240927-70000-C", Delta: "0.6", Gamma: "0.005", Vega: "30", Theta: "-5"},
+		{Symbol: "ETH-240628-4000-C", Delta: "0.4", Gamma: "0.001", Vega: "2", Theta: "-1"},
+	}))
+	// the underlying of the symbol missing from the symbols is taken from the quote asset of its position
+	s.r().NoError(a.SetPositions([]*Position{
+		{Symbol: "BTC-240628-70000-C", Side: "LONG", Quantity: "2"},
+		{Symbol: "BTC-240628-60000-P", Side: "SHORT", Quantity: "-1"},
+		{Symbol: "BTC-240927-70000-C", Side: "SHORT", Quantity: "1", QuoteAsset: "USDT"},
+	}))
+	return a, changes
+}
+
+func (s *greeksAggregatorTestSuite) TestSnapshot() {
+	a, changes := s.newAggregator()
+	expected := map[GreeksKey]NetGreeks{
+		btcJunKey: {Delta: 1.3, Gamma: 0, Vega: 12, Theta: -30},
+		btcSepKey: {Delta: -0.6, Gamma: -0.005, Vega: -30, Theta: 5},
+	}
+	s.assertNetGreeks(expected, a.Snapshot())
+	s.assertNetGreeks(expected, changes)
+
+	s.assertGreeks(NetGreeks{Delta: 0.7, Gamma: -0.005, Vega: -18, Theta: -25}, a.Underlying("BTCUSDT"))
+	s.assertGreeks(NetGreeks{}, a.Underlying("ETHUSDT"))
+}
+
+func (s *greeksAggregatorTestSuite) TestUpdateMarkPrice() {
+	a, changes := s.newAggregator()
+	for key := range changes {
+		delete(changes, key)
+	}
+
+	a.UpdateMarkPrice([]*WsMarkPriceEvent{
+		{Symbol: "BTC-240927-70000-C", Delta: "0.7", Gamma: "0.004", Vega: "28", Theta: "-6"},
+		{Symbol: "ETH-240628-4000-C", Delta: "0.45", Gamma: "0.001", Vega: "2", Theta: "-1"},
+	})
+	s.r().Len(changes, 1)
+	s.assertGreeks(NetGreeks{Delta: -0.7, Gamma: -0.004, Vega: -28, Theta: 6}, changes[btcSepKey])
+	s.assertGreeks(NetGreeks{Delta: 1.3, Gamma: 0, Vega: 12, Theta: -30}, a.Get(btcJunKey))
+}
+
+func (s *greeksAggregatorTestSuite) TestUpdateUserData() {
+	a, changes := s.newAggregator()
+	for key := range changes {
+		delete(changes, key)
+	}
+
+	a.UpdateUserData(&WsUserDataEvent{
+		Event: UserDataEventTypeAccountUpdate,
+		AUPosition: []*WsPosition{
+			{Symbol: "BTC-240927-70000-C", CountQty: "0"},
+			{Symbol: "ETH-240628-4000-C", CountQty: "10"},
+		},
+	})
+	s.r().Len(changes, 2)
+	s.assertGreeks(NetGreeks{}, changes[btcSepKey])
+	s.assertGreeks(NetGreeks{Delta: 4, Gamma: 0.01, Vega: 20, Theta: -10}, changes[ethJunKey])
+
+	snapshot := a.Snapshot()
+	s.r().Len(snapshot, 2)
+	s.r().NotContains(snapshot, btcSepKey)
+
+	a.UpdateUserData(&WsUserDataEvent{Event: UserDataEventTypeOrderTradeUpdate})
+	s.r().Len(a.Snapshot(), 2)
+}
+
+func (s *greeksAggregatorTestSuite) TestSetPositions() {
+	a, changes := s.newAggregator()
+	for key := range changes {
+		delete(changes, key)
+	}
+
+	s.r().NoError(a.SetPositions([]*Position{
+		{Symbol: "BTC-240628-70000-C", Side: "LONG", Quantity: "2"},
+	}))
+	s.r().Len(changes, 2)
+	s.assertGreeks(NetGreeks{Delta: 1, Gamma: 0.02, Vega: 20, Theta: -40}, changes[btcJunKey])
+	s.assertGreeks(NetGreeks{}, changes[btcSepKey])
+	s.r().Len(a.Snapshot(), 1)
+}
+
+func (s *greeksAggregatorTestSuite) TestErrors() {
+	a, changes := s.newAggregator()
+	for key := range changes {
+		delete(changes, key)
+	}
+	var errs []error
+	a.ErrHandler = func(err error) {
+		errs = append(errs, err)
+	}
+
+	err := a.SetMarks([]*Mark{{Symbol: "BTC-240628-70000-C", Delta: "x", Gamma: "0", Vega: "0", Theta: "0"}})
+	s.r().EqualError(err, `invalid delta of BTC-240628-70000-C: strconv.ParseFloat: parsing "x": invalid syntax`)
+	err = a.SetPositions([]*Position{{Symbol: "SOL-240628-200-C", Side: "LONG", Quantity: "1"}})
+	s.r().EqualError(err, "unknown underlying of SOL-240628-200-C, the symbols are set by SetSymbols")
+
+	a.UpdateMarkPrice([]*WsMarkPriceEvent{
+		{Symbol: "ETH-240628-4000-C", Delta: "0.5", Gamma: "", Vega: "2", Theta: "-1"},
+	})
+	a.UpdateUserData(&WsUserDataEvent{
+		Event:      UserDataEventTypeAccountUpdate,
+		AUPosition: []*WsPosition{{Symbol: "ETH-240628-4000-C", CountQty: "1.5.0"}},
+	})
+	s.r().Len(errs, 2)
+	s.r().EqualError(errs[0], `invalid gamma of ETH-240628-4000-C: strconv.ParseFloat: parsing "": invalid syntax`)
+	s.r().EqualError(errs[1], `invalid quantity of ETH-240628-4000-C: strconv.ParseFloat: parsing "1.5.0": invalid syntax`)
+
+	// SetPositions removed the previous positions and skipped the failing one
+	s.r().Empty(a.Snapshot())
+}
+
+func (s *greeksAggregatorTestSuite) TestLoad() {
+	a := NewGreeksAggregator()
+	responses := map[string]string{
+		"/eapi/v1/exchangeInfo": `{"optionSymbols": [{"symbol": "ETH-240628-4000-C", "underlying": "ETHUSDC"}]}`,
+		"/eapi/v1/position":     `[{"symbol": "ETH-240628-4000-C", "side": "LONG", "quantity": "3", "quoteAsset": "USDT"}]`,
+		"/eapi/v1/mark":         `[{"symbol": "ETH-240628-4000-C", "delta": "0.4", "gamma": "0.001", "vega": "2", "theta": "-1"}]`,
+	}
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		return newHTTPResponse([]byte(responses[req.URL.Path]), http.StatusOK), nil
+	}
+
+	s.r().NoError(a.Load(newContext(), s.client.Client))
+	// the underlying of the symbols takes precedence over the quote asset of the position
+	key := GreeksKey{Underlying: "ETHUSDC", Expiry: "240628"}
+	s.assertGreeks(NetGreeks{Delta: 1.2, Gamma: 0.003, Vega: 6, Theta: -3}, a.Get(key))
+}
+
+func (s *greeksAggregatorTestSuite) assertNetGreeks(e, a map[GreeksKey]NetGreeks) {
+	s.r().Len(a, len(e))
+	for key, greeks := range e {
+		s.r().Contains(a, key)
+		s.assertGreeks(greeks, a[key])
+	}
+}
+
+func (s *greeksAggregatorTestSuite) assertGreeks(e, a NetGreeks) {
+	s.r().InDelta(e.Delta, a.Delta, 1e-9, "Delta")
+	s.r().InDelta(e.Gamma, a.Gamma, 1e-9, "Gamma")
+	s.r().InDelta(e.Vega, a.Vega, 1e-9, "Vega")
+	s.r().InDelta(e.Theta, a.Theta, 1e-9, "Theta")
+}
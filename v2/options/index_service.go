@@ -6,6 +6,7 @@ import (
 	"net/http"
 )
 
+//decimalgen:accessors
 type Index struct {
 	Time       uint64 `json:"time"`
 	IndexPrice string `json:"indexPrice"`
@@ -98,6 +98,8 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 }
 
 // Kline define kline info
+//
+//decimalgen:accessors
 type Kline struct {
 	Open        string `json:"open"`
 	High        string `json:"high"`
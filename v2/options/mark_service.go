@@ -6,6 +6,7 @@ import (
 	"net/http"
 )
 
+//decimalgen:accessors
 type Mark struct {
 	Symbol           string `json:"symbol"`
 	MarkPrice        string `json:"markPrice"`
@@ -0,0 +1,141 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// MMP define market maker protection config of an underlying
+//
+//decimalgen:accessors
+type MMP struct {
+	UnderlyingID             int64  `json:"underlyingId"`
+	Underlying               string `json:"underlying"`
+	WindowTimeInMilliseconds int64  `json:"windowTimeInMilliseconds"`
+	FrozenTimeInMilliseconds int64  `json:"frozenTimeInMilliseconds"`
+	QtyLimit                 string `json:"qtyLimit"`
+	DeltaLimit               string `json:"deltaLimit"`
+	LastTriggerTime          int64  `json:"lastTriggerTime"`
+}
+
+// SetMMPService set market maker protection config
+// POST /eapi/v1/mmpSet
+type SetMMPService struct {
+	c                        *Client
+	underlying               string
+	windowTimeInMilliseconds int64
+	frozenTimeInMilliseconds int64
+	qtyLimit                 string
+	deltaLimit               string
+}
+
+// Underlying set underlying, for example BTCUSDT
+func (s *SetMMPService) Underlying(underlying string) *SetMMPService {
+	s.underlying = underlying
+	return s
+}
+
+// WindowTimeInMilliseconds set the time window in which the quantity and delta are accumulated, max 5000ms
+func (s *SetMMPService) WindowTimeInMilliseconds(windowTime int64) *SetMMPService {
+	s.windowTimeInMilliseconds = windowTime
+	return s
+}
+
+// FrozenTimeInMilliseconds set how long MMP orders are rejected after a trigger, 0 keeps them frozen until reset
+func (s *SetMMPService) FrozenTimeInMilliseconds(frozenTime int64) *SetMMPService {
+	s.frozenTimeInMilliseconds = frozenTime
+	return s
+}
+
+// QtyLimit set the quantity limit
+func (s *SetMMPService) QtyLimit(qtyLimit string) *SetMMPService {
+	s.qtyLimit = qtyLimit
+	return s
+}
+
+// DeltaLimit set the net delta limit
+func (s *SetMMPService) DeltaLimit(deltaLimit string) *SetMMPService {
+	s.deltaLimit = deltaLimit
+	return s
+}
+
+// Do send request
+func (s *SetMMPService) Do(ctx context.Context, opts ...RequestOption) (res *MMP, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/mmpSet",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"underlying":               s.underlying,
+		"windowTimeInMilliseconds": s.windowTimeInMilliseconds,
+		"frozenTimeInMilliseconds": s.frozenTimeInMilliseconds,
+		"qtyLimit":                 s.qtyLimit,
+		"deltaLimit":               s.deltaLimit,
+	})
+	return s.c.doMMP(ctx, r, opts...)
+}
+
+// GetMMPService get market maker protection config
+// GET /eapi/v1/mmp
+type GetMMPService struct {
+	c          *Client
+	underlying string
+}
+
+// Underlying set underlying
+func (s *GetMMPService) Underlying(underlying string) *GetMMPService {
+	s.underlying = underlying
+	return s
+}
+
+// Do send request
+func (s *GetMMPService) Do(ctx context.Context, opts ...RequestOption) (res *MMP, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/mmp",
+		secType:  secTypeSigned,
+	}
+	r.setParam("underlying", s.underlying)
+	return s.c.doMMP(ctx, r, opts...)
+}
+
+// ResetMMPService reset market maker protection, MMP orders are accepted again before the frozen time ends
+// POST /eapi/v1/mmpReset
+type ResetMMPService struct {
+	c          *Client
+	underlying string
+}
+
+// Underlying set underlying
+func (s *ResetMMPService) Underlying(underlying string) *ResetMMPService {
+	s.underlying = underlying
+	return s
+}
+
+// Do send request
+func (s *ResetMMPService) Do(ctx context.Context, opts ...RequestOption) (res *MMP, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/mmpReset",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"underlying": s.underlying,
+	})
+	return s.c.doMMP(ctx, r, opts...)
+}
+
+func (c *Client) doMMP(ctx context.Context, r *request, opts ...RequestOption) (res *MMP, err error) {
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MMP)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
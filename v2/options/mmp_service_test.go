@@ -0,0 +1,84 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type mmpServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMMPService(t *testing.T) {
+	suite.Run(t, new(mmpServiceTestSuite))
+}
+
+var mmpResponse = []byte(`{
+	"underlyingId": 2,
+	"underlying": "BTCUSDT",
+	"windowTimeInMilliseconds": 3000,
+	"frozenTimeInMilliseconds": 300000,
+	"qtyLimit": "2",
+	"deltaLimit": "2.3",
+	"lastTriggerTime": 0
+}`)
+
+func (s *mmpServiceTestSuite) assertMMP(a *MMP) {
+	s.r().Equal(&MMP{
+		UnderlyingID:             2,
+		Underlying:               "BTCUSDT",
+		WindowTimeInMilliseconds: 3000,
+		FrozenTimeInMilliseconds: 300000,
+		QtyLimit:                 "2",
+		DeltaLimit:               "2.3",
+		LastTriggerTime:          0,
+	}, a)
+}
+
+func (s *mmpServiceTestSuite) TestSetMMP() {
+	s.mockDo(mmpResponse, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"underlying":               "BTCUSDT",
+			"windowTimeInMilliseconds": 3000,
+			"frozenTimeInMilliseconds": 300000,
+			"qtyLimit":                 "2",
+			"deltaLimit":               "2.3",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewSetMMPService().Underlying("BTCUSDT").
+		WindowTimeInMilliseconds(3000).FrozenTimeInMilliseconds(300000).
+		QtyLimit("2").DeltaLimit("2.3").Do(newContext())
+	s.r().NoError(err)
+	s.assertMMP(res)
+}
+
+func (s *mmpServiceTestSuite) TestGetMMP() {
+	s.mockDo(mmpResponse, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("underlying", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetMMPService().Underlying("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.assertMMP(res)
+}
+
+func (s *mmpServiceTestSuite) TestResetMMP() {
+	s.mockDo(mmpResponse, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParam("underlying", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewResetMMPService().Underlying("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.assertMMP(res)
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 )
 
+//decimalgen:accessors
 type OpenInterest struct {
 	Symbol             string `json:"symbol"`
 	SumOpenInterest    string `json:"sumOpenInterest"`
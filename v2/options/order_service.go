@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/adshao/go-binance/v2/common"
 )
@@ -154,6 +155,7 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+//decimalgen:accessors
 type LastTrade struct {
 	Id      int64  `json:"id"`
 	TradeId int64  `json:"tradeId"`
@@ -164,6 +166,8 @@ type LastTrade struct {
 
 // Unified order structure, it would be used in many ways, for example, create order, cancel order,
 // query open orders, query historical order and so on
+//
+//decimalgen:accessors
 type Order struct {
 	OrderId       int64           `json:"orderId"`
 	Symbol        string          `json:"symbol"`
@@ -699,11 +703,24 @@ func (s *HistoryOrdersService) Do(ctx context.Context, opts ...RequestOption) (r
 	return res, nil
 }
 
+// Iterator walks the orders created between startTime and endTime in milliseconds, in windows of 7 days and pages of
+// 500 orders. OrderId is ignored.
+func (s *HistoryOrdersService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Order] {
+	s.orderId = nil
+	return common.NewCursorIterator(startTime, endTime, 7*24*time.Hour, 500,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*Order, error) {
+			return s.StartTime(uint64(startTime)).EndTime(uint64(endTime)).Limit(limit).Do(ctx, opts...)
+		},
+		func(o *Order) int64 { return o.CreateTime },
+		func(o *Order) int64 { return o.OrderId })
+}
+
 type PositionService struct {
 	c      *Client
 	symbol *string
 }
 
+//decimalgen:accessors
 type Position struct {
 	EntryPrice    string `json:"entryPrice"`
 	Symbol        string `json:"symbol"`
@@ -765,6 +782,7 @@ type UserTradesService struct {
 	limit     *int
 }
 
+//decimalgen:accessors
 type UserTrade struct {
 	Id             uint64 `json:"id"`
 	TradeId        uint32 `json:"tradeId"`
@@ -849,6 +867,18 @@ func (s *UserTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Iterator walks the trades between startTime and endTime in milliseconds, in windows of 7 days and pages of 1000
+// trades. FromId is ignored.
+func (s *UserTradesService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*UserTrade] {
+	s.fromId = nil
+	return common.NewCursorIterator(startTime, endTime, 7*24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*UserTrade, error) {
+			return s.StartTime(uint64(startTime)).EndTime(uint64(endTime)).Limit(limit).Do(ctx, opts...)
+		},
+		func(t *UserTrade) int64 { return int64(t.Time) },
+		func(t *UserTrade) uint64 { return t.Id })
+}
+
 type ExerciseRecordService struct {
 	c         *Client
 	symbol    *string
@@ -857,6 +887,7 @@ type ExerciseRecordService struct {
 	limit     *int // default 1000, max 1000
 }
 
+//decimalgen:accessors
 type ExerciseRecord struct {
 	Id            string `json:"id"`
 	Currency      string `json:"currency"`
@@ -939,6 +970,7 @@ type BillService struct {
 	limit     *int // default 100, max 1000
 }
 
+//decimalgen:accessors
 type Bill struct {
 	Id         string `json:"id"`
 	Asset      string `json:"asset"`
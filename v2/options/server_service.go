@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // PingService ping server
@@ -48,3 +50,11 @@ func (s *ServerTimeService) Do(ctx context.Context, opts ...RequestOption) (serv
 	serverTime = j.Get("serverTime").MustInt64()
 	return serverTime, nil
 }
+
+// NewTimeSync init time sync sampling the server time of the client, set it as TimeSync of the client
+// (and of websocket API services) and call Start to keep the offset in sync
+func (c *Client) NewTimeSync() *common.TimeSync {
+	return common.NewTimeSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	})
+}
@@ -0,0 +1,87 @@
+// Code generated by streamgen; DO NOT EDIT.
+
+package options
+
+import (
+	"context"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// WsCombinedStream is WsCombinedServe stopped when ctx is done, see ServeStream
+func WsCombinedStream(ctx context.Context, streamName []string, handler map[string]any, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedServe(streamName, handler, errHandler)
+	}, errHandler)
+}
+
+// WsDepthStream is WsDepthServe stopped when ctx is done, see ServeStream
+func WsDepthStream(ctx context.Context, symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsDepthServe(symbol, levels, rate, handler, errHandler)
+	}, errHandler)
+}
+
+// WsIndexStream is WsIndexServe stopped when ctx is done, see ServeStream
+func WsIndexStream(ctx context.Context, symbol string, handler WsIndexHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsIndexServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsKlineStream is WsKlineServe stopped when ctx is done, see ServeStream
+func WsKlineStream(ctx context.Context, symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsKlineServe(symbol, interval, handler, errHandler)
+	}, errHandler)
+}
+
+// WsMarkPriceStream is WsMarkPriceServe stopped when ctx is done, see ServeStream
+func WsMarkPriceStream(ctx context.Context, symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsMarkPriceServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsOpenInterestStream is WsOpenInterestServe stopped when ctx is done, see ServeStream
+func WsOpenInterestStream(ctx context.Context, underlying string, expireDate string, handler WsOpenInterestHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsOpenInterestServe(underlying, expireDate, handler, errHandler)
+	}, errHandler)
+}
+
+// WsOptionPairStream is WsOptionPairServe stopped when ctx is done, see ServeStream
+func WsOptionPairStream(ctx context.Context, handler WsOptionPairHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsOptionPairServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsTickerStream is WsTickerServe stopped when ctx is done, see ServeStream
+func WsTickerStream(ctx context.Context, symbol string, handler WsTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsTickerServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsTickerWithExpireStream is WsTickerWithExpireServe stopped when ctx is done, see ServeStream
+func WsTickerWithExpireStream(ctx context.Context, underlying string, expireDate string, handler WsTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsTickerWithExpireServe(underlying, expireDate, handler, errHandler)
+	}, errHandler)
+}
+
+// WsTradeStream is WsTradeServe stopped when ctx is done, see ServeStream
+func WsTradeStream(ctx context.Context, symbol string, handler WsTradeHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsTradeServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsUserDataStream is WsUserDataServe stopped when ctx is done, see ServeStream
+func WsUserDataStream(ctx context.Context, listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, handler, errHandler)
+	}, errHandler)
+}
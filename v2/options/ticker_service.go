@@ -6,6 +6,7 @@ import (
 	"net/http"
 )
 
+//decimalgen:accessors
 type Ticker struct {
 	Symbol             string `json:"symbol"`
 	PriceChange        string `json:"priceChange"`
@@ -6,6 +6,7 @@ import (
 	"net/http"
 )
 
+//decimalgen:accessors
 type Trade struct {
 	Id       uint64 `json:"id"`
 	TradeId  int    `json:"tradeId"`
@@ -111,6 +112,8 @@ func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // HistoricalTrade define historical trade info
+//
+//decimalgen:accessors
 type HistoricalTrade struct {
 	Id       uint64 `json:"id"`
 	TradeId  int    `json:"tradeId"`
@@ -0,0 +1,133 @@
+package options
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// userDataOrderLookback is how long before a gap orders are requested, orders that old may still change during it
+const userDataOrderLookback = 24 * time.Hour
+
+// NewUserDataStream creates a user data stream which owns its listen key. It keeps the key alive and reconnects when
+// the key has expired or the connection failed. The orders of symbols updated meanwhile are backfilled as
+// ORDER_TRADE_UPDATE events holding their trades, nothing is backfilled without symbols. The ACCOUNT_UPDATE events
+// are lost, a *common.UserStreamGapError is passed to the ErrHandler after every reconnect so the balances and
+// positions can be requested again. Set the options of the stream and start it with Run.
+func (c *Client) NewUserDataStream(symbols ...string) *common.UserStream[*WsUserDataEvent] {
+	stream := common.NewUserStream(common.UserStreamSource[*WsUserDataEvent]{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Serve: func(listenKey string, handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			// the stream has to notice the drop to backfill it, so the connection doesn't reconnect by itself
+			cfg := newWsConfig(fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey))
+			cfg.Reconnect = false
+			return wsUserDataServe(cfg, handler, errHandler)
+		},
+		Expired: func(event *WsUserDataEvent) bool {
+			return event.Event == UserDataEventTypeListenKeyExpired
+		},
+	})
+	stream.Backfill = func(ctx context.Context, startTime, endTime int64) ([]*WsUserDataEvent, error) {
+		return c.backfillUserData(ctx, symbols, startTime, endTime)
+	}
+	return stream
+}
+
+// backfillUserData rebuilds the ORDER_TRADE_UPDATE events between startTime and endTime from the orders and trades
+// of symbols. Every order updated meanwhile gets one event with its current state and its trades, trades of orders
+// which weren't found get an event holding the trades only.
+func (c *Client) backfillUserData(ctx context.Context, symbols []string, startTime, endTime int64) ([]*WsUserDataEvent, error) {
+	var events []*WsUserDataEvent
+	for _, symbol := range symbols {
+		var updated []*Order
+		it := c.NewHistoryOrdersService().Symbol(symbol).Iterator(startTime-userDataOrderLookback.Milliseconds(), endTime)
+		for it.Next(ctx) {
+			if o := it.Value(); o.UpdateTime >= startTime {
+				updated = append(updated, o)
+			}
+		}
+		if err := it.Err(); err != nil {
+			return nil, err
+		}
+		open, err := c.NewListOpenOrdersService().Symbol(symbol).Limit(1000).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range open {
+			if o.UpdateTime >= startTime {
+				updated = append(updated, o)
+			}
+		}
+
+		filled := make(map[int64][]WsFilled)
+		var tradedOrders []int64
+		tit := c.NewUserTradesService().Symbol(symbol).Iterator(startTime, endTime)
+		for tit.Next(ctx) {
+			t := tit.Value()
+			id := int64(t.OrderId)
+			if _, ok := filled[id]; !ok {
+				tradedOrders = append(tradedOrders, id)
+			}
+			filled[id] = append(filled[id], WsFilled{
+				TradeId:    strconv.FormatUint(uint64(t.TradeId), 10),
+				Price:      t.Price,
+				Quantity:   t.Quantity,
+				TradedTime: int64(t.Time),
+				Marker:     t.Liquidity,
+				Fee:        t.Fee,
+			})
+		}
+		if err := tit.Err(); err != nil {
+			return nil, err
+		}
+
+		for _, o := range updated {
+			u := &WsOrderTradeUpdate{
+				CreateTime:    o.CreateTime,
+				UpdateTime:    o.UpdateTime,
+				Symbol:        o.Symbol,
+				ClientOrderID: o.ClientOrderId,
+				OrderId:       strconv.FormatInt(o.OrderId, 10),
+				Price:         o.Price,
+				Quantity:      o.Quantity,
+				ReduleOnly:    o.ReduceOnly,
+				PostOnly:      o.PostOnly,
+				Status:        string(o.Status),
+				ExecutedQty:   o.ExecutedQty,
+				Fee:           o.Fee,
+				TimeInForce:   string(o.TimeInForce),
+				OrderType:     string(o.Type),
+				Filled:        filled[o.OrderId],
+			}
+			delete(filled, o.OrderId)
+			events = append(events, &WsUserDataEvent{Event: UserDataEventTypeOrderTradeUpdate, Time: o.UpdateTime,
+				OTU: []*WsOrderTradeUpdate{u}})
+		}
+		for _, id := range tradedOrders {
+			fills, ok := filled[id]
+			if !ok {
+				continue
+			}
+			last := fills[len(fills)-1].TradedTime
+			u := &WsOrderTradeUpdate{UpdateTime: last, Symbol: symbol, OrderId: strconv.FormatInt(id, 10), Filled: fills}
+			events = append(events, &WsUserDataEvent{Event: UserDataEventTypeOrderTradeUpdate, Time: last,
+				OTU: []*WsOrderTradeUpdate{u}})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time < events[j].Time
+	})
+	return events, nil
+}
@@ -31,6 +31,15 @@ var (
 	UseDemo = false
 
 	ProxyUrl = ""
+	// WebsocketAutoReconnect makes market data streams redial with backoff after a disconnect
+	// instead of closing doneC
+	WebsocketAutoReconnect = false
+	// WebsocketReconnectHandler is called after a stream has been redialed if WebsocketAutoReconnect is enabled
+	WebsocketReconnectHandler WsReconnectHandler
+	// WebsocketReconnectMinInterval is the initial delay before redialing a dropped stream
+	WebsocketReconnectMinInterval = 100 * time.Millisecond
+	// WebsocketReconnectMaxInterval caps the delay between redial attempts
+	WebsocketReconnectMaxInterval = 10 * time.Second
 )
 
 // getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
@@ -66,6 +75,7 @@ func getCombinedEndpoint() string {
 	return baseCombinedMainURL
 }
 
+//decimalgen:accessors
 type WsTradeEvent struct {
 	Event     string `json:"e"`
 	Time      int64  `json:"E"`
@@ -80,6 +90,7 @@ type WsTradeEvent struct {
 }
 type WsTradeHandler func(event *WsTradeEvent)
 
+//decimalgen:accessors
 type WsIndexEvent struct {
 	Event  string `json:"e"`
 	Time   int64  `json:"E"`
@@ -88,14 +99,24 @@ type WsIndexEvent struct {
 }
 type WsIndexHandler func(event *WsIndexEvent)
 
+//decimalgen:accessors
 type WsMarkPriceEvent struct {
-	Event     string `json:"e"`
-	Time      int64  `json:"E"`
-	Symbol    string `json:"s"`
-	MarkPrice string `json:"mp"`
+	Event      string `json:"e"`
+	Time       int64  `json:"E"`
+	Symbol     string `json:"s"`
+	MarkPrice  string `json:"mp"`
+	IndexPrice string `json:"i"`
+	BidIV      string `json:"b"`
+	AskIV      string `json:"a"`
+	MarkIV     string `json:"vo"`
+	Delta      string `json:"d"`
+	Theta      string `json:"t"`
+	Gamma      string `json:"g"`
+	Vega       string `json:"v"`
 }
 type WsMarkPriceHandler func(events []*WsMarkPriceEvent)
 
+//decimalgen:accessors
 type WsKline struct {
 	StartTime        int64  `json:"t"`
 	EndTime          int64  `json:"T"`
@@ -122,6 +143,7 @@ type WsKlineEvent struct {
 }
 type WsKlineHandler func(events *WsKlineEvent)
 
+//decimalgen:accessors
 type WsTickerEvent struct {
 	Event              string `json:"e"`
 	Time               int64  `json:"E"`
@@ -158,6 +180,7 @@ type WsTickerEvent struct {
 }
 type WsTickerHandler func(events []*WsTickerEvent)
 
+//decimalgen:accessors
 type WsOpenInterestEvent struct {
 	Event        string `json:"e"`
 	Time         int64  `json:"E"`
@@ -167,6 +190,7 @@ type WsOpenInterestEvent struct {
 }
 type WsOpenInterestHandler func(events []*WsOpenInterestEvent)
 
+//decimalgen:accessors
 type WsOptionPairEvent struct {
 	Event        string `json:"e"`
 	Time         int64  `json:"E"`
@@ -183,6 +207,7 @@ type WsOptionPairEvent struct {
 }
 type WsOptionPairHandler func(events *WsOptionPairEvent)
 
+//decimalgen:accessors
 type PL struct {
 	Price    string `json:"b"`
 	Quantity string `json:"a"`
@@ -727,6 +752,8 @@ type WsUserDataEvent struct {
 }
 
 // WsBalance define balance
+//
+//decimalgen:accessors
 type WsBalance struct {
 	Balance           string `json:"b"`
 	Merit             string `json:"m"`
@@ -745,6 +772,8 @@ type WsGreek struct {
 }
 
 // WsPosition define position
+//
+//decimalgen:accessors
 type WsPosition struct {
 	Symbol      string `json:"s"`
 	CountQty    string `json:"c"`
@@ -753,6 +782,7 @@ type WsPosition struct {
 	AvgPrice    string `json:"a"`
 }
 
+//decimalgen:accessors
 type WsFilled struct {
 	TradeId    string `json:"t"`
 	Price      string `json:"p"`
@@ -763,6 +793,8 @@ type WsFilled struct {
 }
 
 // WsOrderTradeUpdate define order trade update
+//
+//decimalgen:accessors
 type WsOrderTradeUpdate struct {
 	CreateTime    int64      `json:"T"`
 	UpdateTime    int64      `json:"t"`
@@ -788,7 +820,11 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	return wsUserDataServe(newWsConfig(endpoint), handler, errHandler)
+}
+
+// wsUserDataServe serves the user data stream of cfg
+func wsUserDataServe(cfg *WsConfig, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
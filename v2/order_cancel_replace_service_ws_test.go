@@ -0,0 +1,117 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *orderCancelReplaceServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+	s.timeOffset = 0
+
+	s.requestID = "94cefb15-b6bf-46fd-b9c1-68debdf31711"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.orderCancelReplace = &OrderCancelReplaceWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.orderCancelReplaceRequest = NewOrderCancelReplaceWsRequest().
+		Symbol("BTCUSDT").
+		Side(SideTypeBuy).
+		Type(OrderTypeLimit).
+		CancelReplaceMode(CancelReplaceModeAllowFailure).
+		TimeInForce(TimeInForceTypeGTC).
+		Price("23416.10000000").
+		Quantity("0.00847000").
+		CancelOrderID(125690984230)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type orderCancelReplaceServiceWsTestSuite struct {
+	suite.Suite
+	apiKey     string
+	secretKey  string
+	signedKey  string
+	timeOffset int64
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	orderCancelReplace        *OrderCancelReplaceWsService
+	orderCancelReplaceRequest *OrderCancelReplaceWsRequest
+}
+
+func TestOrderCancelReplaceServiceWs(t *testing.T) {
+	suite.Run(t, new(orderCancelReplaceServiceWsTestSuite))
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestOrderCancelReplace() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.orderCancelReplace.Do(s.requestID, s.orderCancelReplaceRequest)
+	s.NoError(err)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestOrderCancelReplace_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderCancelReplace.Do("", s.orderCancelReplaceRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestOrderCancelReplace_EmptyApiKey() {
+	s.orderCancelReplace.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderCancelReplace.Do(s.requestID, s.orderCancelReplaceRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestOrderCancelReplaceSync() {
+	rawResponseData := []byte(`{"id":"94cefb15-b6bf-46fd-b9c1-68debdf31711","status":200,"result":{"cancelResult":"SUCCESS","newOrderResult":"SUCCESS","cancelResponse":{"symbol":"BTCUSDT","origClientOrderId":"4d96324ff9d44481926157","orderId":125690984230,"orderListId":-1,"clientOrderId":"91fe37ce9e69c90d6358c0","price":"23450.00000000","origQty":"0.00847000","executedQty":"0.00001000","cummulativeQuoteQty":"0.23450000","status":"CANCELED","timeInForce":"GTC","type":"LIMIT","side":"BUY"},"newOrderResponse":{"symbol":"BTCUSDT","orderId":12569099453,"orderListId":-1,"clientOrderId":"bX5wROblo6YeDwa9iTLeyY","transactTime":1660813156959}}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderCancelReplace.SyncDo(s.requestID, s.orderCancelReplaceRequest)
+	s.Require().NoError(err)
+	s.Equal("SUCCESS", response.Result.CancelResult)
+	s.Equal(int64(125690984230), response.Result.CancelResponse.OrderID)
+	s.Equal(int64(12569099453), response.Result.NewOrderResponse.OrderID)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestOrderCancelReplaceSync_Error() {
+	rawResponseData := []byte(`{"id":"94cefb15-b6bf-46fd-b9c1-68debdf31711","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderCancelReplace.SyncDo(s.requestID, s.orderCancelReplaceRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestOrderCancelReplaceSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.orderCancelReplace.SyncDo(s.requestID, s.orderCancelReplaceRequest)
+	s.Nil(response)
+	s.Error(err)
+}
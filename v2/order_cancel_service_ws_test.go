@@ -0,0 +1,110 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *orderCancelServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+	s.timeOffset = 0
+
+	s.requestID = "980b22da-6ed1-4b9c-b9a9-52577de24820"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.orderCancel = &OrderCancelWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.orderCancelRequest = NewOrderCancelWsRequest().
+		Symbol("BTCUSDT").
+		OrderID(12569099453)
+}
+
+func (s *orderCancelServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type orderCancelServiceWsTestSuite struct {
+	suite.Suite
+	apiKey     string
+	secretKey  string
+	signedKey  string
+	timeOffset int64
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	orderCancel        *OrderCancelWsService
+	orderCancelRequest *OrderCancelWsRequest
+}
+
+func TestOrderCancelServiceWs(t *testing.T) {
+	suite.Run(t, new(orderCancelServiceWsTestSuite))
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancel() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.orderCancel.Do(s.requestID, s.orderCancelRequest)
+	s.NoError(err)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancel_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderCancel.Do("", s.orderCancelRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancel_EmptyApiKey() {
+	s.orderCancel.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderCancel.Do(s.requestID, s.orderCancelRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancelSync() {
+	rawResponseData := []byte(`{"id":"980b22da-6ed1-4b9c-b9a9-52577de24820","status":200,"result":{"symbol":"BTCUSDT","origClientOrderId":"4d96324ff9d44481926157","orderId":12569099453,"orderListId":-1,"clientOrderId":"91fe37ce9e69c90d6358c0","transactTime":1684804350068,"price":"23416.10000000","origQty":"0.00847000","executedQty":"0.00001000","cummulativeQuoteQty":"0.23416100","status":"CANCELED","timeInForce":"GTC","type":"LIMIT","side":"SELL","selfTradePreventionMode":"NONE"}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderCancel.SyncDo(s.requestID, s.orderCancelRequest)
+	s.Require().NoError(err)
+	s.Equal(int64(12569099453), response.Result.OrderID)
+	s.Equal(OrderStatusTypeCanceled, response.Result.Status)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancelSync_Error() {
+	rawResponseData := []byte(`{"id":"980b22da-6ed1-4b9c-b9a9-52577de24820","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderCancel.SyncDo(s.requestID, s.orderCancelRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancelSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.orderCancel.SyncDo(s.requestID, s.orderCancelRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -14,11 +14,15 @@ type OrderListCancelWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewOrderListCancelWsService init OrderListCancelWsService
-func NewOrderListCancelWsService(apiKey, secretKey string) (*OrderListCancelWsService, error) {
+func NewOrderListCancelWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OrderListCancelWsService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -34,9 +38,24 @@ func NewOrderListCancelWsService(apiKey, secretKey string) (*OrderListCancelWsSe
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewOrderListCancelWsServiceWithSession init OrderListCancelWsService sending its requests on the connection of session
+func NewOrderListCancelWsServiceWithSession(session *SessionWsService) *OrderListCancelWsService {
+	return &OrderListCancelWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // OrderListCancelWsRequest parameters for 'orderList.cancel' websocket API
 type OrderListCancelWsRequest struct {
 	symbol            string
@@ -82,9 +101,9 @@ func (s *OrderListCancelWsService) Do(requestID string, request *OrderListCancel
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderListCancelSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -106,9 +125,9 @@ func (s *OrderListCancelWsService) SyncDo(requestID string, request *OrderListCa
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderListCancelSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -125,6 +144,11 @@ func (s *OrderListCancelWsService) SyncDo(requestID string, request *OrderListCa
 	if err := json.Unmarshal(response, cancelOrderListWsResponse); err != nil {
 		return nil, err
 	}
+	if cancelOrderListWsResponse.Error != nil {
+		cancelOrderListWsResponse.Error.StatusCode = cancelOrderListWsResponse.Status
+		s.TimeSync.CheckError(cancelOrderListWsResponse.Error)
+		return nil, cancelOrderListWsResponse.Error
+	}
 
 	return cancelOrderListWsResponse, nil
 }
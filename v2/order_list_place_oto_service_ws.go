@@ -14,11 +14,15 @@ type OrderListPlaceOtoWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewOrderListPlaceOtoWsService init OrderListPlaceOtoWsService
-func NewOrderListPlaceOtoWsService(apiKey, secretKey string) (*OrderListPlaceOtoWsService, error) {
+func NewOrderListPlaceOtoWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OrderListPlaceOtoWsService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -34,9 +38,24 @@ func NewOrderListPlaceOtoWsService(apiKey, secretKey string) (*OrderListPlaceOto
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewOrderListPlaceOtoWsServiceWithSession init OrderListPlaceOtoWsService sending its requests on the connection of session
+func NewOrderListPlaceOtoWsServiceWithSession(session *SessionWsService) *OrderListPlaceOtoWsService {
+	return &OrderListPlaceOtoWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // OrderListPlaceOtoWsRequest parameters for 'orderList.place.oto' websocket API
 type OrderListPlaceOtoWsRequest struct {
 	symbol                  string
@@ -154,9 +173,9 @@ func (s *OrderListPlaceOtoWsService) Do(requestID string, request *OrderListPlac
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderListPlaceOtoSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -178,9 +197,9 @@ func (s *OrderListPlaceOtoWsService) SyncDo(requestID string, request *OrderList
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderListPlaceOtoSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -197,6 +216,11 @@ func (s *OrderListPlaceOtoWsService) SyncDo(requestID string, request *OrderList
 	if err := json.Unmarshal(response, createOrderListWsResponse); err != nil {
 		return nil, err
 	}
+	if createOrderListWsResponse.Error != nil {
+		createOrderListWsResponse.Error.StatusCode = createOrderListWsResponse.Status
+		s.TimeSync.CheckError(createOrderListWsResponse.Error)
+		return nil, createOrderListWsResponse.Error
+	}
 
 	return createOrderListWsResponse, nil
 }
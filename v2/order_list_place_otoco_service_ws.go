@@ -14,11 +14,15 @@ type OrderListPlaceOtocoWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewOrderListPlaceOtocoWsService init OrderListPlaceOtocoWsService
-func NewOrderListPlaceOtocoWsService(apiKey, secretKey string) (*OrderListPlaceOtocoWsService, error) {
+func NewOrderListPlaceOtocoWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OrderListPlaceOtocoWsService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -34,9 +38,24 @@ func NewOrderListPlaceOtocoWsService(apiKey, secretKey string) (*OrderListPlaceO
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewOrderListPlaceOtocoWsServiceWithSession init OrderListPlaceOtocoWsService sending its requests on the connection of session
+func NewOrderListPlaceOtocoWsServiceWithSession(session *SessionWsService) *OrderListPlaceOtocoWsService {
+	return &OrderListPlaceOtocoWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // OrderListPlaceOtocoWsRequest parameters for 'orderList.place.otoco' websocket API
 type OrderListPlaceOtocoWsRequest struct {
 	symbol                    string
@@ -192,9 +211,9 @@ func (s *OrderListPlaceOtocoWsService) Do(requestID string, request *OrderListPl
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderListPlaceOtocoSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -216,9 +235,9 @@ func (s *OrderListPlaceOtocoWsService) SyncDo(requestID string, request *OrderLi
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderListPlaceOtocoSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -235,6 +254,11 @@ func (s *OrderListPlaceOtocoWsService) SyncDo(requestID string, request *OrderLi
 	if err := json.Unmarshal(response, createOrderListWsResponse); err != nil {
 		return nil, err
 	}
+	if createOrderListWsResponse.Error != nil {
+		createOrderListWsResponse.Error.StatusCode = createOrderListWsResponse.Status
+		s.TimeSync.CheckError(createOrderListWsResponse.Error)
+		return nil, createOrderListWsResponse.Error
+	}
 
 	return createOrderListWsResponse, nil
 }
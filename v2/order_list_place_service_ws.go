@@ -14,11 +14,15 @@ type OrderListPlaceWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewOrderListPlaceWsService init OrderListPlaceWsService
-func NewOrderListPlaceWsService(apiKey, secretKey string) (*OrderListPlaceWsService, error) {
+func NewOrderListPlaceWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OrderListPlaceWsService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -34,9 +38,24 @@ func NewOrderListPlaceWsService(apiKey, secretKey string) (*OrderListPlaceWsServ
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewOrderListPlaceWsServiceWithSession init OrderListPlaceWsService sending its requests on the connection of session
+func NewOrderListPlaceWsServiceWithSession(session *SessionWsService) *OrderListPlaceWsService {
+	return &OrderListPlaceWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // OrderListPlaceWsRequest parameters for 'orderList.place' websocket API (deprecated OCO)
 type OrderListPlaceWsRequest struct {
 	symbol                  string
@@ -142,9 +161,9 @@ func (s *OrderListPlaceWsService) Do(requestID string, request *OrderListPlaceWs
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderListPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -166,9 +185,9 @@ func (s *OrderListPlaceWsService) SyncDo(requestID string, request *OrderListPla
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderListPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -185,6 +204,11 @@ func (s *OrderListPlaceWsService) SyncDo(requestID string, request *OrderListPla
 	if err := json.Unmarshal(response, createOrderListWsResponse); err != nil {
 		return nil, err
 	}
+	if createOrderListWsResponse.Error != nil {
+		createOrderListWsResponse.Error.StatusCode = createOrderListWsResponse.Status
+		s.TimeSync.CheckError(createOrderListWsResponse.Error)
+		return nil, createOrderListWsResponse.Error
+	}
 
 	return createOrderListWsResponse, nil
 }
@@ -14,11 +14,15 @@ type OrderListCreateWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewOrderListCreateWsService init OrderListCreateWsService
-func NewOrderListCreateWsService(apiKey, secretKey string) (*OrderListCreateWsService, error) {
+func NewOrderListCreateWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OrderListCreateWsService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -34,9 +38,24 @@ func NewOrderListCreateWsService(apiKey, secretKey string) (*OrderListCreateWsSe
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewOrderListCreateWsServiceWithSession init OrderListCreateWsService sending its requests on the connection of session
+func NewOrderListCreateWsServiceWithSession(session *SessionWsService) *OrderListCreateWsService {
+	return &OrderListCreateWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // OrderListCreateWsRequest parameters for 'orderList.place.oco' websocket API
 type OrderListCreateWsRequest struct {
 	symbol                  string
@@ -158,9 +177,9 @@ func (s *OrderListCreateWsService) Do(requestID string, request *OrderListCreate
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderListPlaceOcoSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -182,9 +201,9 @@ func (s *OrderListCreateWsService) SyncDo(requestID string, request *OrderListCr
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderListPlaceOcoSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -201,6 +220,11 @@ func (s *OrderListCreateWsService) SyncDo(requestID string, request *OrderListCr
 	if err := json.Unmarshal(response, createOrderListWsResponse); err != nil {
 		return nil, err
 	}
+	if createOrderListWsResponse.Error != nil {
+		createOrderListWsResponse.Error.StatusCode = createOrderListWsResponse.Status
+		s.TimeSync.CheckError(createOrderListWsResponse.Error)
+		return nil, createOrderListWsResponse.Error
+	}
 
 	return createOrderListWsResponse, nil
 }
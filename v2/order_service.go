@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/adshao/go-binance/v2/common"
 )
@@ -24,6 +25,7 @@ type CreateOrderService struct {
 	trailingDelta           *string
 	icebergQuantity         *string
 	selfTradePreventionMode *SelfTradePreventionMode
+	roundToFilters          bool
 }
 
 // Symbol set symbol
@@ -104,7 +106,16 @@ func (s *CreateOrderService) SelfTradePreventionMode(selfTradePreventionMode Sel
 	return s
 }
 
+// RoundToFilters round price, stop price and quantities down to tick and step size of SymbolRules before the order is sent
+func (s *CreateOrderService) RoundToFilters(roundToFilters bool) *CreateOrderService {
+	s.roundToFilters = roundToFilters
+	return s
+}
+
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	if err = s.Validate(ctx); err != nil {
+		return []byte{}, err
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -175,7 +186,37 @@ func (s *CreateOrderService) Test(ctx context.Context, opts ...RequestOption) (e
 	return err
 }
 
+// Validate check the order against the filters of SymbolRules of the client, it returns a *common.FilterError listing
+// the violations. It is called by Do and Test, the order is not checked if SymbolRules is nil or the symbol is unknown.
+func (s *CreateOrderService) Validate(ctx context.Context) error {
+	if s.c.SymbolRules == nil {
+		return nil
+	}
+	rules, err := s.c.SymbolRules.Rules(ctx, s.symbol)
+	if err != nil || rules == nil {
+		return err
+	}
+	values := common.OrderValues{
+		Market:          s.orderType == OrderTypeMarket,
+		Price:           stringValue(s.price),
+		StopPrice:       stringValue(s.stopPrice),
+		Quantity:        stringValue(s.quantity),
+		QuoteQuantity:   stringValue(s.quoteOrderQty),
+		IcebergQuantity: stringValue(s.icebergQuantity),
+	}
+	if s.roundToFilters {
+		rules.Round(&values)
+		setStringValue(&s.price, values.Price)
+		setStringValue(&s.stopPrice, values.StopPrice)
+		setStringValue(&s.quantity, values.Quantity)
+		setStringValue(&s.icebergQuantity, values.IcebergQuantity)
+	}
+	return rules.Validate(values)
+}
+
 // CreateOrderResponse define create order response
+//
+//decimalgen:accessors
 type CreateOrderResponse struct {
 	Symbol                   string `json:"symbol"`
 	OrderID                  int64  `json:"orderId"`
@@ -202,6 +243,8 @@ type CreateOrderResponse struct {
 }
 
 // Fill may be returned in an array of fills in a CreateOrderResponse.
+//
+//decimalgen:accessors
 type Fill struct {
 	TradeID         int64  `json:"tradeId"`
 	Price           string `json:"price"`
@@ -387,6 +430,8 @@ type OCOOrder struct {
 }
 
 // OCOOrderReport may be returned in an array of OCOOrderReport in a CreateOCOResponse.
+//
+//decimalgen:accessors
 type OCOOrderReport struct {
 	Symbol                   string          `json:"symbol"`
 	OrderID                  int64           `json:"orderId"`
@@ -529,6 +574,8 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 }
 
 // Order define order info
+//
+//decimalgen:accessors
 type Order struct {
 	Symbol                   string          `json:"symbol"`
 	OrderID                  int64           `json:"orderId"`
@@ -623,6 +670,18 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Iterator walks the orders created between startTime and endTime in milliseconds, in windows of 24 hours and pages
+// of 1000 orders. OrderID is ignored.
+func (s *ListOrdersService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Order] {
+	s.orderID = nil
+	return common.NewCursorIterator(startTime, endTime, 24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*Order, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx, opts...)
+		},
+		func(o *Order) int64 { return o.Time },
+		func(o *Order) int64 { return o.OrderID })
+}
+
 // CancelOrderService cancel an order
 type CancelOrderService struct {
 	c                 *Client
@@ -771,29 +830,37 @@ func (s *CancelOpenOrdersService) Do(ctx context.Context, opts ...RequestOption)
 	if err != nil {
 		return &CancelOpenOrdersResponse{}, err
 	}
+	cancelOpenOrdersResponse := new(CancelOpenOrdersResponse)
+	if err := cancelOpenOrdersResponse.parse(data); err != nil {
+		return &CancelOpenOrdersResponse{}, err
+	}
+	return cancelOpenOrdersResponse, nil
+}
+
+// parse splits the canceled orders and order lists of data
+func (r *CancelOpenOrdersResponse) parse(data []byte) error {
 	rawMessages := make([]*json.RawMessage, 0)
-	err = json.Unmarshal(data, &rawMessages)
+	err := json.Unmarshal(data, &rawMessages)
 	if err != nil {
-		return &CancelOpenOrdersResponse{}, err
+		return err
 	}
-	cancelOpenOrdersResponse := new(CancelOpenOrdersResponse)
 	for _, j := range rawMessages {
 		o := new(CancelOrderResponse)
 		if err := json.Unmarshal(*j, o); err != nil {
-			return &CancelOpenOrdersResponse{}, err
+			return err
 		}
 		// Non-OCO orders guaranteed to have order list ID of -1
 		if o.OrderListID == -1 {
-			cancelOpenOrdersResponse.Orders = append(cancelOpenOrdersResponse.Orders, o)
+			r.Orders = append(r.Orders, o)
 			continue
 		}
 		oco := new(CancelOCOResponse)
 		if err := json.Unmarshal(*j, oco); err != nil {
-			return &CancelOpenOrdersResponse{}, err
+			return err
 		}
-		cancelOpenOrdersResponse.OCOOrders = append(cancelOpenOrdersResponse.OCOOrders, oco)
+		r.OCOOrders = append(r.OCOOrders, oco)
 	}
-	return cancelOpenOrdersResponse, nil
+	return nil
 }
 
 // CancelOpenOrdersResponse defines cancel open orders response.
@@ -803,6 +870,8 @@ type CancelOpenOrdersResponse struct {
 }
 
 // CancelOrderResponse may be returned included in a CancelOpenOrdersResponse.
+//
+//decimalgen:accessors
 type CancelOrderResponse struct {
 	Symbol                   string                  `json:"symbol"`
 	OrigClientOrderID        string                  `json:"origClientOrderId"`
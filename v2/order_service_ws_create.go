@@ -14,11 +14,15 @@ type OrderCreateWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewOrderCreateWsService init OrderCreateWsService
-func NewOrderCreateWsService(apiKey, secretKey string) (*OrderCreateWsService, error) {
+func NewOrderCreateWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*OrderCreateWsService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -34,9 +38,24 @@ func NewOrderCreateWsService(apiKey, secretKey string) (*OrderCreateWsService, e
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewOrderCreateWsServiceWithSession init OrderCreateWsService sending its requests on the connection of session
+func NewOrderCreateWsServiceWithSession(session *SessionWsService) *OrderCreateWsService {
+	return &OrderCreateWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // OrderCreateWsRequest parameters for 'order.place' websocket API
 type OrderCreateWsRequest struct {
 	symbol           string
@@ -118,9 +137,9 @@ func (s *OrderCreateWsService) Do(requestID string, request *OrderCreateWsReques
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -142,9 +161,9 @@ func (s *OrderCreateWsService) SyncDo(requestID string, request *OrderCreateWsRe
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.OrderPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -161,6 +180,11 @@ func (s *OrderCreateWsService) SyncDo(requestID string, request *OrderCreateWsRe
 	if err := json.Unmarshal(response, createOrderWsResponse); err != nil {
 		return nil, err
 	}
+	if createOrderWsResponse.Error != nil {
+		createOrderWsResponse.Error.StatusCode = createOrderWsResponse.Status
+		s.TimeSync.CheckError(createOrderWsResponse.Error)
+		return nil, createOrderWsResponse.Error
+	}
 
 	return createOrderWsResponse, nil
 }
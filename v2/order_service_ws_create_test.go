@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/adshao/go-binance/v2/common/websocket"
 	"github.com/adshao/go-binance/v2/common/websocket/mock"
 	"github.com/golang/mock/gomock"
@@ -156,6 +157,21 @@ func (s *orderPlaceServiceWsTestSuite) TestOrderPlaceSync() {
 	s.Equal(*req.price, response.Result.Price)
 }
 
+func (s *orderPlaceServiceWsTestSuite) TestOrderPlaceSync_APIError() {
+	s.reset(s.apiKey, s.secretKey, s.signedKey, s.timeOffset)
+
+	rawResponseData := []byte(`{"id":"` + s.requestID + `","status":400,"error":{"code":-2010,"msg":"Account has insufficient balance for requested action."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderPlace.SyncDo(s.requestID, s.orderPlaceRequest)
+	s.Nil(response)
+	s.ErrorIs(err, common.ErrInsufficientBalance)
+	var apiErr *common.APIError
+	s.Require().ErrorAs(err, &apiErr)
+	s.Equal(int64(-2010), apiErr.Code)
+	s.Equal(400, apiErr.StatusCode)
+}
+
 func (s *orderPlaceServiceWsTestSuite) TestOrderPlaceSync_EmptyRequestID() {
 	s.reset(s.apiKey, s.secretKey, s.signedKey, s.timeOffset)
 
@@ -0,0 +1,110 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *orderStatusServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.signedKey = "HMAC"
+	s.timeOffset = 0
+
+	s.requestID = "3874ebad-12fa-49ff-bed1-be59148f23eb"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.orderStatus = &OrderStatusWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   s.signedKey,
+	}
+
+	s.orderStatusRequest = NewOrderStatusWsRequest().
+		Symbol("BTCUSDT").
+		OrigClientOrderID("4d96324ff9d44481926157")
+}
+
+func (s *orderStatusServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type orderStatusServiceWsTestSuite struct {
+	suite.Suite
+	apiKey     string
+	secretKey  string
+	signedKey  string
+	timeOffset int64
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	orderStatus        *OrderStatusWsService
+	orderStatusRequest *OrderStatusWsRequest
+}
+
+func TestOrderStatusServiceWs(t *testing.T) {
+	suite.Run(t, new(orderStatusServiceWsTestSuite))
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatus() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.orderStatus.Do(s.requestID, s.orderStatusRequest)
+	s.NoError(err)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatus_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderStatus.Do("", s.orderStatusRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatus_EmptyApiKey() {
+	s.orderStatus.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.orderStatus.Do(s.requestID, s.orderStatusRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatusSync() {
+	rawResponseData := []byte(`{"id":"3874ebad-12fa-49ff-bed1-be59148f23eb","status":200,"result":{"symbol":"BTCUSDT","orderId":12569099453,"orderListId":-1,"clientOrderId":"4d96324ff9d44481926157","price":"23416.10000000","origQty":"0.00847000","executedQty":"0.00847000","cummulativeQuoteQty":"198.33521500","status":"FILLED","timeInForce":"GTC","type":"LIMIT","side":"SELL","stopPrice":"0.00000000","icebergQty":"0.00000000","time":1660801715639,"updateTime":1660801717945,"isWorking":true,"origQuoteOrderQty":"0.00000000"}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderStatus.SyncDo(s.requestID, s.orderStatusRequest)
+	s.Require().NoError(err)
+	s.Equal("4d96324ff9d44481926157", response.Result.ClientOrderID)
+	s.Equal(OrderStatusTypeFilled, response.Result.Status)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatusSync_Error() {
+	rawResponseData := []byte(`{"id":"3874ebad-12fa-49ff-bed1-be59148f23eb","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.orderStatus.SyncDo(s.requestID, s.orderStatusRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatusSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.orderStatus.SyncDo(s.requestID, s.orderStatusRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -0,0 +1,351 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+const (
+	// orderBookEventBufferSize is the number of depth events queued before reading the stream blocks
+	orderBookEventBufferSize = 1000
+
+	// orderBookRetryInterval is the delay before a failed or outdated snapshot is requested again
+	orderBookRetryInterval = time.Second
+)
+
+var (
+	// ErrOrderBookGap is reported when a depth event doesn't continue the local order book, the book is resynced
+	ErrOrderBookGap = errors.New("order book: gap in depth stream, resyncing")
+
+	// ErrOrderBookStopped is returned when starting an order book which has already been stopped
+	ErrOrderBookStopped = errors.New("order book: stopped")
+)
+
+// OrderBookUpdateHandler is called after the local order book has been updated
+type OrderBookUpdateHandler func(book *OrderBook)
+
+// orderBookSnapshot is the result of a depth snapshot request
+type orderBookSnapshot struct {
+	res *DepthResponse
+	err error
+}
+
+// OrderBook keeps a local order book of a symbol by applying the diff. depth stream to a depth snapshot.
+// Gaps in the stream are detected and the book is rebuilt from a new snapshot automatically.
+// All getters are safe for concurrent use.
+type OrderBook struct {
+	c             *Client
+	symbol        string
+	limit         int
+	rate          time.Duration
+	updateHandler OrderBookUpdateHandler
+	errHandler    ErrHandler
+
+	mu           sync.RWMutex
+	bids         *common.OrderBookSide
+	asks         *common.OrderBookSide
+	lastUpdateID int64
+	synced       bool
+
+	// used by the processing goroutine only
+	buffer   []*WsDepthEvent
+	fetching bool
+
+	eventC    chan *WsDepthEvent
+	snapshotC chan orderBookSnapshot
+	stopC     chan struct{}
+	doneC     chan struct{}
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+// NewOrderBook init local order book of symbol, call Start to sync it
+func (c *Client) NewOrderBook(symbol string) *OrderBook {
+	return &OrderBook{
+		c:         c,
+		symbol:    symbol,
+		limit:     1000,
+		rate:      time.Second,
+		bids:      common.NewOrderBookSide(true),
+		asks:      common.NewOrderBookSide(false),
+		eventC:    make(chan *WsDepthEvent, orderBookEventBufferSize),
+		snapshotC: make(chan orderBookSnapshot, 1),
+		stopC:     make(chan struct{}),
+		doneC:     make(chan struct{}),
+	}
+}
+
+// Limit set depth of the snapshot the book is built from
+func (b *OrderBook) Limit(limit int) *OrderBook {
+	b.limit = limit
+	return b
+}
+
+// UpdateSpeed set update speed of the depth stream, 1s (default) or 100ms
+func (b *OrderBook) UpdateSpeed(rate time.Duration) *OrderBook {
+	b.rate = rate
+	return b
+}
+
+// OnUpdate set handler called after every update of the book
+func (b *OrderBook) OnUpdate(handler OrderBookUpdateHandler) *OrderBook {
+	b.updateHandler = handler
+	return b
+}
+
+// OnError set handler receiving stream, snapshot and resync errors
+func (b *OrderBook) OnError(errHandler ErrHandler) *OrderBook {
+	b.errHandler = errHandler
+	return b
+}
+
+// Start subscribes to the depth stream and syncs the book. The book is kept in sync until Stop is called,
+// ctx is done or the depth stream is closed.
+func (b *OrderBook) Start(ctx context.Context) (err error) {
+	err = ErrOrderBookStopped
+	b.startOnce.Do(func() {
+		var serve func(string, WsDepthHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+		switch b.rate {
+		case time.Second:
+			serve = WsDepthServe
+		case 100 * time.Millisecond:
+			serve = WsDepthServe100Ms
+		default:
+			err = errors.New("Invalid rate")
+			close(b.doneC)
+			return
+		}
+
+		var wsDoneC, wsStopC chan struct{}
+		wsDoneC, wsStopC, err = serve(b.symbol, func(event *WsDepthEvent) {
+			select {
+			case b.eventC <- event:
+			case <-b.doneC:
+			}
+		}, b.error)
+		if err != nil {
+			close(b.doneC)
+			return
+		}
+		go b.run(ctx, wsDoneC, wsStopC)
+	})
+	return err
+}
+
+// Stop unsubscribes from the depth stream
+func (b *OrderBook) Stop() {
+	b.stopOnce.Do(func() {
+		close(b.stopC)
+	})
+}
+
+// Done returns channel closed once the book stopped syncing
+func (b *OrderBook) Done() <-chan struct{} {
+	return b.doneC
+}
+
+// Symbol returns symbol of the book
+func (b *OrderBook) Symbol() string {
+	return b.symbol
+}
+
+// IsSynced reports whether the book is in sync with the exchange
+func (b *OrderBook) IsSynced() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.synced
+}
+
+// LastUpdateID returns update id of the last applied depth event
+func (b *OrderBook) LastUpdateID() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.lastUpdateID
+}
+
+// BestBid returns the highest bid
+func (b *OrderBook) BestBid() (Bid, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.bids.Best()
+}
+
+// BestAsk returns the lowest ask
+func (b *OrderBook) BestAsk() (Ask, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.asks.Best()
+}
+
+// Bids returns up to n best bids, all bids if n <= 0
+func (b *OrderBook) Bids(n int) []Bid {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.bids.Depth(n)
+}
+
+// Asks returns up to n best asks, all asks if n <= 0
+func (b *OrderBook) Asks(n int) []Ask {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.asks.Depth(n)
+}
+
+// run processes depth events and snapshots until the book is stopped
+func (b *OrderBook) run(ctx context.Context, wsDoneC, wsStopC chan struct{}) {
+	defer close(b.doneC)
+	for {
+		select {
+		case <-b.stopC:
+			close(wsStopC)
+			return
+		case <-ctx.Done():
+			close(wsStopC)
+			return
+		case <-wsDoneC:
+			return
+		case event := <-b.eventC:
+			b.handleEvent(ctx, event)
+		case snapshot := <-b.snapshotC:
+			b.handleSnapshot(ctx, snapshot)
+		}
+	}
+}
+
+func (b *OrderBook) handleEvent(ctx context.Context, event *WsDepthEvent) {
+	if !b.IsSynced() {
+		b.buffer = append(b.buffer, event)
+		b.fetchSnapshot(ctx, 0)
+		return
+	}
+	if b.process(ctx, event) {
+		b.notify()
+	}
+}
+
+func (b *OrderBook) handleSnapshot(ctx context.Context, snapshot orderBookSnapshot) {
+	b.fetching = false
+	if snapshot.err != nil {
+		b.error(snapshot.err)
+		b.fetchSnapshot(ctx, orderBookRetryInterval)
+		return
+	}
+	res := snapshot.res
+
+	// drop the events the snapshot already contains
+	i := 0
+	for i < len(b.buffer) && b.buffer[i].LastUpdateID <= res.LastUpdateID {
+		i++
+	}
+	b.buffer = b.buffer[i:]
+	if len(b.buffer) > 0 && b.buffer[0].FirstUpdateID > res.LastUpdateID+1 {
+		// the snapshot is older than the buffered events
+		b.fetchSnapshot(ctx, orderBookRetryInterval)
+		return
+	}
+
+	b.mu.Lock()
+	b.bids.Reset()
+	b.asks.Reset()
+	err := b.load(res.Bids, res.Asks)
+	b.lastUpdateID = res.LastUpdateID
+	b.synced = true
+	b.mu.Unlock()
+	if err != nil {
+		b.error(err)
+	}
+
+	buffer := b.buffer
+	b.buffer = nil
+	for _, event := range buffer {
+		if !b.IsSynced() {
+			// a gap has been detected, the rest of the events wait for the next snapshot
+			b.buffer = append(b.buffer, event)
+			continue
+		}
+		b.process(ctx, event)
+	}
+	if b.IsSynced() {
+		b.notify()
+	}
+}
+
+// process applies event to the synced book, it returns false if the event was skipped
+func (b *OrderBook) process(ctx context.Context, event *WsDepthEvent) bool {
+	b.mu.Lock()
+	if event.LastUpdateID <= b.lastUpdateID {
+		b.mu.Unlock()
+		return false
+	}
+	if event.FirstUpdateID > b.lastUpdateID+1 {
+		b.synced = false
+		b.bids.Reset()
+		b.asks.Reset()
+		b.mu.Unlock()
+
+		b.buffer = append(b.buffer, event)
+		b.error(ErrOrderBookGap)
+		b.fetchSnapshot(ctx, 0)
+		return false
+	}
+	err := b.load(event.Bids, event.Asks)
+	b.lastUpdateID = event.LastUpdateID
+	b.mu.Unlock()
+	if err != nil {
+		b.error(err)
+	}
+	return true
+}
+
+// load updates price levels and returns the first invalid level error, the caller must hold b.mu
+func (b *OrderBook) load(bids []Bid, asks []Ask) (err error) {
+	for _, bid := range bids {
+		if e := b.bids.Update(bid); e != nil && err == nil {
+			err = e
+		}
+	}
+	for _, ask := range asks {
+		if e := b.asks.Update(ask); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// fetchSnapshot requests a depth snapshot after delay unless a request is already in flight
+func (b *OrderBook) fetchSnapshot(ctx context.Context, delay time.Duration) {
+	if b.fetching {
+		return
+	}
+	b.fetching = true
+	go func() {
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-b.doneC:
+				return
+			}
+		}
+		res, err := b.c.NewDepthService().Symbol(b.symbol).Limit(b.limit).Do(ctx)
+		select {
+		case b.snapshotC <- orderBookSnapshot{res: res, err: err}:
+		case <-b.doneC:
+		}
+	}()
+}
+
+func (b *OrderBook) notify() {
+	if b.updateHandler != nil {
+		b.updateHandler(b)
+	}
+}
+
+func (b *OrderBook) error(err error) {
+	if b.errHandler != nil {
+		b.errHandler(err)
+	}
+}
@@ -0,0 +1,109 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderBookTestSuite struct {
+	baseTestSuite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler, ConnHandler) (chan struct{}, chan struct{}, error)
+	handlerC    chan WsHandler
+	snapshots   chan []byte
+	symbols     chan string
+}
+
+func TestOrderBook(t *testing.T) {
+	suite.Run(t, new(orderBookTestSuite))
+}
+
+func (s *orderBookTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsServe = wsServeWithConnHandler
+	s.handlerC = make(chan WsHandler, 1)
+	s.snapshots = make(chan []byte, 10)
+	s.symbols = make(chan string, 10)
+	wsServeWithConnHandler = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler, connHandler ConnHandler) (doneC, stopC chan struct{}, err error) {
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		s.handlerC <- handler
+		return doneC, stopC, nil
+	}
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		s.symbols <- req.URL.Query().Get("symbol")
+		return newHTTPResponse(<-s.snapshots, http.StatusOK), nil
+	}
+}
+
+func (s *orderBookTestSuite) TearDownTest() {
+	wsServeWithConnHandler = s.origWsServe
+}
+
+func (s *orderBookTestSuite) waitUpdate(updateC chan int64) int64 {
+	select {
+	case id := <-updateC:
+		return id
+	case <-time.After(5 * time.Second):
+		s.FailNow("order book was not updated")
+	}
+	return 0
+}
+
+func (s *orderBookTestSuite) TestOrderBook() {
+	updateC := make(chan int64, 10)
+	errC := make(chan error, 10)
+	book := s.client.NewOrderBook("BNBBTC").Limit(5).OnUpdate(func(book *OrderBook) {
+		updateC <- book.LastUpdateID()
+	}).OnError(func(err error) {
+		errC <- err
+	})
+	s.r().NoError(book.Start(context.Background()))
+	defer func() {
+		book.Stop()
+		<-book.Done()
+	}()
+	handler := <-s.handlerC
+	s.False(book.IsSynced())
+
+	// buffered until the snapshot arrives
+	handler([]byte(`{"e":"depthUpdate","E":1,"s":"BNBBTC","U":155,"u":157,"b":[["0.0023","5"]],"a":[]}`))
+	handler([]byte(`{"e":"depthUpdate","E":2,"s":"BNBBTC","U":158,"u":160,"b":[["0.0024","10"]],"a":[["0.0026","0"]]}`))
+	s.Equal("BNBBTC", <-s.symbols)
+	s.snapshots <- []byte(`{"lastUpdateId":158,"bids":[["0.0024","1"],["0.0023","1"]],"asks":[["0.0026","1"],["0.0027","2"]]}`)
+	s.Equal(int64(160), s.waitUpdate(updateC))
+	s.True(book.IsSynced())
+
+	bid, ok := book.BestBid()
+	s.True(ok)
+	s.Equal(Bid{Price: "0.0024", Quantity: "10"}, bid)
+	ask, ok := book.BestAsk()
+	s.True(ok)
+	s.Equal(Ask{Price: "0.0027", Quantity: "2"}, ask)
+
+	handler([]byte(`{"e":"depthUpdate","E":3,"s":"BNBBTC","U":161,"u":161,"b":[["0.0025","3"]],"a":[["0.00255","4"]]}`))
+	s.Equal(int64(161), s.waitUpdate(updateC))
+	s.Equal([]Bid{{Price: "0.0025", Quantity: "3"}, {Price: "0.0024", Quantity: "10"}}, book.Bids(2))
+	s.Equal([]Ask{{Price: "0.00255", Quantity: "4"}, {Price: "0.0027", Quantity: "2"}}, book.Asks(0))
+
+	// a gap triggers a resync from a new snapshot
+	handler([]byte(`{"e":"depthUpdate","E":4,"s":"BNBBTC","U":170,"u":171,"b":[["0.0021","7"]],"a":[]}`))
+	s.Equal(ErrOrderBookGap, <-errC)
+	s.Equal("BNBBTC", <-s.symbols)
+	s.snapshots <- []byte(`{"lastUpdateId":169,"bids":[["0.0020","1"]],"asks":[["0.0030","1"]]}`)
+	s.Equal(int64(171), s.waitUpdate(updateC))
+	s.Equal([]Bid{{Price: "0.0021", Quantity: "7"}, {Price: "0.0020", Quantity: "1"}}, book.Bids(0))
+	s.Equal([]Ask{{Price: "0.0030", Quantity: "1"}}, book.Asks(0))
+}
+
+func (s *orderBookTestSuite) TestInvalidRate() {
+	err := s.client.NewOrderBook("BNBBTC").UpdateSpeed(time.Minute).Start(context.Background())
+	s.r().Error(err)
+}
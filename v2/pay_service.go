@@ -66,6 +66,7 @@ type PayTradeHistory struct {
 	Success bool           `json:"success"`
 }
 
+//decimalgen:accessors
 type PayTradeItem struct {
 	OrderType       string        `json:"orderType"`
 	TransactionID   string        `json:"transactionId"`
@@ -77,6 +78,7 @@ type PayTradeItem struct {
 	FundsDetail     []FundsDetail `json:"fundsDetail"`
 }
 
+//decimalgen:accessors
 type FundsDetail struct {
 	Currency string `json:"currency"`
 	Amount   string `json:"amount"`
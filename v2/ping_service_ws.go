@@ -0,0 +1,106 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// PingWsService tests connectivity to the websocket API
+type PingWsService struct {
+	c websocket.Client
+}
+
+// NewPingWsService init PingWsService
+func NewPingWsService() (*PingWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PingWsService{
+		c: client,
+	}, nil
+}
+
+// NewPingWsServiceWithSession init PingWsService sending its requests on the connection of session
+func NewPingWsServiceWithSession(session *SessionWsService) *PingWsService {
+	return &PingWsService{
+		c: session.c,
+	}
+}
+
+// Do - sends 'ping' request
+func (s *PingWsService) Do(requestID string) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.PingSpotWsApiMethod, map[string]any{})
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'ping' request and receives response
+func (s *PingWsService) SyncDo(requestID string) (*PingWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.PingSpotWsApiMethod, map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	pingWsResponse := &PingWsResponse{}
+	if err := json.Unmarshal(response, pingWsResponse); err != nil {
+		return nil, err
+	}
+	if pingWsResponse.Error != nil {
+		pingWsResponse.Error.StatusCode = pingWsResponse.Status
+		return nil, pingWsResponse.Error
+	}
+
+	return pingWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *PingWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *PingWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *PingWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *PingWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// PingWsResponse define 'ping' websocket API response
+type PingWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result struct{} `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
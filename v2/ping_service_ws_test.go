@@ -0,0 +1,84 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *pingServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.ping = &PingWsService{
+		c: s.client,
+	}
+}
+
+func (s *pingServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type pingServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	ping *PingWsService
+}
+
+func TestPingServiceWs(t *testing.T) {
+	suite.Run(t, new(pingServiceWsTestSuite))
+}
+
+func (s *pingServiceWsTestSuite) TestPing() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.ping.Do(s.requestID)
+	s.NoError(err)
+}
+
+func (s *pingServiceWsTestSuite) TestPing_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.ping.Do("")
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *pingServiceWsTestSuite) TestPingSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":{}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.ping.SyncDo(s.requestID)
+	s.Require().NoError(err)
+	s.Equal(200, response.Status)
+}
+
+func (s *pingServiceWsTestSuite) TestPingSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.ping.SyncDo(s.requestID)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *pingServiceWsTestSuite) TestPingSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.ping.SyncDo(s.requestID)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -31,6 +31,8 @@ func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // Account define account info
+//
+//decimalgen:accessors
 type Account struct {
 	UniMMR                   string `json:"uniMMR"`        // Portfolio margin account maintenance margin rate
 	AccountEquity            string `json:"accountEquity"` // Account equity, in USD value
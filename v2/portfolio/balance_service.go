@@ -52,6 +52,8 @@ func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // Balance define user balance of your account
+//
+//decimalgen:accessors
 type Balance struct {
 	Asset               string `json:"asset"`
 	TotalWalletBalance  string `json:"totalWalletBalance"`
@@ -18,6 +18,9 @@ import (
 	"github.com/adshao/go-binance/v2/common"
 )
 
+//go:generate go run ../internal/decimalgen
+//go:generate go run ../internal/streamgen
+
 // SideType define side type of order
 type SideType string
 
@@ -80,6 +83,8 @@ type SelfTradePreventionMode string
 type StrategyType string
 
 // UMPosition define UM position information
+//
+//decimalgen:accessors
 type UMPosition struct {
 	Symbol                 string `json:"symbol"`                     // symbol name
 	PositionAmt            string `json:"positionAmt"`                // position amount
@@ -104,6 +109,8 @@ type UMPosition struct {
 // ... existing code ...
 
 // CMPosition define CM position information
+//
+//decimalgen:accessors
 type CMPosition struct {
 	Symbol                 string `json:"symbol"`                     // Symbol name
 	PositionAmt            string `json:"positionAmt"`                // Position amount
@@ -330,6 +337,13 @@ type Client struct {
 
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+
+	// TimeSync keeps the offset to the server clock, if set its offset is used instead of TimeOffset
+	TimeSync *common.TimeSync
+	// Signer signs the requests instead of SecretKey and KeyType, if set
+	Signer common.Signer
+
+	signers common.SignerCache
 }
 
 func (c *Client) debug(format string, v ...any) {
@@ -338,6 +352,18 @@ func (c *Client) debug(format string, v ...any) {
 	}
 }
 
+// signer returns Signer if it is set, otherwise the signer of SecretKey and KeyType
+func (c *Client) signer() (common.Signer, error) {
+	if c.Signer != nil {
+		return c.Signer, nil
+	}
+	kt := c.KeyType
+	if kt == "" {
+		kt = common.KeyTypeHmac
+	}
+	return c.signers.Signer(kt, c.SecretKey)
+}
+
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	// set request options from user
 	for _, opt := range opts {
@@ -353,7 +379,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.TimeSync.OffsetOr(c.TimeOffset))
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -369,22 +395,18 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
 		header.Set("X-MBX-APIKEY", c.APIKey)
 	}
-	kt := c.KeyType
-	if kt == "" {
-		kt = common.KeyTypeHmac
-	}
-	sf, err := common.SignFunc(kt)
-	if err != nil {
-		return err
-	}
 	if r.secType == secTypeSigned {
+		signer, err := c.signer()
+		if err != nil {
+			return err
+		}
 		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		sign, err := sf(c.SecretKey, raw)
+		sign, err := signer.Sign(raw)
 		if err != nil {
 			return err
 		}
 		v := url.Values{}
-		v.Set(signatureKey, *sign)
+		v.Set(signatureKey, sign)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -443,14 +465,22 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	if res.StatusCode >= http.StatusBadRequest {
 		// Try to parse the error response
 		var apiErr Error
+		var respErr *Error
 		e := json.Unmarshal(data, &apiErr)
 		if e != nil {
 			c.debug("failed to unmarshal error response: %s\n", e)
 			// If we can't parse the JSON response, return a generic error with the raw response
-			return nil, &res.Header, NewErrorFromResponse(int64(res.StatusCode), res.Status, data)
+			respErr = NewErrorFromResponse(int64(res.StatusCode), res.Status, data)
+		} else {
+			// Return the parsed error with the raw response included
+			respErr = NewErrorFromResponse(apiErr.Code, apiErr.Message, data)
 		}
-		// Return the parsed error with the raw response included
-		return nil, &res.Header, NewErrorFromResponse(apiErr.Code, apiErr.Message, data)
+		respErr.StatusCode = res.StatusCode
+		respErr.Header = res.Header
+		respErr.Method = r.method
+		respErr.Endpoint = r.endpoint
+		c.TimeSync.CheckError(respErr)
+		return nil, &res.Header, respErr
 	}
 	return data, &res.Header, nil
 }
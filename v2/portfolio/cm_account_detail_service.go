@@ -38,6 +38,8 @@ type CMAccountDetail struct {
 }
 
 // CMAsset define CM asset info
+//
+//decimalgen:accessors
 type CMAsset struct {
 	Asset                  string `json:"asset"`                  // asset name
 	CrossWalletBalance     string `json:"crossWalletBalance"`     // total wallet balance
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CMAccountTradesService service to get CM account trade list
@@ -115,7 +118,21 @@ func (s *CMAccountTradesService) Do(ctx context.Context) ([]*CMAccountTrade, err
 	return res, nil
 }
 
+// Iterator walks the trades between startTime and endTime in milliseconds, in windows of 7 days and pages of 1000
+// trades. FromID is ignored.
+func (s *CMAccountTradesService) Iterator(startTime, endTime int64) *common.Iterator[*CMAccountTrade] {
+	s.fromID = nil
+	return common.NewCursorIterator(startTime, endTime, 7*24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*CMAccountTrade, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		func(t *CMAccountTrade) int64 { return t.Time },
+		func(t *CMAccountTrade) int64 { return t.ID })
+}
+
 // CMAccountTrade define CM account trade
+//
+//decimalgen:accessors
 type CMAccountTrade struct {
 	Symbol          string `json:"symbol"`
 	ID              int64  `json:"id"`
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CMAllOrdersService service to get all CM orders
@@ -101,7 +104,21 @@ func (s *CMAllOrdersService) Do(ctx context.Context) ([]*CMAllOrdersResponse, er
 	return res, nil
 }
 
+// Iterator walks the orders created between startTime and endTime in milliseconds, in windows of 7 days and pages of
+// 100 orders. OrderID is ignored.
+func (s *CMAllOrdersService) Iterator(startTime, endTime int64) *common.Iterator[*CMAllOrdersResponse] {
+	s.orderID = nil
+	return common.NewCursorIterator(startTime, endTime, 7*24*time.Hour, 100,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*CMAllOrdersResponse, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		func(o *CMAllOrdersResponse) int64 { return o.Time },
+		func(o *CMAllOrdersResponse) int64 { return o.OrderID })
+}
+
 // CMAllOrdersResponse define all orders response
+//
+//decimalgen:accessors
 type CMAllOrdersResponse struct {
 	AvgPrice      string `json:"avgPrice"`
 	ClientOrderID string `json:"clientOrderId"`
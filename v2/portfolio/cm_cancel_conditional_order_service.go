@@ -70,6 +70,8 @@ func (s *CMCancelConditionalOrderService) Do(ctx context.Context) (*CMCancelCond
 }
 
 // CMCancelConditionalOrderResponse define cancel conditional order response
+//
+//decimalgen:accessors
 type CMCancelConditionalOrderResponse struct {
 	NewClientStrategyID string `json:"newClientStrategyId"`
 	StrategyID          int64  `json:"strategyId"`
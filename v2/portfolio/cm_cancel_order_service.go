@@ -70,6 +70,8 @@ func (s *CMCancelOrderService) Do(ctx context.Context) (*CMCancelOrderResponse,
 }
 
 // CMCancelOrderResponse define cancel order response
+//
+//decimalgen:accessors
 type CMCancelOrderResponse struct {
 	AvgPrice      string `json:"avgPrice"`
 	ClientOrderID string `json:"clientOrderId"`
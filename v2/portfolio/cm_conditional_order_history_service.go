@@ -71,6 +71,8 @@ func (s *CMConditionalOrderHistoryService) Do(ctx context.Context) (*CMCondition
 }
 
 // CMConditionalOrderHistoryResponse define conditional order history response
+//
+//decimalgen:accessors
 type CMConditionalOrderHistoryResponse struct {
 	NewClientStrategyID string `json:"newClientStrategyId"`
 	StrategyID          int64  `json:"strategyId"`
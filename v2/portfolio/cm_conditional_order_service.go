@@ -159,6 +159,8 @@ func (s *CMConditionalOrderService) Do(ctx context.Context, opts ...RequestOptio
 }
 
 // CMConditionalOrder define conditional order info
+//
+//decimalgen:accessors
 type CMConditionalOrder struct {
 	NewClientStrategyId string           `json:"newClientStrategyId"`
 	StrategyId          int64            `json:"strategyId"`
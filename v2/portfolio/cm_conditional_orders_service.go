@@ -92,6 +92,8 @@ func (s *CMConditionalOrdersService) Do(ctx context.Context) ([]*CMConditionalOr
 }
 
 // CMConditionalOrderResponse define conditional order response
+//
+//decimalgen:accessors
 type CMConditionalOrderResponse struct {
 	NewClientStrategyID string `json:"newClientStrategyId"`
 	StrategyID          int64  `json:"strategyId"`
@@ -92,6 +92,8 @@ func (s *CMForceOrdersService) Do(ctx context.Context) ([]*CMForceOrderResponse,
 }
 
 // CMForceOrderResponse define force order response
+//
+//decimalgen:accessors
 type CMForceOrderResponse struct {
 	OrderID       int64  `json:"orderId"`
 	Symbol        string `json:"symbol"`
@@ -48,6 +48,8 @@ func (s *ChangeCMInitialLeverageService) Do(ctx context.Context, opts ...Request
 }
 
 // CMLeverage define leverage info
+//
+//decimalgen:accessors
 type CMLeverage struct {
 	Leverage int    `json:"leverage"`
 	MaxQty   string `json:"maxQty"`
@@ -94,6 +94,8 @@ func (s *CMModifyOrderService) Do(ctx context.Context) (*CMModifyOrderResponse,
 }
 
 // CMModifyOrderResponse define modify order response
+//
+//decimalgen:accessors
 type CMModifyOrderResponse struct {
 	OrderID       int64  `json:"orderId"`
 	Symbol        string `json:"symbol"`
@@ -71,6 +71,8 @@ func (s *CMOpenConditionalOrderService) Do(ctx context.Context) (*CMOpenConditio
 }
 
 // CMOpenConditionalOrderResponse define open conditional order response
+//
+//decimalgen:accessors
 type CMOpenConditionalOrderResponse struct {
 	NewClientStrategyID string `json:"newClientStrategyId"`
 	StrategyID          int64  `json:"strategyId"`
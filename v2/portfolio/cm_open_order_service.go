@@ -70,6 +70,8 @@ func (s *CMOpenOrderService) Do(ctx context.Context) (*CMOpenOrderResponse, erro
 }
 
 // CMOpenOrderResponse define open order response
+//
+//decimalgen:accessors
 type CMOpenOrderResponse struct {
 	AvgPrice      string `json:"avgPrice"`
 	ClientOrderID string `json:"clientOrderId"`
@@ -62,6 +62,8 @@ func (s *CMOpenOrdersService) Do(ctx context.Context) ([]*CMOpenOrdersResponse,
 }
 
 // CMOpenOrdersResponse define open orders response
+//
+//decimalgen:accessors
 type CMOpenOrdersResponse struct {
 	AvgPrice      string `json:"avgPrice"`
 	ClientOrderID string `json:"clientOrderId"`
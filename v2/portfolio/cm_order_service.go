@@ -132,6 +132,8 @@ func (s *CMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CM
 }
 
 // CMOrder define CM order info
+//
+//decimalgen:accessors
 type CMOrder struct {
 	ClientOrderID string           `json:"clientOrderId"`
 	CumQty        string           `json:"cumQty"`
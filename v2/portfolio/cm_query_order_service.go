@@ -70,6 +70,8 @@ func (s *CMQueryOrderService) Do(ctx context.Context) (*CMQueryOrderResponse, er
 }
 
 // CMQueryOrderResponse define query order response
+//
+//decimalgen:accessors
 type CMQueryOrderResponse struct {
 	AvgPrice      string `json:"avgPrice"`
 	ClientOrderID string `json:"clientOrderId"`
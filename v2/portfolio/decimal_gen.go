@@ -0,0 +1,1539 @@
+// Code generated by decimalgen; DO NOT EDIT.
+
+package portfolio
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// AccountEquityDecimal returns AccountEquity as a decimal, zero if it is empty
+func (v *Account) AccountEquityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.AccountEquity", v.AccountEquity)
+}
+
+// AccountInitialMarginDecimal returns AccountInitialMargin as a decimal, zero if it is empty
+func (v *Account) AccountInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.AccountInitialMargin", v.AccountInitialMargin)
+}
+
+// AccountMaintMarginDecimal returns AccountMaintMargin as a decimal, zero if it is empty
+func (v *Account) AccountMaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.AccountMaintMargin", v.AccountMaintMargin)
+}
+
+// ActualEquityDecimal returns ActualEquity as a decimal, zero if it is empty
+func (v *Account) ActualEquityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.ActualEquity", v.ActualEquity)
+}
+
+// TotalAvailableBalanceDecimal returns TotalAvailableBalance as a decimal, zero if it is empty
+func (v *Account) TotalAvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.TotalAvailableBalance", v.TotalAvailableBalance)
+}
+
+// TotalMarginOpenLossDecimal returns TotalMarginOpenLoss as a decimal, zero if it is empty
+func (v *Account) TotalMarginOpenLossDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.TotalMarginOpenLoss", v.TotalMarginOpenLoss)
+}
+
+// VirtualMaxWithdrawAmountDecimal returns VirtualMaxWithdrawAmount as a decimal, zero if it is empty
+func (v *Account) VirtualMaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Account.VirtualMaxWithdrawAmount", v.VirtualMaxWithdrawAmount)
+}
+
+// CMWalletBalanceDecimal returns CMWalletBalance as a decimal, zero if it is empty
+func (v *Balance) CMWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.CMWalletBalance", v.CMWalletBalance)
+}
+
+// CrossMarginBorrowedDecimal returns CrossMarginBorrowed as a decimal, zero if it is empty
+func (v *Balance) CrossMarginBorrowedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.CrossMarginBorrowed", v.CrossMarginBorrowed)
+}
+
+// CrossMarginFreeDecimal returns CrossMarginFree as a decimal, zero if it is empty
+func (v *Balance) CrossMarginFreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.CrossMarginFree", v.CrossMarginFree)
+}
+
+// CrossMarginInterestDecimal returns CrossMarginInterest as a decimal, zero if it is empty
+func (v *Balance) CrossMarginInterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.CrossMarginInterest", v.CrossMarginInterest)
+}
+
+// CrossMarginLockedDecimal returns CrossMarginLocked as a decimal, zero if it is empty
+func (v *Balance) CrossMarginLockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.CrossMarginLocked", v.CrossMarginLocked)
+}
+
+// NegativeBalanceDecimal returns NegativeBalance as a decimal, zero if it is empty
+func (v *Balance) NegativeBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.NegativeBalance", v.NegativeBalance)
+}
+
+// TotalWalletBalanceDecimal returns TotalWalletBalance as a decimal, zero if it is empty
+func (v *Balance) TotalWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.TotalWalletBalance", v.TotalWalletBalance)
+}
+
+// UMWalletBalanceDecimal returns UMWalletBalance as a decimal, zero if it is empty
+func (v *Balance) UMWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Balance.UMWalletBalance", v.UMWalletBalance)
+}
+
+// BaseQtyDecimal returns BaseQty as a decimal, zero if it is empty
+func (v *CMAccountTrade) BaseQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAccountTrade.BaseQty", v.BaseQty)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *CMAccountTrade) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAccountTrade.Commission", v.Commission)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMAccountTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAccountTrade.Price", v.Price)
+}
+
+// QtyDecimal returns Qty as a decimal, zero if it is empty
+func (v *CMAccountTrade) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAccountTrade.Qty", v.Qty)
+}
+
+// RealizedPnlDecimal returns RealizedPnl as a decimal, zero if it is empty
+func (v *CMAccountTrade) RealizedPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAccountTrade.RealizedPnl", v.RealizedPnl)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *CMAllOrdersResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAllOrdersResponse.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *CMAllOrdersResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAllOrdersResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMAllOrdersResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAllOrdersResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMAllOrdersResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAllOrdersResponse.Price", v.Price)
+}
+
+// CrossUnPnlDecimal returns CrossUnPnl as a decimal, zero if it is empty
+func (v *CMAsset) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAsset.CrossUnPnl", v.CrossUnPnl)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *CMAsset) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAsset.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *CMAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAsset.InitialMargin", v.InitialMargin)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *CMAsset) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAsset.MaintMargin", v.MaintMargin)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *CMAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAsset.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *CMAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMAsset.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *CMCancelConditionalOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMCancelConditionalOrderResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMCancelConditionalOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMCancelConditionalOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMCancelConditionalOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMCancelConditionalOrderResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *CMCancelConditionalOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMCancelConditionalOrderResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *CMCancelConditionalOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMCancelConditionalOrderResponse.StopPrice", v.StopPrice)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *CMCancelOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMCancelOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// CumQtyDecimal returns CumQty as a decimal, zero if it is empty
+func (v *CMCancelOrderResponse) CumQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMCancelOrderResponse.CumQty", v.CumQty)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *CMCancelOrderResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMCancelOrderResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMCancelOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMCancelOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMCancelOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMCancelOrderResponse.Price", v.Price)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *CMConditionalOrder) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrder.ActivatePrice", v.ActivatePrice)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMConditionalOrder) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrder.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMConditionalOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrder.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *CMConditionalOrder) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrder.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *CMConditionalOrder) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrder.StopPrice", v.StopPrice)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *CMConditionalOrderHistoryResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrderHistoryResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMConditionalOrderHistoryResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrderHistoryResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMConditionalOrderHistoryResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrderHistoryResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *CMConditionalOrderHistoryResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrderHistoryResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *CMConditionalOrderHistoryResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrderHistoryResponse.StopPrice", v.StopPrice)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *CMConditionalOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrderResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMConditionalOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMConditionalOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrderResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *CMConditionalOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrderResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *CMConditionalOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMConditionalOrderResponse.StopPrice", v.StopPrice)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *CMForceOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMForceOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *CMForceOrderResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMForceOrderResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMForceOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMForceOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMForceOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMForceOrderResponse.Price", v.Price)
+}
+
+// MaxQtyDecimal returns MaxQty as a decimal, zero if it is empty
+func (v *CMLeverage) MaxQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMLeverage.MaxQty", v.MaxQty)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *CMModifyOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMModifyOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// CumQtyDecimal returns CumQty as a decimal, zero if it is empty
+func (v *CMModifyOrderResponse) CumQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMModifyOrderResponse.CumQty", v.CumQty)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *CMModifyOrderResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMModifyOrderResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMModifyOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMModifyOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMModifyOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMModifyOrderResponse.Price", v.Price)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *CMOpenConditionalOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenConditionalOrderResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMOpenConditionalOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenConditionalOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMOpenConditionalOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenConditionalOrderResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *CMOpenConditionalOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenConditionalOrderResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *CMOpenConditionalOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenConditionalOrderResponse.StopPrice", v.StopPrice)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *CMOpenOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *CMOpenOrderResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenOrderResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMOpenOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMOpenOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenOrderResponse.Price", v.Price)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *CMOpenOrdersResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenOrdersResponse.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *CMOpenOrdersResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenOrdersResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMOpenOrdersResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenOrdersResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMOpenOrdersResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOpenOrdersResponse.Price", v.Price)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *CMOrder) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOrder.AvgPrice", v.AvgPrice)
+}
+
+// CumQtyDecimal returns CumQty as a decimal, zero if it is empty
+func (v *CMOrder) CumQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOrder.CumQty", v.CumQty)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *CMOrder) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOrder.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMOrder) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOrder.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMOrder.Price", v.Price)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *CMPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMPosition.EntryPrice", v.EntryPrice)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *CMPosition) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMPosition.InitialMargin", v.InitialMargin)
+}
+
+// LiquidationPriceDecimal returns LiquidationPrice as a decimal, zero if it is empty
+func (v *CMPosition) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMPosition.LiquidationPrice", v.LiquidationPrice)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *CMPosition) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMPosition.MaintMargin", v.MaintMargin)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *CMPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMPosition.MarkPrice", v.MarkPrice)
+}
+
+// MaxQtyDecimal returns MaxQty as a decimal, zero if it is empty
+func (v *CMPosition) MaxQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMPosition.MaxQty", v.MaxQty)
+}
+
+// NotionalValueDecimal returns NotionalValue as a decimal, zero if it is empty
+func (v *CMPosition) NotionalValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMPosition.NotionalValue", v.NotionalValue)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *CMPosition) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMPosition.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *CMPosition) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMPosition.PositionAmt", v.PositionAmt)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *CMPosition) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMPosition.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *CMPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMPosition.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *CMQueryOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMQueryOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *CMQueryOrderResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMQueryOrderResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *CMQueryOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMQueryOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *CMQueryOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CMQueryOrderResponse.Price", v.Price)
+}
+
+// MakerCommissionRateDecimal returns MakerCommissionRate as a decimal, zero if it is empty
+func (v *CommissionRate) MakerCommissionRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CommissionRate.MakerCommissionRate", v.MakerCommissionRate)
+}
+
+// TakerCommissionRateDecimal returns TakerCommissionRate as a decimal, zero if it is empty
+func (v *CommissionRate) TakerCommissionRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("CommissionRate.TakerCommissionRate", v.TakerCommissionRate)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *Fill) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Fill.Commission", v.Commission)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *Fill) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Fill.Price", v.Price)
+}
+
+// QtyDecimal returns Qty as a decimal, zero if it is empty
+func (v *Fill) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Fill.Qty", v.Qty)
+}
+
+// NotionalCoefDecimal returns NotionalCoef as a decimal, zero if it is empty
+func (v *LeverageBracket) NotionalCoefDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("LeverageBracket.NotionalCoef", v.NotionalCoef)
+}
+
+// CummulativeQuoteQtyDecimal returns CummulativeQuoteQty as a decimal, zero if it is empty
+func (v *MarginCancelAllOrdersResponse) CummulativeQuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginCancelAllOrdersResponse.CummulativeQuoteQty", v.CummulativeQuoteQty)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *MarginCancelAllOrdersResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginCancelAllOrdersResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *MarginCancelAllOrdersResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginCancelAllOrdersResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *MarginCancelAllOrdersResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginCancelAllOrdersResponse.Price", v.Price)
+}
+
+// CummulativeQuoteQtyDecimal returns CummulativeQuoteQty as a decimal, zero if it is empty
+func (v *MarginCancelOrderResponse) CummulativeQuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginCancelOrderResponse.CummulativeQuoteQty", v.CummulativeQuoteQty)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *MarginCancelOrderResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginCancelOrderResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *MarginCancelOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginCancelOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *MarginCancelOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginCancelOrderResponse.Price", v.Price)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *MarginForceOrder) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginForceOrder.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *MarginForceOrder) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginForceOrder.ExecutedQty", v.ExecutedQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *MarginForceOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginForceOrder.Price", v.Price)
+}
+
+// QtyDecimal returns Qty as a decimal, zero if it is empty
+func (v *MarginForceOrder) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginForceOrder.Qty", v.Qty)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *MarginInterest) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginInterest.Interest", v.Interest)
+}
+
+// InterestRateDecimal returns InterestRate as a decimal, zero if it is empty
+func (v *MarginInterest) InterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginInterest.InterestRate", v.InterestRate)
+}
+
+// PrincipalDecimal returns Principal as a decimal, zero if it is empty
+func (v *MarginInterest) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginInterest.Principal", v.Principal)
+}
+
+// PrincipalDecimal returns Principal as a decimal, zero if it is empty
+func (v *MarginLoan) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginLoan.Principal", v.Principal)
+}
+
+// CummulativeQuoteQtyDecimal returns CummulativeQuoteQty as a decimal, zero if it is empty
+func (v *MarginOCOOrderReport) CummulativeQuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOCOOrderReport.CummulativeQuoteQty", v.CummulativeQuoteQty)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *MarginOCOOrderReport) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOCOOrderReport.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *MarginOCOOrderReport) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOCOOrderReport.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *MarginOCOOrderReport) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOCOOrderReport.Price", v.Price)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *MarginOCOOrderReport) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOCOOrderReport.StopPrice", v.StopPrice)
+}
+
+// MarginBuyBorrowAmountDecimal returns MarginBuyBorrowAmount as a decimal, zero if it is empty
+func (v *MarginOCOResponse) MarginBuyBorrowAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOCOResponse.MarginBuyBorrowAmount", v.MarginBuyBorrowAmount)
+}
+
+// CummulativeQuoteQtyDecimal returns CummulativeQuoteQty as a decimal, zero if it is empty
+func (v *MarginOrder) CummulativeQuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOrder.CummulativeQuoteQty", v.CummulativeQuoteQty)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *MarginOrder) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOrder.ExecutedQty", v.ExecutedQty)
+}
+
+// IcebergQtyDecimal returns IcebergQty as a decimal, zero if it is empty
+func (v *MarginOrder) IcebergQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOrder.IcebergQty", v.IcebergQty)
+}
+
+// MarginBuyBorrowAmountDecimal returns MarginBuyBorrowAmount as a decimal, zero if it is empty
+func (v *MarginOrder) MarginBuyBorrowAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOrder.MarginBuyBorrowAmount", v.MarginBuyBorrowAmount)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *MarginOrder) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOrder.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *MarginOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOrder.Price", v.Price)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *MarginOrder) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginOrder.StopPrice", v.StopPrice)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *MarginRepay) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginRepay.Amount", v.Amount)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *MarginRepay) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginRepay.Interest", v.Interest)
+}
+
+// PrincipalDecimal returns Principal as a decimal, zero if it is empty
+func (v *MarginRepay) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginRepay.Principal", v.Principal)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *MarginRepayDebtResponse) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginRepayDebtResponse.Amount", v.Amount)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *MarginTrade) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginTrade.Commission", v.Commission)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *MarginTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginTrade.Price", v.Price)
+}
+
+// QtyDecimal returns Qty as a decimal, zero if it is empty
+func (v *MarginTrade) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MarginTrade.Qty", v.Qty)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *MaxBorrow) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MaxBorrow.Amount", v.Amount)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *MaxWithdraw) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MaxWithdraw.Amount", v.Amount)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *NegativeBalanceInterest) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("NegativeBalanceInterest.Interest", v.Interest)
+}
+
+// InterestRateDecimal returns InterestRate as a decimal, zero if it is empty
+func (v *NegativeBalanceInterest) InterestRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("NegativeBalanceInterest.InterestRate", v.InterestRate)
+}
+
+// PrincipalDecimal returns Principal as a decimal, zero if it is empty
+func (v *NegativeBalanceInterest) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("NegativeBalanceInterest.Principal", v.Principal)
+}
+
+// CummulativeQuoteQtyDecimal returns CummulativeQuoteQty as a decimal, zero if it is empty
+func (v *Report) CummulativeQuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Report.CummulativeQuoteQty", v.CummulativeQuoteQty)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *Report) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Report.ExecutedQty", v.ExecutedQty)
+}
+
+// IcebergQtyDecimal returns IcebergQty as a decimal, zero if it is empty
+func (v *Report) IcebergQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Report.IcebergQty", v.IcebergQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *Report) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Report.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *Report) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Report.Price", v.Price)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *Report) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("Report.StopPrice", v.StopPrice)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *UMAccountTrade) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAccountTrade.Commission", v.Commission)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMAccountTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAccountTrade.Price", v.Price)
+}
+
+// QtyDecimal returns Qty as a decimal, zero if it is empty
+func (v *UMAccountTrade) QtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAccountTrade.Qty", v.Qty)
+}
+
+// QuoteQtyDecimal returns QuoteQty as a decimal, zero if it is empty
+func (v *UMAccountTrade) QuoteQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAccountTrade.QuoteQty", v.QuoteQty)
+}
+
+// RealizedPnlDecimal returns RealizedPnl as a decimal, zero if it is empty
+func (v *UMAccountTrade) RealizedPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAccountTrade.RealizedPnl", v.RealizedPnl)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *UMAllOrdersResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAllOrdersResponse.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *UMAllOrdersResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAllOrdersResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMAllOrdersResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAllOrdersResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMAllOrdersResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAllOrdersResponse.Price", v.Price)
+}
+
+// CrossUnPnlDecimal returns CrossUnPnl as a decimal, zero if it is empty
+func (v *UMAsset) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAsset.CrossUnPnl", v.CrossUnPnl)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *UMAsset) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAsset.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *UMAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAsset.InitialMargin", v.InitialMargin)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *UMAsset) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAsset.MaintMargin", v.MaintMargin)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *UMAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAsset.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *UMAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAsset.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// CrossUnPnlDecimal returns CrossUnPnl as a decimal, zero if it is empty
+func (v *UMAssetV2) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAssetV2.CrossUnPnl", v.CrossUnPnl)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *UMAssetV2) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAssetV2.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *UMAssetV2) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAssetV2.InitialMargin", v.InitialMargin)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *UMAssetV2) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAssetV2.MaintMargin", v.MaintMargin)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *UMAssetV2) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAssetV2.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *UMAssetV2) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMAssetV2.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *UMCancelConditionalOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMCancelConditionalOrderResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMCancelConditionalOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMCancelConditionalOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMCancelConditionalOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMCancelConditionalOrderResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *UMCancelConditionalOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMCancelConditionalOrderResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *UMCancelConditionalOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMCancelConditionalOrderResponse.StopPrice", v.StopPrice)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *UMConditionalOrder) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrder.ActivatePrice", v.ActivatePrice)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMConditionalOrder) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrder.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMConditionalOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrder.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *UMConditionalOrder) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrder.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *UMConditionalOrder) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrder.StopPrice", v.StopPrice)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *UMConditionalOrderHistoryResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrderHistoryResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMConditionalOrderHistoryResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrderHistoryResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMConditionalOrderHistoryResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrderHistoryResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *UMConditionalOrderHistoryResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrderHistoryResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *UMConditionalOrderHistoryResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrderHistoryResponse.StopPrice", v.StopPrice)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *UMConditionalOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrderResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMConditionalOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMConditionalOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrderResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *UMConditionalOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrderResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *UMConditionalOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMConditionalOrderResponse.StopPrice", v.StopPrice)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *UMForceOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMForceOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *UMForceOrderResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMForceOrderResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMForceOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMForceOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMForceOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMForceOrderResponse.Price", v.Price)
+}
+
+// MaxNotionalValueDecimal returns MaxNotionalValue as a decimal, zero if it is empty
+func (v *UMLeverage) MaxNotionalValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMLeverage.MaxNotionalValue", v.MaxNotionalValue)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *UMModifyOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMModifyOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// CumQtyDecimal returns CumQty as a decimal, zero if it is empty
+func (v *UMModifyOrderResponse) CumQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMModifyOrderResponse.CumQty", v.CumQty)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *UMModifyOrderResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMModifyOrderResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMModifyOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMModifyOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMModifyOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMModifyOrderResponse.Price", v.Price)
+}
+
+// ActivatePriceDecimal returns ActivatePrice as a decimal, zero if it is empty
+func (v *UMOpenConditionalOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenConditionalOrderResponse.ActivatePrice", v.ActivatePrice)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMOpenConditionalOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenConditionalOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMOpenConditionalOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenConditionalOrderResponse.Price", v.Price)
+}
+
+// PriceRateDecimal returns PriceRate as a decimal, zero if it is empty
+func (v *UMOpenConditionalOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenConditionalOrderResponse.PriceRate", v.PriceRate)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *UMOpenConditionalOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenConditionalOrderResponse.StopPrice", v.StopPrice)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *UMOpenOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *UMOpenOrderResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenOrderResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMOpenOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMOpenOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenOrderResponse.Price", v.Price)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *UMOpenOrdersResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenOrdersResponse.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *UMOpenOrdersResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenOrdersResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMOpenOrdersResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenOrdersResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMOpenOrdersResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOpenOrdersResponse.Price", v.Price)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *UMOrder) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOrder.AvgPrice", v.AvgPrice)
+}
+
+// CumQtyDecimal returns CumQty as a decimal, zero if it is empty
+func (v *UMOrder) CumQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOrder.CumQty", v.CumQty)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *UMOrder) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOrder.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMOrder) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOrder.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMOrder) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMOrder.Price", v.Price)
+}
+
+// AskNotionalDecimal returns AskNotional as a decimal, zero if it is empty
+func (v *UMPosition) AskNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.AskNotional", v.AskNotional)
+}
+
+// BidNotionalDecimal returns BidNotional as a decimal, zero if it is empty
+func (v *UMPosition) BidNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.BidNotional", v.BidNotional)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *UMPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.EntryPrice", v.EntryPrice)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *UMPosition) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.InitialMargin", v.InitialMargin)
+}
+
+// LiquidationPriceDecimal returns LiquidationPrice as a decimal, zero if it is empty
+func (v *UMPosition) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.LiquidationPrice", v.LiquidationPrice)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *UMPosition) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.MaintMargin", v.MaintMargin)
+}
+
+// MarkPriceDecimal returns MarkPrice as a decimal, zero if it is empty
+func (v *UMPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.MarkPrice", v.MarkPrice)
+}
+
+// MaxNotionalDecimal returns MaxNotional as a decimal, zero if it is empty
+func (v *UMPosition) MaxNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.MaxNotional", v.MaxNotional)
+}
+
+// MaxNotionalValueDecimal returns MaxNotionalValue as a decimal, zero if it is empty
+func (v *UMPosition) MaxNotionalValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.MaxNotionalValue", v.MaxNotionalValue)
+}
+
+// NotionalDecimal returns Notional as a decimal, zero if it is empty
+func (v *UMPosition) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.Notional", v.Notional)
+}
+
+// OpenOrderInitialMarginDecimal returns OpenOrderInitialMargin as a decimal, zero if it is empty
+func (v *UMPosition) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.OpenOrderInitialMargin", v.OpenOrderInitialMargin)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *UMPosition) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.PositionAmt", v.PositionAmt)
+}
+
+// PositionInitialMarginDecimal returns PositionInitialMargin as a decimal, zero if it is empty
+func (v *UMPosition) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.PositionInitialMargin", v.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *UMPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPosition.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// InitialMarginDecimal returns InitialMargin as a decimal, zero if it is empty
+func (v *UMPositionV2) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPositionV2.InitialMargin", v.InitialMargin)
+}
+
+// MaintMarginDecimal returns MaintMargin as a decimal, zero if it is empty
+func (v *UMPositionV2) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPositionV2.MaintMargin", v.MaintMargin)
+}
+
+// NotionalDecimal returns Notional as a decimal, zero if it is empty
+func (v *UMPositionV2) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPositionV2.Notional", v.Notional)
+}
+
+// PositionAmtDecimal returns PositionAmt as a decimal, zero if it is empty
+func (v *UMPositionV2) PositionAmtDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPositionV2.PositionAmt", v.PositionAmt)
+}
+
+// UnrealizedProfitDecimal returns UnrealizedProfit as a decimal, zero if it is empty
+func (v *UMPositionV2) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMPositionV2.UnrealizedProfit", v.UnrealizedProfit)
+}
+
+// AvgPriceDecimal returns AvgPrice as a decimal, zero if it is empty
+func (v *UMQueryOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMQueryOrderResponse.AvgPrice", v.AvgPrice)
+}
+
+// ExecutedQtyDecimal returns ExecutedQty as a decimal, zero if it is empty
+func (v *UMQueryOrderResponse) ExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMQueryOrderResponse.ExecutedQty", v.ExecutedQty)
+}
+
+// OrigQtyDecimal returns OrigQty as a decimal, zero if it is empty
+func (v *UMQueryOrderResponse) OrigQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMQueryOrderResponse.OrigQty", v.OrigQty)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *UMQueryOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMQueryOrderResponse.Price", v.Price)
+}
+
+// MaxNotionalValueDecimal returns MaxNotionalValue as a decimal, zero if it is empty
+func (v *UMSymbolConfig) MaxNotionalValueDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("UMSymbolConfig.MaxNotionalValue", v.MaxNotionalValue)
+}
+
+// BalanceDecimal returns Balance as a decimal, zero if it is empty
+func (v *WsBalance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.Balance", v.Balance)
+}
+
+// ChangeBalanceDecimal returns ChangeBalance as a decimal, zero if it is empty
+func (v *WsBalance) ChangeBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.ChangeBalance", v.ChangeBalance)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *WsBalance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsBalance.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// BalanceChangeDecimal returns BalanceChange as a decimal, zero if it is empty
+func (v *WsFuturesBalance) BalanceChangeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesBalance.BalanceChange", v.BalanceChange)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *WsFuturesBalance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesBalance.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// WalletBalanceDecimal returns WalletBalance as a decimal, zero if it is empty
+func (v *WsFuturesBalance) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesBalance.WalletBalance", v.WalletBalance)
+}
+
+// AskNotionalDecimal returns AskNotional as a decimal, zero if it is empty
+func (v *WsFuturesOrderData) AskNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesOrderData.AskNotional", v.AskNotional)
+}
+
+// AveragePriceDecimal returns AveragePrice as a decimal, zero if it is empty
+func (v *WsFuturesOrderData) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesOrderData.AveragePrice", v.AveragePrice)
+}
+
+// BidsNotionalDecimal returns BidsNotional as a decimal, zero if it is empty
+func (v *WsFuturesOrderData) BidsNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesOrderData.BidsNotional", v.BidsNotional)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *WsFuturesOrderData) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesOrderData.Commission", v.Commission)
+}
+
+// FilledAccumQtyDecimal returns FilledAccumQty as a decimal, zero if it is empty
+func (v *WsFuturesOrderData) FilledAccumQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesOrderData.FilledAccumQty", v.FilledAccumQty)
+}
+
+// LastFilledPriceDecimal returns LastFilledPrice as a decimal, zero if it is empty
+func (v *WsFuturesOrderData) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesOrderData.LastFilledPrice", v.LastFilledPrice)
+}
+
+// LastFilledQtyDecimal returns LastFilledQty as a decimal, zero if it is empty
+func (v *WsFuturesOrderData) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesOrderData.LastFilledQty", v.LastFilledQty)
+}
+
+// OriginalPriceDecimal returns OriginalPrice as a decimal, zero if it is empty
+func (v *WsFuturesOrderData) OriginalPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesOrderData.OriginalPrice", v.OriginalPrice)
+}
+
+// OriginalQtyDecimal returns OriginalQty as a decimal, zero if it is empty
+func (v *WsFuturesOrderData) OriginalQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesOrderData.OriginalQty", v.OriginalQty)
+}
+
+// RealizedProfitDecimal returns RealizedProfit as a decimal, zero if it is empty
+func (v *WsFuturesOrderData) RealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesOrderData.RealizedProfit", v.RealizedProfit)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *WsFuturesOrderData) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesOrderData.StopPrice", v.StopPrice)
+}
+
+// BreakEvenPriceDecimal returns BreakEvenPrice as a decimal, zero if it is empty
+func (v *WsFuturesPosition) BreakEvenPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesPosition.BreakEvenPrice", v.BreakEvenPrice)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *WsFuturesPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesPosition.EntryPrice", v.EntryPrice)
+}
+
+// PositionAmountDecimal returns PositionAmount as a decimal, zero if it is empty
+func (v *WsFuturesPosition) PositionAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesPosition.PositionAmount", v.PositionAmount)
+}
+
+// UnrealizedPnLDecimal returns UnrealizedPnL as a decimal, zero if it is empty
+func (v *WsFuturesPosition) UnrealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsFuturesPosition.UnrealizedPnL", v.UnrealizedPnL)
+}
+
+// InterestDecimal returns Interest as a decimal, zero if it is empty
+func (v *WsLiabilityUpdate) InterestDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiabilityUpdate.Interest", v.Interest)
+}
+
+// PrincipalDecimal returns Principal as a decimal, zero if it is empty
+func (v *WsLiabilityUpdate) PrincipalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiabilityUpdate.Principal", v.Principal)
+}
+
+// TotalLiabilityDecimal returns TotalLiability as a decimal, zero if it is empty
+func (v *WsLiabilityUpdate) TotalLiabilityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsLiabilityUpdate.TotalLiability", v.TotalLiability)
+}
+
+// FreeDecimal returns Free as a decimal, zero if it is empty
+func (v *WsMarginBalance) FreeDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginBalance.Free", v.Free)
+}
+
+// LockedDecimal returns Locked as a decimal, zero if it is empty
+func (v *WsMarginBalance) LockedDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginBalance.Locked", v.Locked)
+}
+
+// BalanceDeltaDecimal returns BalanceDelta as a decimal, zero if it is empty
+func (v *WsMarginBalanceUpdate) BalanceDeltaDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginBalanceUpdate.BalanceDelta", v.BalanceDelta)
+}
+
+// CommissionAmountDecimal returns CommissionAmount as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) CommissionAmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.CommissionAmount", v.CommissionAmount)
+}
+
+// CumulativeFilledQtyDecimal returns CumulativeFilledQty as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) CumulativeFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.CumulativeFilledQty", v.CumulativeFilledQty)
+}
+
+// IcebergQuantityDecimal returns IcebergQuantity as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) IcebergQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.IcebergQuantity", v.IcebergQuantity)
+}
+
+// LastExecutedPriceDecimal returns LastExecutedPrice as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) LastExecutedPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.LastExecutedPrice", v.LastExecutedPrice)
+}
+
+// LastExecutedQtyDecimal returns LastExecutedQty as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) LastExecutedQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.LastExecutedQty", v.LastExecutedQty)
+}
+
+// LastPreventedQuantityDecimal returns LastPreventedQuantity as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) LastPreventedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.LastPreventedQuantity", v.LastPreventedQuantity)
+}
+
+// LastQuoteQtyFilledDecimal returns LastQuoteQtyFilled as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) LastQuoteQtyFilledDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.LastQuoteQtyFilled", v.LastQuoteQtyFilled)
+}
+
+// PreventedQuantityDecimal returns PreventedQuantity as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) PreventedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.PreventedQuantity", v.PreventedQuantity)
+}
+
+// PriceDecimal returns Price as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) PriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.Price", v.Price)
+}
+
+// QuantityDecimal returns Quantity as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.Quantity", v.Quantity)
+}
+
+// QuoteOrderQtyDecimal returns QuoteOrderQty as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) QuoteOrderQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.QuoteOrderQty", v.QuoteOrderQty)
+}
+
+// QuoteQtyFilledDecimal returns QuoteQtyFilled as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) QuoteQtyFilledDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.QuoteQtyFilled", v.QuoteQtyFilled)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *WsMarginOrderUpdate) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsMarginOrderUpdate.StopPrice", v.StopPrice)
+}
+
+// AccumulatedFilledQtyDecimal returns AccumulatedFilledQty as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) AccumulatedFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.AccumulatedFilledQty", v.AccumulatedFilledQty)
+}
+
+// AsksNotionalDecimal returns AsksNotional as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) AsksNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.AsksNotional", v.AsksNotional)
+}
+
+// AveragePriceDecimal returns AveragePrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.AveragePrice", v.AveragePrice)
+}
+
+// BidsNotionalDecimal returns BidsNotional as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) BidsNotionalDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.BidsNotional", v.BidsNotional)
+}
+
+// CommissionDecimal returns Commission as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.Commission", v.Commission)
+}
+
+// LastFilledPriceDecimal returns LastFilledPrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.LastFilledPrice", v.LastFilledPrice)
+}
+
+// LastFilledQtyDecimal returns LastFilledQty as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.LastFilledQty", v.LastFilledQty)
+}
+
+// OriginalPriceDecimal returns OriginalPrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) OriginalPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.OriginalPrice", v.OriginalPrice)
+}
+
+// OriginalQtyDecimal returns OriginalQty as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) OriginalQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.OriginalQty", v.OriginalQty)
+}
+
+// RealizedPnLDecimal returns RealizedPnL as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) RealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.RealizedPnL", v.RealizedPnL)
+}
+
+// StopPriceDecimal returns StopPrice as a decimal, zero if it is empty
+func (v *WsOrderTradeUpdate) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsOrderTradeUpdate.StopPrice", v.StopPrice)
+}
+
+// AmountDecimal returns Amount as a decimal, zero if it is empty
+func (v *WsPosition) AmountDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.Amount", v.Amount)
+}
+
+// EntryPriceDecimal returns EntryPrice as a decimal, zero if it is empty
+func (v *WsPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.EntryPrice", v.EntryPrice)
+}
+
+// UnrealizedPnLDecimal returns UnrealizedPnL as a decimal, zero if it is empty
+func (v *WsPosition) UnrealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsPosition.UnrealizedPnL", v.UnrealizedPnL)
+}
+
+// ActualEquityUSDDecimal returns ActualEquityUSD as a decimal, zero if it is empty
+func (v *WsRiskLevelChange) ActualEquityUSDDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsRiskLevelChange.ActualEquityUSD", v.ActualEquityUSD)
+}
+
+// EquityUSDDecimal returns EquityUSD as a decimal, zero if it is empty
+func (v *WsRiskLevelChange) EquityUSDDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsRiskLevelChange.EquityUSD", v.EquityUSD)
+}
+
+// MaintenanceMarginUSDDecimal returns MaintenanceMarginUSD as a decimal, zero if it is empty
+func (v *WsRiskLevelChange) MaintenanceMarginUSDDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsRiskLevelChange.MaintenanceMarginUSD", v.MaintenanceMarginUSD)
+}
+
+// CrossWalletBalanceDecimal returns CrossWalletBalance as a decimal, zero if it is empty
+func (v *WsUserDataMarginCall) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsUserDataMarginCall.CrossWalletBalance", v.CrossWalletBalance)
+}
+
+// LastFilledPriceDecimal returns LastFilledPrice as a decimal, zero if it is empty
+func (v *WsUserDataTradeLite) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsUserDataTradeLite.LastFilledPrice", v.LastFilledPrice)
+}
+
+// LastFilledQtyDecimal returns LastFilledQty as a decimal, zero if it is empty
+func (v *WsUserDataTradeLite) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsUserDataTradeLite.LastFilledQty", v.LastFilledQty)
+}
+
+// OriginalPriceDecimal returns OriginalPrice as a decimal, zero if it is empty
+func (v *WsUserDataTradeLite) OriginalPriceDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsUserDataTradeLite.OriginalPrice", v.OriginalPrice)
+}
+
+// OriginalQtyDecimal returns OriginalQty as a decimal, zero if it is empty
+func (v *WsUserDataTradeLite) OriginalQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("WsUserDataTradeLite.OriginalQty", v.OriginalQty)
+}
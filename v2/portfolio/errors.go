@@ -33,6 +33,11 @@ func (e Error) Error() string {
 	return e.APIError.Error()
 }
 
+// Unwrap returns the API error, so that errors.As matches *common.APIError
+func (e *Error) Unwrap() error {
+	return &e.APIError
+}
+
 // IsPortfolioError check if e is a Portfolio error
 func IsPortfolioError(e error) bool {
 	_, ok := e.(*Error)
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // GetMarginAllOrdersService service to get all margin account orders
@@ -88,3 +91,15 @@ func (s *GetMarginAllOrdersService) Do(ctx context.Context) ([]*MarginOrder, err
 	}
 	return res, nil
 }
+
+// Iterator walks the orders created between startTime and endTime in milliseconds, in windows of 24 hours and pages of
+// 500 orders. OrderID is ignored.
+func (s *GetMarginAllOrdersService) Iterator(startTime, endTime int64) *common.Iterator[*MarginOrder] {
+	s.orderID = nil
+	return common.NewCursorIterator(startTime, endTime, 24*time.Hour, 500,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*MarginOrder, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		func(o *MarginOrder) int64 { return o.TransactTime },
+		func(o *MarginOrder) int64 { return o.OrderID })
+}
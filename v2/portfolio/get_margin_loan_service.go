@@ -106,6 +106,8 @@ type GetMarginLoanResponse struct {
 }
 
 // MarginLoan define margin loan info
+//
+//decimalgen:accessors
 type MarginLoan struct {
 	TxID      int64  `json:"txId"`
 	Asset     string `json:"asset"`
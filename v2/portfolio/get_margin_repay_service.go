@@ -106,6 +106,8 @@ type GetMarginRepayResponse struct {
 }
 
 // MarginRepay define margin repay info
+//
+//decimalgen:accessors
 type MarginRepay struct {
 	Amount    string `json:"amount"` // Total amount repaid
 	Asset     string `json:"asset"`
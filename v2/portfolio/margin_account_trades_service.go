@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // MarginAccountTradesService service to get margin account trade list
@@ -99,7 +102,21 @@ func (s *MarginAccountTradesService) Do(ctx context.Context) ([]*MarginTrade, er
 	return res, nil
 }
 
+// Iterator walks the trades between startTime and endTime in milliseconds, in windows of 24 hours and pages of 1000
+// trades. FromID is ignored.
+func (s *MarginAccountTradesService) Iterator(startTime, endTime int64) *common.Iterator[*MarginTrade] {
+	s.fromID = nil
+	return common.NewCursorIterator(startTime, endTime, 24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*MarginTrade, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		func(t *MarginTrade) int64 { return t.Time },
+		func(t *MarginTrade) int64 { return t.ID })
+}
+
 // MarginTrade define margin trade info
+//
+//decimalgen:accessors
 type MarginTrade struct {
 	Commission      string `json:"commission"`
 	CommissionAsset string `json:"commissionAsset"`
@@ -40,6 +40,8 @@ func (s *GetMarginMaxBorrowService) Do(ctx context.Context, opts ...RequestOptio
 }
 
 // MaxBorrow define margin max borrowable amount info
+//
+//decimalgen:accessors
 type MaxBorrow struct {
 	Amount      string `json:"amount"`      // account's currently max borrowable amount with sufficient system availability
 	BorrowLimit string `json:"borrowLimit"` // max borrowable amount limited by the account level
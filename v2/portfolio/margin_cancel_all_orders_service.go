@@ -50,6 +50,8 @@ func (s *MarginCancelAllOrdersService) Do(ctx context.Context) ([]*MarginCancelA
 }
 
 // MarginCancelAllOrdersResponse define cancel all orders response
+//
+//decimalgen:accessors
 type MarginCancelAllOrdersResponse struct {
 	Symbol              string   `json:"symbol"`
 	OrigClientOrderID   string   `json:"origClientOrderId,omitempty"`
@@ -81,6 +83,8 @@ type Order struct {
 }
 
 // Report define order report
+//
+//decimalgen:accessors
 type Report struct {
 	Symbol              string `json:"symbol"`
 	OrigClientOrderID   string `json:"origClientOrderId"`
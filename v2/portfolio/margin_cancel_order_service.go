@@ -80,6 +80,8 @@ func (s *MarginCancelOrderService) Do(ctx context.Context) (*MarginCancelOrderRe
 }
 
 // MarginCancelOrderResponse define cancel order response
+//
+//decimalgen:accessors
 type MarginCancelOrderResponse struct {
 	Symbol              string `json:"symbol"`
 	OrderID             int64  `json:"orderId"`
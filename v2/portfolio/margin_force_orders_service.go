@@ -82,6 +82,8 @@ func (s *MarginForceOrdersService) Do(ctx context.Context) (*MarginForceOrdersRe
 }
 
 // MarginForceOrder define margin force order
+//
+//decimalgen:accessors
 type MarginForceOrder struct {
 	AvgPrice    string `json:"avgPrice"`
 	ExecutedQty string `json:"executedQty"`
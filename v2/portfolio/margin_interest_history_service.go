@@ -98,6 +98,8 @@ type MarginInterestHistoryResponse struct {
 }
 
 // MarginInterest define margin interest info
+//
+//decimalgen:accessors
 type MarginInterest struct {
 	TxID                int64  `json:"txId"`
 	InterestAccuredTime int64  `json:"interestAccuredTime"`
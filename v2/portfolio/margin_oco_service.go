@@ -174,6 +174,8 @@ func (s *MarginOCOService) Do(ctx context.Context, opts ...RequestOption) (res *
 }
 
 // MarginOCOResponse defines margin OCO response
+//
+//decimalgen:accessors
 type MarginOCOResponse struct {
 	OrderListID           int64                  `json:"orderListId"`
 	ContingencyType       string                 `json:"contingencyType"`
@@ -196,6 +198,8 @@ type MarginOCOOrder struct {
 }
 
 // MarginOCOOrderReport defines margin OCO order report
+//
+//decimalgen:accessors
 type MarginOCOOrderReport struct {
 	Symbol              string          `json:"symbol"`
 	OrderID             int64           `json:"orderId"`
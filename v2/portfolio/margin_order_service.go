@@ -168,6 +168,8 @@ func (s *MarginOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // Fill define fill info
+//
+//decimalgen:accessors
 type Fill struct {
 	Price           string `json:"price"`
 	Qty             string `json:"qty"`
@@ -176,6 +178,8 @@ type Fill struct {
 }
 
 // MarginOrder define margin order info
+//
+//decimalgen:accessors
 type MarginOrder struct {
 	Symbol                  string          `json:"symbol"`
 	OrderID                 int64           `json:"orderId"`
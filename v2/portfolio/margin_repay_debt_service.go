@@ -71,6 +71,8 @@ func (s *MarginRepayDebtService) Do(ctx context.Context) (*MarginRepayDebtRespon
 }
 
 // MarginRepayDebtResponse represents the response from repaying margin debt
+//
+//decimalgen:accessors
 type MarginRepayDebtResponse struct {
 	Amount             string   `json:"amount"`
 	Asset              string   `json:"asset"`
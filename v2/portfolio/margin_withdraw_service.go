@@ -40,6 +40,8 @@ func (s *GetMarginMaxWithdrawService) Do(ctx context.Context, opts ...RequestOpt
 }
 
 // MaxWithdraw define margin max withdrawable amount info
+//
+//decimalgen:accessors
 type MaxWithdraw struct {
 	Amount string `json:"amount"` // max withdrawable amount
 }
@@ -72,6 +72,8 @@ func (s *GetNegativeBalanceInterestHistoryService) Do(ctx context.Context, opts
 }
 
 // NegativeBalanceInterest define negative balance interest info
+//
+//decimalgen:accessors
 type NegativeBalanceInterest struct {
 	Asset               string `json:"asset"`
 	Interest            string `json:"interest"` // interest amount
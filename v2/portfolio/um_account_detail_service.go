@@ -38,6 +38,8 @@ type UMAccountDetail struct {
 }
 
 // UMAsset define UM asset info
+//
+//decimalgen:accessors
 type UMAsset struct {
 	Asset                  string `json:"asset"`                  // asset name
 	CrossWalletBalance     string `json:"crossWalletBalance"`     // wallet balance
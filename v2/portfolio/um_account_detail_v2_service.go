@@ -17,6 +17,8 @@ type UMAccountDetailV2 struct {
 }
 
 // UMAssetV2 define UM asset detail v2
+//
+//decimalgen:accessors
 type UMAssetV2 struct {
 	Asset                  string `json:"asset"`                  // Asset name
 	CrossWalletBalance     string `json:"crossWalletBalance"`     // Wallet balance
@@ -29,6 +31,8 @@ type UMAssetV2 struct {
 }
 
 // UMPositionV2 define UM position detail v2
+//
+//decimalgen:accessors
 type UMPositionV2 struct {
 	Symbol           string `json:"symbol"`           // Symbol name
 	InitialMargin    string `json:"initialMargin"`    // Initial margin required
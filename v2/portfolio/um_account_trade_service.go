@@ -90,6 +90,8 @@ func (s *UMAccountTradeService) Do(ctx context.Context) ([]*UMAccountTrade, erro
 }
 
 // UMAccountTrade define UM account trade
+//
+//decimalgen:accessors
 type UMAccountTrade struct {
 	Symbol          string `json:"symbol"`
 	ID              int64  `json:"id"`
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // UMAccountTradesService service to get UM account trade list
@@ -88,3 +91,15 @@ func (s *UMAccountTradesService) Do(ctx context.Context) ([]*UMAccountTrade, err
 	}
 	return res, nil
 }
+
+// Iterator walks the trades between startTime and endTime in milliseconds, in windows of 7 days and pages of 1000
+// trades. FromID is ignored.
+func (s *UMAccountTradesService) Iterator(startTime, endTime int64) *common.Iterator[*UMAccountTrade] {
+	s.fromID = nil
+	return common.NewCursorIterator(startTime, endTime, 7*24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*UMAccountTrade, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		func(t *UMAccountTrade) int64 { return t.Time },
+		func(t *UMAccountTrade) int64 { return t.ID })
+}
@@ -92,6 +92,8 @@ func (s *UMAllConditionalOrdersService) Do(ctx context.Context) ([]*UMConditiona
 }
 
 // UMConditionalOrderResponse define conditional order response
+//
+//decimalgen:accessors
 type UMConditionalOrderResponse struct {
 	NewClientStrategyID     string `json:"newClientStrategyId"`
 	StrategyID              int64  `json:"strategyId"`
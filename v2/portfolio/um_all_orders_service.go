@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // UMAllOrdersService service to get all UM orders
@@ -89,7 +92,21 @@ func (s *UMAllOrdersService) Do(ctx context.Context) ([]*UMAllOrdersResponse, er
 	return res, nil
 }
 
+// Iterator walks the orders created between startTime and endTime in milliseconds, in windows of 7 days and pages of
+// 1000 orders. OrderID is ignored.
+func (s *UMAllOrdersService) Iterator(startTime, endTime int64) *common.Iterator[*UMAllOrdersResponse] {
+	s.orderID = nil
+	return common.NewCursorIterator(startTime, endTime, 7*24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*UMAllOrdersResponse, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx)
+		},
+		func(o *UMAllOrdersResponse) int64 { return o.Time },
+		func(o *UMAllOrdersResponse) int64 { return o.OrderID })
+}
+
 // UMAllOrdersResponse define all orders response
+//
+//decimalgen:accessors
 type UMAllOrdersResponse struct {
 	AvgPrice                string `json:"avgPrice"`
 	ClientOrderID           string `json:"clientOrderId"`
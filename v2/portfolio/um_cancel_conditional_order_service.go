@@ -70,6 +70,8 @@ func (s *UMCancelConditionalOrderService) Do(ctx context.Context) (*UMCancelCond
 }
 
 // UMCancelConditionalOrderResponse define cancel conditional order response
+//
+//decimalgen:accessors
 type UMCancelConditionalOrderResponse struct {
 	NewClientStrategyID     string `json:"newClientStrategyId"`
 	StrategyID              int64  `json:"strategyId"`
@@ -40,6 +40,8 @@ func (s *GetUMCommissionRateService) Do(ctx context.Context, opts ...RequestOpti
 }
 
 // CommissionRate define commission rate info
+//
+//decimalgen:accessors
 type CommissionRate struct {
 	Symbol              string `json:"symbol"`
 	MakerCommissionRate string `json:"makerCommissionRate"` // 0.02%
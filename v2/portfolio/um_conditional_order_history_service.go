@@ -70,6 +70,8 @@ func (s *UMConditionalOrderHistoryService) Do(ctx context.Context) (*UMCondition
 }
 
 // UMConditionalOrderHistoryResponse define conditional order history response
+//
+//decimalgen:accessors
 type UMConditionalOrderHistoryResponse struct {
 	NewClientStrategyID     string `json:"newClientStrategyId"`
 	StrategyID              int64  `json:"strategyId"`
@@ -153,6 +153,8 @@ func (s *UMConditionalOrderService) Do(ctx context.Context, opts ...RequestOptio
 }
 
 // UMConditionalOrder define conditional order info
+//
+//decimalgen:accessors
 type UMConditionalOrder struct {
 	NewClientStrategyId string           `json:"newClientStrategyId"`
 	StrategyId          int64            `json:"strategyId"`
@@ -92,6 +92,8 @@ func (s *UMForceOrdersService) Do(ctx context.Context) ([]*UMForceOrderResponse,
 }
 
 // UMForceOrderResponse define force order response
+//
+//decimalgen:accessors
 type UMForceOrderResponse struct {
 	OrderID       int64  `json:"orderId"`
 	Symbol        string `json:"symbol"`
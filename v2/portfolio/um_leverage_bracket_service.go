@@ -42,6 +42,8 @@ func (s *GetUMLeverageBracketService) Do(ctx context.Context, opts ...RequestOpt
 }
 
 // LeverageBracket define leverage bracket
+//
+//decimalgen:accessors
 type LeverageBracket struct {
 	Symbol       string    `json:"symbol"`
 	NotionalCoef string    `json:"notionalCoef"`
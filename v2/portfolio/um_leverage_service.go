@@ -48,6 +48,8 @@ func (s *ChangeUMInitialLeverageService) Do(ctx context.Context, opts ...Request
 }
 
 // UMLeverage define leverage info
+//
+//decimalgen:accessors
 type UMLeverage struct {
 	Leverage         int    `json:"leverage"`
 	MaxNotionalValue string `json:"maxNotionalValue"`
@@ -106,6 +106,8 @@ func (s *UMModifyOrderService) Do(ctx context.Context) (*UMModifyOrderResponse,
 }
 
 // UMModifyOrderResponse define modify order response
+//
+//decimalgen:accessors
 type UMModifyOrderResponse struct {
 	OrderID                 int64  `json:"orderId"`
 	Symbol                  string `json:"symbol"`
@@ -70,6 +70,8 @@ func (s *UMOpenConditionalOrderService) Do(ctx context.Context) (*UMOpenConditio
 }
 
 // UMOpenConditionalOrderResponse define open conditional order response
+//
+//decimalgen:accessors
 type UMOpenConditionalOrderResponse struct {
 	NewClientStrategyID     string `json:"newClientStrategyId"`
 	StrategyID              int64  `json:"strategyId"`
@@ -70,6 +70,8 @@ func (s *UMOpenOrderService) Do(ctx context.Context) (*UMOpenOrderResponse, erro
 }
 
 // UMOpenOrderResponse define open order response
+//
+//decimalgen:accessors
 type UMOpenOrderResponse struct {
 	AvgPrice                string `json:"avgPrice"`
 	ClientOrderID           string `json:"clientOrderId"`
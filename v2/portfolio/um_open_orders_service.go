@@ -52,6 +52,8 @@ func (s *UMOpenOrdersService) Do(ctx context.Context) ([]*UMOpenOrdersResponse,
 }
 
 // UMOpenOrdersResponse define open orders response
+//
+//decimalgen:accessors
 type UMOpenOrdersResponse struct {
 	AvgPrice                string `json:"avgPrice"`
 	ClientOrderID           string `json:"clientOrderId"`
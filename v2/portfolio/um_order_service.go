@@ -158,6 +158,8 @@ func (s *UMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *UM
 }
 
 // UMOrder define UM order info
+//
+//decimalgen:accessors
 type UMOrder struct {
 	ClientOrderID           string                  `json:"clientOrderId"`
 	CumQty                  string                  `json:"cumQty"`
@@ -70,6 +70,8 @@ func (s *UMQueryOrderService) Do(ctx context.Context) (*UMQueryOrderResponse, er
 }
 
 // UMQueryOrderResponse define query order response
+//
+//decimalgen:accessors
 type UMQueryOrderResponse struct {
 	AvgPrice                string `json:"avgPrice"`
 	ClientOrderID           string `json:"clientOrderId"`
@@ -12,6 +12,8 @@ type UMSymbolConfigService struct {
 }
 
 // UMSymbolConfig define UM futures symbol configuration
+//
+//decimalgen:accessors
 type UMSymbolConfig struct {
 	Symbol           string `json:"symbol"`
 	MarginType       string `json:"marginType"`
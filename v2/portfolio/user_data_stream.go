@@ -0,0 +1,372 @@
+package portfolio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// userDataOrderLookback is how long before a gap orders are requested, orders that old may still change during it
+const userDataOrderLookback = 24 * time.Hour
+
+// UserDataSymbols are the symbols whose orders and trades a user data stream backfills, by product
+type UserDataSymbols struct {
+	// UM are the symbols of USDⓈ-M futures
+	UM []string
+	// CM are the symbols of COIN-M futures
+	CM []string
+	// Margin are the symbols of cross margin
+	Margin []string
+}
+
+// UserDataStream is a user data stream of the portfolio margin account which owns its listen key. The options of
+// the embedded common.UserStream are set before calling Run, the messages returned by Backfill are dispatched like
+// the messages of the stream.
+type UserDataStream struct {
+	*common.UserStream[[]byte]
+}
+
+// Run dispatches the events to the methods of handler until ctx is done, then closes the listen key
+func (s *UserDataStream) Run(ctx context.Context, handler WsUserDataHandler) error {
+	return s.UserStream.Run(ctx, wsUserDataHandler(handler))
+}
+
+// NewUserDataStream creates a user data stream which owns its listen key. It keeps the key alive and reconnects when
+// the key has expired or the connection failed. The orders and trades of symbols missed meanwhile are backfilled as
+// ORDER_TRADE_UPDATE events of UM and CM futures and executionReport events of margin, nothing is backfilled without
+// symbols. The balance and position events are lost, a *common.UserStreamGapError is passed to the ErrHandler after
+// every reconnect so the account can be requested again:
+//
+//	stream := client.NewUserDataStream(portfolio.UserDataSymbols{UM: []string{"BTCUSDT"}})
+//	err := stream.Run(ctx, handler)
+func (c *Client) NewUserDataStream(symbols UserDataSymbols) *UserDataStream {
+	stream := common.NewUserStream(common.UserStreamSource[[]byte]{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Serve: func(listenKey string, handler func(message []byte), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			cfg := newWsConfig(fmt.Sprintf("%s/ws/%s", getWsEndpoint(), listenKey))
+			return wsServe(cfg, handler, errHandler)
+		},
+		Expired: func(message []byte) bool {
+			var event struct {
+				EventType string `json:"e"`
+			}
+			return json.Unmarshal(message, &event) == nil && event.EventType == "listenKeyExpired"
+		},
+	})
+	stream.Backfill = func(ctx context.Context, startTime, endTime int64) ([][]byte, error) {
+		return c.backfillUserData(ctx, symbols, startTime, endTime)
+	}
+	return &UserDataStream{stream}
+}
+
+// userDataMessage is a backfilled message and the time it is ordered by
+type userDataMessage struct {
+	time    int64
+	message []byte
+}
+
+// backfillUserData rebuilds the order events between startTime and endTime from the orders and trades of symbols.
+// Fields which the responses lack are empty.
+func (c *Client) backfillUserData(ctx context.Context, symbols UserDataSymbols, startTime, endTime int64) ([][]byte, error) {
+	orderStartTime := startTime - userDataOrderLookback.Milliseconds()
+	um, err := futuresOrderReplay("UM",
+		func(symbol string) *common.Iterator[*UMAllOrdersResponse] {
+			return c.NewUMAllOrdersService().Symbol(symbol).Iterator(orderStartTime, endTime)
+		},
+		func(symbol string) *common.Iterator[*UMAccountTrade] {
+			return c.NewUMAccountTradesService().Symbol(symbol).Iterator(startTime, endTime)
+		},
+		func(o *UMAllOrdersResponse) (WsFuturesOrderData, int64, int64) {
+			return WsFuturesOrderData{
+				Symbol:         o.Symbol,
+				ClientOrderID:  o.ClientOrderID,
+				Side:           SideType(o.Side),
+				OrderType:      OrderType(o.Type),
+				TimeInForce:    TimeInForceType(o.TimeInForce),
+				OriginalQty:    o.OrigQty,
+				OriginalPrice:  o.Price,
+				AveragePrice:   o.AvgPrice,
+				OrderStatus:    OrderStatusType(o.Status),
+				OrderID:        o.OrderID,
+				FilledAccumQty: o.ExecutedQty,
+				IsReduceOnly:   o.ReduceOnly,
+				PositionSide:   PositionSideType(o.PositionSide),
+				STPMode:        o.SelfTradePreventionMode,
+				GTD:            o.GoodTillDate,
+			}, o.Time, o.UpdateTime
+		},
+		func(t *UMAccountTrade) WsFuturesOrderData {
+			return WsFuturesOrderData{
+				Symbol:          t.Symbol,
+				Side:            SideType(t.Side),
+				OrderID:         t.OrderID,
+				LastFilledQty:   t.Qty,
+				LastFilledPrice: t.Price,
+				CommissionAsset: t.CommissionAsset,
+				Commission:      t.Commission,
+				TradeTime:       t.Time,
+				TradeID:         t.ID,
+				IsMaker:         t.Maker,
+				PositionSide:    PositionSideType(t.PositionSide),
+				RealizedProfit:  t.RealizedPnl,
+			}
+		}).Events(ctx, symbols.UM, startTime)
+	if err != nil {
+		return nil, err
+	}
+	cm, err := futuresOrderReplay("CM",
+		func(symbol string) *common.Iterator[*CMAllOrdersResponse] {
+			return c.NewCMAllOrdersService().Symbol(symbol).Iterator(orderStartTime, endTime)
+		},
+		func(symbol string) *common.Iterator[*CMAccountTrade] {
+			return c.NewCMAccountTradesService().Symbol(symbol).Iterator(startTime, endTime)
+		},
+		func(o *CMAllOrdersResponse) (WsFuturesOrderData, int64, int64) {
+			return WsFuturesOrderData{
+				Symbol:         o.Symbol,
+				ClientOrderID:  o.ClientOrderID,
+				Side:           SideType(o.Side),
+				OrderType:      OrderType(o.Type),
+				TimeInForce:    TimeInForceType(o.TimeInForce),
+				OriginalQty:    o.OrigQty,
+				OriginalPrice:  o.Price,
+				AveragePrice:   o.AvgPrice,
+				OrderStatus:    OrderStatusType(o.Status),
+				OrderID:        o.OrderID,
+				FilledAccumQty: o.ExecutedQty,
+				IsReduceOnly:   o.ReduceOnly,
+				PositionSide:   PositionSideType(o.PositionSide),
+			}, o.Time, o.UpdateTime
+		},
+		func(t *CMAccountTrade) WsFuturesOrderData {
+			return WsFuturesOrderData{
+				Symbol:          t.Symbol,
+				Side:            SideType(t.Side),
+				OrderID:         t.OrderID,
+				LastFilledQty:   t.Qty,
+				LastFilledPrice: t.Price,
+				CommissionAsset: t.CommissionAsset,
+				Commission:      t.Commission,
+				TradeTime:       t.Time,
+				TradeID:         t.ID,
+				IsMaker:         t.Maker,
+				PositionSide:    PositionSideType(t.PositionSide),
+				RealizedProfit:  t.RealizedPnl,
+			}
+		}).Events(ctx, symbols.CM, startTime)
+	if err != nil {
+		return nil, err
+	}
+	margin, err := c.marginOrderReplay(orderStartTime, startTime, endTime).Events(ctx, symbols.Margin, startTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []userDataMessage
+	for _, e := range append(um, cm...) {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, userDataMessage{e.TransactionTime, data})
+	}
+	for _, e := range margin {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, userDataMessage{e.TransactionTime, data})
+	}
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].time < messages[j].time
+	})
+	res := make([][]byte, len(messages))
+	for i, m := range messages {
+		res[i] = m.message
+	}
+	return res, nil
+}
+
+// futuresOrderReplay rebuilds the ORDER_TRADE_UPDATE events of the futures of businessUnit. order returns the
+// current state of an order with its creation and update time, trade returns the order, trade and fill fields of a
+// trade.
+func futuresOrderReplay[O, T any](businessUnit string,
+	orders func(symbol string) *common.Iterator[O], trades func(symbol string) *common.Iterator[T],
+	order func(o O) (data WsFuturesOrderData, createTime, updateTime int64), trade func(t T) WsFuturesOrderData,
+) *common.OrderReplay[O, T, *WsFuturesOrderUpdate] {
+	return &common.OrderReplay[O, T, *WsFuturesOrderUpdate]{
+		Orders: orders,
+		Trades: trades,
+		OrderInfo: func(o O) (id, createTime, updateTime int64) {
+			d, createTime, updateTime := order(o)
+			return d.OrderID, createTime, updateTime
+		},
+		TradeInfo: func(t T) (id, orderID int64) {
+			d := trade(t)
+			return d.TradeID, d.OrderID
+		},
+		Created: func(o O) *WsFuturesOrderUpdate {
+			d, createTime, _ := order(o)
+			d.ExecutionType = OrderExecutionTypeNew
+			d.OrderStatus = OrderStatusTypeNew
+			d.FilledAccumQty = "0"
+			d.TradeTime = createTime
+			return futuresOrderUpdate(businessUnit, d)
+		},
+		Ended: func(o O) (*WsFuturesOrderUpdate, bool) {
+			d, _, updateTime := order(o)
+			if d.OrderStatus != OrderStatusTypeCanceled && d.OrderStatus != OrderStatusTypeExpired {
+				return nil, false
+			}
+			d.ExecutionType = OrderExecutionType(d.OrderStatus)
+			d.TradeTime = updateTime
+			return futuresOrderUpdate(businessUnit, d), true
+		},
+		Traded: func(t T, o O, found, last bool) *WsFuturesOrderUpdate {
+			d := trade(t)
+			d.OrderStatus = OrderStatusTypePartiallyFilled
+			if found {
+				od, _, _ := order(o)
+				d.ClientOrderID = od.ClientOrderID
+				d.OrderType = od.OrderType
+				d.TimeInForce = od.TimeInForce
+				d.OriginalQty = od.OriginalQty
+				d.OriginalPrice = od.OriginalPrice
+				d.AveragePrice = od.AveragePrice
+				d.IsReduceOnly = od.IsReduceOnly
+				d.STPMode = od.STPMode
+				d.GTD = od.GTD
+				if last && od.OrderStatus == OrderStatusTypeFilled {
+					d.OrderStatus = OrderStatusTypeFilled
+					d.FilledAccumQty = od.FilledAccumQty
+				}
+			}
+			d.ExecutionType = OrderExecutionTypeTrade
+			return futuresOrderUpdate(businessUnit, d)
+		},
+		Time: func(e *WsFuturesOrderUpdate) int64 {
+			return e.TransactionTime
+		},
+	}
+}
+
+func futuresOrderUpdate(businessUnit string, d WsFuturesOrderData) *WsFuturesOrderUpdate {
+	return &WsFuturesOrderUpdate{
+		EventType:       "ORDER_TRADE_UPDATE",
+		BusinessUnit:    businessUnit,
+		EventTime:       d.TradeTime,
+		TransactionTime: d.TradeTime,
+		Order:           d,
+	}
+}
+
+// marginOrderReplay rebuilds the executionReport events of cross margin
+func (c *Client) marginOrderReplay(orderStartTime, startTime, endTime int64) *common.OrderReplay[*MarginOrder, *MarginTrade, *WsMarginOrderUpdate] {
+	return &common.OrderReplay[*MarginOrder, *MarginTrade, *WsMarginOrderUpdate]{
+		Orders: func(symbol string) *common.Iterator[*MarginOrder] {
+			return c.NewGetMarginAllOrdersService().Symbol(symbol).Iterator(orderStartTime, endTime)
+		},
+		Trades: func(symbol string) *common.Iterator[*MarginTrade] {
+			return c.NewMarginAccountTradesService().Symbol(symbol).Iterator(startTime, endTime)
+		},
+		OrderInfo: func(o *MarginOrder) (id, createTime, updateTime int64) {
+			return o.OrderID, o.TransactTime, o.UpdateTime
+		},
+		TradeInfo: func(t *MarginTrade) (id, orderID int64) {
+			return t.ID, t.OrderID
+		},
+		Created: func(o *MarginOrder) *WsMarginOrderUpdate {
+			u := marginOrderUpdate(o)
+			u.ExecutionType = string(OrderExecutionTypeNew)
+			u.OrderStatus = string(OrderStatusTypeNew)
+			u.CumulativeFilledQty = "0"
+			u.QuoteQtyFilled = "0"
+			u.TransactionTime = o.TransactTime
+			u.EventTime = o.TransactTime
+			return u
+		},
+		Ended: func(o *MarginOrder) (*WsMarginOrderUpdate, bool) {
+			executionType := ""
+			switch OrderStatusType(o.Status) {
+			case OrderStatusTypeCanceled, OrderStatusTypeRejected, OrderStatusTypeExpired:
+				executionType = o.Status
+			case "EXPIRED_IN_MATCH":
+				executionType = "TRADE_PREVENTION"
+			default:
+				return nil, false
+			}
+			u := marginOrderUpdate(o)
+			u.ExecutionType = executionType
+			u.TransactionTime = o.UpdateTime
+			u.EventTime = o.UpdateTime
+			return u, true
+		},
+		Traded: func(t *MarginTrade, o *MarginOrder, found, last bool) *WsMarginOrderUpdate {
+			u := &WsMarginOrderUpdate{EventType: "executionReport", Symbol: t.Symbol, OrderID: t.OrderID}
+			if t.IsBuyer {
+				u.Side = string(SideTypeBuy)
+			} else {
+				u.Side = string(SideTypeSell)
+			}
+			if found {
+				u = marginOrderUpdate(o)
+				u.CumulativeFilledQty = ""
+				u.QuoteQtyFilled = ""
+			}
+			u.OrderStatus = string(OrderStatusTypePartiallyFilled)
+			if found && last && OrderStatusType(o.Status) == OrderStatusTypeFilled {
+				u.OrderStatus = string(OrderStatusTypeFilled)
+				u.CumulativeFilledQty = o.ExecutedQty
+				u.QuoteQtyFilled = o.CummulativeQuoteQty
+			}
+			u.ExecutionType = string(OrderExecutionTypeTrade)
+			u.TradeID = t.ID
+			u.LastExecutedQty = t.Qty
+			u.LastExecutedPrice = t.Price
+			u.CommissionAsset = t.CommissionAsset
+			u.CommissionAmount = t.Commission
+			u.IsMaker = t.IsMaker
+			u.TransactionTime = t.Time
+			u.EventTime = t.Time
+			return u
+		},
+		Time: func(e *WsMarginOrderUpdate) int64 {
+			return e.TransactionTime
+		},
+	}
+}
+
+// marginOrderUpdate returns the order update of the current state of o
+func marginOrderUpdate(o *MarginOrder) *WsMarginOrderUpdate {
+	return &WsMarginOrderUpdate{
+		EventType:               "executionReport",
+		Symbol:                  o.Symbol,
+		ClientOrderID:           o.ClientOrderID,
+		Side:                    string(o.Side),
+		OrderType:               string(o.Type),
+		TimeInForce:             string(o.TimeInForce),
+		Quantity:                o.OrigQty,
+		Price:                   o.Price,
+		StopPrice:               o.StopPrice,
+		IcebergQuantity:         o.IcebergQty,
+		OrderStatus:             o.Status,
+		OrderID:                 o.OrderID,
+		CumulativeFilledQty:     o.ExecutedQty,
+		QuoteQtyFilled:          o.CummulativeQuoteQty,
+		IsOnBook:                o.IsWorking,
+		OrderCreationTime:       o.TransactTime,
+		SelfTradePreventionMode: o.SelfTradePreventionMode,
+	}
+}
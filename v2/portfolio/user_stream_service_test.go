@@ -1,6 +1,10 @@
 package portfolio
 
 import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -57,3 +61,67 @@ func (s *userStreamServiceTestSuite) TestCloseUserStream() {
 	err := s.client.NewCloseUserStreamService().ListenKey(listenKey).Do(newContext())
 	s.r().NoError(err)
 }
+
+// recordingUserDataHandler records the order updates it is dispatched
+type recordingUserDataHandler struct {
+	*testWsUserDataHandler
+	got []string
+}
+
+func (h *recordingUserDataHandler) HandleFuturesOrderUpdate(event *WsFuturesOrderUpdate) {
+	o := event.Order
+	h.got = append(h.got, fmt.Sprintf("%d %s %s %d %s %s %s", event.TransactionTime, event.BusinessUnit, o.Symbol,
+		o.OrderID, o.ExecutionType, o.OrderStatus, o.FilledAccumQty))
+}
+
+func (h *recordingUserDataHandler) HandleMarginOrderUpdate(event *WsMarginOrderUpdate) {
+	h.got = append(h.got, fmt.Sprintf("%d margin %s %d %s %s %s", event.TransactionTime, event.Symbol,
+		event.OrderID, event.ExecutionType, event.OrderStatus, event.CumulativeFilledQty))
+}
+
+func (s *userStreamServiceTestSuite) TestUserDataStreamBackfill() {
+	start := int64(1700000000000)
+	rows := map[string][]string{
+		"/papi/v1/um/allOrders": {
+			fmt.Sprintf(`{"symbol":"BTCUSDT","orderId":1,"origQty":"2","executedQty":"2","status":"FILLED","side":"BUY",
+				"time":%d,"updateTime":%d}`, start-1000, start+10000),
+		},
+		"/papi/v1/um/userTrades": {
+			fmt.Sprintf(`{"id":11,"symbol":"BTCUSDT","orderId":1,"price":"10","qty":"1","side":"BUY","time":%d}`, start+8000),
+			fmt.Sprintf(`{"id":12,"symbol":"BTCUSDT","orderId":1,"price":"10","qty":"1","side":"BUY","time":%d}`, start+10000),
+		},
+		"/papi/v1/margin/allOrders": {
+			fmt.Sprintf(`{"symbol":"ETHUSDT","orderId":2,"origQty":"1","executedQty":"0","status":"CANCELED","side":"SELL",
+				"time":%d,"updateTime":%d}`, start+5000, start+20000),
+		},
+		"/papi/v1/margin/myTrades": {},
+	}
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		startTime, _ := strconv.ParseInt(query.Get("startTime"), 10, 64)
+		endTime, _ := strconv.ParseInt(query.Get("endTime"), 10, 64)
+		var res []string
+		for _, row := range rows[req.URL.Path] {
+			var t int64
+			fmt.Sscanf(row[strings.Index(row, `"time":`)+7:], "%d", &t)
+			if t >= startTime && t <= endTime {
+				res = append(res, row)
+			}
+		}
+		return newHTTPResponse([]byte("["+strings.Join(res, ",")+"]"), http.StatusOK), nil
+	}
+
+	stream := s.client.NewUserDataStream(UserDataSymbols{UM: []string{"BTCUSDT"}, Margin: []string{"ETHUSDT"}})
+	messages, err := stream.Backfill(newContext(), start, start+60000)
+	s.r().NoError(err)
+	handler := &recordingUserDataHandler{testWsUserDataHandler: &testWsUserDataHandler{}}
+	for _, m := range messages {
+		wsUserDataHandler(handler)(m)
+	}
+	s.r().Equal([]string{
+		fmt.Sprintf("%d margin ETHUSDT 2 NEW NEW 0", start+5000),
+		fmt.Sprintf("%d UM BTCUSDT 1 TRADE PARTIALLY_FILLED ", start+8000),
+		fmt.Sprintf("%d UM BTCUSDT 1 TRADE FILLED 2", start+10000),
+		fmt.Sprintf("%d margin ETHUSDT 2 CANCELED CANCELED 0", start+20000),
+	}, handler.got)
+}
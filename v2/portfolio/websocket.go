@@ -1,10 +1,12 @@
 package portfolio
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/gorilla/websocket"
 )
 
@@ -27,6 +29,19 @@ func newWsConfig(endpoint string) *WsConfig {
 	}
 }
 
+// ServeStream starts the stream of serve and returns its handle, the stream is stopped when ctx is done, e.g.
+//
+//	stream, err := ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+//		return WsUserDataServe(listenKey, wsUserDataHandler, errHandler)
+//	}, nil)
+//
+// errHandler receives the errors of the stream if it isn't nil, the error which ended the stream is returned by Err.
+func ServeStream(ctx context.Context, serve func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error), errHandler ErrHandler) (*common.Stream, error) {
+	return common.ServeStream(ctx, func(errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return serve(errHandler)
+	}, errHandler)
+}
+
 var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	proxy := http.ProxyFromEnvironment
 	if cfg.Proxy != nil {
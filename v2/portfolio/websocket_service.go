@@ -73,6 +73,7 @@ type WsUserDataAccountUpdate struct {
 	AccountUpdate WsAccountUpdate `json:"a"`
 }
 
+//decimalgen:accessors
 type WsUserDataMarginCall struct {
 	CrossWalletBalance  string       `json:"cw"`
 	MarginCallPositions []WsPosition `json:"p"`
@@ -82,6 +83,7 @@ type WsUserDataOrderTradeUpdate struct {
 	OrderTradeUpdate WsOrderTradeUpdate `json:"o"`
 }
 
+//decimalgen:accessors
 type WsUserDataTradeLite struct {
 	Symbol          string   `json:"s"`
 	OriginalQty     string   `json:"q"`
@@ -137,6 +139,8 @@ type WsAccountUpdate struct {
 }
 
 // WsBalance define balance
+//
+//decimalgen:accessors
 type WsBalance struct {
 	Asset              string `json:"a"`
 	Balance            string `json:"wb"`
@@ -145,6 +149,8 @@ type WsBalance struct {
 }
 
 // WsPosition define position
+//
+//decimalgen:accessors
 type WsPosition struct {
 	Symbol              string           `json:"s"`
 	Side                PositionSideType `json:"ps"`
@@ -156,6 +162,8 @@ type WsPosition struct {
 }
 
 // WsOrderTradeUpdate define order trade update
+//
+//decimalgen:accessors
 type WsOrderTradeUpdate struct {
 	Symbol               string             `json:"s"`   // Symbol
 	ClientOrderID        string             `json:"c"`   // Client order ID
@@ -244,6 +252,7 @@ type WsMarginAccountUpdate struct {
 	Balances       []WsMarginBalance `json:"B"`
 }
 
+//decimalgen:accessors
 type WsMarginBalance struct {
 	Asset  string `json:"a"`
 	Free   string `json:"f"`
@@ -251,6 +260,8 @@ type WsMarginBalance struct {
 }
 
 // WsLiabilityUpdate represents a liability update event
+//
+//decimalgen:accessors
 type WsLiabilityUpdate struct {
 	EventType      string `json:"e"` // "liabilityChange"
 	EventTime      int64  `json:"E"`
@@ -263,6 +274,8 @@ type WsLiabilityUpdate struct {
 }
 
 // WsMarginOrderUpdate represents a margin order update event
+//
+//decimalgen:accessors
 type WsMarginOrderUpdate struct {
 	EventType               string `json:"e"` // "executionReport"
 	EventTime               int64  `json:"E"`
@@ -318,6 +331,7 @@ type WsFuturesOrderUpdate struct {
 	Order           WsFuturesOrderData `json:"o"`
 }
 
+//decimalgen:accessors
 type WsFuturesOrderData struct {
 	Symbol          string             `json:"s"`
 	ClientOrderID   string             `json:"c"`
@@ -364,6 +378,7 @@ type WsFuturesAccountUpdate struct {
 	} `json:"a"`
 }
 
+//decimalgen:accessors
 type WsFuturesBalance struct {
 	Asset              string `json:"a"`
 	WalletBalance      string `json:"wb"`
@@ -371,6 +386,7 @@ type WsFuturesBalance struct {
 	BalanceChange      string `json:"bc"`
 }
 
+//decimalgen:accessors
 type WsFuturesPosition struct {
 	Symbol              string           `json:"s"`
 	PositionAmount      string           `json:"pa"`
@@ -415,6 +431,8 @@ const (
 )
 
 // WsRiskLevelChange represents a risk level change event
+//
+//decimalgen:accessors
 type WsRiskLevelChange struct {
 	EventType            string `json:"e"` // "riskLevelChange"
 	EventTime            int64  `json:"E"`
@@ -433,6 +451,8 @@ const (
 )
 
 // WsMarginBalanceUpdate represents a margin balance update event
+//
+//decimalgen:accessors
 type WsMarginBalanceUpdate struct {
 	EventType    string `json:"e"` // "balanceUpdate"
 	EventTime    int64  `json:"E"`
@@ -0,0 +1,39 @@
+// Code generated by decimalgen; DO NOT EDIT.
+
+package portfolio_pro
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// FromAssetQtyDecimal returns FromAssetQty as a decimal, zero if it is empty
+func (v *MintBFUSDResponse) FromAssetQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MintBFUSDResponse.FromAssetQty", v.FromAssetQty)
+}
+
+// MintRateDecimal returns MintRate as a decimal, zero if it is empty
+func (v *MintBFUSDResponse) MintRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MintBFUSDResponse.MintRate", v.MintRate)
+}
+
+// TargetAssetQtyDecimal returns TargetAssetQty as a decimal, zero if it is empty
+func (v *MintBFUSDResponse) TargetAssetQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("MintBFUSDResponse.TargetAssetQty", v.TargetAssetQty)
+}
+
+// FromAssetQtyDecimal returns FromAssetQty as a decimal, zero if it is empty
+func (v *RedeemBFUSDResponse) FromAssetQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("RedeemBFUSDResponse.FromAssetQty", v.FromAssetQty)
+}
+
+// RedeemRateDecimal returns RedeemRate as a decimal, zero if it is empty
+func (v *RedeemBFUSDResponse) RedeemRateDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("RedeemBFUSDResponse.RedeemRate", v.RedeemRate)
+}
+
+// TargetAssetQtyDecimal returns TargetAssetQty as a decimal, zero if it is empty
+func (v *RedeemBFUSDResponse) TargetAssetQtyDecimal() (decimal.Decimal, error) {
+	return common.ParseDecimal("RedeemBFUSDResponse.TargetAssetQty", v.TargetAssetQty)
+}
@@ -52,6 +52,8 @@ func (s *MintBFUSDService) Do(ctx context.Context, opts ...RequestOption) (*Mint
 
 // Example:
 // {"fromAssetQty":"10","targetAssetQty":"9.9966156","mintRate":"0.99966156","fromAsset":"USDC","targetAsset":"BFUSD"}
+//
+//decimalgen:accessors
 type MintBFUSDResponse struct {
 	FromAsset      string `json:"fromAsset"`
 	TargetAsset    string `json:"targetAsset"`
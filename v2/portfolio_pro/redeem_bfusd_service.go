@@ -53,6 +53,8 @@ func (s *RedeemBFUSDService) Do(ctx context.Context, opts ...RequestOption) (*Re
 
 // Example:
 // {"fromAssetQty":"10","targetAssetQty":"9.9983733","redeemRate":"0.99983733","fromAsset":"BFUSD","targetAsset":"USDC"}
+//
+//decimalgen:accessors
 type RedeemBFUSDResponse struct {
 	FromAsset      string `json:"fromAsset"`
 	TargetAsset    string `json:"targetAsset"`
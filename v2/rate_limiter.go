@@ -0,0 +1,146 @@
+package binance
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// DefaultRateLimits are the spot limits used when they can't be loaded from exchange info
+var DefaultRateLimits = []common.RateLimitRule{
+	{Type: common.RateLimitTypeRequestWeight, Interval: time.Minute, Limit: 6000},
+	{Type: common.RateLimitTypeOrders, Interval: 10 * time.Second, Limit: 100},
+	{Type: common.RateLimitTypeOrders, Interval: 24 * time.Hour, Limit: 200000},
+	{Type: common.RateLimitTypeRawRequests, Interval: 5 * time.Minute, Limit: 61000},
+}
+
+// endpointWeights define request weight of endpoints, endpoints which are not listed weigh 1
+var endpointWeights = map[string]int64{
+	"GET /api/v3/trades":             25,
+	"GET /api/v3/historicalTrades":   25,
+	"GET /api/v3/aggTrades":          4,
+	"GET /api/v3/klines":             2,
+	"GET /api/v3/uiKlines":           2,
+	"GET /api/v3/avgPrice":           2,
+	"GET /api/v3/exchangeInfo":       20,
+	"GET /api/v3/order":              4,
+	"GET /api/v3/allOrders":          20,
+	"GET /api/v3/orderList":          4,
+	"GET /api/v3/allOrderList":       20,
+	"GET /api/v3/openOrderList":      6,
+	"GET /api/v3/account":            20,
+	"GET /api/v3/account/commission": 20,
+	"GET /api/v3/myTrades":           20,
+	"GET /api/v3/rateLimit/order":    40,
+}
+
+// orderEndpoints are the endpoints counted by the ORDERS limits
+var orderEndpoints = map[string]bool{
+	"POST /api/v3/order":               true,
+	"POST /api/v3/order/oco":           true,
+	"POST /api/v3/order/cancelReplace": true,
+	"POST /api/v3/orderList/oco":       true,
+	"POST /api/v3/orderList/oto":       true,
+	"POST /api/v3/orderList/otoco":     true,
+	"POST /api/v3/sor/order":           true,
+}
+
+// requestWeight returns request weight of r, weights depending on parameters are computed as documented
+func requestWeight(r *request) int64 {
+	hasSymbol := r.query.Get("symbol") != ""
+	symbols := strings.Count(r.query.Get("symbols"), ",") + 1
+	switch r.method + " " + r.endpoint {
+	case "GET /api/v3/depth":
+		limit, _ := strconv.Atoi(r.query.Get("limit"))
+		switch {
+		case limit > 1000:
+			return 250
+		case limit > 500:
+			return 50
+		case limit > 100:
+			return 25
+		}
+		return 5
+	case "GET /api/v3/openOrders":
+		if hasSymbol {
+			return 6
+		}
+		return 80
+	case "GET /api/v3/ticker/24hr":
+		switch {
+		case hasSymbol:
+			return 2
+		case r.query.Get("symbols") == "":
+			return 80
+		case symbols <= 20:
+			return 2
+		case symbols <= 100:
+			return 40
+		}
+		return 80
+	case "GET /api/v3/ticker/price", "GET /api/v3/ticker/bookTicker":
+		if hasSymbol {
+			return 2
+		}
+		return 4
+	case "GET /api/v3/ticker", "GET /api/v3/ticker/tradingDay":
+		if hasSymbol {
+			return 4
+		}
+		if weight := int64(symbols) * 4; weight < 200 {
+			return weight
+		}
+		return 200
+	}
+	if weight, ok := endpointWeights[r.method+" "+r.endpoint]; ok {
+		return weight
+	}
+	return 1
+}
+
+// requestOrders returns the number of orders r counts for the ORDERS limits
+func requestOrders(r *request) int64 {
+	if orderEndpoints[r.method+" "+r.endpoint] {
+		return 1
+	}
+	return 0
+}
+
+// SyncRateLimits load the spot limits of RateLimiter from exchange info
+func (c *Client) SyncRateLimits(ctx context.Context) error {
+	res, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	rules := make([]common.RateLimitRule, 0, len(res.RateLimits))
+	for _, limit := range res.RateLimits {
+		rule, err := common.NewRateLimitRule(limit.RateLimitType, limit.Interval, limit.IntervalNum, limit.Limit)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, rule)
+	}
+	c.RateLimiter.SetRules("api", rules)
+	return nil
+}
+
+// waitRateLimit reserves weight of r, the limits are loaded from exchange info before the first request
+func (c *Client) waitRateLimit(ctx context.Context, r *request) error {
+	if c.RateLimiter == nil {
+		return nil
+	}
+	api := common.RateLimitAPI(r.endpoint)
+	if api != "api" {
+		return nil
+	}
+	if !c.RateLimiter.HasRules(api) && r.endpoint != "/api/v3/exchangeInfo" {
+		if err := c.SyncRateLimits(ctx); err != nil {
+			c.debug("failed to sync rate limits: %s\n", err)
+			c.RateLimiter.SetRules(api, DefaultRateLimits)
+		}
+	}
+	return c.RateLimiter.Wait(ctx, api, requestWeight(r), requestOrders(r))
+}
@@ -0,0 +1,94 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type rateLimitTestSuite struct {
+	baseTestSuite
+	paths []string
+}
+
+func TestRateLimit(t *testing.T) {
+	suite.Run(t, new(rateLimitTestSuite))
+}
+
+func (s *rateLimitTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.paths = nil
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		s.paths = append(s.paths, req.URL.Path)
+		switch req.URL.Path {
+		case "/api/v3/exchangeInfo":
+			return newHTTPResponse([]byte(`{"rateLimits":[
+				{"rateLimitType":"REQUEST_WEIGHT","interval":"MINUTE","intervalNum":1,"limit":60},
+				{"rateLimitType":"ORDERS","interval":"SECOND","intervalNum":10,"limit":1}
+			],"symbols":[]}`), http.StatusOK), nil
+		case "/api/v3/order":
+			res := newHTTPResponse([]byte(`{}`), http.StatusOK)
+			res.Header = http.Header{"X-Mbx-Order-Count-10s": []string{"1"}}
+			return res, nil
+		}
+		res := newHTTPResponse([]byte(`{"lastUpdateId":1,"bids":[],"asks":[]}`), http.StatusOK)
+		res.Header = http.Header{"X-Mbx-Used-Weight-1m": []string{"30"}}
+		return res, nil
+	}
+}
+
+func (s *rateLimitTestSuite) TestRateLimiter() {
+	limiter := common.NewRateLimiter().FailFast(true)
+	s.client.RateLimiter = limiter
+
+	_, err := s.client.NewDepthService().Symbol("LTCBTC").Limit(500).Do(context.Background())
+	s.r().NoError(err)
+	s.Equal([]string{"/api/v3/exchangeInfo", "/api/v3/depth"}, s.paths)
+	s.Equal(int64(30), limiter.Used("api", common.RateLimitTypeRequestWeight, time.Minute))
+
+	// weight of limit 5000 is 250 which exceeds the limit
+	_, err = s.client.NewDepthService().Symbol("LTCBTC").Limit(5000).Do(context.Background())
+	var rateLimitErr *common.RateLimitError
+	s.r().True(errors.As(err, &rateLimitErr))
+	s.Equal(common.RateLimitTypeRequestWeight, rateLimitErr.Type)
+	s.Len(s.paths, 2)
+
+	_, err = s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).Type(OrderTypeMarket).
+		Quantity("1").Do(context.Background())
+	s.r().NoError(err)
+	_, err = s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).Type(OrderTypeMarket).
+		Quantity("1").Do(context.Background())
+	s.r().True(errors.As(err, &rateLimitErr))
+	s.Equal(common.RateLimitTypeOrders, rateLimitErr.Type)
+	s.Len(s.paths, 3)
+}
+
+func (s *rateLimitTestSuite) TestRequestWeight() {
+	for _, c := range []struct {
+		method   string
+		endpoint string
+		params   params
+		weight   int64
+		orders   int64
+	}{
+		{http.MethodGet, "/api/v3/depth", params{}, 5, 0},
+		{http.MethodGet, "/api/v3/depth", params{"limit": 1000}, 50, 0},
+		{http.MethodGet, "/api/v3/openOrders", params{}, 80, 0},
+		{http.MethodGet, "/api/v3/openOrders", params{"symbol": "LTCBTC"}, 6, 0},
+		{http.MethodGet, "/api/v3/ticker/24hr", params{"symbols": `["A","B"]`}, 2, 0},
+		{http.MethodGet, "/api/v3/ticker", params{"symbols": `["A","B","C"]`}, 12, 0},
+		{http.MethodGet, "/api/v3/account", params{}, 20, 0},
+		{http.MethodPost, "/api/v3/order", params{}, 1, 1},
+		{http.MethodGet, "/api/v3/ping", params{}, 1, 0},
+	} {
+		r := &request{method: c.method, endpoint: c.endpoint}
+		r.setParams(c.params)
+		s.Equal(c.weight, requestWeight(r), c.endpoint)
+		s.Equal(c.orders, requestOrders(r), c.endpoint)
+	}
+}
@@ -75,6 +75,8 @@ type SpotRebateHistoryData struct {
 }
 
 // SpotRebateHistoryDataItem define a spot rebate history data item
+//
+//decimalgen:accessors
 type SpotRebateHistoryDataItem struct {
 	Asset      string `json:"asset"`
 	Type       int32  `json:"type"`
@@ -0,0 +1,144 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// RecentTradesWsService queries recent trades
+type RecentTradesWsService struct {
+	c websocket.Client
+}
+
+// NewRecentTradesWsService init RecentTradesWsService
+func NewRecentTradesWsService() (*RecentTradesWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecentTradesWsService{
+		c: client,
+	}, nil
+}
+
+// NewRecentTradesWsServiceWithSession init RecentTradesWsService sending its requests on the connection of session
+func NewRecentTradesWsServiceWithSession(session *SessionWsService) *RecentTradesWsService {
+	return &RecentTradesWsService{
+		c: session.c,
+	}
+}
+
+// RecentTradesWsRequest parameters for 'trades.recent' websocket API
+type RecentTradesWsRequest struct {
+	symbol string
+	limit  *int
+}
+
+// NewRecentTradesWsRequest init RecentTradesWsRequest
+func NewRecentTradesWsRequest() *RecentTradesWsRequest {
+	return &RecentTradesWsRequest{}
+}
+
+func (s *RecentTradesWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *RecentTradesWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'trades.recent' request
+func (s *RecentTradesWsService) Do(requestID string, request *RecentTradesWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TradesRecentSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'trades.recent' request and receives response
+func (s *RecentTradesWsService) SyncDo(requestID string, request *RecentTradesWsRequest) (*RecentTradesWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TradesRecentSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	recentTradesWsResponse := &RecentTradesWsResponse{}
+	if err := json.Unmarshal(response, recentTradesWsResponse); err != nil {
+		return nil, err
+	}
+	if recentTradesWsResponse.Error != nil {
+		recentTradesWsResponse.Error.StatusCode = recentTradesWsResponse.Status
+		return nil, recentTradesWsResponse.Error
+	}
+
+	return recentTradesWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *RecentTradesWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *RecentTradesWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *RecentTradesWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *RecentTradesWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *RecentTradesWsRequest) Symbol(symbol string) *RecentTradesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// Limit set limit
+func (s *RecentTradesWsRequest) Limit(limit int) *RecentTradesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// RecentTradesWsResponse define 'trades.recent' websocket API response
+type RecentTradesWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result []*Trade `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,97 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *recentTradesServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.recentTrades = &RecentTradesWsService{
+		c: s.client,
+	}
+
+	s.recentTradesRequest = NewRecentTradesWsRequest().
+		Symbol("BNBBTC").
+		Limit(1)
+}
+
+func (s *recentTradesServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type recentTradesServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	recentTrades        *RecentTradesWsService
+	recentTradesRequest *RecentTradesWsRequest
+}
+
+func TestRecentTradesServiceWs(t *testing.T) {
+	suite.Run(t, new(recentTradesServiceWsTestSuite))
+}
+
+func (s *recentTradesServiceWsTestSuite) TestRecentTrades() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.recentTrades.Do(s.requestID, s.recentTradesRequest)
+	s.NoError(err)
+}
+
+func (s *recentTradesServiceWsTestSuite) TestRecentTrades_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.recentTrades.Do("", s.recentTradesRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *recentTradesServiceWsTestSuite) TestRecentTradesSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":[{"id":194686783,"price":"0.01361000","qty":"0.01400000","quoteQty":"0.00019054","time":1660009530807,"isBuyerMaker":true,"isBestMatch":true}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.recentTrades.SyncDo(s.requestID, s.recentTradesRequest)
+	s.Require().NoError(err)
+	s.Equal([]*Trade{{
+		ID:            194686783,
+		Price:         "0.01361000",
+		Quantity:      "0.01400000",
+		QuoteQuantity: "0.00019054",
+		Time:          1660009530807,
+		IsBuyerMaker:  true,
+		IsBestMatch:   true,
+	}}, response.Result)
+}
+
+func (s *recentTradesServiceWsTestSuite) TestRecentTradesSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.recentTrades.SyncDo(s.requestID, s.recentTradesRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *recentTradesServiceWsTestSuite) TestRecentTradesSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.recentTrades.SyncDo(s.requestID, s.recentTradesRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -0,0 +1,128 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// callAPIWithRetry sends r and retries it according to RetryPolicy. Orders are retried only if they carry
+// a client order id, the order is looked up before each retry so that it can't be placed twice. If the order
+// has been placed, the response is built from the order status and its trades.
+func (c *Client) callAPIWithRetry(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	var clientOrderID string
+	if r.method == http.MethodPost && r.endpoint == "/api/v3/order" {
+		clientOrderID = requestParam(r, "newClientOrderId")
+	}
+	retryable := r.method == http.MethodGet || clientOrderID != ""
+	header := r.header.Clone()
+	for attempt := 0; ; attempt++ {
+		r.header = header.Clone()
+		var statusCode int
+		var resHeader http.Header
+		data, statusCode, resHeader, err = c.sendRequest(ctx, r, opts...)
+		if err == nil || !retryable || attempt >= c.RetryPolicy.MaxRetries || ctx.Err() != nil ||
+			!common.IsRetryable(statusCode, err) {
+			return data, err
+		}
+		c.debug("retry request after error: %s\n", err)
+		if c.RetryPolicy.Wait(ctx, attempt, resHeader) != nil {
+			return data, err
+		}
+		if clientOrderID != "" {
+			orderData, found, qerr := c.queryOrder(ctx, requestParam(r, "symbol"), clientOrderID)
+			if qerr != nil {
+				// the order may have been placed, it is not sent again
+				return nil, err
+			}
+			if found {
+				orderData, qerr = c.createOrderResponse(ctx, orderData, NewOrderRespType(requestParam(r, "newOrderRespType")))
+				if qerr != nil {
+					return nil, err
+				}
+				return orderData, nil
+			}
+		}
+	}
+}
+
+// queryOrder looks up order by client order id, found is false if the order doesn't exist
+func (c *Client) queryOrder(ctx context.Context, symbol string, clientOrderID string) (data []byte, found bool, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/order",
+		secType:  secTypeSigned,
+	}
+	r.setParams(params{
+		"symbol":            symbol,
+		"origClientOrderId": clientOrderID,
+	})
+	data, _, _, err = c.sendRequest(ctx, r)
+	if err != nil {
+		var apiErr *common.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == common.ErrorCodeNoSuchOrder {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// createOrderResponse builds the response of a placed order from its status. Unless respType asks for no fills they
+// are built from the trades of the order.
+func (c *Client) createOrderResponse(ctx context.Context, data []byte, respType NewOrderRespType) ([]byte, error) {
+	o := new(Order)
+	if err := json.Unmarshal(data, o); err != nil {
+		return nil, err
+	}
+	res := &CreateOrderResponse{
+		Symbol:                   o.Symbol,
+		OrderID:                  o.OrderID,
+		ClientOrderID:            o.ClientOrderID,
+		TransactTime:             o.Time,
+		Price:                    o.Price,
+		OrigQuantity:             o.OrigQuantity,
+		OrigQuoteOrderQuantity:   o.OrigQuoteOrderQuantity,
+		ExecutedQuantity:         o.ExecutedQuantity,
+		CummulativeQuoteQuantity: o.CummulativeQuoteQuantity,
+		IsIsolated:               o.IsIsolated,
+		Status:                   o.Status,
+		TimeInForce:              o.TimeInForce,
+		Type:                     o.Type,
+		Side:                     o.Side,
+		Fills:                    []*Fill{},
+	}
+	if respType != NewOrderRespTypeACK && respType != NewOrderRespTypeRESULT && strings.Trim(o.ExecutedQuantity, "0.") != "" {
+		r := &request{
+			method:   http.MethodGet,
+			endpoint: "/api/v3/myTrades",
+			secType:  secTypeSigned,
+		}
+		r.setParams(params{
+			"symbol":  o.Symbol,
+			"orderId": o.OrderID,
+		})
+		data, _, _, err := c.sendRequest(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		var trades []*TradeV3
+		if err := json.Unmarshal(data, &trades); err != nil {
+			return nil, err
+		}
+		for _, t := range trades {
+			res.Fills = append(res.Fills, &Fill{
+				TradeID:         t.ID,
+				Price:           t.Price,
+				Quantity:        t.Quantity,
+				Commission:      t.Commission,
+				CommissionAsset: t.CommissionAsset,
+			})
+		}
+	}
+	return json.Marshal(res)
+}
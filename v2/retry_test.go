@@ -0,0 +1,148 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type retryResponse struct {
+	statusCode int
+	header     http.Header
+	data       string
+}
+
+type retryTestSuite struct {
+	baseTestSuite
+	requests  []string
+	responses []retryResponse
+}
+
+func TestRetry(t *testing.T) {
+	suite.Run(t, new(retryTestSuite))
+}
+
+func (s *retryTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.requests = nil
+	s.responses = nil
+	s.client.RetryPolicy = &common.RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		s.requests = append(s.requests, req.Method+" "+req.URL.Path)
+		s.r().NotEmpty(s.responses, "unexpected request")
+		res := s.responses[0]
+		s.responses = s.responses[1:]
+		r := newHTTPResponse([]byte(res.data), res.statusCode)
+		r.Header = res.header
+		return r, nil
+	}
+}
+
+func (s *retryTestSuite) TestRetryGet() {
+	s.responses = []retryResponse{
+		{statusCode: http.StatusServiceUnavailable, data: `unavailable`},
+		{statusCode: http.StatusTooManyRequests, header: http.Header{"Retry-After": []string{"0"}}, data: `{"code":-1003,"msg":"Too many requests"}`},
+		{statusCode: http.StatusOK, data: `{"serverTime":1499827319559}`},
+	}
+	serverTime, err := s.client.NewServerTimeService().Do(context.Background())
+	s.r().NoError(err)
+	s.Equal(int64(1499827319559), serverTime)
+	s.Len(s.requests, 3)
+}
+
+func (s *retryTestSuite) TestRetryExhausted() {
+	s.responses = []retryResponse{
+		{statusCode: http.StatusBadRequest, data: `{"code":-1001,"msg":"Internal error; unable to process your request. Please try again."}`},
+		{statusCode: http.StatusBadRequest, data: `{"code":-1001,"msg":"Internal error; unable to process your request. Please try again."}`},
+		{statusCode: http.StatusBadRequest, data: `{"code":-1001,"msg":"Internal error; unable to process your request. Please try again."}`},
+	}
+	_, err := s.client.NewServerTimeService().Do(context.Background())
+	var apiErr *common.APIError
+	s.r().True(errors.As(err, &apiErr))
+	s.Equal(int64(-1001), apiErr.Code)
+	s.Len(s.requests, 3)
+}
+
+func (s *retryTestSuite) TestNoRetry() {
+	s.responses = []retryResponse{
+		{statusCode: http.StatusBadRequest, data: `{"code":-1121,"msg":"Invalid symbol."}`},
+	}
+	_, err := s.client.NewDepthService().Symbol("XXX").Do(context.Background())
+	s.r().ErrorIs(err, common.ErrInvalidSymbol)
+	var apiErr *common.APIError
+	s.r().True(errors.As(err, &apiErr))
+	s.Equal(http.StatusBadRequest, apiErr.StatusCode)
+	s.Equal(http.MethodGet, apiErr.Method)
+	s.Equal("/api/v3/depth", apiErr.Endpoint)
+	s.Len(s.requests, 1)
+
+	// cancelling is not idempotent
+	s.requests = nil
+	s.responses = []retryResponse{
+		{statusCode: http.StatusInternalServerError, data: `error`},
+	}
+	_, err = s.client.NewCancelOrderService().Symbol("LTCBTC").OrderID(1).Do(context.Background())
+	s.r().Error(err)
+	s.Len(s.requests, 1)
+}
+
+func (s *retryTestSuite) TestIPBanned() {
+	s.responses = []retryResponse{
+		{statusCode: http.StatusTeapot, data: `{"code":-1003,"msg":"Way too many requests; IP(127.0.0.1) banned until 1659146399830. Please use the websocket for live updates to avoid bans."}`},
+	}
+	_, err := s.client.NewServerTimeService().Do(context.Background())
+	var bannedErr *common.IPBannedError
+	s.r().True(errors.As(err, &bannedErr))
+	s.Equal(time.UnixMilli(1659146399830), bannedErr.Until)
+	s.Len(s.requests, 1)
+}
+
+func (s *retryTestSuite) TestRetryOrderNotPlaced() {
+	s.responses = []retryResponse{
+		{statusCode: http.StatusServiceUnavailable, data: `{"code":-1007,"msg":"Timeout waiting for response from backend server. Send status unknown; execution status unknown."}`},
+		{statusCode: http.StatusBadRequest, data: `{"code":-2013,"msg":"Order does not exist."}`},
+		{statusCode: http.StatusOK, data: `{"symbol":"LTCBTC","orderId":1,"clientOrderId":"myOrder1","transactTime":1499827319559,"status":"NEW"}`},
+	}
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).Type(OrderTypeMarket).
+		Quantity("1").NewClientOrderID("myOrder1").Do(context.Background())
+	s.r().NoError(err)
+	s.Equal(int64(1), res.OrderID)
+	s.Equal([]string{"POST /api/v3/order", "GET /api/v3/order", "POST /api/v3/order"}, s.requests)
+}
+
+func (s *retryTestSuite) TestRetryOrderPlaced() {
+	s.responses = []retryResponse{
+		{statusCode: http.StatusServiceUnavailable, data: `{"code":-1007,"msg":"Timeout waiting for response from backend server. Send status unknown; execution status unknown."}`},
+		{statusCode: http.StatusOK, data: `{"symbol":"LTCBTC","orderId":1,"clientOrderId":"myOrder1","status":"FILLED","executedQty":"1",
+			"time":1499827319559,"updateTime":1499827319560}`},
+		{statusCode: http.StatusOK, data: `[{"id":28457,"symbol":"LTCBTC","orderId":1,"price":"4.00000100","qty":"1.00000000",
+			"commission":"10.10000000","commissionAsset":"BNB","time":1499827319560}]`},
+	}
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).Type(OrderTypeMarket).
+		Quantity("1").NewClientOrderID("myOrder1").Do(context.Background())
+	s.r().NoError(err)
+	s.Equal(OrderStatusTypeFilled, res.Status)
+	s.Equal("1", res.ExecutedQuantity)
+	s.Equal(int64(1499827319559), res.TransactTime)
+	s.r().Len(res.Fills, 1)
+	s.Equal(&Fill{TradeID: 28457, Price: "4.00000100", Quantity: "1.00000000", Commission: "10.10000000", CommissionAsset: "BNB"},
+		res.Fills[0])
+	s.Equal([]string{"POST /api/v3/order", "GET /api/v3/order", "GET /api/v3/myTrades"}, s.requests)
+
+	// an ACK response has no fills
+	s.requests = nil
+	s.responses = []retryResponse{
+		{statusCode: http.StatusServiceUnavailable, data: `{"code":-1007,"msg":"Timeout waiting for response from backend server. Send status unknown; execution status unknown."}`},
+		{statusCode: http.StatusOK, data: `{"symbol":"LTCBTC","orderId":1,"clientOrderId":"myOrder1","status":"FILLED","executedQty":"1"}`},
+	}
+	res, err = s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).Type(OrderTypeMarket).
+		Quantity("1").NewClientOrderID("myOrder1").NewOrderRespType(NewOrderRespTypeACK).Do(context.Background())
+	s.r().NoError(err)
+	s.Empty(res.Fills)
+	s.Equal([]string{"POST /api/v3/order", "GET /api/v3/order"}, s.requests)
+}
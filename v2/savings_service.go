@@ -73,6 +73,8 @@ func (s *ListSavingsFlexibleProductsService) Do(ctx context.Context, opts ...Req
 }
 
 // SavingsFlexibleProduct define a flexible product (Savings)
+//
+//decimalgen:accessors
 type SavingsFlexibleProduct struct {
 	Asset                    string `json:"asset"`
 	AvgAnnualInterestRate    string `json:"avgAnnualInterestRate"`
@@ -277,6 +279,8 @@ func (s *ListSavingsFixedAndActivityProductsService) Do(ctx context.Context, opt
 }
 
 // SavingsFixedProduct define a fixed product (Savings)
+//
+//decimalgen:accessors
 type SavingsFixedProduct struct {
 	Asset              string `json:"asset"`
 	DisplayPriority    int    `json:"displayPriority"`
@@ -332,6 +336,8 @@ func (s *SavingFlexibleProductPositionsService) Do(ctx context.Context, opts ...
 }
 
 // SavingFlexibleProductPosition represents a saving flexible product position.
+//
+//decimalgen:accessors
 type SavingFlexibleProductPosition struct {
 	Asset                 string `json:"asset"`
 	ProductId             string `json:"productId"`
@@ -405,6 +411,8 @@ func (s *SavingFixedProjectPositionsService) Do(ctx context.Context, opts ...Req
 }
 
 // SavingFixedProjectPosition represents a saving flexible product position.
+//
+//decimalgen:accessors
 type SavingFixedProjectPosition struct {
 	Asset           string `json:"asset"`
 	CanTransfer     bool   `json:"canTransfer"`
@@ -0,0 +1,111 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// ServerTimeWsService queries server time
+type ServerTimeWsService struct {
+	c websocket.Client
+}
+
+// NewServerTimeWsService init ServerTimeWsService
+func NewServerTimeWsService() (*ServerTimeWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServerTimeWsService{
+		c: client,
+	}, nil
+}
+
+// NewServerTimeWsServiceWithSession init ServerTimeWsService sending its requests on the connection of session
+func NewServerTimeWsServiceWithSession(session *SessionWsService) *ServerTimeWsService {
+	return &ServerTimeWsService{
+		c: session.c,
+	}
+}
+
+// Do - sends 'time' request
+func (s *ServerTimeWsService) Do(requestID string) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TimeSpotWsApiMethod, map[string]any{})
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'time' request and receives response
+func (s *ServerTimeWsService) SyncDo(requestID string) (*ServerTimeWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TimeSpotWsApiMethod, map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	serverTimeWsResponse := &ServerTimeWsResponse{}
+	if err := json.Unmarshal(response, serverTimeWsResponse); err != nil {
+		return nil, err
+	}
+	if serverTimeWsResponse.Error != nil {
+		serverTimeWsResponse.Error.StatusCode = serverTimeWsResponse.Status
+		return nil, serverTimeWsResponse.Error
+	}
+
+	return serverTimeWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *ServerTimeWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *ServerTimeWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *ServerTimeWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *ServerTimeWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// ServerTimeWsResponse define 'time' websocket API response
+type ServerTimeWsResponse struct {
+	Id     string     `json:"id"`
+	Status int        `json:"status"`
+	Result ServerTime `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// ServerTime define server time
+type ServerTime struct {
+	ServerTime int64 `json:"serverTime"`
+}
@@ -0,0 +1,84 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *serverTimeServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.serverTime = &ServerTimeWsService{
+		c: s.client,
+	}
+}
+
+func (s *serverTimeServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type serverTimeServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	serverTime *ServerTimeWsService
+}
+
+func TestServerTimeServiceWs(t *testing.T) {
+	suite.Run(t, new(serverTimeServiceWsTestSuite))
+}
+
+func (s *serverTimeServiceWsTestSuite) TestServerTime() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.serverTime.Do(s.requestID)
+	s.NoError(err)
+}
+
+func (s *serverTimeServiceWsTestSuite) TestServerTime_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.serverTime.Do("")
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *serverTimeServiceWsTestSuite) TestServerTimeSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":{"serverTime":1656400526260}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.serverTime.SyncDo(s.requestID)
+	s.Require().NoError(err)
+	s.Equal(int64(1656400526260), response.Result.ServerTime)
+}
+
+func (s *serverTimeServiceWsTestSuite) TestServerTimeSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.serverTime.SyncDo(s.requestID)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *serverTimeServiceWsTestSuite) TestServerTimeSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.serverTime.SyncDo(s.requestID)
+	s.Nil(response)
+	s.Error(err)
+}
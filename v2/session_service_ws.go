@@ -0,0 +1,136 @@
+package binance
+
+import (
+	"encoding/json"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// SessionWsService authenticates a websocket API connection with 'session.logon'. The services created with it share
+// its connection and send their requests without apiKey and signature while it is logged on, the logon is repeated
+// when the connection is restored
+type SessionWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
+	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	signers    common.SignerCache
+}
+
+// NewSessionWsService init SessionWsService, secretKey is an Ed25519 private key in PEM format
+func NewSessionWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*SessionWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SessionWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeEd25519,
+		TimeSync:  optionalTimeSync(timeSync),
+	}, nil
+}
+
+// Logon - sends 'session.logon' request and receives response
+func (s *SessionWsService) Logon(requestID string) (*SessionWsResponse, error) {
+	response, err := s.c.Logon(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithTimeSync(s.TimeSync),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.parse(response)
+}
+
+// Status - sends 'session.status' request and receives response
+func (s *SessionWsService) Status(requestID string) (*SessionWsResponse, error) {
+	if requestID == "" {
+		return nil, websocket.ErrorRequestIDNotSet
+	}
+
+	response, err := s.c.SessionStatus(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.parse(response)
+}
+
+// Logout - sends 'session.logout' request and receives response
+func (s *SessionWsService) Logout(requestID string) (*SessionWsResponse, error) {
+	if requestID == "" {
+		return nil, websocket.ErrorRequestIDNotSet
+	}
+
+	response, err := s.c.Logout(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.parse(response)
+}
+
+func (s *SessionWsService) parse(response []byte) (*SessionWsResponse, error) {
+	sessionWsResponse := &SessionWsResponse{}
+	if err := json.Unmarshal(response, sessionWsResponse); err != nil {
+		return nil, err
+	}
+	if sessionWsResponse.Error != nil {
+		sessionWsResponse.Error.StatusCode = sessionWsResponse.Status
+		s.TimeSync.CheckError(sessionWsResponse.Error)
+		return nil, sessionWsResponse.Error
+	}
+
+	return sessionWsResponse, nil
+}
+
+// IsLoggedOn reports whether the connection of the session is authenticated, it is false for a nil session
+func (s *SessionWsService) IsLoggedOn() bool {
+	if s == nil {
+		return false
+	}
+	return s.c.IsLoggedOn()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *SessionWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// SessionWsResponse define 'session.logon', 'session.status' and 'session.logout' websocket API response
+type SessionWsResponse struct {
+	Id     string        `json:"id"`
+	Status int           `json:"status"`
+	Result SessionStatus `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// SessionStatus define authentication status of a websocket API connection, APIKey is empty if it isn't logged on
+type SessionStatus struct {
+	APIKey           string `json:"apiKey"`
+	AuthorizedSince  int64  `json:"authorizedSince"`
+	ConnectedSince   int64  `json:"connectedSince"`
+	ReturnRateLimits bool   `json:"returnRateLimits"`
+	ServerTime       int64  `json:"serverTime"`
+	UserDataStream   bool   `json:"userDataStream"`
+}
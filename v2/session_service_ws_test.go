@@ -0,0 +1,140 @@
+package binance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *sessionServiceWsTestSuite) SetupTest() {
+	s.apiKey = "dummyApiKey"
+	s.secretKey = "dummySecretKey"
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.session = &SessionWsService{
+		c:         s.client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   common.KeyTypeEd25519,
+	}
+}
+
+func (s *sessionServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type sessionServiceWsTestSuite struct {
+	suite.Suite
+	apiKey    string
+	secretKey string
+	requestID string
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	session *SessionWsService
+}
+
+func TestSessionServiceWs(t *testing.T) {
+	suite.Run(t, new(sessionServiceWsTestSuite))
+}
+
+func (s *sessionServiceWsTestSuite) TestLogon() {
+	rawResponseData := []byte(`{"id":"e2a85d9f-07a5-4f94-8d5f-789dc3deb097","status":200,"result":{"apiKey":"dummyApiKey","authorizedSince":1649729878532,"connectedSince":1649729873021,"returnRateLimits":false,"serverTime":1649729878630,"userDataStream":false}}`)
+	s.client.EXPECT().Logon(websocket.NewRequestData(s.requestID, s.apiKey, s.secretKey, 0, common.KeyTypeEd25519).
+		WithSignerCache(&s.session.signers).WithTimeSync(nil)).
+		Return(rawResponseData, nil).Times(1)
+
+	response, err := s.session.Logon(s.requestID)
+	s.Require().NoError(err)
+	s.Equal(SessionStatus{
+		APIKey:          "dummyApiKey",
+		AuthorizedSince: 1649729878532,
+		ConnectedSince:  1649729873021,
+		ServerTime:      1649729878630,
+	}, response.Result)
+}
+
+func (s *sessionServiceWsTestSuite) TestLogon_Error() {
+	rawResponseData := []byte(`{"id":"e2a85d9f-07a5-4f94-8d5f-789dc3deb097","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`)
+	s.client.EXPECT().Logon(gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.session.Logon(s.requestID)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrTimestampOutsideRecvWindow))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *sessionServiceWsTestSuite) TestLogon_WriteError() {
+	s.client.EXPECT().Logon(gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.session.Logon(s.requestID)
+	s.Nil(response)
+	s.Error(err)
+}
+
+func (s *sessionServiceWsTestSuite) TestStatus() {
+	rawResponseData := []byte(`{"id":"e2a85d9f-07a5-4f94-8d5f-789dc3deb097","status":200,"result":{"apiKey":null,"authorizedSince":null,"connectedSince":1649729873021,"returnRateLimits":false,"serverTime":1649730611671,"userDataStream":false}}`)
+	s.client.EXPECT().SessionStatus(s.requestID).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.session.Status(s.requestID)
+	s.Require().NoError(err)
+	s.Empty(response.Result.APIKey)
+	s.Equal(int64(1649729873021), response.Result.ConnectedSince)
+}
+
+func (s *sessionServiceWsTestSuite) TestLogout() {
+	rawResponseData := []byte(`{"id":"e2a85d9f-07a5-4f94-8d5f-789dc3deb097","status":200,"result":{"apiKey":null,"authorizedSince":null,"connectedSince":1649729873021,"returnRateLimits":false,"serverTime":1649730611671,"userDataStream":false}}`)
+	s.client.EXPECT().Logout(s.requestID).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.session.Logout(s.requestID)
+	s.Require().NoError(err)
+	s.Equal(int64(1649730611671), response.Result.ServerTime)
+}
+
+func (s *sessionServiceWsTestSuite) TestStatus_EmptyRequestID() {
+	response, err := s.session.Status("")
+	s.Nil(response)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *sessionServiceWsTestSuite) TestServiceWithSession() {
+	service := NewOrderStatusWsServiceWithSession(s.session)
+	service.KeyType = common.KeyTypeHmac
+	request := NewOrderStatusWsRequest().Symbol("BTCUSDT").OrderID(1)
+
+	var params map[string]any
+	writeSync := func(id string, data []byte, _ any) ([]byte, error) {
+		req := websocket.WsApiRequest{}
+		s.Require().NoError(json.Unmarshal(data, &req))
+		params = req.Params
+		return []byte(`{"id":"` + id + `","status":200,"result":{"symbol":"BTCUSDT","orderId":1}}`), nil
+	}
+
+	// requests are signed until the session is logged on
+	s.client.EXPECT().IsLoggedOn().Return(false).Times(1)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).DoAndReturn(writeSync).Times(1)
+	_, err := service.SyncDo(s.requestID, request)
+	s.Require().NoError(err)
+	s.Contains(params, "apiKey")
+	s.Contains(params, "signature")
+
+	s.client.EXPECT().IsLoggedOn().Return(true).Times(1)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).DoAndReturn(writeSync).Times(1)
+	response, err := service.SyncDo(s.requestID, request)
+	s.Require().NoError(err)
+	s.Equal(int64(1), response.Result.OrderID)
+	s.NotContains(params, "apiKey")
+	s.NotContains(params, "signature")
+	s.Contains(params, "timestamp")
+}
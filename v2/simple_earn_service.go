@@ -94,6 +94,7 @@ type SimpleEarnGetAccountService struct {
 	c *Client
 }
 
+//decimalgen:accessors
 type SimpleEarnAccount struct {
 	TotalAmountInBTC          string `json:"totalAmountInBTC"`
 	TotalAmountInUSDT         string `json:"totalAmountInUSDT"`
@@ -147,6 +148,7 @@ type SimpleEarnFlexibleProductResp struct {
 	Total int                         `json:"total"`
 }
 
+//decimalgen:accessors
 type SimpleEarnFlexibleProduct struct {
 	Asset                      string            `json:"asset"`
 	LatestAnnualPercentageRate string            `json:"latestAnnualPercentageRate"`
@@ -300,6 +302,7 @@ type SimpleEarnFlexiblePositionResp struct {
 	Total int                          `json:"total"`
 }
 
+//decimalgen:accessors
 type SimpleEarnFlexiblePosition struct {
 	TotalAmount                    string            `json:"totalAmount"`
 	TierAnnualPercentageRate       map[string]string `json:"tierAnnualPercentageRate"`
@@ -388,6 +391,7 @@ type SimpleEarnLockedPositionResp struct {
 	Total int                        `json:"total"`
 }
 
+//decimalgen:accessors
 type SimpleEarnLockedPosition struct {
 	PositionId            int    `json:"positionId"`
 	ParentPositionId      int    `json:"parentPositionId"`
@@ -889,6 +893,7 @@ func (s *SimpleEarnFlexibleSubscriptionPreviewService) Amount(amount string) *Si
 	return s
 }
 
+//decimalgen:accessors
 type SimpleEarnFlexibleSubscriptionPreviewResp struct {
 	TotalAmount             string `json:"totalAmount"`
 	RewardAsset             string `json:"rewardAsset"`
@@ -946,6 +951,7 @@ func (s *SimpleEarnLockedSubscriptionPreviewService) AutoSubscribe(autoSubscribe
 	return s
 }
 
+//decimalgen:accessors
 type SimpleEarnLockedSubscriptionPreviewResp struct {
 	RewardAsset            string `json:"rewardAsset"`
 	TotalRewardAmt         string `json:"totalRewardAmt"`
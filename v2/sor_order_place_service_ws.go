@@ -14,11 +14,15 @@ type SorOrderPlaceWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer // if set, it signs the requests instead of SecretKey and KeyType
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
+	signers    common.SignerCache
 }
 
 // NewSorOrderPlaceWsService init SorOrderPlaceWsService
-func NewSorOrderPlaceWsService(apiKey, secretKey string) (*SorOrderPlaceWsService, error) {
+func NewSorOrderPlaceWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*SorOrderPlaceWsService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -34,9 +38,24 @@ func NewSorOrderPlaceWsService(apiKey, secretKey string) (*SorOrderPlaceWsServic
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewSorOrderPlaceWsServiceWithSession init SorOrderPlaceWsService sending its requests on the connection of session
+func NewSorOrderPlaceWsServiceWithSession(session *SessionWsService) *SorOrderPlaceWsService {
+	return &SorOrderPlaceWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		Signer:     session.Signer,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // SorOrderPlaceWsRequest parameters for 'sor.order.place' websocket API
 type SorOrderPlaceWsRequest struct {
 	symbol                  string
@@ -110,9 +129,9 @@ func (s *SorOrderPlaceWsService) Do(requestID string, request *SorOrderPlaceWsRe
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.SorOrderPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -134,9 +153,9 @@ func (s *SorOrderPlaceWsService) SyncDo(requestID string, request *SorOrderPlace
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(&s.signers).WithSession(s.session.IsLoggedOn()),
 		websocket.SorOrderPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -153,6 +172,11 @@ func (s *SorOrderPlaceWsService) SyncDo(requestID string, request *SorOrderPlace
 	if err := json.Unmarshal(response, sorOrderPlaceWsResponse); err != nil {
 		return nil, err
 	}
+	if sorOrderPlaceWsResponse.Error != nil {
+		sorOrderPlaceWsResponse.Error.StatusCode = sorOrderPlaceWsResponse.Status
+		s.TimeSync.CheckError(sorOrderPlaceWsResponse.Error)
+		return nil, sorOrderPlaceWsResponse.Error
+	}
 
 	return sorOrderPlaceWsResponse, nil
 }
@@ -256,6 +280,8 @@ func (s *SorOrderPlaceWsRequest) RecvWindow(recvWindow uint16) *SorOrderPlaceWsR
 }
 
 // SorOrderPlaceResult define SOR order placement result
+//
+//decimalgen:accessors
 type SorOrderPlaceResult struct {
 	Symbol              string          `json:"symbol"`
 	OrderId             int64           `json:"orderId"`
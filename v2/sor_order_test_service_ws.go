@@ -15,10 +15,12 @@ type SorOrderTestWsService struct {
 	SecretKey  string
 	KeyType    string
 	TimeOffset int64
+	TimeSync   *common.TimeSync // if set, its offset is used instead of TimeOffset
+	session    *SessionWsService
 }
 
 // NewSorOrderTestWsService init SorOrderTestWsService
-func NewSorOrderTestWsService(apiKey, secretKey string) (*SorOrderTestWsService, error) {
+func NewSorOrderTestWsService(apiKey, secretKey string, timeSync ...*common.TimeSync) (*SorOrderTestWsService, error) {
 	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
 	if err != nil {
 		return nil, err
@@ -34,9 +36,23 @@ func NewSorOrderTestWsService(apiKey, secretKey string) (*SorOrderTestWsService,
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		TimeSync:  optionalTimeSync(timeSync),
 	}, nil
 }
 
+// NewSorOrderTestWsServiceWithSession init SorOrderTestWsService sending its requests on the connection of session
+func NewSorOrderTestWsServiceWithSession(session *SessionWsService) *SorOrderTestWsService {
+	return &SorOrderTestWsService{
+		c:          session.c,
+		ApiKey:     session.ApiKey,
+		SecretKey:  session.SecretKey,
+		KeyType:    session.KeyType,
+		TimeOffset: session.TimeOffset,
+		TimeSync:   session.TimeSync,
+		session:    session,
+	}
+}
+
 // SorOrderTestWsRequest parameters for 'sor.order.test' websocket API
 type SorOrderTestWsRequest struct {
 	symbol                  string
@@ -108,9 +124,9 @@ func (s *SorOrderTestWsService) Do(requestID string, request *SorOrderTestWsRequ
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSession(s.session.IsLoggedOn()),
 		websocket.SorOrderTestSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -132,9 +148,9 @@ func (s *SorOrderTestWsService) SyncDo(requestID string, request *SorOrderTestWs
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.TimeSync.OffsetOr(s.TimeOffset),
 			s.KeyType,
-		),
+		).WithSession(s.session.IsLoggedOn()),
 		websocket.SorOrderTestSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -151,6 +167,11 @@ func (s *SorOrderTestWsService) SyncDo(requestID string, request *SorOrderTestWs
 	if err := json.Unmarshal(response, sorOrderTestWsResponse); err != nil {
 		return nil, err
 	}
+	if sorOrderTestWsResponse.Error != nil {
+		sorOrderTestWsResponse.Error.StatusCode = sorOrderTestWsResponse.Status
+		s.TimeSync.CheckError(sorOrderTestWsResponse.Error)
+		return nil, sorOrderTestWsResponse.Error
+	}
 
 	return sorOrderTestWsResponse, nil
 }
@@ -82,6 +82,8 @@ func (s *StakingProductPositionService) Do(ctx context.Context) (*StakingProduct
 type StakingProductPositions []StakingProductPosition
 
 // StakingProductPosition represents a staking product position.
+//
+//decimalgen:accessors
 type StakingProductPosition struct {
 	PositionId                 int64  `json:"positionId"`
 	ProductId                  string `json:"productId"`
@@ -205,6 +207,8 @@ func (s *StakingHistoryService) Do(ctx context.Context) (*StakingHistory, error)
 type StakingHistory []StakingHistoryTransaction
 
 // StakingHistoryTransaction represents a staking history transaction.
+//
+//decimalgen:accessors
 type StakingHistoryTransaction struct {
 	PositionId  int64  `json:"positionId"`
 	Time        int64  `json:"time"`
@@ -0,0 +1,177 @@
+// Code generated by streamgen; DO NOT EDIT.
+
+package binance
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// WsAggTradeStream is WsAggTradeServe stopped when ctx is done, see ServeStream
+func WsAggTradeStream(ctx context.Context, symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAggTradeServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllBookTickerStream is WsAllBookTickerServe stopped when ctx is done, see ServeStream
+func WsAllBookTickerStream(ctx context.Context, handler WsBookTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllBookTickerServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllMarketsStatStream is WsAllMarketsStatServe stopped when ctx is done, see ServeStream
+func WsAllMarketsStatStream(ctx context.Context, handler WsAllMarketsStatHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllMarketsStatServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAllMiniMarketsStatStream is WsAllMiniMarketsStatServe stopped when ctx is done, see ServeStream
+func WsAllMiniMarketsStatStream(ctx context.Context, handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAllMiniMarketsStatServe(handler, errHandler)
+	}, errHandler)
+}
+
+// WsAnnouncementStream is WsAnnouncementServe stopped when ctx is done, see ServeStream
+func WsAnnouncementStream(ctx context.Context, params WsAnnouncementParam, handler WsAnnouncementHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsAnnouncementServe(params, handler, errHandler)
+	}, errHandler)
+}
+
+// WsBookTickerStream is WsBookTickerServe stopped when ctx is done, see ServeStream
+func WsBookTickerStream(ctx context.Context, symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsBookTickerServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedAggTradeStream is WsCombinedAggTradeServe stopped when ctx is done, see ServeStream
+func WsCombinedAggTradeStream(ctx context.Context, symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedAggTradeServe(symbols, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedBookTickerStream is WsCombinedBookTickerServe stopped when ctx is done, see ServeStream
+func WsCombinedBookTickerStream(ctx context.Context, symbols []string, handler WsBookTickerHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedBookTickerServe(symbols, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedDepthStream is WsCombinedDepthServe stopped when ctx is done, see ServeStream
+func WsCombinedDepthStream(ctx context.Context, symbols []string, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedDepthServe(symbols, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedDepthStream100Ms is WsCombinedDepthServe100Ms stopped when ctx is done, see ServeStream
+func WsCombinedDepthStream100Ms(ctx context.Context, symbols []string, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedDepthServe100Ms(symbols, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedKlineStream is WsCombinedKlineServe stopped when ctx is done, see ServeStream
+func WsCombinedKlineStream(ctx context.Context, symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedKlineServe(symbolIntervalPair, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedKlineStreamMultiInterval is WsCombinedKlineServeMultiInterval stopped when ctx is done, see ServeStream
+func WsCombinedKlineStreamMultiInterval(ctx context.Context, symbolIntervals map[string][]string, handler WsKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedKlineServeMultiInterval(symbolIntervals, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedMarketStatStream is WsCombinedMarketStatServe stopped when ctx is done, see ServeStream
+func WsCombinedMarketStatStream(ctx context.Context, symbols []string, handler WsMarketStatHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedMarketStatServe(symbols, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedPartialDepthStream is WsCombinedPartialDepthServe stopped when ctx is done, see ServeStream
+func WsCombinedPartialDepthStream(ctx context.Context, symbolLevels map[string]string, handler WsPartialDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedPartialDepthServe(symbolLevels, handler, errHandler)
+	}, errHandler)
+}
+
+// WsCombinedTradeStream is WsCombinedTradeServe stopped when ctx is done, see ServeStream
+func WsCombinedTradeStream(ctx context.Context, symbols []string, handler WsCombinedTradeHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedTradeServe(symbols, handler, errHandler)
+	}, errHandler)
+}
+
+// WsDepthStream is WsDepthServe stopped when ctx is done, see ServeStream
+func WsDepthStream(ctx context.Context, symbol string, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsDepthServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsDepthStream100Ms is WsDepthServe100Ms stopped when ctx is done, see ServeStream
+func WsDepthStream100Ms(ctx context.Context, symbol string, handler WsDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsDepthServe100Ms(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsKlineStream is WsKlineServe stopped when ctx is done, see ServeStream
+func WsKlineStream(ctx context.Context, symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsKlineServe(symbol, interval, handler, errHandler)
+	}, errHandler)
+}
+
+// WsMarketStatStream is WsMarketStatServe stopped when ctx is done, see ServeStream
+func WsMarketStatStream(ctx context.Context, symbol string, handler WsMarketStatHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsMarketStatServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsPartialDepthStream is WsPartialDepthServe stopped when ctx is done, see ServeStream
+func WsPartialDepthStream(ctx context.Context, symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsPartialDepthServe(symbol, levels, handler, errHandler)
+	}, errHandler)
+}
+
+// WsPartialDepthStream100Ms is WsPartialDepthServe100Ms stopped when ctx is done, see ServeStream
+func WsPartialDepthStream100Ms(ctx context.Context, symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsPartialDepthServe100Ms(symbol, levels, handler, errHandler)
+	}, errHandler)
+}
+
+// WsTradeStream is WsTradeServe stopped when ctx is done, see ServeStream
+func WsTradeStream(ctx context.Context, symbol string, handler WsTradeHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsTradeServe(symbol, handler, errHandler)
+	}, errHandler)
+}
+
+// WsUserDataStream is WsUserDataServe stopped when ctx is done, see ServeStream
+func WsUserDataStream(ctx context.Context, listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, handler, errHandler)
+	}, errHandler)
+}
+
+// WsUserDataStreamSignature is WsUserDataServeSignature stopped when ctx is done, see ServeStream
+func WsUserDataStreamSignature(ctx context.Context, apiKey string, secretKey string, keyType string, timeOffset int64, handler WsUserDataHandler, errHandler ErrHandler, timeSync ...*common.TimeSync) (*common.Stream, error) {
+	return ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServeSignature(apiKey, secretKey, keyType, timeOffset, handler, errHandler, timeSync...)
+	}, errHandler)
+}
@@ -471,6 +471,7 @@ func (s *ManagedSubAccountAssetsService) Email(email string) *ManagedSubAccountA
 	return s
 }
 
+//decimalgen:accessors
 type ManagedSubAccountAsset struct {
 	Coin             string `json:"coin"`
 	Name             string `json:"name"`
@@ -535,6 +536,7 @@ func (s *SubAccountFuturesAccountService) Do(ctx context.Context, opts ...Reques
 	return res, nil
 }
 
+//decimalgen:accessors
 type SubAccountFuturesAccount struct {
 	Email                       string                          `json:"email"`
 	Asset                       string                          `json:"asset"`
@@ -554,6 +556,7 @@ type SubAccountFuturesAccount struct {
 	UpdateTime                  int64                           `json:"updateTime"`
 }
 
+//decimalgen:accessors
 type SubAccountFuturesAccountAsset struct {
 	Asset                  string `json:"asset"`
 	InitialMargin          string `json:"initialMargin"`
@@ -591,6 +594,7 @@ func (s *SubAccountFuturesSummaryV1Service) Do(ctx context.Context, opts ...Requ
 	return res, nil
 }
 
+//decimalgen:accessors
 type SubAccountFuturesSummaryCommon struct {
 	Asset                       string `json:"asset"`
 	TotalInitialMargin          string `json:"totalInitialMargin"`
@@ -756,6 +760,7 @@ func (s *SubAccountTransferHistoryService) Do(ctx context.Context, opts ...Reque
 	return res, nil
 }
 
+//decimalgen:accessors
 type SubAccountTransferHistory struct {
 	CounterParty    string                 `json:"counterParty"`
 	Email           string                 `json:"email"`
@@ -826,6 +831,7 @@ type SubAccountSpotTransferHistoryService struct {
 	recvWindow *int64
 }
 
+//decimalgen:accessors
 type SubAccountSpotTransfer struct {
 	From   string `json:"from"`
 	To     string `json:"to"`
@@ -930,6 +936,7 @@ type SubAccountFuturesTransferHistoryResponse struct {
 	Transfers   []*SubAccountFuturesTransfer `json:"transfers"`
 }
 
+//decimalgen:accessors
 type SubAccountFuturesTransfer struct {
 	From   string `json:"from"`
 	To     string `json:"to"`
@@ -1100,6 +1107,7 @@ type SubAccountDepositRecordService struct {
 	recvWindow *int64
 }
 
+//decimalgen:accessors
 type SubAccountDepositRecord struct {
 	Id            string `json:"id"`
 	Amount        string `json:"amount"`
@@ -1312,6 +1320,7 @@ type SubAccountMarginAccountInfoService struct {
 	recvWindow *int64
 }
 
+//decimalgen:accessors
 type SubAccountMarginAccountInfo struct {
 	Email                 string               `json:"email"`
 	MarginLevel           string               `json:"marginLevel"`
@@ -1322,12 +1331,14 @@ type SubAccountMarginAccountInfo struct {
 	MarginUserAssetVoList []*MarginUserAssetVo `json:"marginUserAssetVoList"`
 }
 
+//decimalgen:accessors
 type MarginTradeCoeffVo struct {
 	ForceLiquidationBar string `json:"forceLiquidationBar"`
 	MarginCallBar       string `json:"marginCallBar"`
 	NormalBar           string `json:"normalBar"`
 }
 
+//decimalgen:accessors
 type MarginUserAssetVo struct {
 	Asset    string `json:"asset"`
 	Borrowed string `json:"borrowed"`
@@ -1377,6 +1388,7 @@ type SubAccountMarginAccountSummaryService struct {
 	recvWindow *int64
 }
 
+//decimalgen:accessors
 type SubAccountMarginAccountSummary struct {
 	TotalAssetOfBtc     string              `json:"totalAssetOfBtc"`
 	TotalLiabilityOfBtc string              `json:"totalLiabilityOfBtc"`
@@ -1384,6 +1396,7 @@ type SubAccountMarginAccountSummary struct {
 	SubAccountList      []*MarginSubAccount `json:"subAccountList"`
 }
 
+//decimalgen:accessors
 type MarginSubAccount struct {
 	Email               string `json:"email"`
 	TotalAssetOfBtc     string `json:"totalAssetOfBtc"`
@@ -1477,6 +1490,7 @@ type SubAccountFuturesAccountV2ServiceResponse struct {
 	DeliveryAccountResp *SubAccountDeliveryAccountV2 `json:"deliveryAccountResp"` // set while futuresType=2(COIN margined)
 }
 
+//decimalgen:accessors
 type SubAccountFuturesAccountV2 struct {
 	Email                       string          `json:"email"`
 	Asset                       string          `json:"asset"`
@@ -1506,6 +1520,7 @@ type SubAccountDeliveryAccountV2 struct {
 	UpdateTime  int64           `json:"updateTime"`
 }
 
+//decimalgen:accessors
 type FuturesAsset struct {
 	Asset                  string `json:"asset"`
 	InitialMargin          string `json:"initialMargin"`
@@ -1572,6 +1587,7 @@ type SubAccountFuturesAccountSummaryServiceResponse struct {
 	DeliveryAccountSummaryResp *SubAccountDeliveryAccountSummary `json:"deliveryAccountSummaryResp"` // set while futuresType=2
 }
 
+//decimalgen:accessors
 type SubAccountFuturesAccountSummary struct {
 	TotalInitialMargin          string               `json:"totalInitialMargin"`
 	TotalMaintenanceMargin      string               `json:"totalMaintenanceMargin"`
@@ -1584,6 +1600,7 @@ type SubAccountFuturesAccountSummary struct {
 	SubAccountList              []*FuturesSubAccount `json:"subAccountList"`
 }
 
+//decimalgen:accessors
 type FuturesSubAccount struct {
 	Email                       string `json:"email"`
 	TotalInitialMargin          string `json:"totalInitialMargin"`
@@ -1596,6 +1613,7 @@ type FuturesSubAccount struct {
 	Asset                       string `json:"asset"`
 }
 
+//decimalgen:accessors
 type SubAccountDeliveryAccountSummary struct {
 	TotalMarginBalanceOfBTC    string                `json:"totalMarginBalanceOfBTC"`
 	TotalUnrealizedProfitOfBTC string                `json:"totalUnrealizedProfitOfBTC"`
@@ -1604,6 +1622,7 @@ type SubAccountDeliveryAccountSummary struct {
 	SubAccountList             []*DeliverySubAccount `json:"subAccountList"`
 }
 
+//decimalgen:accessors
 type DeliverySubAccount struct {
 	Email                 string `json:"email"`
 	TotalMarginBalance    string `json:"totalMarginBalance"`
@@ -1675,6 +1694,7 @@ type SubAccountFuturesPositionsServiceResponse struct {
 	DeliveryPositionRiskVos []*SubAccountDeliveryPosition `json:"deliveryPositionRiskVos"` // set while futuresType=2
 }
 
+//decimalgen:accessors
 type SubAccountFuturesPosition struct {
 	EntryPrice       string `json:"entryPrice"`
 	Leverage         string `json:"leverage"`
@@ -1686,6 +1706,7 @@ type SubAccountFuturesPosition struct {
 	UnrealizedProfit string `json:"unrealizedProfit"`
 }
 
+//decimalgen:accessors
 type SubAccountDeliveryPosition struct {
 	EntryPrice       string `json:"entryPrice"`
 	MarkPrice        string `json:"markPrice"`
@@ -1979,6 +2000,7 @@ type SubAccountUniversalTransferHistoryServiceResponse struct {
 	TotalCount int64                                `json:"totalCount"`
 }
 
+//decimalgen:accessors
 type SubAccountUniversalTransferRecord struct {
 	TranId          int64  `json:"tranId"`
 	FromEmail       string `json:"fromEmail"`
@@ -2409,6 +2431,7 @@ type SnapshotVo struct {
 	UpdateTime int64          `json:"updateTime"`
 }
 
+//decimalgen:accessors
 type SnapshotVoData struct {
 	Balances            []*SnapShotSpotBalance `json:"balances"`            // set while SnapshotVo.type=spot
 	TotalAssetOfBtc     string                 `json:"totalAssetOfBtc"`     // set while SnapshotVo.type is one of spot and margin
@@ -2420,12 +2443,14 @@ type SnapshotVoData struct {
 	Position            []*FuturesUserPosition `json:"position"`            // set while SnapshotVo.type=futures
 }
 
+//decimalgen:accessors
 type SnapShotSpotBalance struct {
 	Asset  string `json:"asset"`
 	Free   string `json:"free"`
 	Locked string `json:"locked"`
 }
 
+//decimalgen:accessors
 type MarginUserAsset struct {
 	Asset    string `json:"asset"`
 	Borrowed string `json:"borrowed"`
@@ -2435,12 +2460,14 @@ type MarginUserAsset struct {
 	NetAsset string `json:"netAsset"`
 }
 
+//decimalgen:accessors
 type FuturesUserAsset struct {
 	Asset         string `json:"asset"`
 	MarginBalance string `json:"marginBalance"`
 	WalletBalance string `json:"walletBalance"`
 }
 
+//decimalgen:accessors
 type FuturesUserPosition struct {
 	EntryPrice       string `json:"entryPrice"`
 	MarkPrice        string `json:"markPrice"`
@@ -2530,6 +2557,7 @@ type ManagedSubAccountQueryTransferLogForInvestorServiceResponse struct {
 	Count                        int32                          `json:"count"`
 }
 
+//decimalgen:accessors
 type ManagedSubTransferHistoryVo struct {
 	FromEmail       string `json:"fromEmail"`
 	FromAccountType string `json:"fromAccountType"`
@@ -2714,12 +2742,14 @@ type ManagedSubFuturesAccountSnapVoData struct {
 	Position []*ManagedSubFuturesAccountSnapVoDataPosition `json:"position"`
 }
 
+//decimalgen:accessors
 type ManagedSubFuturesAccountSnapVoDataAsset struct {
 	Asset         string `json:"asset"`
 	MarginBalance string `json:"marginBalance"`
 	WalletBalance string `json:"walletBalance"`
 }
 
+//decimalgen:accessors
 type ManagedSubFuturesAccountSnapVoDataPosition struct {
 	Symbol      string `json:"symbol"`
 	EntryPrice  string `json:"entryPrice"`
@@ -2759,6 +2789,7 @@ type ManagedSubAccountQueryMarginAssetService struct {
 	email string
 }
 
+//decimalgen:accessors
 type ManagedSubAccountQueryMarginAssetServiceResponse struct {
 	MarginLevel         string                          `json:"marginLevel"`
 	TotalAssetOfBtc     string                          `json:"totalAssetOfBtc"`
@@ -2767,6 +2798,7 @@ type ManagedSubAccountQueryMarginAssetServiceResponse struct {
 	UserAssets          []*ManagedSubAccountMarginAsset `json:"userAssets"`
 }
 
+//decimalgen:accessors
 type ManagedSubAccountMarginAsset struct {
 	Asset    string `json:"asset"`
 	Borrowed string `json:"borrowed"`
@@ -2813,6 +2845,7 @@ type SubAccountAssetServiceResponse struct {
 	Balances []*SubAccountAssetBalance `json:"balances"`
 }
 
+//decimalgen:accessors
 type SubAccountAssetBalance struct {
 	Asset  string `json:"asset"`
 	Free   string `json:"free"`
@@ -2938,6 +2971,7 @@ type SubAccountTransactionStatisticsService struct {
 	recvWindow *int64
 }
 
+//decimalgen:accessors
 type SubAccountTransactionStatisticServiceResponse struct {
 	Recent30BtcTotal         string         `json:"recent30BtcTotal"`
 	Recent30BtcFuturesTotal  string         `json:"recent30BtcFuturesTotal"`
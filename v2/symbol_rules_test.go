@@ -0,0 +1,76 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type symbolRulesTestSuite struct {
+	baseTestSuite
+	orders []*http.Request
+}
+
+func TestSymbolRules(t *testing.T) {
+	suite.Run(t, new(symbolRulesTestSuite))
+}
+
+func (s *symbolRulesTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.orders = nil
+	s.client.SymbolRules = s.client.NewSymbolRulesRegistry()
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/api/v3/exchangeInfo" {
+			return newHTTPResponse([]byte(`{"symbols":[{"symbol":"BNBBTC","status":"TRADING","filters":[
+				{"filterType":"PRICE_FILTER","minPrice":"0.00000100","maxPrice":"100000.00000000","tickSize":"0.00000100"},
+				{"filterType":"LOT_SIZE","minQty":"0.00100000","maxQty":"100000.00000000","stepSize":"0.00100000"},
+				{"filterType":"MARKET_LOT_SIZE","minQty":"0.00000000","maxQty":"100.00000000","stepSize":"0.00000000"},
+				{"filterType":"NOTIONAL","minNotional":"0.00010000","applyMinToMarket":true,"maxNotional":"9000000.00000000","applyMaxToMarket":false,"avgPriceMins":5}
+			]}]}`), http.StatusOK), nil
+		}
+		s.r().NoError(req.ParseForm())
+		s.orders = append(s.orders, req)
+		return newHTTPResponse([]byte(`{"symbol":"BNBBTC","orderId":1}`), http.StatusOK), nil
+	}
+}
+
+func (s *symbolRulesTestSuite) TestRules() {
+	rules, err := s.client.SymbolRules.Rules(context.Background(), "BNBBTC")
+	s.r().NoError(err)
+	s.Equal("TRADING", rules.Status)
+	s.Equal("0.000001", rules.TickSize.String())
+	s.Equal("0.001", rules.StepSize.String())
+	s.Equal("100", rules.MarketMaxQty.String())
+	s.True(rules.MarketStepSize.IsZero())
+	s.Equal("0.0001", rules.MinNotional.String())
+	s.True(rules.ApplyMinToMarket)
+}
+
+func (s *symbolRulesTestSuite) TestCreateOrderValidate() {
+	_, err := s.client.NewCreateOrderService().Symbol("BNBBTC").Side(SideTypeBuy).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Price("0.0012345").Quantity("0.0015").Do(context.Background())
+	s.r().ErrorIs(err, common.ErrFilterFailure)
+	var filterErr *common.FilterError
+	s.r().True(errors.As(err, &filterErr))
+	s.Len(filterErr.Violations, 3)
+	s.Empty(s.orders)
+
+	// unknown symbols are left to the exchange
+	_, err = s.client.NewCreateOrderService().Symbol("ETHBTC").Side(SideTypeBuy).Type(OrderTypeMarket).
+		Quantity("0.0015").Do(context.Background())
+	s.r().NoError(err)
+	s.Len(s.orders, 1)
+}
+
+func (s *symbolRulesTestSuite) TestCreateOrderRoundToFilters() {
+	_, err := s.client.NewCreateOrderService().Symbol("BNBBTC").Side(SideTypeBuy).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Price("0.0012345").Quantity("1.0015").RoundToFilters(true).Do(context.Background())
+	s.r().NoError(err)
+	s.r().Len(s.orders, 1)
+	s.Equal("0.001234", s.orders[0].Form.Get("price"))
+	s.Equal("1.001", s.orders[0].Form.Get("quantity"))
+}
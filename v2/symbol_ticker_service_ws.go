@@ -0,0 +1,178 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// SymbolTickerWsService queries rolling window price change statistics
+type SymbolTickerWsService struct {
+	c websocket.Client
+}
+
+// NewSymbolTickerWsService init SymbolTickerWsService
+func NewSymbolTickerWsService() (*SymbolTickerWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SymbolTickerWsService{
+		c: client,
+	}, nil
+}
+
+// NewSymbolTickerWsServiceWithSession init SymbolTickerWsService sending its requests on the connection of session
+func NewSymbolTickerWsServiceWithSession(session *SessionWsService) *SymbolTickerWsService {
+	return &SymbolTickerWsService{
+		c: session.c,
+	}
+}
+
+// SymbolTickerWsRequest parameters for 'ticker' websocket API
+type SymbolTickerWsRequest struct {
+	symbol     *string
+	symbols    []string
+	windowSize *string
+	tickerType *string
+}
+
+// NewSymbolTickerWsRequest init SymbolTickerWsRequest
+func NewSymbolTickerWsRequest() *SymbolTickerWsRequest {
+	return &SymbolTickerWsRequest{}
+}
+
+func (s *SymbolTickerWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *SymbolTickerWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if len(s.symbols) != 0 {
+		m["symbols"] = s.symbols
+	}
+	if s.windowSize != nil {
+		m["windowSize"] = *s.windowSize
+	}
+	if s.tickerType != nil {
+		m["type"] = *s.tickerType
+	}
+	return m
+}
+
+// Do - sends 'ticker' request
+func (s *SymbolTickerWsService) Do(requestID string, request *SymbolTickerWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TickerSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'ticker' request and receives response
+func (s *SymbolTickerWsService) SyncDo(requestID string, request *SymbolTickerWsRequest) (*SymbolTickerWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TickerSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	symbolTickerWsResponse := &SymbolTickerWsResponse{}
+	if err := json.Unmarshal(response, symbolTickerWsResponse); err != nil {
+		return nil, err
+	}
+	if symbolTickerWsResponse.Error != nil {
+		symbolTickerWsResponse.Error.StatusCode = symbolTickerWsResponse.Status
+		return nil, symbolTickerWsResponse.Error
+	}
+
+	return symbolTickerWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *SymbolTickerWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *SymbolTickerWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *SymbolTickerWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *SymbolTickerWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *SymbolTickerWsRequest) Symbol(symbol string) *SymbolTickerWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols
+func (s *SymbolTickerWsRequest) Symbols(symbols []string) *SymbolTickerWsRequest {
+	s.symbols = symbols
+	return s
+}
+
+// WindowSize set windowSize
+func (s *SymbolTickerWsRequest) WindowSize(windowSize string) *SymbolTickerWsRequest {
+	s.windowSize = &windowSize
+	return s
+}
+
+// Type set type
+func (s *SymbolTickerWsRequest) Type(tickerType string) *SymbolTickerWsRequest {
+	s.tickerType = &tickerType
+	return s
+}
+
+// SymbolTickerWsResponse define 'ticker' websocket API response
+type SymbolTickerWsResponse struct {
+	Id     string          `json:"id"`
+	Status int             `json:"status"`
+	Result []*SymbolTicker `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON accepts the result of a single symbol as well as a list
+func (r *SymbolTickerWsResponse) UnmarshalJSON(data []byte) error {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if len(raw.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(common.ToJSONList(raw.Result), &r.Result)
+}
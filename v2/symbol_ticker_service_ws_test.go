@@ -0,0 +1,91 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *symbolTickerServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.symbolTicker = &SymbolTickerWsService{
+		c: s.client,
+	}
+
+	s.symbolTickerRequest = NewSymbolTickerWsRequest().
+		Symbol("BNBBTC").
+		WindowSize("7d")
+}
+
+func (s *symbolTickerServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type symbolTickerServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	symbolTicker        *SymbolTickerWsService
+	symbolTickerRequest *SymbolTickerWsRequest
+}
+
+func TestSymbolTickerServiceWs(t *testing.T) {
+	suite.Run(t, new(symbolTickerServiceWsTestSuite))
+}
+
+func (s *symbolTickerServiceWsTestSuite) TestSymbolTicker() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.symbolTicker.Do(s.requestID, s.symbolTickerRequest)
+	s.NoError(err)
+}
+
+func (s *symbolTickerServiceWsTestSuite) TestSymbolTicker_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.symbolTicker.Do("", s.symbolTickerRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *symbolTickerServiceWsTestSuite) TestSymbolTickerSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":{"symbol":"BNBBTC","priceChange":"0.00061500","priceChangePercent":"4.735","weightedAvgPrice":"0.01368242","openPrice":"0.01298900","highPrice":"0.01418800","lowPrice":"0.01296000","lastPrice":"0.01360400","volume":"587179.23900000","quoteVolume":"8034.03382165","openTime":1659580020000,"closeTime":1660184865291,"firstId":192977765,"lastId":195365758,"count":2387994}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.symbolTicker.SyncDo(s.requestID, s.symbolTickerRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal("0.01360400", response.Result[0].LastPrice)
+	s.Equal(int64(2387994), response.Result[0].Count)
+}
+
+func (s *symbolTickerServiceWsTestSuite) TestSymbolTickerSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.symbolTicker.SyncDo(s.requestID, s.symbolTickerRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *symbolTickerServiceWsTestSuite) TestSymbolTickerSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.symbolTicker.SyncDo(s.requestID, s.symbolTickerRequest)
+	s.Nil(response)
+	s.Error(err)
+}
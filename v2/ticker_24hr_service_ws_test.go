@@ -0,0 +1,91 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *ticker24hrServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.ticker24hr = &Ticker24hrWsService{
+		c: s.client,
+	}
+
+	s.ticker24hrRequest = NewTicker24hrWsRequest().
+		Symbol("BNBBTC")
+}
+
+func (s *ticker24hrServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type ticker24hrServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	ticker24hr        *Ticker24hrWsService
+	ticker24hrRequest *Ticker24hrWsRequest
+}
+
+func TestTicker24hrServiceWs(t *testing.T) {
+	suite.Run(t, new(ticker24hrServiceWsTestSuite))
+}
+
+func (s *ticker24hrServiceWsTestSuite) TestTicker24hr() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.ticker24hr.Do(s.requestID, s.ticker24hrRequest)
+	s.NoError(err)
+}
+
+func (s *ticker24hrServiceWsTestSuite) TestTicker24hr_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.ticker24hr.Do("", s.ticker24hrRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *ticker24hrServiceWsTestSuite) TestTicker24hrSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":{"symbol":"BNBBTC","priceChange":"0.00013900","priceChangePercent":"1.020","weightedAvgPrice":"0.01382453","prevClosePrice":"0.01362800","lastPrice":"0.01376700","lastQty":"1.78800000","bidPrice":"0.01376700","bidQty":"4.64600000","askPrice":"0.01376800","askQty":"14.31400000","openPrice":"0.01362800","highPrice":"0.01414900","lowPrice":"0.01346600","volume":"69412.40500000","quoteVolume":"959.59411487","openTime":1660014164909,"closeTime":1660100564909,"firstId":194696115,"lastId":194968287,"count":272173}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.ticker24hr.SyncDo(s.requestID, s.ticker24hrRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal("BNBBTC", response.Result[0].Symbol)
+	s.Equal("1.020", response.Result[0].PriceChangePercent)
+	s.Equal(int64(272173), response.Result[0].Count)
+}
+
+func (s *ticker24hrServiceWsTestSuite) TestTicker24hrSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.ticker24hr.SyncDo(s.requestID, s.ticker24hrRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *ticker24hrServiceWsTestSuite) TestTicker24hrSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.ticker24hr.SyncDo(s.requestID, s.ticker24hrRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -0,0 +1,158 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// BookTickerWsService queries best price and quantity on the order book
+type BookTickerWsService struct {
+	c websocket.Client
+}
+
+// NewBookTickerWsService init BookTickerWsService
+func NewBookTickerWsService() (*BookTickerWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BookTickerWsService{
+		c: client,
+	}, nil
+}
+
+// NewBookTickerWsServiceWithSession init BookTickerWsService sending its requests on the connection of session
+func NewBookTickerWsServiceWithSession(session *SessionWsService) *BookTickerWsService {
+	return &BookTickerWsService{
+		c: session.c,
+	}
+}
+
+// BookTickerWsRequest parameters for 'ticker.book' websocket API
+type BookTickerWsRequest struct {
+	symbol  *string
+	symbols []string
+}
+
+// NewBookTickerWsRequest init BookTickerWsRequest
+func NewBookTickerWsRequest() *BookTickerWsRequest {
+	return &BookTickerWsRequest{}
+}
+
+func (s *BookTickerWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *BookTickerWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if len(s.symbols) != 0 {
+		m["symbols"] = s.symbols
+	}
+	return m
+}
+
+// Do - sends 'ticker.book' request
+func (s *BookTickerWsService) Do(requestID string, request *BookTickerWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TickerBookSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'ticker.book' request and receives response
+func (s *BookTickerWsService) SyncDo(requestID string, request *BookTickerWsRequest) (*BookTickerWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TickerBookSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	bookTickerWsResponse := &BookTickerWsResponse{}
+	if err := json.Unmarshal(response, bookTickerWsResponse); err != nil {
+		return nil, err
+	}
+	if bookTickerWsResponse.Error != nil {
+		bookTickerWsResponse.Error.StatusCode = bookTickerWsResponse.Status
+		return nil, bookTickerWsResponse.Error
+	}
+
+	return bookTickerWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *BookTickerWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *BookTickerWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *BookTickerWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *BookTickerWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *BookTickerWsRequest) Symbol(symbol string) *BookTickerWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols
+func (s *BookTickerWsRequest) Symbols(symbols []string) *BookTickerWsRequest {
+	s.symbols = symbols
+	return s
+}
+
+// BookTickerWsResponse define 'ticker.book' websocket API response
+type BookTickerWsResponse struct {
+	Id     string        `json:"id"`
+	Status int           `json:"status"`
+	Result []*BookTicker `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON accepts the result of a single symbol as well as a list
+func (r *BookTickerWsResponse) UnmarshalJSON(data []byte) error {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if len(raw.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(common.ToJSONList(raw.Result), &r.Result)
+}
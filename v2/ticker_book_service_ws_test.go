@@ -0,0 +1,95 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *bookTickerServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.bookTicker = &BookTickerWsService{
+		c: s.client,
+	}
+
+	s.bookTickerRequest = NewBookTickerWsRequest().
+		Symbols([]string{"BNBBTC", "BTCUSDT"})
+}
+
+func (s *bookTickerServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type bookTickerServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	bookTicker        *BookTickerWsService
+	bookTickerRequest *BookTickerWsRequest
+}
+
+func TestBookTickerServiceWs(t *testing.T) {
+	suite.Run(t, new(bookTickerServiceWsTestSuite))
+}
+
+func (s *bookTickerServiceWsTestSuite) TestBookTicker() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.bookTicker.Do(s.requestID, s.bookTickerRequest)
+	s.NoError(err)
+}
+
+func (s *bookTickerServiceWsTestSuite) TestBookTicker_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.bookTicker.Do("", s.bookTickerRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *bookTickerServiceWsTestSuite) TestBookTickerSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":[{"symbol":"BNBBTC","bidPrice":"0.01358000","bidQty":"12.53400000","askPrice":"0.01358100","askQty":"17.83700000"},{"symbol":"BTCUSDT","bidPrice":"23980.49000000","bidQty":"0.01000000","askPrice":"23981.31000000","askQty":"0.01512000"}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.bookTicker.SyncDo(s.requestID, s.bookTickerRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 2)
+	s.Equal(&BookTicker{
+		Symbol:      "BTCUSDT",
+		BidPrice:    "23980.49000000",
+		BidQuantity: "0.01000000",
+		AskPrice:    "23981.31000000",
+		AskQuantity: "0.01512000",
+	}, response.Result[1])
+}
+
+func (s *bookTickerServiceWsTestSuite) TestBookTickerSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.bookTicker.SyncDo(s.requestID, s.bookTickerRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *bookTickerServiceWsTestSuite) TestBookTickerSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.bookTicker.SyncDo(s.requestID, s.bookTickerRequest)
+	s.Nil(response)
+	s.Error(err)
+}
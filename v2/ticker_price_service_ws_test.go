@@ -0,0 +1,88 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *tickerPriceServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.tickerPrice = &TickerPriceWsService{
+		c: s.client,
+	}
+
+	s.tickerPriceRequest = NewTickerPriceWsRequest().
+		Symbol("BNBBTC")
+}
+
+func (s *tickerPriceServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type tickerPriceServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	tickerPrice        *TickerPriceWsService
+	tickerPriceRequest *TickerPriceWsRequest
+}
+
+func TestTickerPriceServiceWs(t *testing.T) {
+	suite.Run(t, new(tickerPriceServiceWsTestSuite))
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestTickerPrice() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.tickerPrice.Do(s.requestID, s.tickerPriceRequest)
+	s.NoError(err)
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestTickerPrice_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.tickerPrice.Do("", s.tickerPriceRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestTickerPriceSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":{"symbol":"BNBBTC","price":"0.01361900"}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.tickerPrice.SyncDo(s.requestID, s.tickerPriceRequest)
+	s.Require().NoError(err)
+	s.Equal([]*SymbolPrice{{Symbol: "BNBBTC", Price: "0.01361900"}}, response.Result)
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestTickerPriceSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.tickerPrice.SyncDo(s.requestID, s.tickerPriceRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestTickerPriceSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.tickerPrice.SyncDo(s.requestID, s.tickerPriceRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -52,6 +52,8 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // BookTicker define book ticker info
+//
+//decimalgen:accessors
 type BookTicker struct {
 	Symbol      string `json:"symbol"`
 	BidPrice    string `json:"bidPrice"`
@@ -99,6 +101,8 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // SymbolPrice define symbol and price pair
+//
+//decimalgen:accessors
 type SymbolPrice struct {
 	Symbol string `json:"symbol"`
 	Price  string `json:"price"`
@@ -156,6 +160,8 @@ func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOpt
 }
 
 // PriceChangeStats define price change stats
+//
+//decimalgen:accessors
 type PriceChangeStats struct {
 	Symbol             string `json:"symbol"`
 	PriceChange        string `json:"priceChange"`
@@ -212,6 +218,8 @@ func (s *AveragePriceService) Do(ctx context.Context, opts ...RequestOption) (re
 }
 
 // AvgPrice define average price
+//
+//decimalgen:accessors
 type AvgPrice struct {
 	Mins  int64  `json:"mins"`
 	Price string `json:"price"`
@@ -224,6 +232,7 @@ type ListSymbolTickerService struct {
 	windowSize *string
 }
 
+//decimalgen:accessors
 type SymbolTicker struct {
 	Symbol             string `json:"symbol"`
 	PriceChange        string `json:"priceChange"`
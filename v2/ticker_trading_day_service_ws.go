@@ -0,0 +1,178 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// TradingDayTickerWsService queries price change statistics of the trading day
+type TradingDayTickerWsService struct {
+	c websocket.Client
+}
+
+// NewTradingDayTickerWsService init TradingDayTickerWsService
+func NewTradingDayTickerWsService() (*TradingDayTickerWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TradingDayTickerWsService{
+		c: client,
+	}, nil
+}
+
+// NewTradingDayTickerWsServiceWithSession init TradingDayTickerWsService sending its requests on the connection of session
+func NewTradingDayTickerWsServiceWithSession(session *SessionWsService) *TradingDayTickerWsService {
+	return &TradingDayTickerWsService{
+		c: session.c,
+	}
+}
+
+// TradingDayTickerWsRequest parameters for 'ticker.tradingDay' websocket API
+type TradingDayTickerWsRequest struct {
+	symbol     *string
+	symbols    []string
+	timeZone   *string
+	tickerType *string
+}
+
+// NewTradingDayTickerWsRequest init TradingDayTickerWsRequest
+func NewTradingDayTickerWsRequest() *TradingDayTickerWsRequest {
+	return &TradingDayTickerWsRequest{}
+}
+
+func (s *TradingDayTickerWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *TradingDayTickerWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if len(s.symbols) != 0 {
+		m["symbols"] = s.symbols
+	}
+	if s.timeZone != nil {
+		m["timeZone"] = *s.timeZone
+	}
+	if s.tickerType != nil {
+		m["type"] = *s.tickerType
+	}
+	return m
+}
+
+// Do - sends 'ticker.tradingDay' request
+func (s *TradingDayTickerWsService) Do(requestID string, request *TradingDayTickerWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TickerTradingDaySpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'ticker.tradingDay' request and receives response
+func (s *TradingDayTickerWsService) SyncDo(requestID string, request *TradingDayTickerWsRequest) (*TradingDayTickerWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.TickerTradingDaySpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	tradingDayTickerWsResponse := &TradingDayTickerWsResponse{}
+	if err := json.Unmarshal(response, tradingDayTickerWsResponse); err != nil {
+		return nil, err
+	}
+	if tradingDayTickerWsResponse.Error != nil {
+		tradingDayTickerWsResponse.Error.StatusCode = tradingDayTickerWsResponse.Status
+		return nil, tradingDayTickerWsResponse.Error
+	}
+
+	return tradingDayTickerWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *TradingDayTickerWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *TradingDayTickerWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *TradingDayTickerWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *TradingDayTickerWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *TradingDayTickerWsRequest) Symbol(symbol string) *TradingDayTickerWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols
+func (s *TradingDayTickerWsRequest) Symbols(symbols []string) *TradingDayTickerWsRequest {
+	s.symbols = symbols
+	return s
+}
+
+// TimeZone set timeZone
+func (s *TradingDayTickerWsRequest) TimeZone(timeZone string) *TradingDayTickerWsRequest {
+	s.timeZone = &timeZone
+	return s
+}
+
+// Type set type
+func (s *TradingDayTickerWsRequest) Type(tickerType string) *TradingDayTickerWsRequest {
+	s.tickerType = &tickerType
+	return s
+}
+
+// TradingDayTickerWsResponse define 'ticker.tradingDay' websocket API response
+type TradingDayTickerWsResponse struct {
+	Id     string              `json:"id"`
+	Status int                 `json:"status"`
+	Result []*TradingDayTicker `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON accepts the result of a single symbol as well as a list
+func (r *TradingDayTickerWsResponse) UnmarshalJSON(data []byte) error {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if len(raw.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(common.ToJSONList(raw.Result), &r.Result)
+}
@@ -0,0 +1,90 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *tradingDayTickerServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.tradingDayTicker = &TradingDayTickerWsService{
+		c: s.client,
+	}
+
+	s.tradingDayTickerRequest = NewTradingDayTickerWsRequest().
+		Symbols([]string{"BNBBTC", "BTCUSDT"})
+}
+
+func (s *tradingDayTickerServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type tradingDayTickerServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	tradingDayTicker        *TradingDayTickerWsService
+	tradingDayTickerRequest *TradingDayTickerWsRequest
+}
+
+func TestTradingDayTickerServiceWs(t *testing.T) {
+	suite.Run(t, new(tradingDayTickerServiceWsTestSuite))
+}
+
+func (s *tradingDayTickerServiceWsTestSuite) TestTradingDayTicker() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.tradingDayTicker.Do(s.requestID, s.tradingDayTickerRequest)
+	s.NoError(err)
+}
+
+func (s *tradingDayTickerServiceWsTestSuite) TestTradingDayTicker_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.tradingDayTicker.Do("", s.tradingDayTickerRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *tradingDayTickerServiceWsTestSuite) TestTradingDayTickerSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":[{"symbol":"BNBBTC","priceChange":"0.00000500","priceChangePercent":"0.033","weightedAvgPrice":"0.01531473","openPrice":"0.01530700","highPrice":"0.01538000","lowPrice":"0.01525100","lastPrice":"0.01531200","volume":"26553.46200000","quoteVolume":"406.65706064","openTime":1695686400000,"closeTime":1695772799999,"firstId":299268463,"lastId":299319040,"count":50578},{"symbol":"BTCUSDT","priceChange":"-83.13000000","priceChangePercent":"-0.317","weightedAvgPrice":"26234.58803036","openPrice":"26304.80000000","highPrice":"26397.46000000","lowPrice":"26088.34000000","lastPrice":"26221.67000000","volume":"18495.35066000","quoteVolume":"485217905.04210480","openTime":1695686400000,"closeTime":1695772799999,"firstId":3220151555,"lastId":3220849281,"count":697727}]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.tradingDayTicker.SyncDo(s.requestID, s.tradingDayTickerRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 2)
+	s.Equal("BTCUSDT", response.Result[1].Symbol)
+	s.Equal(uint64(697727), response.Result[1].Count)
+}
+
+func (s *tradingDayTickerServiceWsTestSuite) TestTradingDayTickerSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.tradingDayTicker.SyncDo(s.requestID, s.tradingDayTickerRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *tradingDayTickerServiceWsTestSuite) TestTradingDayTickerSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.tradingDayTicker.SyncDo(s.requestID, s.tradingDayTickerRequest)
+	s.Nil(response)
+	s.Error(err)
+}
@@ -0,0 +1,60 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type timeSyncTestSuite struct {
+	baseTestSuite
+	serverTimeC chan struct{}
+	timestamps  chan int64
+}
+
+func TestTimeSync(t *testing.T) {
+	suite.Run(t, new(timeSyncTestSuite))
+}
+
+func (s *timeSyncTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.serverTimeC = make(chan struct{}, 10)
+	s.timestamps = make(chan int64, 10)
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/api/v3/time" {
+			s.serverTimeC <- struct{}{}
+			// the server clock is 10s behind
+			data := `{"serverTime":` + strconv.FormatInt(time.Now().UnixMilli()-10000, 10) + `}`
+			return newHTTPResponse([]byte(data), http.StatusOK), nil
+		}
+		timestamp, _ := strconv.ParseInt(req.URL.Query().Get(timestampKey), 10, 64)
+		s.timestamps <- timestamp
+		return newHTTPResponse([]byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`), http.StatusBadRequest), nil
+	}
+}
+
+func (s *timeSyncTestSuite) TestTimeSync() {
+	timeSync := s.client.NewTimeSync()
+	timeSync.Samples = 1
+	timeSync.Interval = time.Hour
+	s.r().NoError(timeSync.Start(context.Background()))
+	defer timeSync.Stop()
+	<-s.serverTimeC
+	s.InDelta(10000, timeSync.Offset(), 100)
+
+	s.client.TimeSync = timeSync
+	_, err := s.client.NewGetAccountService().Do(context.Background())
+	s.r().Error(err)
+	s.InDelta(time.Now().UnixMilli()-10000, <-s.timestamps, 100)
+
+	// the timestamp error triggers a resync
+	select {
+	case <-s.serverTimeC:
+	case <-time.After(5 * time.Second):
+		s.Fail("time was not resynced")
+	}
+}
@@ -43,6 +43,8 @@ func (s *TradeFeeService) Do(ctx context.Context) (res []*TradeFeeDetails, err e
 }
 
 // TradeFeeDetails represents details about fees
+//
+//decimalgen:accessors
 type TradeFeeDetails struct {
 	Symbol          string `json:"symbol"`
 	MakerCommission string `json:"makerCommission"`
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // ListTradesService list trades
@@ -88,6 +91,18 @@ func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Iterator walks the trades between startTime and endTime in milliseconds, in windows of 24 hours and pages of 1000
+// trades. FromID is ignored.
+func (s *ListTradesService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*TradeV3] {
+	s.fromID = nil
+	return common.NewCursorIterator(startTime, endTime, 24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*TradeV3, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx, opts...)
+		},
+		func(t *TradeV3) int64 { return t.Time },
+		func(t *TradeV3) int64 { return t.ID })
+}
+
 // HistoricalTradesService trades
 type HistoricalTradesService struct {
 	c      *Client
@@ -142,6 +157,8 @@ func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption)
 }
 
 // Trade define trade info
+//
+//decimalgen:accessors
 type Trade struct {
 	ID            int64  `json:"id"`
 	Price         string `json:"price"`
@@ -154,6 +171,8 @@ type Trade struct {
 }
 
 // TradeV3 define v3 trade info
+//
+//decimalgen:accessors
 type TradeV3 struct {
 	ID              int64  `json:"id"`
 	Symbol          string `json:"symbol"`
@@ -242,7 +261,21 @@ func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res [
 	return res, nil
 }
 
+// Iterator walks the aggregate trades between startTime and endTime in milliseconds, in windows of 1 hour and pages of
+// 1000 trades. FromID is ignored.
+func (s *AggTradesService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*AggTrade] {
+	s.fromID = nil
+	return common.NewCursorIterator(startTime, endTime, time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, limit int) ([]*AggTrade, error) {
+			return s.StartTime(startTime).EndTime(endTime).Limit(limit).Do(ctx, opts...)
+		},
+		func(t *AggTrade) int64 { return t.Timestamp },
+		func(t *AggTrade) int64 { return t.AggTradeID })
+}
+
 // AggTrade define aggregate trade info
+//
+//decimalgen:accessors
 type AggTrade struct {
 	AggTradeID       int64  `json:"a"`
 	Price            string `json:"p"`
@@ -1,7 +1,12 @@
 package binance
 
 import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -233,3 +238,33 @@ func (s *tradeServiceTestSuite) assertTradeEqual(e, a *Trade) {
 	r.Equal(e.IsBuyerMaker, a.IsBuyerMaker, "IsBuyerMaker")
 	r.Equal(e.IsBestMatch, a.IsBestMatch, "IsBestMatch")
 }
+
+func (s *tradeServiceTestSuite) TestListTradesIterator() {
+	day := int64(24 * time.Hour / time.Millisecond)
+	var queries []url.Values
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		queries = append(queries, query)
+		data := `[{"id": 1, "symbol": "BTCUSDT", "time": 100}, {"id": 2, "symbol": "BTCUSDT", "time": 200}]`
+		if query.Get("startTime") != "0" {
+			data = fmt.Sprintf(`[{"id": 3, "symbol": "BTCUSDT", "time": %d}]`, day+100)
+		}
+		return newHTTPResponse([]byte(data), http.StatusOK), nil
+	}
+
+	it := s.client.NewListTradesService().Symbol("BTCUSDT").FromID(10).Iterator(0, 2*day-1)
+	var ids []int64
+	for it.Next(newContext()) {
+		ids = append(ids, it.Value().ID)
+	}
+	s.r().NoError(it.Err())
+	s.r().Equal([]int64{1, 2, 3}, ids)
+
+	s.r().Len(queries, 2)
+	s.r().Equal("0", queries[0].Get("startTime"))
+	s.r().Equal(strconv.FormatInt(day-1, 10), queries[0].Get("endTime"))
+	s.r().Equal(strconv.FormatInt(day, 10), queries[1].Get("startTime"))
+	s.r().Equal(strconv.FormatInt(2*day-1, 10), queries[1].Get("endTime"))
+	s.r().Equal("1000", queries[1].Get("limit"))
+	s.r().Empty(queries[1].Get("fromId"))
+}
@@ -17,6 +17,7 @@ type TradingDayTickerService struct {
 	tickerType *string // [FULL, MINT]. default FULL
 }
 
+//decimalgen:accessors
 type TradingDayTicker struct {
 	Symbol             string `json:"symbol"`
 	PriceChange        string `json:"priceChange"`
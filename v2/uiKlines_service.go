@@ -16,6 +16,7 @@ type UiKlinesService struct {
 	limit     *uint32 // default 500, max 1000
 }
 
+//decimalgen:accessors
 type UiKline struct {
 	OpenTime                 uint64
 	Open                     string
@@ -84,6 +85,11 @@ func (s *UiKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []
 	if err != nil {
 		return nil, err
 	}
+	return parseUiKlines(data)
+}
+
+// parseUiKlines parses klines given as arrays
+func parseUiKlines(data []byte) (res []*UiKline, err error) {
 	j, err := newJSON(data)
 	if err != nil {
 		return []*UiKline{}, err
@@ -0,0 +1,196 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// UiKlinesWsService queries klines optimized for presentation
+type UiKlinesWsService struct {
+	c websocket.Client
+}
+
+// NewUiKlinesWsService init UiKlinesWsService
+func NewUiKlinesWsService() (*UiKlinesWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UiKlinesWsService{
+		c: client,
+	}, nil
+}
+
+// NewUiKlinesWsServiceWithSession init UiKlinesWsService sending its requests on the connection of session
+func NewUiKlinesWsServiceWithSession(session *SessionWsService) *UiKlinesWsService {
+	return &UiKlinesWsService{
+		c: session.c,
+	}
+}
+
+// UiKlinesWsRequest parameters for 'uiKlines' websocket API
+type UiKlinesWsRequest struct {
+	symbol    string
+	interval  string
+	startTime *uint64
+	endTime   *uint64
+	timeZone  *string
+	limit     *uint32
+}
+
+// NewUiKlinesWsRequest init UiKlinesWsRequest
+func NewUiKlinesWsRequest() *UiKlinesWsRequest {
+	return &UiKlinesWsRequest{}
+}
+
+func (s *UiKlinesWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *UiKlinesWsRequest) buildParams() params {
+	m := params{
+		"symbol":   s.symbol,
+		"interval": s.interval,
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.timeZone != nil {
+		m["timeZone"] = *s.timeZone
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'uiKlines' request
+func (s *UiKlinesWsService) Do(requestID string, request *UiKlinesWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.UiKlinesSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'uiKlines' request and receives response
+func (s *UiKlinesWsService) SyncDo(requestID string, request *UiKlinesWsRequest) (*UiKlinesWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(requestID, websocket.UiKlinesSpotWsApiMethod, request.buildParams())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	uiKlinesWsResponse := &UiKlinesWsResponse{}
+	if err := json.Unmarshal(response, uiKlinesWsResponse); err != nil {
+		return nil, err
+	}
+	if uiKlinesWsResponse.Error != nil {
+		uiKlinesWsResponse.Error.StatusCode = uiKlinesWsResponse.Status
+		return nil, uiKlinesWsResponse.Error
+	}
+
+	return uiKlinesWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *UiKlinesWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *UiKlinesWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *UiKlinesWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *UiKlinesWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *UiKlinesWsRequest) Symbol(symbol string) *UiKlinesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *UiKlinesWsRequest) Interval(interval string) *UiKlinesWsRequest {
+	s.interval = interval
+	return s
+}
+
+// StartTime set startTime
+func (s *UiKlinesWsRequest) StartTime(startTime uint64) *UiKlinesWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *UiKlinesWsRequest) EndTime(endTime uint64) *UiKlinesWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// TimeZone set timeZone
+func (s *UiKlinesWsRequest) TimeZone(timeZone string) *UiKlinesWsRequest {
+	s.timeZone = &timeZone
+	return s
+}
+
+// Limit set limit
+func (s *UiKlinesWsRequest) Limit(limit uint32) *UiKlinesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// UiKlinesWsResponse define 'uiKlines' websocket API response
+type UiKlinesWsResponse struct {
+	Id     string     `json:"id"`
+	Status int        `json:"status"`
+	Result []*UiKline `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON parses klines given as arrays
+func (r *UiKlinesWsResponse) UnmarshalJSON(data []byte) (err error) {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if len(raw.Result) == 0 {
+		return nil
+	}
+	r.Result, err = parseUiKlines(raw.Result)
+	return err
+}
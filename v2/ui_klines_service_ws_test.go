@@ -0,0 +1,93 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+func (s *uiKlinesServiceWsTestSuite) SetupTest() {
+	s.requestID = "b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.uiKlines = &UiKlinesWsService{
+		c: s.client,
+	}
+
+	s.uiKlinesRequest = NewUiKlinesWsRequest().
+		Symbol("BNBBTC").
+		Interval("1h").
+		Limit(1)
+}
+
+func (s *uiKlinesServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+type uiKlinesServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	uiKlines        *UiKlinesWsService
+	uiKlinesRequest *UiKlinesWsRequest
+}
+
+func TestUiKlinesServiceWs(t *testing.T) {
+	suite.Run(t, new(uiKlinesServiceWsTestSuite))
+}
+
+func (s *uiKlinesServiceWsTestSuite) TestUiKlines() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).Return(nil).Times(1)
+
+	err := s.uiKlines.Do(s.requestID, s.uiKlinesRequest)
+	s.NoError(err)
+}
+
+func (s *uiKlinesServiceWsTestSuite) TestUiKlines_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).Times(0)
+
+	err := s.uiKlines.Do("", s.uiKlinesRequest)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *uiKlinesServiceWsTestSuite) TestUiKlinesSync() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":200,"result":[[1655971200000,"0.01086000","0.01086600","0.01083600","0.01083800","2290.53800000",1655974799999,"24.85074442",2283,"1171.64000000","12.71225884","0"]]}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.uiKlines.SyncDo(s.requestID, s.uiKlinesRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal(uint64(1655971200000), response.Result[0].OpenTime)
+	s.Equal("24.85074442", response.Result[0].QuoteVolume)
+	s.Equal(uint64(2283), response.Result[0].TradeNum)
+}
+
+func (s *uiKlinesServiceWsTestSuite) TestUiKlinesSync_Error() {
+	rawResponseData := []byte(`{"id":"b3c0c1a4-1c4e-4a8f-9a68-3f0e0b2d6d2e","status":400,"error":{"code":-1121,"msg":"Invalid symbol."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.uiKlines.SyncDo(s.requestID, s.uiKlinesRequest)
+	s.Nil(response)
+	s.True(errors.Is(err, common.ErrInvalidSymbol))
+	s.Equal(400, err.(*common.APIError).StatusCode)
+}
+
+func (s *uiKlinesServiceWsTestSuite) TestUiKlinesSync_WriteError() {
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("write sync: error")).Times(1)
+
+	response, err := s.uiKlines.SyncDo(s.requestID, s.uiKlinesRequest)
+	s.Nil(response)
+	s.Error(err)
+}
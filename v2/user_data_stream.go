@@ -0,0 +1,219 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// userDataOrderLookback is how long before a gap orders are requested, orders that old may still change during it
+const userDataOrderLookback = 24 * time.Hour
+
+// NewUserDataStream creates a user data stream of the spot account which owns its listen key. It keeps the key
+// alive and reconnects when the key has expired or the connection failed. The orders and trades of symbols missed
+// meanwhile are backfilled as executionReport events, nothing is backfilled without symbols. The balance events
+// are lost, a *common.UserStreamGapError is passed to the ErrHandler after every reconnect so the account can be
+// requested again. Set the options of the stream and start it with Run:
+//
+//	stream := client.NewUserDataStream("BTCUSDT")
+//	stream.ErrHandler = func(err error) {
+//		...
+//	}
+//	err := stream.Run(ctx, func(event *binance.WsUserDataEvent) {
+//		...
+//	})
+func (c *Client) NewUserDataStream(symbols ...string) *common.UserStream[*WsUserDataEvent] {
+	stream := common.NewUserStream(common.UserStreamSource[*WsUserDataEvent]{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Serve:   serveUserData,
+		Expired: userDataExpired,
+	})
+	stream.Backfill = func(ctx context.Context, startTime, endTime int64) ([]*WsUserDataEvent, error) {
+		return backfillUserData(ctx, symbols, startTime,
+			func(symbol string) *common.Iterator[*Order] {
+				return c.NewListOrdersService().Symbol(symbol).Iterator(startTime-userDataOrderLookback.Milliseconds(), endTime)
+			},
+			func(symbol string) *common.Iterator[*TradeV3] {
+				return c.NewListTradesService().Symbol(symbol).Iterator(startTime, endTime)
+			})
+	}
+	return stream
+}
+
+// NewMarginUserDataStream creates a user data stream of the cross margin account, see NewUserDataStream
+func (c *Client) NewMarginUserDataStream(symbols ...string) *common.UserStream[*WsUserDataEvent] {
+	stream := common.NewUserStream(common.UserStreamSource[*WsUserDataEvent]{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartMarginUserStreamService().Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveMarginUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseMarginUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Serve:   serveUserData,
+		Expired: userDataExpired,
+	})
+	stream.Backfill = func(ctx context.Context, startTime, endTime int64) ([]*WsUserDataEvent, error) {
+		return backfillUserData(ctx, symbols, startTime,
+			func(symbol string) *common.Iterator[*Order] {
+				return c.NewListMarginOrdersService().Symbol(symbol).Iterator(startTime-userDataOrderLookback.Milliseconds(), endTime)
+			},
+			func(symbol string) *common.Iterator[*TradeV3] {
+				return c.NewListMarginTradesService().Symbol(symbol).Iterator(startTime, endTime)
+			})
+	}
+	return stream
+}
+
+// NewIsolatedMarginUserDataStream creates a user data stream of the isolated margin account of symbol, see
+// NewUserDataStream
+func (c *Client) NewIsolatedMarginUserDataStream(symbol string) *common.UserStream[*WsUserDataEvent] {
+	stream := common.NewUserStream(common.UserStreamSource[*WsUserDataEvent]{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartIsolatedMarginUserStreamService().Symbol(symbol).Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveIsolatedMarginUserStreamService().Symbol(symbol).ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseIsolatedMarginUserStreamService().Symbol(symbol).ListenKey(listenKey).Do(ctx)
+		},
+		Serve:   serveUserData,
+		Expired: userDataExpired,
+	})
+	stream.Backfill = func(ctx context.Context, startTime, endTime int64) ([]*WsUserDataEvent, error) {
+		return backfillUserData(ctx, []string{symbol}, startTime,
+			func(symbol string) *common.Iterator[*Order] {
+				return c.NewListMarginOrdersService().Symbol(symbol).IsIsolated(true).
+					Iterator(startTime-userDataOrderLookback.Milliseconds(), endTime)
+			},
+			func(symbol string) *common.Iterator[*TradeV3] {
+				return c.NewListMarginTradesService().Symbol(symbol).IsIsolated(true).Iterator(startTime, endTime)
+			})
+	}
+	return stream
+}
+
+// serveUserData connects to the stream of listenKey without reconnecting by itself, the stream has to notice the
+// drop to backfill it
+func serveUserData(listenKey string, handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey))
+	cfg.Reconnect = false
+	return wsUserDataServe(cfg, handler, errHandler)
+}
+
+func userDataExpired(event *WsUserDataEvent) bool {
+	return event.Event == "listenKeyExpired"
+}
+
+// backfillUserData rebuilds the executionReport events since startTime from the orders and trades of symbols. Fields
+// which the responses lack are empty.
+func backfillUserData(ctx context.Context, symbols []string, startTime int64,
+	orders func(symbol string) *common.Iterator[*Order], trades func(symbol string) *common.Iterator[*TradeV3]) ([]*WsUserDataEvent, error) {
+	replay := &common.OrderReplay[*Order, *TradeV3, *WsUserDataEvent]{
+		Orders: orders,
+		Trades: trades,
+		OrderInfo: func(o *Order) (id, createTime, updateTime int64) {
+			return o.OrderID, o.Time, o.UpdateTime
+		},
+		TradeInfo: func(t *TradeV3) (id, orderID int64) {
+			return t.ID, t.OrderID
+		},
+		Created: func(o *Order) *WsUserDataEvent {
+			u := orderUpdate(o)
+			u.ExecutionType = "NEW"
+			u.Status = string(OrderStatusTypeNew)
+			u.FilledVolume = "0"
+			u.FilledQuoteVolume = "0"
+			u.TransactionTime = o.Time
+			return executionReport(u)
+		},
+		Ended: func(o *Order) (*WsUserDataEvent, bool) {
+			executionType := ""
+			switch o.Status {
+			case OrderStatusTypeCanceled, OrderStatusTypeRejected, OrderStatusTypeExpired:
+				executionType = string(o.Status)
+			case OrderStatusExpiredInMatch:
+				executionType = "TRADE_PREVENTION"
+			default:
+				return nil, false
+			}
+			u := orderUpdate(o)
+			u.ExecutionType = executionType
+			u.TransactionTime = o.UpdateTime
+			return executionReport(u), true
+		},
+		Traded: func(t *TradeV3, o *Order, found, last bool) *WsUserDataEvent {
+			u := WsOrderUpdate{Symbol: t.Symbol, Id: t.OrderID, Status: string(OrderStatusTypePartiallyFilled)}
+			if t.IsBuyer {
+				u.Side = string(SideTypeBuy)
+			} else {
+				u.Side = string(SideTypeSell)
+			}
+			if found {
+				u = orderUpdate(o)
+				u.Status = string(OrderStatusTypePartiallyFilled)
+				u.FilledVolume = ""
+				u.FilledQuoteVolume = ""
+				if last && o.Status == OrderStatusTypeFilled {
+					u.Status = string(OrderStatusTypeFilled)
+					u.FilledVolume = o.ExecutedQuantity
+					u.FilledQuoteVolume = o.CummulativeQuoteQuantity
+				}
+			}
+			u.ExecutionType = "TRADE"
+			u.TradeId = t.ID
+			u.LatestVolume = t.Quantity
+			u.LatestPrice = t.Price
+			u.LatestQuoteVolume = t.QuoteQuantity
+			u.FeeAsset = t.CommissionAsset
+			u.FeeCost = t.Commission
+			u.IsMaker = t.IsMaker
+			u.TransactionTime = t.Time
+			return executionReport(u)
+		},
+		Time: func(e *WsUserDataEvent) int64 {
+			return e.OrderUpdate.TransactionTime
+		},
+	}
+	return replay.Events(ctx, symbols, startTime)
+}
+
+// orderUpdate returns the order update of the current state of o
+func orderUpdate(o *Order) WsOrderUpdate {
+	return WsOrderUpdate{
+		Symbol:            o.Symbol,
+		ClientOrderId:     o.ClientOrderID,
+		Side:              string(o.Side),
+		Type:              string(o.Type),
+		TimeInForce:       o.TimeInForce,
+		Volume:            o.OrigQuantity,
+		Price:             o.Price,
+		StopPrice:         o.StopPrice,
+		IceBergVolume:     o.IcebergQuantity,
+		OrderListId:       o.OrderListId,
+		Status:            string(o.Status),
+		Id:                o.OrderID,
+		FilledVolume:      o.ExecutedQuantity,
+		FilledQuoteVolume: o.CummulativeQuoteQuantity,
+		IsInOrderBook:     o.IsWorking,
+		CreateTime:        o.Time,
+		QuoteVolume:       o.OrigQuoteOrderQuantity,
+	}
+}
+
+func executionReport(u WsOrderUpdate) *WsUserDataEvent {
+	return &WsUserDataEvent{Event: UserDataEventTypeExecutionReport, Time: u.TransactionTime, OrderUpdate: u}
+}
@@ -1,6 +1,10 @@
 package binance
 
 import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -57,3 +61,72 @@ func (s *userStreamServiceTestSuite) TestCloseUserStream() {
 	err := s.client.NewCloseUserStreamService().ListenKey(listenKey).Do(newContext())
 	s.r().NoError(err)
 }
+
+func (s *userStreamServiceTestSuite) TestUserDataStreamBackfill() {
+	start := int64(1700000000000)
+	orders := []string{
+		fmt.Sprintf(`{"symbol":"BTCUSDT","orderId":1,"origQty":"2","executedQty":"2","cummulativeQuoteQty":"20",
+			"status":"FILLED","side":"BUY","time":%d,"updateTime":%d}`, start-1000, start+10000),
+		fmt.Sprintf(`{"symbol":"BTCUSDT","orderId":2,"origQty":"1","executedQty":"0","cummulativeQuoteQty":"0",
+			"status":"CANCELED","side":"SELL","time":%d,"updateTime":%d}`, start+5000, start+20000),
+		fmt.Sprintf(`{"symbol":"BTCUSDT","orderId":3,"origQty":"1","executedQty":"1","cummulativeQuoteQty":"10",
+			"status":"FILLED","side":"BUY","time":%d,"updateTime":%d}`, start-50000, start-40000),
+	}
+	trades := []string{
+		fmt.Sprintf(`{"id":11,"symbol":"BTCUSDT","orderId":1,"price":"10","qty":"1","quoteQty":"10","time":%d,"isBuyer":true}`, start+8000),
+		fmt.Sprintf(`{"id":12,"symbol":"BTCUSDT","orderId":1,"price":"10","qty":"1","quoteQty":"10","time":%d,"isBuyer":true}`, start+10000),
+	}
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		startTime, _ := strconv.ParseInt(query.Get("startTime"), 10, 64)
+		endTime, _ := strconv.ParseInt(query.Get("endTime"), 10, 64)
+		rows := trades
+		if strings.HasSuffix(req.URL.Path, "/allOrders") {
+			rows = orders
+		}
+		var res []string
+		for _, row := range rows {
+			var t int64
+			fmt.Sscanf(row[strings.Index(row, `"time":`)+7:], "%d", &t)
+			if t >= startTime && t <= endTime {
+				res = append(res, row)
+			}
+		}
+		return newHTTPResponse([]byte("["+strings.Join(res, ",")+"]"), http.StatusOK), nil
+	}
+
+	stream := s.client.NewUserDataStream("BTCUSDT")
+	events, err := stream.Backfill(newContext(), start, start+60000)
+	s.r().NoError(err)
+	var got []string
+	for _, e := range events {
+		s.r().Equal(UserDataEventTypeExecutionReport, e.Event)
+		u := e.OrderUpdate
+		got = append(got, fmt.Sprintf("%d %d %s %s %s", u.TransactionTime-start, u.Id, u.ExecutionType, u.Status, u.FilledVolume))
+	}
+	s.r().Equal([]string{
+		"5000 2 NEW NEW 0",
+		"8000 1 TRADE PARTIALLY_FILLED ",
+		"10000 1 TRADE FILLED 2",
+		"20000 2 CANCELED CANCELED 0",
+	}, got)
+}
+
+func (s *userStreamServiceTestSuite) TestUserDataStreamServeWithoutReconnect() {
+	defer func(reconnect bool, serve func(*WsConfig, WsHandler, ErrHandler, ConnHandler) (chan struct{}, chan struct{}, error)) {
+		WebsocketAutoReconnect = reconnect
+		wsServeWithConnHandler = serve
+	}(WebsocketAutoReconnect, wsServeWithConnHandler)
+	WebsocketAutoReconnect = true
+	var cfg *WsConfig
+	wsServeWithConnHandler = func(c *WsConfig, handler WsHandler, errHandler ErrHandler, connHandler ConnHandler) (doneC, stopC chan struct{}, err error) {
+		cfg = c
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+
+	// the stream backfills after a drop only if the drop closes doneC
+	_, _, err := serveUserData("listenKey", func(event *WsUserDataEvent) {}, func(err error) {})
+	s.r().NoError(err)
+	s.r().Equal(getWsEndpoint()+"/listenKey", cfg.Endpoint)
+	s.r().False(cfg.Reconnect)
+}
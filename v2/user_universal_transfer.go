@@ -193,6 +193,7 @@ type UserUniversalTransferResponse struct {
 	Results []*UserUniversalTransfer `json:"rows"`
 }
 
+//decimalgen:accessors
 type UserUniversalTransfer struct {
 	Asset     string                          `json:"asset"`
 	Amount    string                          `json:"amount"`
@@ -44,6 +44,8 @@ func (s *WalletBalanceService) Do(ctx context.Context, opts ...RequestOption) (r
 }
 
 // WalletBalanceResponse defines the response of WalletBalanceService
+//
+//decimalgen:accessors
 type WalletBalance struct {
 	Activate   bool   `json:"activate"`
 	Balance    string `json:"balance"`
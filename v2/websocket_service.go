@@ -7,7 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/bitly/go-simplejson"
 	"github.com/google/uuid"
 	gorilla "github.com/gorilla/websocket"
 )
@@ -37,8 +39,25 @@ var (
 	// using for websocket API (read/write)
 	WebsocketTimeoutReadWriteConnection = time.Second * 10
 	ProxyUrl                            = ""
+	// WebsocketAutoReconnect makes market data streams redial with backoff after a disconnect
+	// instead of closing doneC
+	WebsocketAutoReconnect = false
+	// WebsocketReconnectHandler is called after a stream has been redialed if WebsocketAutoReconnect is enabled
+	WebsocketReconnectHandler WsReconnectHandler
+	// WebsocketReconnectMinInterval is the initial delay before redialing a dropped stream
+	WebsocketReconnectMinInterval = 100 * time.Millisecond
+	// WebsocketReconnectMaxInterval caps the delay between redial attempts
+	WebsocketReconnectMaxInterval = 10 * time.Second
 )
 
+// optionalTimeSync returns the time sync passed to a constructor, nil if there is none
+func optionalTimeSync(timeSync []*common.TimeSync) *common.TimeSync {
+	if len(timeSync) > 0 {
+		return timeSync[0]
+	}
+	return nil
+}
+
 func getWsProxyUrl() *string {
 	if ProxyUrl == "" {
 		return nil
@@ -104,30 +123,35 @@ func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthH
 			errHandler(err)
 			return
 		}
-		event := new(WsPartialDepthEvent)
-		event.Symbol = symbol
-		event.LastUpdateID = j.Get("lastUpdateId").MustInt64()
-		bidsLen := len(j.Get("bids").MustArray())
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := j.Get("bids").GetIndex(i)
-			event.Bids[i] = Bid{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
+		handler(newWsPartialDepthEvent(j, symbol))
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// newWsPartialDepthEvent parses partial depth event of symbol
+func newWsPartialDepthEvent(j *simplejson.Json, symbol string) *WsPartialDepthEvent {
+	event := new(WsPartialDepthEvent)
+	event.Symbol = symbol
+	event.LastUpdateID = j.Get("lastUpdateId").MustInt64()
+	bidsLen := len(j.Get("bids").MustArray())
+	event.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("bids").GetIndex(i)
+		event.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
 		}
-		asksLen := len(j.Get("asks").MustArray())
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := j.Get("asks").GetIndex(i)
-			event.Asks[i] = Ask{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
+	}
+	asksLen := len(j.Get("asks").MustArray())
+	event.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("asks").GetIndex(i)
+		event.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
 		}
-		handler(event)
 	}
-	return wsServe(cfg, wsHandler, errHandler)
+	return event
 }
 
 // WsCombinedPartialDepthServe is similar to WsPartialDepthServe, but it for multiple symbols
@@ -198,33 +222,38 @@ func wsDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler
 			errHandler(err)
 			return
 		}
-		event := new(WsDepthEvent)
-		event.Event = j.Get("e").MustString()
-		event.Time = j.Get("E").MustInt64()
-		event.Symbol = j.Get("s").MustString()
-		event.LastUpdateID = j.Get("u").MustInt64()
-		event.FirstUpdateID = j.Get("U").MustInt64()
-		bidsLen := len(j.Get("b").MustArray())
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := j.Get("b").GetIndex(i)
-			event.Bids[i] = Bid{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
+		handler(newWsDepthEvent(j))
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// newWsDepthEvent parses diff. depth event
+func newWsDepthEvent(j *simplejson.Json) *WsDepthEvent {
+	event := new(WsDepthEvent)
+	event.Event = j.Get("e").MustString()
+	event.Time = j.Get("E").MustInt64()
+	event.Symbol = j.Get("s").MustString()
+	event.LastUpdateID = j.Get("u").MustInt64()
+	event.FirstUpdateID = j.Get("U").MustInt64()
+	bidsLen := len(j.Get("b").MustArray())
+	event.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("b").GetIndex(i)
+		event.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
 		}
-		asksLen := len(j.Get("a").MustArray())
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := j.Get("a").GetIndex(i)
-			event.Asks[i] = Ask{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
+	}
+	asksLen := len(j.Get("a").MustArray())
+	event.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("a").GetIndex(i)
+		event.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
 		}
-		handler(event)
 	}
-	return wsServe(cfg, wsHandler, errHandler)
+	return event
 }
 
 // WsDepthEvent define websocket depth event
@@ -397,6 +426,8 @@ type WsKlineEvent struct {
 }
 
 // WsKline define websocket kline
+//
+//decimalgen:accessors
 type WsKline struct {
 	StartTime            int64  `json:"t"`
 	EndTime              int64  `json:"T"`
@@ -472,6 +503,8 @@ func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHan
 }
 
 // WsAggTradeEvent define websocket aggregate trade event
+//
+//decimalgen:accessors
 type WsAggTradeEvent struct {
 	Event                 string `json:"e"`
 	Time                  int64  `json:"E"`
@@ -526,6 +559,8 @@ func WsCombinedTradeServe(symbols []string, handler WsCombinedTradeHandler, errH
 }
 
 // WsTradeEvent define websocket trade event
+//
+//decimalgen:accessors
 type WsTradeEvent struct {
 	Event         string `json:"e"`
 	Time          int64  `json:"E"`
@@ -561,6 +596,8 @@ type WsAccountUpdateList struct {
 }
 
 // WsAccountUpdate define account update
+//
+//decimalgen:accessors
 type WsAccountUpdate struct {
 	Asset  string `json:"a"`
 	Free   string `json:"f"`
@@ -573,6 +610,7 @@ type WsBalanceUpdate struct {
 	TransactionTime int64  `json:"T"`
 }
 
+//decimalgen:accessors
 type WsOrderUpdate struct {
 	Symbol                  string          `json:"s"`
 	ClientOrderId           string          `json:"c"`
@@ -656,7 +694,11 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 // Deprecated: Listen key management is deprecated. Use WsUserDataServeSignature instead.
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	return wsUserDataServe(newWsConfig(endpoint), handler, errHandler)
+}
+
+// wsUserDataServe serves the user data stream of cfg
+func wsUserDataServe(cfg *WsConfig, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -707,7 +749,8 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 // WsUserDataServeSignature serves user data handler using signature-based subscription via WebSocket API.
 // This is the recommended method as listen key management has been deprecated by Binance.
 // It connects to the WebSocket API endpoint and subscribes to user data stream using signature authentication.
-func WsUserDataServeSignature(apiKey, secretKey string, keyType string, timeOffset int64, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+// The offset of timeSync, if passed, is used instead of timeOffset.
+func WsUserDataServeSignature(apiKey, secretKey string, keyType string, timeOffset int64, handler WsUserDataHandler, errHandler ErrHandler, timeSync ...*common.TimeSync) (doneC, stopC chan struct{}, err error) {
 	cfg := newWsConfig(getWsApiEndpoint())
 
 	doneC = make(chan struct{})
@@ -725,7 +768,7 @@ func WsUserDataServeSignature(apiKey, secretKey string, keyType string, timeOffs
 		secretKey,
 		timeOffset,
 		keyType,
-	)
+	).WithTimeSync(optionalTimeSync(timeSync))
 	subscribeRequest, err := websocket.CreateRequest(
 		reqData,
 		websocket.UserDataStreamSubscribeSignatureSpotWsApiMethod,
@@ -963,6 +1006,8 @@ func WsAllMarketsStatServe(handler WsAllMarketsStatHandler, errHandler ErrHandle
 type WsAllMarketsStatEvent []*WsMarketStatEvent
 
 // WsMarketStatEvent define websocket market statistics event
+//
+//decimalgen:accessors
 type WsMarketStatEvent struct {
 	Event              string `json:"e"`
 	Time               int64  `json:"E"`
@@ -1012,6 +1057,8 @@ func WsAllMiniMarketsStatServe(handler WsAllMiniMarketsStatServeHandler, errHand
 type WsAllMiniMarketsStatEvent []*WsMiniMarketsStatEvent
 
 // WsMiniMarketsStatEvent define websocket market mini-ticker statistics event
+//
+//decimalgen:accessors
 type WsMiniMarketsStatEvent struct {
 	Event       string `json:"e"`
 	Time        int64  `json:"E"`
@@ -1025,6 +1072,8 @@ type WsMiniMarketsStatEvent struct {
 }
 
 // WsBookTickerEvent define websocket best book ticker event.
+//
+//decimalgen:accessors
 type WsBookTickerEvent struct {
 	UpdateID     int64  `json:"u"`
 	Symbol       string `json:"s"`
@@ -1105,6 +1154,14 @@ func WsApiInitReadWriteConn() (*gorilla.Conn, error) {
 	return conn, err
 }
 
+// wsApiRawResponse define websocket API response with undecoded result, used by responses whose result needs parsing
+type wsApiRawResponse struct {
+	Id     string           `json:"id"`
+	Status int              `json:"status"`
+	Result json.RawMessage  `json:"result"`
+	Error  *common.APIError `json:"error,omitempty"`
+}
+
 type WsAnnouncementEvent struct {
 	CatalogID   int64  `json:"catalogId"`
 	CatalogName string `json:"catalogName"`
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -220,6 +221,52 @@ func (s *websocketServiceTestSuite) assertWsPartialDepthEventEqual(e, a *WsParti
 	}
 }
 
+func (s *websocketServiceTestSuite) TestServeStream() {
+	data := []byte(`{"e": "depthUpdate", "E": 1499404630606, "s": "ETHBTC", "u": 7913455, "U": 7913452, "b": [], "a": []}`)
+	s.mockWsServe(data, errors.New("fake error"))
+	defer s.assertWsServe()
+
+	var events []*WsDepthEvent
+	var errs []error
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := ServeStream(ctx, func(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		return WsDepthServe("ETHBTC", func(event *WsDepthEvent) {
+			events = append(events, event)
+		}, errHandler)
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	s.r().NoError(err)
+	s.r().Len(events, 1)
+	s.r().Equal("ETHBTC", events[0].Symbol)
+	s.r().EqualError(errs[0], "fake error")
+
+	cancel()
+	<-stream.Done()
+	s.r().ErrorIs(stream.Err(), context.Canceled)
+	s.r().NoError(stream.Close())
+}
+
+func (s *websocketServiceTestSuite) TestDepthStream() {
+	data := []byte(`{"e": "depthUpdate", "E": 1499404630606, "s": "ETHBTC", "u": 7913455, "U": 7913452, "b": [], "a": []}`)
+	s.mockWsServe(data, errors.New("fake error"))
+	defer s.assertWsServe()
+
+	var events []*WsDepthEvent
+	ctx, cancel := context.WithCancel(context.Background())
+	// errHandler may be nil, the error is returned by Err if it ends the stream
+	stream, err := WsDepthStream(ctx, "ETHBTC", func(event *WsDepthEvent) {
+		events = append(events, event)
+	}, nil)
+	s.r().NoError(err)
+	s.r().Len(events, 1)
+	s.r().Equal("ETHBTC", events[0].Symbol)
+
+	cancel()
+	<-stream.Done()
+	s.r().ErrorIs(stream.Err(), context.Canceled)
+}
+
 func (s *websocketServiceTestSuite) TestDepthServe() {
 	data := []byte(`{
         "e": "depthUpdate",
@@ -0,0 +1,130 @@
+package binance
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type wsStreamManagerTestSuite struct {
+	suite.Suite
+	server      *httptest.Server
+	origBaseURL string
+	requests    chan map[string]any
+}
+
+func TestWsStreamManager(t *testing.T) {
+	suite.Run(t, new(wsStreamManagerTestSuite))
+}
+
+func (s *wsStreamManagerTestSuite) SetupTest() {
+	s.requests = make(chan map[string]any, 10)
+	upgrader := websocket.Upgrader{}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		for {
+			_, message, err := c.ReadMessage()
+			if err != nil {
+				return
+			}
+			req := map[string]any{}
+			if err = json.Unmarshal(message, &req); err != nil {
+				return
+			}
+			s.requests <- req
+			resp, _ := json.Marshal(map[string]any{"result": nil, "id": req["id"]})
+			if err = c.WriteMessage(websocket.TextMessage, resp); err != nil {
+				return
+			}
+			if req["method"] != "SUBSCRIBE" {
+				continue
+			}
+			for _, stream := range req["params"].([]any) {
+				var data string
+				switch stream {
+				case "bnbbtc@depth":
+					data = `{"e":"depthUpdate","E":1499404630606,"s":"BNBBTC","U":157,"u":160,"b":[["0.0024","10"]],"a":[["0.0026","100"]]}`
+				case "bnbbtc@kline_1m":
+					data = `{"e":"kline","E":1499404907056,"s":"BNBBTC","k":{"t":1499404860000,"T":1499404919999,"s":"BNBBTC","i":"1m","o":"0.10278577","c":"0.10278645","x":false}}`
+				}
+				msg := `{"stream":"` + stream.(string) + `","data":` + data + `}`
+				if err = c.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+					return
+				}
+			}
+		}
+	}))
+	s.origBaseURL = BaseCombinedMainURL
+	BaseCombinedMainURL = "ws" + strings.TrimPrefix(s.server.URL, "http") + "/stream?streams="
+}
+
+func (s *wsStreamManagerTestSuite) TearDownTest() {
+	BaseCombinedMainURL = s.origBaseURL
+	s.server.Close()
+}
+
+func (s *wsStreamManagerTestSuite) TestSubscribe() {
+	m := NewWsStreamManager(func(err error) {
+		s.Fail(err.Error())
+	})
+	defer m.Close()
+
+	depthC := make(chan *WsDepthEvent, 1)
+	stream, err := m.SubscribeDepth("BNBBTC", func(event *WsDepthEvent) {
+		depthC <- event
+	})
+	s.Require().NoError(err)
+	s.Equal("bnbbtc@depth", stream)
+	req := <-s.requests
+	s.Equal("SUBSCRIBE", req["method"])
+	s.Equal([]any{"bnbbtc@depth"}, req["params"])
+
+	select {
+	case event := <-depthC:
+		s.Equal(&WsDepthEvent{
+			Event:         "depthUpdate",
+			Time:          1499404630606,
+			Symbol:        "BNBBTC",
+			FirstUpdateID: 157,
+			LastUpdateID:  160,
+			Bids:          []Bid{{Price: "0.0024", Quantity: "10"}},
+			Asks:          []Ask{{Price: "0.0026", Quantity: "100"}},
+		}, event)
+	case <-time.After(5 * time.Second):
+		s.FailNow("depth event was not delivered")
+	}
+
+	klineC := make(chan *WsKlineEvent, 1)
+	stream, err = m.SubscribeKline("BNBBTC", "1m", func(event *WsKlineEvent) {
+		klineC <- event
+	})
+	s.Require().NoError(err)
+	s.Equal("bnbbtc@kline_1m", stream)
+	<-s.requests
+
+	select {
+	case event := <-klineC:
+		s.Equal("BNBBTC", event.Symbol)
+		s.Equal("1m", event.Kline.Interval)
+		s.Equal("0.10278645", event.Kline.Close)
+	case <-time.After(5 * time.Second):
+		s.FailNow("kline event was not delivered")
+	}
+	s.Equal([]string{"bnbbtc@depth", "bnbbtc@kline_1m"}, m.Streams())
+
+	s.Require().NoError(m.Unsubscribe("bnbbtc@depth"))
+	req = <-s.requests
+	s.Equal("UNSUBSCRIBE", req["method"])
+	s.Equal([]any{"bnbbtc@depth"}, req["params"])
+	s.Equal([]string{"bnbbtc@kline_1m"}, m.Streams())
+}
@@ -0,0 +1,162 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type wsServeTestSuite struct {
+	suite.Suite
+	server      *httptest.Server
+	connections int32
+}
+
+func TestWsServe(t *testing.T) {
+	suite.Run(t, new(wsServeTestSuite))
+}
+
+func (s *wsServeTestSuite) SetupTest() {
+	atomic.StoreInt32(&s.connections, 0)
+	upgrader := websocket.Upgrader{}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		n := atomic.AddInt32(&s.connections, 1)
+		if err := c.WriteMessage(websocket.TextMessage, []byte(`{"n":1}`)); err != nil {
+			return
+		}
+		if n == 1 {
+			// drop the first connection to force a reconnect
+			return
+		}
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+func (s *wsServeTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *wsServeTestSuite) endpoint() string {
+	return "ws" + strings.TrimPrefix(s.server.URL, "http")
+}
+
+func (s *wsServeTestSuite) TestWithoutReconnect() {
+	cfg := newWsConfig(s.endpoint())
+	var errCount int32
+	doneC, _, err := wsServe(cfg, func(message []byte) {}, func(err error) {
+		atomic.AddInt32(&errCount, 1)
+	})
+	s.Require().NoError(err)
+
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream was not closed after disconnect")
+	}
+	s.Equal(int32(1), atomic.LoadInt32(&s.connections))
+	s.Equal(int32(1), atomic.LoadInt32(&errCount))
+}
+
+func (s *wsServeTestSuite) TestReconnect() {
+	cfg := newWsConfig(s.endpoint())
+	cfg.Reconnect = true
+	reconnectedC := make(chan string, 1)
+	cfg.ReconnectHandler = func(endpoint string, attempts int) {
+		s.Equal(1, attempts)
+		reconnectedC <- endpoint
+	}
+	messages := make(chan []byte, 2)
+	doneC, stopC, err := wsServe(cfg, func(message []byte) {
+		messages <- message
+	}, func(err error) {})
+	s.Require().NoError(err)
+
+	select {
+	case endpoint := <-reconnectedC:
+		s.Equal(s.endpoint(), endpoint)
+	case <-doneC:
+		s.FailNow("stream was closed instead of reconnecting")
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream was not reconnected")
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case m := <-messages:
+			s.Equal(`{"n":1}`, string(m))
+		case <-time.After(5 * time.Second):
+			s.FailNow("message was not delivered")
+		}
+	}
+	s.Equal(int32(2), atomic.LoadInt32(&s.connections))
+
+	close(stopC)
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream was not stopped")
+	}
+}
+
+func (s *wsServeTestSuite) TestReconnectClosesDroppedConnections() {
+	s.server.Close()
+	var connections, closed int32
+	upgrader := websocket.Upgrader{}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		if atomic.AddInt32(&connections, 1) > 3 {
+			for {
+				if _, _, err := c.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}
+		// a message over the read limit fails the client without closing the socket, it stays open until the
+		// client closes it
+		if err := c.WriteMessage(websocket.TextMessage, make([]byte, 700000)); err != nil {
+			return
+		}
+		conn := c.UnderlyingConn()
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		buf := make([]byte, 1024)
+		for {
+			if _, err := conn.Read(buf); err != nil {
+				if !errors.Is(err, os.ErrDeadlineExceeded) {
+					atomic.AddInt32(&closed, 1)
+				}
+				return
+			}
+		}
+	}))
+
+	cfg := newWsConfig(s.endpoint())
+	cfg.Reconnect = true
+	doneC, stopC, err := wsServe(cfg, func(message []byte) {}, func(err error) {})
+	s.Require().NoError(err)
+	s.Eventually(func() bool {
+		return atomic.LoadInt32(&closed) == 3 && atomic.LoadInt32(&connections) == 4
+	}, 5*time.Second, 10*time.Millisecond, "dropped connections were not closed")
+
+	close(stopC)
+	<-doneC
+}
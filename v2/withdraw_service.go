@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateWithdrawService submits a withdraw request.
@@ -236,7 +239,19 @@ func (s *ListWithdrawsService) Do(ctx context.Context, opts ...RequestOption) (r
 	return res, nil
 }
 
+// Iterator walks the withdrawals between startTime and endTime in milliseconds, in windows of 90 days and pages of
+// 1000 withdrawals
+func (s *ListWithdrawsService) Iterator(startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Withdraw] {
+	return common.NewOffsetIterator(startTime, endTime, 90*24*time.Hour, 1000,
+		func(ctx context.Context, startTime, endTime int64, page, size int) ([]*Withdraw, error) {
+			return s.StartTime(startTime).EndTime(endTime).Offset((page-1)*size).Limit(size).Do(ctx, opts...)
+		},
+		func(w *Withdraw) string { return w.ID })
+}
+
 // Withdraw represents a single withdraw entry.
+//
+//decimalgen:accessors
 type Withdraw struct {
 	Address         string `json:"address"`
 	Amount          string `json:"amount"`